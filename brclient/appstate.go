@@ -32,6 +32,7 @@ import (
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/client/resources"
+	"github.com/companyzero/bisonrelay/client/resources/paywall"
 	"github.com/companyzero/bisonrelay/client/resources/simplestore"
 	"github.com/companyzero/bisonrelay/client/rpcserver"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
@@ -90,6 +91,14 @@ type inboundRemoteMsg struct {
 	recvts time.Time
 }
 
+// paywallInvoice is an invoice received from a remote user for access to one
+// of their paywalls.
+type paywallInvoice struct {
+	name    string
+	invoice string
+	decoded clientintf.DecodedInvoice
+}
+
 type appState struct {
 	ctx         context.Context
 	cancel      func()
@@ -104,6 +113,7 @@ type appState struct {
 	network     string
 	isRestore   bool
 	rpcServer   *rpcserver.Server
+	rpcTokens   *rpcserver.TokenStore
 
 	lnPC       *client.DcrlnPaymentClient
 	lnRPC      lnrpc.LightningClient
@@ -159,6 +169,13 @@ type appState struct {
 	remoteFiles map[clientintf.UserID]map[clientdb.FileID]clientdb.RemoteFile
 	progressMsg map[clientdb.FileID]*chatMsg
 
+	// lastContentSearch is the query of the last content search.
+	lastContentSearch string
+
+	// paywallInvoices are the last invoices received from remote users
+	// for access to their paywalls.
+	paywallInvoices map[clientintf.UserID]paywallInvoice
+
 	qlenMtx sync.Mutex
 	qlen    int
 
@@ -363,6 +380,22 @@ func (as *appState) run() error {
 	return err
 }
 
+// streamCallAudio streams audio from the capture device to the call and from
+// the call to the playback device, until the call ends.
+func (as *appState) streamCallAudio(cw *chatWindow, call *client.VoiceCall) {
+	go func() {
+		err := as.noterec.Call(as.ctx, call.SendFrame, call.Frames())
+		if err == nil || call.State() == client.CallStateEnded {
+			return
+		}
+		cw.newInternalMsg("Unable to stream call audio: %v", err)
+		as.repaintIfActive(cw)
+		if err := as.c.HangupCall(call.ID, "audio error"); err != nil {
+			as.diagMsg("Unable to hang up call: %v", err)
+		}
+	}()
+}
+
 // recheckLNBalance schedules a re-check of the wallet balance. This blocks
 // until the request is made to the trackLNBalances goroutine.
 func (as *appState) recheckLNBalance() {
@@ -1423,6 +1456,9 @@ func (as *appState) writeInvite(filename string, gcID zkidentity.ShortID, funds
 // pm sends the given pm message in the specified window. Blocks until the
 // messsage is sent to the server.
 func (as *appState) pm(cw *chatWindow, msg string) {
+	if cw.isGC {
+		as.showChannelPublishCost(cw, msg)
+	}
 	m := cw.newUnsentPM(msg)
 	as.repaintIfActive(cw)
 
@@ -1461,6 +1497,24 @@ func (as *appState) pm(cw *chatWindow, msg string) {
 	}
 }
 
+// showChannelPublishCost adds an internal msg to the window with the estimated
+// cost of publishing the message, if the window is for a channel.
+func (as *appState) showChannelPublishCost(cw *chatWindow, msg string) {
+	gc, err := as.c.GetGC(cw.gc)
+	if err != nil || !gc.Channel {
+		return
+	}
+
+	cost, nbDests, err := as.c.EstimateGCMCost(cw.gc, msg)
+	if err != nil {
+		as.log.Warnf("Unable to estimate cost to publish in channel %s: %v",
+			cw.gc, err)
+		return
+	}
+	cw.newInternalMsg("Publishing to %d subscribers (estimated cost %s)",
+		nbDests, dcrutil.Amount(cost/1e3))
+}
+
 // payTip sends a tip to the user of the given window. This blocks until the
 // tip has been paid.
 func (as *appState) payTip(cw *chatWindow, dcrAmount float64) {
@@ -2558,22 +2612,29 @@ func (as *appState) fetchPage(uid clientintf.UserID, pagePath string, session,
 		return err
 	}
 
-	tag, err := as.c.FetchResource(uid, path, nil, session, parent, data)
-	if err != nil {
-		return err
-	}
-
-	// Mark session making a new request (if it already exists).
+	// Mark session making a new request (if it already exists). This is
+	// done before the request is made because cached pages may be
+	// returned before FetchResource() returns.
 	cw := as.findPagesChatWindow(session)
 	if cw != nil {
 		cw.Lock()
 		cw.pageRequested = &path
 		cw.Unlock()
+	}
 
-		if as.activeChatWindow() == cw {
-			// Initialize the page spinner.
-			as.sendMsg(msgActiveCWRequestedPage{[]tea.Cmd{cw.pageSpinner.Tick}})
+	tag, err := as.c.FetchResource(uid, path, nil, session, parent, data)
+	if err != nil {
+		if cw != nil {
+			cw.Lock()
+			cw.pageRequested = nil
+			cw.Unlock()
 		}
+		return err
+	}
+
+	if cw != nil && as.activeChatWindow() == cw {
+		// Initialize the page spinner.
+		as.sendMsg(msgActiveCWRequestedPage{[]tea.Cmd{cw.pageSpinner.Tick}})
 	}
 
 	as.diagMsg("Attempting to fetch %s from %s (session %s, tag %s)",
@@ -2994,6 +3055,27 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 			"Resetting all KXs", oldConnDate.Format(ISO8601DateTime))
 	}))
 
+	ntfns.Register(client.OnSpendingLimitAlertNtfn(func(alert client.SpendingLimitAlert) {
+		spent := float64(alert.SpentMAtoms) / 1e11
+		budget := float64(alert.BudgetMAtoms) / 1e11
+		switch {
+		case alert.Held:
+			as.diagMsg(as.styles.Load().err.Render(fmt.Sprintf("Holding "+
+				"payments: %s budget of spending limit %q would be "+
+				"exceeded (spent %.8f of %.8f DCR). Type /spendlimits "+
+				"approve %s to release them", alert.Period, alert.Limit,
+				spent, budget, alert.Limit)))
+		case alert.Exceeded:
+			as.diagMsg(as.styles.Load().err.Render(fmt.Sprintf("Exceeded "+
+				"%s budget of spending limit %q (spent %.8f of %.8f DCR)",
+				alert.Period, alert.Limit, spent, budget)))
+		default:
+			as.diagMsg("Spent %.8f of %.8f DCR of the %s budget of "+
+				"spending limit %q", spent, budget, alert.Period,
+				alert.Limit)
+		}
+	}))
+
 	ntfns.Register(client.OnKXCompleted(func(_ *clientintf.RawRVID, user *client.RemoteUser, isNew bool) {
 		as.manyDiagMsgsCb(func(pf printf) {
 			if isNew {
@@ -3104,6 +3186,18 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		as.repaintIfActive(cw)
 	}))
 
+	ntfns.Register(client.OnGCHistoryReceivedNtfn(func(ru *client.RemoteUser, gc rpc.RMGroupList, entries []rpc.RMGCHistoryEntry) {
+		cw := as.findOrNewGCWindow(gc.ID)
+		srcNick := strescape.Nick(ru.Nick())
+		cw.newInternalMsg("History from %s (%d messages)", srcNick, len(entries))
+		for _, e := range entries {
+			cw.newRecvdMsg(strescape.Nick(e.From), e.Message, nil,
+				time.Unix(e.Timestamp, 0))
+		}
+		cw.newInternalMsg("End of history from %s", srcNick)
+		as.repaintIfActive(cw)
+	}))
+
 	ntfns.Register(client.OnGCKilledNtfn(func(ru *client.RemoteUser, gcid client.GCID, reason string) {
 		cw := as.findOrNewGCWindow(gcid)
 		cw.newInternalMsg("GC killed by %s. Reason: %q", strescape.Nick(ru.Nick()), reason)
@@ -3322,6 +3416,70 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		as.recheckLNBalance()
 	}))
 
+	ntfns.Register(client.OnPaywallInvoiceNtfn(func(user *client.RemoteUser, name string, invoice string, decoded clientintf.DecodedInvoice) {
+		as.contentMtx.Lock()
+		as.paywallInvoices[user.ID()] = paywallInvoice{
+			name:    name,
+			invoice: invoice,
+			decoded: decoded,
+		}
+		as.contentMtx.Unlock()
+
+		cw := as.findOrNewChatWindow(user.ID(), strescape.Nick(user.Nick()))
+		cw.newInternalMsg("Received invoice of %.8f DCR for access to "+
+			"paywall %q. Type /paywall pay %s to pay it",
+			float64(decoded.MAtoms)/1e11, name, strescape.Nick(user.Nick()))
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnPaywallAccessGrantedNtfn(func(user *client.RemoteUser, access clientdb.PaywallAccess) {
+		expires := "never"
+		if !access.Expires.IsZero() {
+			expires = access.Expires.Format(ISO8601DateTime)
+		}
+		as.diagMsg("%s paid for access to paywall %q (expires: %s)",
+			strescape.Nick(user.Nick()), access.Paywall, expires)
+		as.recheckLNBalance()
+	}))
+
+	ntfns.Register(client.OnCallOfferedNtfn(func(user *client.RemoteUser, call *client.VoiceCall, costPerMinute uint64) {
+		cw := as.findOrNewChatWindow(user.ID(), strescape.Nick(user.Nick()))
+		cw.newInternalMsg("Incoming call %s (estimated cost %s/minute). "+
+			"Type /call accept or /call reject", call.ID.ShortLogID(),
+			dcrutil.Amount(costPerMinute/1e3))
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnCallAnsweredNtfn(func(user *client.RemoteUser, call *client.VoiceCall, accepted bool, reason string) {
+		cw := as.findOrNewChatWindow(user.ID(), strescape.Nick(user.Nick()))
+		if !accepted {
+			msg := fmt.Sprintf("Call %s rejected", call.ID.ShortLogID())
+			if reason != "" {
+				msg += fmt.Sprintf(" (%s)", strescape.Content(reason))
+			}
+			cw.newInternalMsg("%s", msg)
+			as.repaintIfActive(cw)
+			return
+		}
+		cw.newInternalMsg("Call %s accepted", call.ID.ShortLogID())
+		as.repaintIfActive(cw)
+		as.streamCallAudio(cw, call)
+	}))
+
+	ntfns.Register(client.OnCallEndedNtfn(func(user *client.RemoteUser, call *client.VoiceCall, reason string, byRemote bool) {
+		cw := as.findOrNewChatWindow(user.ID(), strescape.Nick(user.Nick()))
+		msg := fmt.Sprintf("Call %s ended after %s", call.ID.ShortLogID(),
+			call.Stats().Duration.Truncate(time.Second))
+		if byRemote {
+			msg += " by remote user"
+		}
+		if reason != "" {
+			msg += fmt.Sprintf(" (%s)", strescape.Content(reason))
+		}
+		cw.newInternalMsg("%s", msg)
+		as.repaintIfActive(cw)
+	}))
+
 	ntfns.Register(client.OnPostSubscriberUpdated(func(user *client.RemoteUser, subscribed bool) {
 		cw := as.findChatWindow(user.ID())
 		msg := fmt.Sprintf("%s subscribed to my posts", strescape.Nick(user.Nick()))
@@ -3388,6 +3546,9 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 				pf("Filename   : %q", meta.Filename)
 				pf("Description: %q", meta.Description)
 				pf("Size       : %d", meta.Size)
+				if meta.IsBundle() {
+					pf("Files      : %d", len(meta.Files))
+				}
 				if dcrPrice == 0 {
 					pf("Cost       : invalid exchange rate")
 				} else {
@@ -3401,6 +3562,10 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		as.repaintIfActive(cw)
 	}))
 
+	ntfns.Register(client.OnContentSearchResultsNtfn(func(ru *client.RemoteUser, search clientdb.ContentSearch) {
+		as.sendMsg(contentSearchUpdated{search: search})
+	}))
+
 	ntfns.Register(client.OnFileDownloadCompleted(func(user *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
 		cw := as.findOrNewChatWindow(user.ID(), strescape.Nick(user.Nick()))
 		cw.newInternalMsg("Download completed: %s",
@@ -3552,12 +3717,16 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		TipUserMaxLifetime:           args.TipUserMaxLifetime,
 		TipUserPayRetryDelayFactor:   args.TipUserPayRetryDelayFactor,
 
+		Webhooks:       args.Webhooks,
+		SpendingLimits: args.SpendingLimits,
+
 		SendReceiveReceipts: args.SendRecvReceipts,
 
 		AutoHandshakeInterval:         args.AutoHandshakeInterval,
 		AutoRemoveIdleUsersInterval:   args.AutoRemoveIdleUsersInterval,
 		AutoRemoveIdleUsersIgnoreList: args.AutoRemoveIdleUsersIgnore,
 		AutoSubscribeToPosts:          args.AutoSubPosts,
+		SwarmDownloads:                args.SwarmDownloads,
 
 		CertConfirmer: func(ctx context.Context, cs *tls.ConnectionState,
 			svrID *zkidentity.PublicIdentity) error {
@@ -3787,9 +3956,15 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		return nil, err
 	}
 
-	// Initialize RPC server.
+	// Initialize RPC server. The token store is always loaded, so that
+	// tokens may be managed even when the RPC server is disabled.
+	rpcTokens, err := rpcserver.NewTokenStore(filepath.Join(args.Root,
+		"rpctokens.json"), logBknd.logger("RPCS"))
+	if err != nil {
+		return nil, err
+	}
 	var rpcServer *rpcserver.Server
-	if len(args.JSONRPCListen) > 0 {
+	if len(args.JSONRPCListen) > 0 || len(args.GRPCListen) > 0 {
 		rpcsLog := logBknd.logger("RPCS")
 		tlsConnCfg := tlsconn.TLSListenersConfig{
 			Addresses:                   args.JSONRPCListen,
@@ -3806,13 +3981,25 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		if err != nil {
 			return nil, err
 		}
+		tlsConnCfg.Addresses = args.GRPCListen
+		tlsConnCfg.NextProtos = []string{"h2"}
+		grpcListeners, err := tlsconn.TLSListeners(tlsConnCfg)
+		if err != nil {
+			return nil, err
+		}
 		rpcServer = rpcserver.New(rpcserver.Config{
 			JSONRPCListeners: jsonListeners,
+			GRPCListeners:    grpcListeners,
 			Log:              rpcsLog,
 			RPCUser:          args.RPCUser,
 			RPCPass:          args.RPCPass,
 			AuthMode:         args.RPCAuthMode,
 		})
+		rpcServer.InitTokenAuth(rpcserver.TokenAuthCfg{
+			Store:     rpcTokens,
+			Client:    c,
+			PayClient: lnPC,
+		})
 		rpcServer.InitVersionService(appName, version.Version)
 		chatRPCServerCfg := rpcserver.ChatServerCfg{
 			Log:                logBknd.logger("RPCS"),
@@ -3884,8 +4071,9 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		}
 
 		resServerCfg := rpcserver.ResourcesServerCfg{
-			Log:    logBknd.logger("RPCS"),
-			Client: c,
+			Log:               logBknd.logger("RPCS"),
+			Client:            c,
+			RootReplayMsgLogs: filepath.Join(args.DBRoot, "replaymsglog"),
 		}
 		if args.ResourcesUpstream == "clientrpc" {
 			resServerCfg.Router = resRouter
@@ -3904,14 +4092,43 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		if err != nil {
 			return nil, err
 		}
+
+		mediaServerCfg := rpcserver.MediaServerCfg{
+			Log:               logBknd.logger("RPCS"),
+			Client:            c,
+			RootReplayMsgLogs: filepath.Join(args.DBRoot, "replaymsglog"),
+		}
+		err = rpcServer.InitMediaService(mediaServerCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		usersServerCfg := rpcserver.UsersServerCfg{
+			Log:               logBknd.logger("RPCS"),
+			Client:            c,
+			RootReplayMsgLogs: filepath.Join(args.DBRoot, "replaymsglog"),
+		}
+		err = rpcServer.InitUsersService(usersServerCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		lnServerCfg := rpcserver.LNServerCfg{
+			Log:       logBknd.logger("RPCS"),
+			PayClient: lnPC,
+		}
+		err = rpcServer.InitLNService(lnServerCfg)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Bind the selected upstream resource provider.
+	var upstream resources.Provider
 	switch {
 	case strings.HasPrefix(args.ResourcesUpstream, "http://"),
 		strings.HasPrefix(args.ResourcesUpstream, "https://"):
-		p := resources.NewHttpProvider(args.ResourcesUpstream)
-		resRouter.BindPrefixPath([]string{}, p)
+		upstream = resources.NewHttpProvider(args.ResourcesUpstream)
 	case strings.HasPrefix(args.ResourcesUpstream, "simplestore:"):
 		// Generate the template store if the path does not exist.
 		path := args.ResourcesUpstream[len("simplestore:"):]
@@ -3948,11 +4165,38 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize simple store: %v", err)
 		}
-		resRouter.BindPrefixPath([]string{}, sstore)
+		upstream = sstore
 	case strings.HasPrefix(args.ResourcesUpstream, "pages:"):
 		path := args.ResourcesUpstream[len("pages:"):]
-		p := resources.NewFilesystemResource(path, logBknd.logger("PAGE"))
-		resRouter.BindPrefixPath([]string{}, p)
+		upstream = resources.NewFilesystemResource(path, logBknd.logger("PAGE"))
+	}
+	if upstream != nil && args.ResourcesPaywallCost > 0 {
+		var prefix []string
+		name := "default"
+		if args.ResourcesPaywallPrefix != "" {
+			prefix = resources.SplitPath(args.ResourcesPaywallPrefix)
+			name = args.ResourcesPaywallPrefix
+		}
+		cost, err := dcrutil.NewAmount(args.ResourcesPaywallCost)
+		if err != nil {
+			return nil, err
+		}
+		pw, err := paywall.New(paywall.Config{
+			Client:              c,
+			Provider:            upstream,
+			Name:                name,
+			MilliAtoms:          int64(cost) * 1000,
+			Duration:            args.ResourcesPaywallDuration,
+			SubscriptionProduct: args.ResourcesPaywallSubscription,
+			Log:                 logBknd.logger("PAYW"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize paywall: %v", err)
+		}
+		resRouter.BindPrefixPath(prefix, pw)
+	}
+	if upstream != nil {
+		resRouter.BindPrefixPath([]string{}, upstream)
 	}
 
 	noterec, err := audio.NewRecorder(logBknd.logger("AREC"))
@@ -3980,6 +4224,7 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		network:   args.Network,
 		isRestore: isRestore,
 		rpcServer: rpcServer,
+		rpcTokens: rpcTokens,
 
 		skipWalletCheckChan: make(chan struct{}),
 
@@ -3995,6 +4240,8 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		remoteFiles: make(map[clientintf.UserID]map[clientdb.FileID]clientdb.RemoteFile),
 		progressMsg: make(map[clientdb.FileID]*chatMsg),
 
+		paywallInvoices: make(map[clientintf.UserID]paywallInvoice),
+
 		activeCW:  activeCWDiag,
 		updatedCW: make(map[int]bool),
 
@@ -85,6 +85,11 @@ root = {{ .Root }}
 # Whether to automatically subscribe to posts of everyone you KX with.
 # autosubposts = 1
 
+# Whether to look for other users that share the same file when downloading
+# and download its chunks from all of them. Only users that replied with the
+# same file to previous content searches are asked.
+# swarmdownloads = 0
+
 # logging and debug
 [log]
 
@@ -186,6 +191,21 @@ minimumsendbalance = 0.01
 # Enable the JSON-RPC clientrpc protocol on the comma-separated list of addresses.
 # jsonrpclisten = 127.0.0.1:7676
 
+# Enable the gRPC clientrpc protocol on the comma-separated list of addresses.
+# It serves the same services as the JSON-RPC protocol, using the same TLS and
+# authentication settings.
+# grpclisten = 127.0.0.1:7677
+
+# Authentication mode of the clientrpc interfaces, in addition to client-side
+# TLS authentication. Requests may always be authenticated with API tokens
+# (managed with the /rpctoken commands), which restrict the methods the client
+# may call, the users it may target and the amount it may spend per day.
+# "token" requires every request to carry an API token. "basic" requires
+# requests without a token to use the rpcuser and rpcpass credentials.
+# rpcauthmode =
+# rpcuser =
+# rpcpass =
+
 # Path to the keypair used for running TLS on the clientrpc interfaces.
 # rpccertpath = {{ .Root }}/rpc.cert
 # rpckeypath = {{ .Root }}/rpc.key
@@ -212,6 +232,22 @@ minimumsendbalance = 0.01
 # upstream = clientrpc
 # upstream = https://example.com
 
+# Require payment to access the resources of the upstream processor under the
+# given path prefix (for example, "premium" requires payment for every page
+# under "premium/"). An empty prefix with a non-zero cost requires payment for
+# every page. Remote users are sent an LN invoice and gain access once it is
+# paid.
+# paywallprefix = premium
+# paywallcost = 0.0001
+
+# How long access lasts after each payment (e.g. 720h for monthly
+# subscriptions). If empty, a single payment grants permanent access.
+# paywallduration =
+
+# SKU of a simplestore subscription product. Users with an active subscription
+# to this product also have access to the resources behind the paywall.
+# paywallsubscription =
+
 [simplestore]
 # paytype defines how to charge for purchases done in the simplestore.  The
 # options are "ln" (use lightning network), "onchain" (generates an on-chain address),
@@ -228,6 +264,31 @@ minimumsendbalance = 0.01
 # cover shipping and handling.
 # shipcharge = 0.0
 
+[webhooks]
+# POST client events to an HTTP endpoint. Each endpoint line is in the form
+# <name>,<url>,<secret>[,<event>...], where <name> identifies the queue of events
+# not yet delivered to the endpoint and <secret> is the key used to sign
+# requests (the X-BR-Signature header is the HMAC-SHA256 of the body). The
+# events are pm, gcm, tip, kx_completed and download_completed; if none are
+# listed, all events are sent. Events are delivered in order and retried with
+# backoff until the endpoint replies with a 2xx status. This option may be
+# specified multiple times.
+# endpoint = mybot,https://example.com/hook,secret,pm,tip
+
+[spendinglimits]
+# Budgets for the payments made automatically by the client (server push and
+# subscription fees, file chunk invoices and tips). Each limit line is in the
+# form <name>,<category>,<user id>,<daily dcr>,<monthly dcr>[,<alert pct>[,hardstop]].
+# <category> is one of tip, file_chunk, sub_fee or push_fee (* for all
+# categories) and <user id> restricts the limit to payments related to a
+# single user (* or empty for all users). An empty budget means no budget for
+# that period. An alert is shown when the spending crosses <alert pct> of a
+# budget. With hardstop, payments that would exceed a budget are held (and the
+# corresponding sends queued) until approved with /spendlimits approve or
+# until the period of the budget ends. This option may be specified multiple
+# times.
+# limit = fees,*,*,0.01,0.1,80,hardstop
+
 [tipuser]
 # restartdelay = 1m
 # rerequestinvoicedelay=24h
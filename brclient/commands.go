@@ -20,7 +20,10 @@ import (
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/client/resources/simplestore"
+	"github.com/companyzero/bisonrelay/client/rpcserver"
 	"github.com/companyzero/bisonrelay/internal/audio"
+	"github.com/companyzero/bisonrelay/internal/mediamsg"
 	"github.com/companyzero/bisonrelay/internal/strescape"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
@@ -888,6 +891,127 @@ var inviteCommands = []tuicmd{
 	},
 }
 
+// parsePayLedgerRange parses the optional [<start date> [<end date>]] args of
+// the payledger commands. The end date is inclusive.
+func parsePayLedgerRange(args []string) (time.Time, time.Time, error) {
+	var start, end time.Time
+	var err error
+	if len(args) > 0 {
+		start, err = time.ParseInLocation(ISO8601Date, args[0], time.Local)
+		if err != nil {
+			return start, end, fmt.Errorf("invalid start date: %v", err)
+		}
+	}
+	if len(args) > 1 {
+		end, err = time.ParseInLocation(ISO8601Date, args[1], time.Local)
+		if err != nil {
+			return start, end, fmt.Errorf("invalid end date: %v", err)
+		}
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end, nil
+}
+
+var payLedgerCommands = []tuicmd{
+	{
+		cmd:           "list",
+		usableOffline: true,
+		usage:         "[<start date> [<end date>]]",
+		descr:         "List the payments made and received",
+		long:          []string{"Dates are in YYYY-MM-DD format. By default, lists the payments of the last 30 days."},
+		handler: func(args []string, as *appState) error {
+			start, end, err := parsePayLedgerRange(args)
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 {
+				start = time.Now().AddDate(0, 0, -30)
+			}
+			entries, err := as.c.ListPayLedger(start, end)
+			if err != nil {
+				return err
+			}
+
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Payment Ledger")
+				var total, totalFees int64
+				for _, e := range entries {
+					nick, _ := as.c.UserNick(e.UID)
+					pf("%s %+14.8f %12.8f %-11s %s - %s",
+						e.Timestamp.Format(ISO8601DateTime),
+						float64(e.Amount)/1e11,
+						float64(-e.PayFee)/1e11, e.Purpose,
+						strescape.Nick(nick), e.Event)
+					total += e.Amount
+					totalFees += e.PayFee
+				}
+				pf("Totals: %+.8f DCR (fees %.8f DCR) in %d payments",
+					float64(total)/1e11, float64(-totalFees)/1e11,
+					len(entries))
+			})
+			return nil
+		},
+	}, {
+		cmd:           "export",
+		usableOffline: true,
+		usage:         "<filename> [<start date> [<end date>]]",
+		descr:         "Export the payments made and received as CSV",
+		long:          []string{"Dates are in YYYY-MM-DD format. By default, exports the full ledger."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "filename cannot be empty"}
+			}
+			filename, err := homedir.Expand(args[0])
+			if err != nil {
+				return err
+			}
+			start, end, err := parsePayLedgerRange(args[1:])
+			if err != nil {
+				return err
+			}
+			entries, err := as.c.ListPayLedger(start, end)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Create(filename)
+			if err != nil {
+				return err
+			}
+			if err := as.c.WritePayLedgerCSV(f, entries); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Exported %d payments to %s", len(entries), filename)
+			return nil
+		},
+	},
+}
+
+var spendLimitsCommands = []tuicmd{
+	{
+		cmd:           "approve",
+		usableOffline: true,
+		usage:         "<limit name>",
+		descr:         "Approve the payments held by a spending limit",
+		long:          []string{"The budgets of the limit are not enforced until the end of the period (day or month) that was exceeded."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "limit name cannot be empty"}
+			}
+			if err := as.c.ApproveSpendingLimit(args[0]); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Approved held payments of spending limit %q", args[0])
+			return nil
+		},
+	},
+}
+
 var gcCommands = []tuicmd{
 	{
 		cmd:           "new",
@@ -1681,6 +1805,191 @@ var gcCommands = []tuicmd{
 			}
 			return nil
 		},
+	}, {
+		cmd:           "newchannel",
+		usableOffline: true,
+		usage:         "<channel name>",
+		descr:         "Create a new announcement-only channel named <channel name>",
+		long: []string{"Only the local client may publish messages in a channel. " +
+			"Subscribers cannot reply and do not learn about other subscribers."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "channel name cannot be empty"}
+			}
+			if _, err := as.c.NewChannel(args[0]); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Channel %q created", args[0])
+			return nil
+		},
+	}, {
+		cmd:           "channelinvite",
+		usableOffline: true,
+		usage:         "<channel> [regen]",
+		descr:         "Show the shareable invite to subscribe to a channel",
+		long: []string{"Any user KX'd with the local client may use the " +
+			"invite (with /gc joinchannel) to subscribe to the channel. " +
+			"Specifying 'regen' creates a new invite, invalidating the " +
+			"previous one."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "channel cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			regen := len(args) > 1 && args[1] == "regen"
+			invite, err := as.c.ChannelShareInvite(gcID, regen)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Shareable invite to channel %q:\n%s",
+				strescape.Nick(invite.Name), invite)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "joinchannel",
+		usage: "<invite>",
+		descr: "Subscribe to a channel using a shareable invite",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "invite cannot be empty"}
+			}
+			invite, err := client.ParseChannelInvite(args[0])
+			if err != nil {
+				return err
+			}
+			go func() {
+				err := as.c.JoinChannel(invite)
+				if err != nil {
+					as.diagMsg("Unable to join channel %q: %v",
+						strescape.Nick(invite.Name), err)
+				} else {
+					as.diagMsg("Requested to join channel %q",
+						strescape.Nick(invite.Name))
+				}
+			}()
+			return nil
+		},
+	}, {
+		cmd:   "estimatecost",
+		usage: "<gc> <message>",
+		descr: "Estimate the cost of sending a message to a GC or channel",
+		rawHandler: func(rawCmd string, args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{"gc name cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{"message cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			_, msg := popNArgs(rawCmd, 3) // cmd + subcmd + gcname
+			cost, nbDests, err := as.c.EstimateGCMCost(gcID, msg)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Cost to send message to %d members: %s",
+				nbDests, dcrutil.Amount(cost/1e3))
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "sharehistory",
+		usableOffline: true,
+		usage:         "<gc> <nb msgs>",
+		descr:         "Set how many recent messages are sent to new members of the GC",
+		long: []string{"When a user joins the GC through an invite sent by the " +
+			"local client, the last <nb msgs> messages of the GC are sent to " +
+			"them. Use 0 to disable sharing the GC history."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "gc name cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "number of messages cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			nbMsgs, err := strconv.Atoi(args[1])
+			if err != nil {
+				return usageError{msg: fmt.Sprintf("invalid number of messages: %v", err)}
+			}
+			if err := as.c.SetGCShareHistory(gcID, nbMsgs); err != nil {
+				return err
+			}
+			if nbMsgs == 0 {
+				as.cwHelpMsg("Disabled sharing history with new GC members")
+			} else {
+				as.cwHelpMsg("Sharing the last %d messages with new GC members",
+					nbMsgs)
+			}
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "sendhistory",
+		usage: "<gc> <nick> [<nb msgs>]",
+		descr: "Send the recent GC history to a member of the GC",
+		long: []string{"The remote member only merges the history if it joined " +
+			"the GC and has not merged any history since then. Defaults to 50 messages."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "gc name cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "nick cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			uid, err := as.c.UIDByNick(args[1])
+			if err != nil {
+				return err
+			}
+			nbMsgs := 50
+			if len(args) > 2 {
+				if nbMsgs, err = strconv.Atoi(args[2]); err != nil {
+					return usageError{msg: fmt.Sprintf("invalid number of messages: %v", err)}
+				}
+			}
+			if err := as.c.SendGCHistory(gcID, uid, nbMsgs); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Sending GC history to %s", strescape.Nick(args[1]))
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			if len(args) == 1 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
 	},
 }
 
@@ -1768,66 +2077,150 @@ var ftCommands = []tuicmd{
 
 		},
 	}, {
-		cmd:           "list",
+		cmd:           "sharedir",
 		usableOffline: true,
-		aliases:       []string{"ls", "l"},
-		usage:         "<nick> <*|shared|global> [<filename_regex>]",
-		descr:         "List files of a remote peer",
-		handler: func(args []string, as *appState) error {
-			if len(args) < 1 {
-				return usageError{msg: "nick cannot be empty"}
-			}
-			if len(args) < 2 {
-				return usageError{msg: "dir cannot be empty"}
-			}
-
-			uid, err := as.c.UIDByNick(args[0])
-			if err != nil {
-				return err
-			}
-
-			cw := as.findOrNewChatWindow(uid, args[0])
-			var filter string
-			if len(args) > 2 {
-				filter = args[2]
-			}
-			go as.listUserContent(cw, args[1], filter)
-			return nil
+		usage:         "<dir> <cost> [<nick>]",
+		descr:         "Share all files in the given dir as a single bundle",
+		long: []string{
+			"Imports all files in the passed dir (recursively) into the local FTP repository as a single bundle. The bundle is bought and downloaded as a single file and the downloader recreates the dir tree. The cost is specified in DCR.",
+			"Symlinks are not followed. They (and other non-regular files) are not included in the bundle and are reported in the log.",
+			"If a nick or user ID is specified, the bundle is shared only to that user.",
+			"By default, the passed cost is *added* to the estimated upload cost. Use \"=<amount>\" to directly specify the full cost",
 		},
 		completer: func(args []string, arg string, as *appState) []string {
 			if len(args) == 0 {
+				return fileCompleter(arg)
+			}
+			if len(args) == 2 {
 				return nickCompleter(arg, as)
 			}
 			return nil
 		},
-	}, {
-		cmd:   "get",
-		usage: "<nick> [<filename> | <FID>]",
-		descr: "Fetch the given file from the remote peer",
-		long: []string{
-			"The file can be referenced either as a filename (in which case the local client must have had a /ft ls issued first) or a full file ID.",
-			"If the file requires payment, the remote peer will send an invoice that will be automatically paid before actually receiving the file's contents",
-		},
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "nick cannot be empty"}
+				return usageError{msg: "dir cannot be empty"}
 			}
 			if len(args) < 2 {
-				return usageError{msg: "filename cannot be empty"}
+				return usageError{msg: "cost cannot be empty"}
+			}
+			if len(args[1]) < 1 {
+				return usageError{msg: "cost cannot be the empty string"}
 			}
 
-			uid, err := as.c.UIDByNick(args[0])
+			dir, err := homedir.Expand(args[0])
+			if err != nil {
+				return err
+			}
+			size, err := dirSize(dir)
 			if err != nil {
 				return err
 			}
 
-			cw := as.findOrNewChatWindow(uid, args[0])
-			go as.getUserContent(cw, args[1])
-			return nil
-		},
-		completer: func(args []string, arg string, as *appState) []string {
-			if len(args) == 0 {
-				return nickCompleter(arg, as)
+			var dcrCost, dcrUploadCost float64
+			// Figure out upload cost.
+			policy := as.serverPolicy()
+			uploadCost, err := clientintf.EstimateUploadCost(size, &policy)
+			if err != nil {
+				return err
+			}
+			dcrUploadCost = float64(uploadCost) / 1e11
+
+			if args[1][0] == '=' {
+				// Exact cost specified.
+				dcrCost, err = strconv.ParseFloat(args[1][1:], 64)
+				if err != nil {
+					return err
+				}
+			} else {
+				// Upload cost + overcharge
+				dcrCost, err = strconv.ParseFloat(args[1], 64)
+				if err != nil {
+					return err
+				}
+				dcrCost += dcrUploadCost
+			}
+
+			var uid *clientintf.UserID
+			with := ""
+			if len(args) > 2 {
+				id, err := as.c.UIDByNick(args[2])
+				if err != nil {
+					return err
+				}
+				uid = &id
+				with = fmt.Sprintf(" with %q", args[2])
+			}
+			atomCost := uint64(dcrCost * 1e8)
+			sf, md, err := as.c.ShareDir(dir, uid, atomCost, "")
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Shared bundle %q (%d files) for %.8f DCR (est. cost %.8f DCR)%s. FID: %s",
+				sf.Filename, len(md.Files), dcrCost, dcrUploadCost, with,
+				sf.FID)
+			return nil
+		},
+	}, {
+		cmd:           "list",
+		usableOffline: true,
+		aliases:       []string{"ls", "l"},
+		usage:         "<nick> <*|shared|global> [<filename_regex>]",
+		descr:         "List files of a remote peer",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "nick cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "dir cannot be empty"}
+			}
+
+			uid, err := as.c.UIDByNick(args[0])
+			if err != nil {
+				return err
+			}
+
+			cw := as.findOrNewChatWindow(uid, args[0])
+			var filter string
+			if len(args) > 2 {
+				filter = args[2]
+			}
+			go as.listUserContent(cw, args[1], filter)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "get",
+		usage: "<nick> [<filename> | <FID>]",
+		descr: "Fetch the given file from the remote peer",
+		long: []string{
+			"The file can be referenced either as a filename (in which case the local client must have had a /ft ls issued first) or a full file ID.",
+			"If the file requires payment, the remote peer will send an invoice that will be automatically paid before actually receiving the file's contents",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "nick cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "filename cannot be empty"}
+			}
+
+			uid, err := as.c.UIDByNick(args[0])
+			if err != nil {
+				return err
+			}
+
+			cw := as.findOrNewChatWindow(uid, args[0])
+			go as.getUserContent(cw, args[1])
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
 			}
 			return nil
 		},
@@ -2005,6 +2398,169 @@ var ftCommands = []tuicmd{
 			as.cwHelpMsg("Canceled download of file %s", matches[0])
 			return nil
 		},
+	}, {
+		cmd:   "search",
+		usage: "<query> [<nick>...]",
+		descr: "Search the files shared by all (or the given) users",
+		long: []string{
+			"Asks the given users (or all users, if none are specified) for files with a filename or description that matches the query. The query is a case insensitive regular expression. Use quotes for queries with spaces.",
+			"Results are shown in a new window as replies are received.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "query must be specified"}
+			}
+
+			uids := make([]clientintf.UserID, 0, len(args)-1)
+			for _, nick := range args[1:] {
+				uid, err := as.c.UIDByNick(nick)
+				if err != nil {
+					return err
+				}
+				uids = append(uids, uid)
+			}
+
+			if err := as.c.SearchContent(args[0], uids); err != nil {
+				return err
+			}
+			as.contentMtx.Lock()
+			as.lastContentSearch = args[0]
+			as.contentMtx.Unlock()
+			as.sendMsg(showContentSearchWindow{query: args[0]})
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) > 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "searchresults",
+		usableOffline: true,
+		usage:         "[<query>]",
+		descr:         "Show the results of a previous content search",
+		long: []string{
+			"Shows the cached results of the search for the query, or of the last search made if no query is specified.",
+		},
+		handler: func(args []string, as *appState) error {
+			var query string
+			if len(args) > 0 {
+				query = args[0]
+			} else {
+				as.contentMtx.Lock()
+				query = as.lastContentSearch
+				as.contentMtx.Unlock()
+			}
+			if query == "" {
+				return usageError{msg: "query must be specified"}
+			}
+			as.sendMsg(showContentSearchWindow{query: query})
+			return nil
+		},
+	}, {
+		cmd:   "findsources",
+		descr: "Look for other users sharing the file of an in-progress download",
+		usage: "<file id prefix> [<nick>...]",
+		long: []string{
+			"Queries the given users (or the users that replied with the same content to previous content searches, if none are specified) for files with the same content as the in-progress download. Chunks of the download are then fetched from all users that have the file and do not charge more for it than the original source.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "file id must be specified"}
+			}
+			fds, err := as.c.ListDownloads()
+			if err != nil {
+				return err
+			}
+
+			var matches []clientintf.FileID
+			for _, fd := range fds {
+				if fd.CompletedName == "" && strings.HasPrefix(fd.FID.String(), args[0]) {
+					matches = append(matches, fd.FID)
+				}
+			}
+
+			if len(matches) == 0 {
+				return fmt.Errorf("file with id %q not found", args[0])
+			}
+			if len(matches) > 1 {
+				return fmt.Errorf("more than one file with id %q exists", args[0])
+			}
+
+			uids := make([]clientintf.UserID, 0, len(args)-1)
+			for _, nick := range args[1:] {
+				uid, err := as.c.UIDByNick(nick)
+				if err != nil {
+					return err
+				}
+				uids = append(uids, uid)
+			}
+
+			err = as.c.FindDownloadSources(matches[0], uids)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Looking for other sources of file %s", matches[0])
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) > 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "stream",
+		descr: "Stream a download to a local media player",
+		usage: "<file id prefix>",
+		long: []string{
+			"Switches the download to sequential mode (fetching its chunks in order) and prints a local URL from which the file can be played while it is downloading.",
+			"Reads of parts of the file that have not been downloaded yet block until they are received. Seeking prioritizes fetching the chunks at the new position.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "file id must be specified"}
+			}
+			fds, err := as.c.ListDownloads()
+			if err != nil {
+				return err
+			}
+
+			var matches []clientdb.FileDownload
+			for _, fd := range fds {
+				if strings.HasPrefix(fd.FID.String(), args[0]) {
+					matches = append(matches, fd)
+				}
+			}
+
+			if len(matches) == 0 {
+				return fmt.Errorf("file with id %q not found", args[0])
+			}
+			if len(matches) > 1 {
+				return fmt.Errorf("more than one file with id %q exists", args[0])
+			}
+			fd := matches[0]
+			if fd.Metadata == nil {
+				return fmt.Errorf("metadata for file %s not received yet", fd.FID)
+			}
+			if fd.Metadata.IsBundle() {
+				return fmt.Errorf("bundles cannot be streamed")
+			}
+
+			if fd.CompletedName == "" {
+				if err := as.c.SetDownloadSequential(fd.FID, true); err != nil {
+					return err
+				}
+			}
+
+			streamURL, err := as.c.DownloadStreamURL(fd.FID)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Stream %q from %s", fd.Metadata.Filename, streamURL)
+			return nil
+		},
 	},
 }
 
@@ -3126,9 +3682,11 @@ var lnCommands = []tuicmd{
 
 var pagesCommands = []tuicmd{
 	{
-		cmd:   "view",
-		descr: "View user page",
-		usage: "<nick> [<path/to/page>]",
+		cmd:           "view",
+		descr:         "View user page",
+		usage:         "<nick> [<path/to/page>]",
+		usableOffline: true,
+		long:          []string{"While offline, only pages that were previously fetched from the user and cached locally can be viewed."},
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
 				return usageError{msg: "nick cannot be empty"}
@@ -3171,52 +3729,196 @@ var pagesCommands = []tuicmd{
 			nextSess := clientintf.PagesSessionID(0)
 			return as.fetchPage(as.c.PublicID(), pagePath, nextSess, 0, nil)
 		},
-	},
-}
-
-var filterCommands = []tuicmd{
-	{
-		cmd:           "list",
+	}, {
+		cmd:           "storestats",
+		descr:         "Show the sales statistics of the local simplestore",
+		usage:         "[<days>]",
 		usableOffline: true,
-		aliases:       []string{"ls"},
-		descr:         "Lists content-based filters",
+		long:          []string{"Shows the revenue, orders and cart conversion of the last number of days (default 30)."},
 		handler: func(args []string, as *appState) error {
-			filters := as.c.ListContentFilters()
-			if len(filters) == 0 {
-				as.cwHelpMsg("Client has no content-based filters")
-				return nil
+			if as.sstore == nil {
+				return fmt.Errorf("simplestore is not enabled")
+			}
+			days, err := parseStoreDays(args, 0)
+			if err != nil {
+				return err
+			}
+			stats, err := as.sstore.Stats(simplestore.LastDays(days))
+			if err != nil {
+				return err
 			}
 
+			usd := func(v int64) string {
+				return fmt.Sprintf("$%.2f", float64(v)/100)
+			}
 			as.cwHelpMsgs(func(pf printf) {
 				pf("")
-				pf("Content filters (%d total)", len(filters))
-				for _, cf := range filters {
-					var s string
-					if !cf.SkipPMs {
-						s += "PMs, "
-					}
-					if !cf.SkipGCMs {
-						s += "GCMs"
-						if cf.GC != nil && !cf.GC.IsEmpty() {
-							gc, _ := as.c.GetGCAlias(*cf.GC)
-							s += fmt.Sprintf(" gc=%s", strescape.Nick(gc))
-						}
-						s += ", "
-
-					}
-					if !cf.SkipPosts {
-						s += "posts, "
-					}
-					if !cf.SkipPostComments {
-						s += "post comments, "
-					}
-					if cf.UID != nil {
-						nick, _ := as.c.UserNick(*cf.UID)
-						s += fmt.Sprintf("user=%s, ", strescape.Nick(nick))
-					}
-
-					s += fmt.Sprintf("regexp=\"%s\"", cf.Regexp)
-					pf("%08d - %s", cf.ID, s)
+				pf("Store stats since %s", stats.From.Format("2006-01-02"))
+				pf("Revenue: %s (discounts %s, shipping %s)",
+					usd(stats.RevenueCents), usd(stats.DiscountCents),
+					usd(stats.ShippingCents))
+				pf("Orders: %d (%d paid, %d pending, %d expired, %d renewals)",
+					stats.Orders, stats.PaidOrders, stats.PendingOrders,
+					stats.ExpiredOrders, stats.RenewalOrders)
+				pf("Open carts: %d - Conversion: %.1f%%", stats.OpenCarts,
+					stats.Conversion*100)
+				for _, dr := range stats.ByDay {
+					pf("  %s - %d orders - %s", dr.Day, dr.Orders,
+						usd(dr.RevenueCents))
+				}
+				for _, ps := range stats.ByProduct {
+					pf("  %s - %s - %d units - %s", ps.SKU, ps.Title,
+						ps.Quantity, usd(ps.RevenueCents))
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "exportorders",
+		descr:         "Export the orders of the local simplestore",
+		usage:         "<filename> [<days>]",
+		usableOffline: true,
+		long:          []string{"Exports the orders placed in the last number of days (default 30), including their shipping addresses. Orders are exported as JSON if the filename ends in .json, otherwise as CSV (one row per order item)."},
+		handler: func(args []string, as *appState) error {
+			if as.sstore == nil {
+				return fmt.Errorf("simplestore is not enabled")
+			}
+			if len(args) < 1 {
+				return usageError{msg: "filename cannot be empty"}
+			}
+			days, err := parseStoreDays(args, 1)
+			if err != nil {
+				return err
+			}
+			fname := cleanAndExpandPath(args[0])
+			from, to := simplestore.LastDays(days)
+			if err := as.sstore.ExportOrders(fname, from, to); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Exported orders of the last %d days to %s",
+				days, fname)
+			return nil
+		},
+	}, {
+		cmd:           "cached",
+		descr:         "List pages cached locally",
+		usage:         "[<nick>]",
+		usableOffline: true,
+		handler: func(args []string, as *appState) error {
+			var uid *clientintf.UserID
+			if len(args) > 0 {
+				id, err := as.c.UIDByNick(args[0])
+				if err != nil {
+					return err
+				}
+				uid = &id
+			}
+
+			crs, err := as.c.ListCachedResources(uid)
+			if err != nil {
+				return err
+			}
+			if len(crs) == 0 {
+				as.cwHelpMsg("No cached pages")
+				return nil
+			}
+
+			now := time.Now()
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Cached pages (%d total)", len(crs))
+				for _, cr := range crs {
+					nick, _ := as.c.UserNick(cr.UID)
+					state := "stale"
+					if cr.IsFresh(now) {
+						state = "fresh"
+					}
+					pf("%s %s %s (%s, %s)", strescape.Nick(nick),
+						strescape.ResourcesPath(cr.Path),
+						cr.Fetched.Format(ISO8601DateTime), state,
+						hbytes(int64(len(cr.Response.Data))))
+				}
+			})
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "clearcache",
+		descr:         "Remove locally cached pages",
+		usage:         "[<nick>]",
+		usableOffline: true,
+		handler: func(args []string, as *appState) error {
+			var uid *clientintf.UserID
+			if len(args) > 0 {
+				id, err := as.c.UIDByNick(args[0])
+				if err != nil {
+					return err
+				}
+				uid = &id
+			}
+			if err := as.c.ClearResourceCache(uid); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Removed cached pages")
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	},
+}
+
+var filterCommands = []tuicmd{
+	{
+		cmd:           "list",
+		usableOffline: true,
+		aliases:       []string{"ls"},
+		descr:         "Lists content-based filters",
+		handler: func(args []string, as *appState) error {
+			filters := as.c.ListContentFilters()
+			if len(filters) == 0 {
+				as.cwHelpMsg("Client has no content-based filters")
+				return nil
+			}
+
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Content filters (%d total)", len(filters))
+				for _, cf := range filters {
+					var s string
+					if !cf.SkipPMs {
+						s += "PMs, "
+					}
+					if !cf.SkipGCMs {
+						s += "GCMs"
+						if cf.GC != nil && !cf.GC.IsEmpty() {
+							gc, _ := as.c.GetGCAlias(*cf.GC)
+							s += fmt.Sprintf(" gc=%s", strescape.Nick(gc))
+						}
+						s += ", "
+
+					}
+					if !cf.SkipPosts {
+						s += "posts, "
+					}
+					if !cf.SkipPostComments {
+						s += "post comments, "
+					}
+					if cf.UID != nil {
+						nick, _ := as.c.UserNick(*cf.UID)
+						s += fmt.Sprintf("user=%s, ", strescape.Nick(nick))
+					}
+
+					s += fmt.Sprintf("regexp=\"%s\"", cf.Regexp)
+					pf("%08d - %s", cf.ID, s)
 				}
 			})
 
@@ -3623,124 +4325,471 @@ var audioCmds = []tuicmd{
 		usage:         "Select the capture device",
 		descr:         "[<device index>]",
 		handler: func(args []string, as *appState) error {
-			if len(args) == 0 {
-				as.noterec.SetCaptureDevice(nil)
-				as.diagMsg("Using default device for audio capture")
-				return nil
+			if len(args) == 0 {
+				as.noterec.SetCaptureDevice(nil)
+				as.diagMsg("Using default device for audio capture")
+				return nil
+			}
+
+			devIndex, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				return usageError{msg: "Argument not a number"}
+			}
+			if devIndex < 0 {
+				return usageError{msg: "Device index cannot be negative"}
+			}
+
+			devices, err := audio.ListAudioDevices(as.log)
+			if err != nil {
+				return err
+			}
+			if devIndex >= int64(len(devices.Capture)) {
+				return fmt.Errorf("device %d does not exist", devIndex)
+			}
+
+			err = as.noterec.SetCaptureDevice(&devices.Capture[devIndex])
+			return err
+		},
+	}, {
+		cmd:           "playbackdevice",
+		usableOffline: true,
+		aliases:       []string{"playdevice", "pdevice", "playdev"},
+		usage:         "Select the playback device",
+		descr:         "[<device index>]",
+		handler: func(args []string, as *appState) error {
+			if len(args) == 0 {
+				as.noterec.SetPlaybackDevice(nil)
+				as.diagMsg("Using default device for audio capture")
+				return nil
+			}
+
+			devIndex, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				return usageError{msg: "Argument not a number"}
+			}
+			if devIndex < 0 {
+				return usageError{msg: "Device index cannot be negative"}
+			}
+
+			devices, err := audio.ListAudioDevices(as.log)
+			if err != nil {
+				return err
+			}
+			if devIndex >= int64(len(devices.Playback)) {
+				return fmt.Errorf("device %d does not exist", devIndex)
+			}
+
+			err = as.noterec.SetPlaybackDevice(&devices.Playback[devIndex])
+			return err
+		},
+	}, {
+		cmd:   "send",
+		descr: "Send an audio note",
+		usage: "[<target>]",
+		handler: func(args []string, as *appState) error {
+			var targetId clientintf.UserID
+			var targetIsGC bool
+
+			if len(args) > 0 {
+				uid, err := as.c.UIDByNick(args[0])
+				if err == nil {
+					targetId = uid
+				} else if gcid, err := as.c.GCIDByName(args[0]); err == nil {
+					targetId = gcid
+					targetIsGC = true
+				} else {
+					return usageError{"Target user or GC not found"}
+				}
+			} else {
+				cw := as.activeChatWindow()
+				if cw == nil || cw.isPage {
+					return usageError{"No target specified"}
+				}
+				if cw.isGC {
+					targetId = cw.gc
+					targetIsGC = true
+				} else {
+					targetId = cw.uid
+				}
+			}
+
+			as.sendMsg(msgSendAudioNote{targetID: targetId, targetIsGC: targetIsGC})
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "test",
+		usableOffline: true,
+		descr:         "Record and playback a 3-second test note",
+		handler: func(args []string, as *appState) error {
+			go func() {
+				as.diagMsg("Starting 3 second capture")
+				ctx, cancel := context.WithTimeout(as.ctx, 3*time.Second)
+				defer cancel()
+				err := as.noterec.Capture(ctx)
+				if err != nil {
+					as.diagMsg("Error capturing audio: %v", err)
+					return
+				}
+
+				as.diagMsg("Starting playback")
+				err = as.noterec.Playback(as.ctx)
+				if err != nil {
+					as.diagMsg("Error playing back audio: %v", err)
+				}
+			}()
+			return nil
+		},
+	},
+}
+
+var mediaCmds = []tuicmd{
+	{
+		cmd:   "send",
+		descr: "Send an audio or video file to a user or GC",
+		usage: "<target> <filename> [<caption>]",
+		long: []string{
+			"Small files are embedded in the message, while larger files are sent as a file transfer to the target (or to every member of the target GC).",
+			"Duration and codec are determined from the file when possible.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "target cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "filename cannot be empty"}
+			}
+
+			var uid clientintf.UserID
+			var gcID zkidentity.ShortID
+			var cw *chatWindow
+			if id, err := as.c.UIDByNick(args[0]); err == nil {
+				uid = id
+				cw = as.findOrNewChatWindow(uid, "")
+			} else if id, err := as.c.GCIDByName(args[0]); err == nil {
+				gcID = id
+				cw = as.findOrNewGCWindow(gcID)
+			} else {
+				return usageError{msg: "target user or GC not found"}
+			}
+
+			m, err := mediamsg.LoadFile(args[1])
+			if err != nil {
+				return err
+			}
+			caption := strings.Join(args[2:], " ")
+
+			go func() {
+				var inline bool
+				var err error
+				if cw.isGC {
+					var cost uint64
+					var nbDests int
+					cost, nbDests, err = as.c.EstimateMediaGCMCost(gcID, m, caption)
+					if err != nil {
+						as.diagMsg("Unable to estimate cost to send media %q: %v",
+							m.Filename, err)
+						return
+					}
+					cw.newInternalMsg("Sending media to %d members (estimated cost %s)",
+						nbDests, dcrutil.Amount(cost/1e3))
+					as.repaintIfActive(cw)
+					inline, err = as.c.SendMediaGCM(gcID, m, caption)
+				} else {
+					inline, err = as.c.SendMediaPM(uid, m, caption)
+				}
+				if err != nil {
+					as.diagMsg("Unable to send media %q: %v",
+						m.Filename, err)
+					return
+				}
+				how := "as a file transfer"
+				if inline {
+					how = "inline"
+				}
+				cw.newInternalMsg("Sent media %q (%s) %s",
+					m.Filename, m.Duration.Truncate(time.Second), how)
+				as.repaintIfActive(cw)
+			}()
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return append(nickCompleter(arg, as), gcCompleter(arg, as)...)
+			}
+			if len(args) == 1 {
+				return fileCompleter(arg)
+			}
+			return nil
+		},
+	},
+}
+
+// findCallArg returns the call identified by arg, which may be a prefix of the
+// call ID or the nick of the remote user. When arg is empty and there is a
+// single call, that call is returned.
+func findCallArg(arg string, as *appState) (*client.VoiceCall, error) {
+	calls := as.c.ListCalls()
+	if arg == "" {
+		if len(calls) == 1 {
+			return calls[0], nil
+		}
+		if len(calls) == 0 {
+			return nil, client.ErrCallNotFound
+		}
+		return nil, usageError{msg: "multiple calls in progress; specify the call"}
+	}
+	for _, call := range calls {
+		if strings.HasPrefix(call.ID.String(), arg) {
+			return call, nil
+		}
+		if nick, _ := as.c.UserNick(call.Peer); nick == arg {
+			return call, nil
+		}
+	}
+	return nil, client.ErrCallNotFound
+}
+
+var paywallCmds = []tuicmd{
+	{
+		cmd:   "pay",
+		descr: "Pay for access to a remote user's paywall",
+		usage: "<nick>",
+		long: []string{
+			"Pays the last invoice received from the user for access to the pages behind one of their paywalls. Reload the page after the payment completes.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "nick cannot be empty"}
+			}
+			uid, err := as.c.UIDByNick(args[0])
+			if err != nil {
+				return err
+			}
+
+			as.contentMtx.Lock()
+			pi, ok := as.paywallInvoices[uid]
+			as.contentMtx.Unlock()
+			if !ok {
+				return fmt.Errorf("no paywall invoice received from %s", args[0])
+			}
+			if pi.decoded.IsExpired(0) {
+				return fmt.Errorf("paywall invoice from %s expired", args[0])
+			}
+
+			cw := as.findOrNewChatWindow(uid, args[0])
+			cw.newInternalMsg("Paying %.8f DCR for access to paywall %q",
+				float64(pi.decoded.MAtoms)/1e11, pi.name)
+			as.repaintIfActive(cw)
+			go func() {
+				err := as.c.PayPaywallInvoice(uid, pi.name, pi.invoice)
+				if err != nil {
+					cw.newInternalMsg("Unable to pay for access to "+
+						"paywall %q: %v", pi.name, err)
+				} else {
+					as.contentMtx.Lock()
+					if as.paywallInvoices[uid].invoice == pi.invoice {
+						delete(as.paywallInvoices, uid)
+					}
+					as.contentMtx.Unlock()
+					cw.newInternalMsg("Paid for access to paywall %q",
+						pi.name)
+				}
+				as.repaintIfActive(cw)
+			}()
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "access",
+		descr: "List remote users with access to the local paywalls",
+		usage: "[<paywall>]",
+		handler: func(args []string, as *appState) error {
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			}
+			pas, err := as.c.ListPaywallAccess(name)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			as.manyDiagMsgsCb(func(pf printf) {
+				pf("Paywall access")
+				for _, pa := range pas {
+					nick, _ := as.c.UserNick(pa.UID)
+					if nick == "" {
+						nick = pa.UID.ShortLogID()
+					}
+					var status string
+					switch {
+					case pa.HasAccess(now) && pa.Expires.IsZero():
+						status = "permanent access"
+					case pa.HasAccess(now):
+						status = "access until " + pa.Expires.Format(ISO8601DateTime)
+					case len(pa.Invoices) > 0:
+						status = "invoice pending"
+					default:
+						status = "no access"
+					}
+					pf("%s - %s - %s (paid %.8f DCR)", pa.Paywall,
+						strescape.Nick(nick), status,
+						float64(pa.PaidMAtoms)/1e11)
+				}
+			})
+			return nil
+		},
+	},
+}
+
+var callCmds = []tuicmd{
+	{
+		cmd:   "offer",
+		descr: "Call a remote user",
+		usage: "<nick>",
+		long: []string{
+			"Offers a real-time voice call to the remote user. Audio starts streaming once the remote user accepts the call.",
+			"While in a call, every packet of audio is paid for individually, so check the estimated cost per minute with /call cost.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "nick cannot be empty"}
 			}
-
-			devIndex, err := strconv.ParseInt(args[0], 10, 32)
+			uid, err := as.c.UIDByNick(args[0])
 			if err != nil {
-				return usageError{msg: "Argument not a number"}
+				return err
 			}
-			if devIndex < 0 {
-				return usageError{msg: "Device index cannot be negative"}
+			cost, err := as.c.EstimateCallCost()
+			if err != nil {
+				return err
 			}
-
-			devices, err := audio.ListAudioDevices(as.log)
+			call, err := as.c.OfferCall(uid)
 			if err != nil {
 				return err
 			}
-			if devIndex >= int64(len(devices.Capture)) {
-				return fmt.Errorf("device %d does not exist", devIndex)
+			cw := as.findOrNewChatWindow(uid, args[0])
+			cw.newInternalMsg("Calling %s (call %s, estimated cost %s/minute)",
+				cw.alias, call.ID.ShortLogID(), dcrutil.Amount(cost/1e3))
+			as.repaintIfActive(cw)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
 			}
-
-			err = as.noterec.SetCaptureDevice(&devices.Capture[devIndex])
-			return err
+			return nil
 		},
 	}, {
-		cmd:           "playbackdevice",
-		usableOffline: true,
-		aliases:       []string{"playdevice", "pdevice", "playdev"},
-		usage:         "Select the playback device",
-		descr:         "[<device index>]",
+		cmd:   "accept",
+		descr: "Accept a call offered by a remote user",
+		usage: "[<call id or nick>]",
 		handler: func(args []string, as *appState) error {
-			if len(args) == 0 {
-				as.noterec.SetPlaybackDevice(nil)
-				as.diagMsg("Using default device for audio capture")
-				return nil
+			var arg string
+			if len(args) > 0 {
+				arg = args[0]
 			}
-
-			devIndex, err := strconv.ParseInt(args[0], 10, 32)
+			call, err := findCallArg(arg, as)
 			if err != nil {
-				return usageError{msg: "Argument not a number"}
+				return err
 			}
-			if devIndex < 0 {
-				return usageError{msg: "Device index cannot be negative"}
+			call, err = as.c.AcceptCall(call.ID)
+			if err != nil {
+				return err
 			}
-
-			devices, err := audio.ListAudioDevices(as.log)
+			cw := as.findOrNewChatWindow(call.Peer, "")
+			cw.newInternalMsg("Accepted call %s", call.ID.ShortLogID())
+			as.repaintIfActive(cw)
+			as.streamCallAudio(cw, call)
+			return nil
+		},
+	}, {
+		cmd:   "reject",
+		descr: "Reject a call offered by a remote user",
+		usage: "[<call id or nick>] [<reason>]",
+		handler: func(args []string, as *appState) error {
+			var arg, reason string
+			if len(args) > 0 {
+				arg = args[0]
+			}
+			if len(args) > 1 {
+				reason = strings.Join(args[1:], " ")
+			}
+			call, err := findCallArg(arg, as)
 			if err != nil {
 				return err
 			}
-			if devIndex >= int64(len(devices.Playback)) {
-				return fmt.Errorf("device %d does not exist", devIndex)
+			if err := as.c.RejectCall(call.ID, reason); err != nil {
+				return err
 			}
-
-			err = as.noterec.SetPlaybackDevice(&devices.Playback[devIndex])
-			return err
+			cw := as.findOrNewChatWindow(call.Peer, "")
+			cw.newInternalMsg("Rejected call %s", call.ID.ShortLogID())
+			as.repaintIfActive(cw)
+			return nil
 		},
 	}, {
-		cmd:   "send",
-		descr: "Send an audio note",
-		usage: "[<target>]",
+		cmd:     "hangup",
+		aliases: []string{"end"},
+		descr:   "Hang up an active call",
+		usage:   "[<call id or nick>]",
 		handler: func(args []string, as *appState) error {
-			var targetId clientintf.UserID
-			var targetIsGC bool
-
+			var arg string
 			if len(args) > 0 {
-				uid, err := as.c.UIDByNick(args[0])
-				if err == nil {
-					targetId = uid
-				} else if gcid, err := as.c.GCIDByName(args[0]); err == nil {
-					targetId = gcid
-					targetIsGC = true
-				} else {
-					return usageError{"Target user or GC not found"}
-				}
-			} else {
-				cw := as.activeChatWindow()
-				if cw == nil || cw.isPage {
-					return usageError{"No target specified"}
-				}
-				if cw.isGC {
-					targetId = cw.gc
-					targetIsGC = true
-				} else {
-					targetId = cw.uid
-				}
+				arg = args[0]
 			}
-
-			as.sendMsg(msgSendAudioNote{targetID: targetId, targetIsGC: targetIsGC})
-			return nil
+			call, err := findCallArg(arg, as)
+			if err != nil {
+				return err
+			}
+			return as.c.HangupCall(call.ID, "")
 		},
-		completer: func(args []string, arg string, as *appState) []string {
-			if len(args) == 0 {
-				return nickCompleter(arg, as)
+	}, {
+		cmd:   "list",
+		descr: "List the calls in progress",
+		handler: func(args []string, as *appState) error {
+			calls := as.c.ListCalls()
+			if len(calls) == 0 {
+				as.cwHelpMsg("No calls in progress")
+				return nil
 			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("Calls in progress")
+				for _, call := range calls {
+					nick, _ := as.c.UserNick(call.Peer)
+					dir := "from"
+					if call.Outgoing {
+						dir = "to"
+					}
+					stats := call.Stats()
+					pf("%s %s %s - %s - %s, sent %d recv %d lost %d packets",
+						call.ID.ShortLogID(), dir,
+						strescape.Nick(nick), call.State(),
+						stats.Duration.Truncate(time.Second),
+						stats.PacketsSent, stats.PacketsReceived,
+						stats.PacketsLost)
+				}
+			})
 			return nil
 		},
 	}, {
-		cmd:           "test",
-		usableOffline: true,
-		descr:         "Record and playback a 3-second test note",
+		cmd:   "cost",
+		descr: "Show the estimated cost per minute of a call",
 		handler: func(args []string, as *appState) error {
-			go func() {
-				as.diagMsg("Starting 3 second capture")
-				ctx, cancel := context.WithTimeout(as.ctx, 3*time.Second)
-				defer cancel()
-				err := as.noterec.Capture(ctx)
-				if err != nil {
-					as.diagMsg("Error capturing audio: %v", err)
-					return
-				}
-
-				as.diagMsg("Starting playback")
-				err = as.noterec.Playback(as.ctx)
-				if err != nil {
-					as.diagMsg("Error playing back audio: %v", err)
-				}
-			}()
+			cost, err := as.c.EstimateCallCost()
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Estimated cost of calls: %s/minute",
+				dcrutil.Amount(cost/1e3))
 			return nil
 		},
 	},
@@ -3838,6 +4887,151 @@ var profileCmds = []tuicmd{
 	},
 }
 
+var rpcTokenCmds = []tuicmd{
+	{
+		cmd:           "mint",
+		usableOffline: true,
+		descr:         "Create a new clientrpc API token",
+		usage:         "<name> <methods> [users=<nick>,...] [maxdcr=<amount>] [expires=<duration>] [localfiles=true]",
+		long: []string{
+			"Creates an API token that allows calling the comma-separated list of clientrpc methods (Service.Method). Methods may be patterns, such as 'ChatService.*Stream' or '*'.",
+			"The 'users' argument restricts the users that may be sent messages, files and tips with the token. The 'maxdcr' argument is the maximum amount of DCR the token may spend per day on tips and funded invites (by default, the token may not spend funds). The 'expires' argument is the duration after which the token expires (e.g. 720h). The 'localfiles' argument allows the token to send and share local files (by default, the token may only send media embedded in the request).",
+			"The token is only displayed once, so it should be copied to the clientrpc client. Requests are authenticated with the token by sending it in a 'Authorization: Bearer <token>' header.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 2 {
+				return usageError{msg: "name and methods cannot be empty"}
+			}
+			name := args[0]
+			perms := rpcserver.TokenPermissions{
+				Methods: strings.Split(args[1], ","),
+			}
+			var expires *time.Time
+			for _, arg := range args[2:] {
+				key, value, _ := strings.Cut(arg, "=")
+				switch key {
+				case "users":
+					for _, nick := range strings.Split(value, ",") {
+						uid, err := as.c.UIDByNick(nick)
+						if err != nil {
+							return err
+						}
+						perms.Users = append(perms.Users, uid.String())
+					}
+				case "maxdcr":
+					dcr, err := strconv.ParseFloat(value, 64)
+					if err != nil {
+						return err
+					}
+					amount, err := dcrutil.NewAmount(dcr)
+					if err != nil {
+						return err
+					}
+					perms.MaxAtomsPerDay = int64(amount)
+				case "localfiles":
+					allow, err := strconv.ParseBool(value)
+					if err != nil {
+						return err
+					}
+					perms.LocalFiles = allow
+				case "expires":
+					d, err := time.ParseDuration(value)
+					if err != nil {
+						return err
+					}
+					t := time.Now().Add(d)
+					expires = &t
+				default:
+					return usageError{msg: fmt.Sprintf("unknown argument %q", arg)}
+				}
+			}
+
+			token, secret, err := as.rpcTokens.Mint(name, perms, expires)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("Created API token %s (%s)", token.ID, token.Name)
+				pf("Token: %s", secret)
+				pf("This token will not be displayed again.")
+			})
+			return nil
+		},
+	}, {
+		cmd:           "list",
+		aliases:       []string{"ls"},
+		usableOffline: true,
+		descr:         "List the clientrpc API tokens",
+		handler: func(args []string, as *appState) error {
+			tokens := as.rpcTokens.List()
+			now := time.Now()
+			as.cwHelpMsgs(func(pf printf) {
+				pf("API tokens")
+				for _, t := range tokens {
+					var status string
+					switch {
+					case t.Revoked != nil:
+						status = "revoked " + t.Revoked.Format(ISO8601DateTime)
+					case !t.IsActive(now):
+						status = "expired " + t.Expires.Format(ISO8601DateTime)
+					case t.Expires != nil:
+						status = "expires " + t.Expires.Format(ISO8601DateTime)
+					default:
+						status = "active"
+					}
+					pf("%s - %s - %s", t.ID, t.Name, status)
+					pf("  methods: %s", strings.Join(t.Permissions.Methods, ","))
+					if len(t.Permissions.Users) > 0 {
+						nicks := make([]string, len(t.Permissions.Users))
+						for i, u := range t.Permissions.Users {
+							nicks[i] = u
+							var uid clientintf.UserID
+							if uid.FromString(u) != nil {
+								continue
+							}
+							if nick, err := as.c.UserNick(uid); err == nil {
+								nicks[i] = strescape.Nick(nick)
+							}
+						}
+						pf("  users: %s", strings.Join(nicks, ","))
+					}
+					if t.Permissions.LocalFiles {
+						pf("  local files: allowed")
+					}
+					if t.Permissions.MaxAtomsPerDay > 0 {
+						pf("  spent today: %s of %s",
+							dcrutil.Amount(t.SpentToday(now)),
+							dcrutil.Amount(t.Permissions.MaxAtomsPerDay))
+					}
+					if t.LastUsed != nil {
+						pf("  last used: %s", t.LastUsed.Format(ISO8601DateTime))
+					}
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "revoke",
+		usableOffline: true,
+		descr:         "Revoke a clientrpc API token",
+		usage:         "<id>",
+		long: []string{
+			"Revokes the token with the given id (or unique id prefix). Requests made with a revoked token are immediately rejected.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "id cannot be empty"}
+			}
+			token, err := as.rpcTokens.Revoke(args[0])
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Revoked API token %s (%s)", token.ID, token.Name)
+			return nil
+		},
+	},
+}
+
 var commands = []tuicmd{
 	{
 		cmd:           "backup",
@@ -4540,6 +5734,66 @@ var commands = []tuicmd{
 			}
 			return nil
 		},
+	}, {
+		cmd:   "payledger",
+		usage: "[sub]",
+		descr: "Payment ledger commands",
+		sub:   payLedgerCommands,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(payLedgerCommands, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
+	}, {
+		cmd:           "spendlimits",
+		usableOffline: true,
+		usage:         "[sub]",
+		descr:         "Show the status of the spending limits",
+		sub:           spendLimitsCommands,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(spendLimitsCommands, arg, false)
+			}
+			return nil
+		},
+		handler: func(args []string, as *appState) error {
+			status := as.c.SpendingLimitsStatus()
+			if len(status) == 0 {
+				return fmt.Errorf("no spending limits configured")
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Spending Limits (DCR)")
+				for _, st := range status {
+					l := st.Limit
+					category := l.Category
+					if category == "" {
+						category = "*"
+					}
+					user := "*"
+					if l.User != nil {
+						user, _ = as.c.UserNick(*l.User)
+					}
+					pf("%s - category %s - user %s", l.Name,
+						category, strescape.Nick(user))
+					pf("  daily %.8f / %.8f - monthly %.8f / %.8f",
+						float64(st.DailySpentMAtoms)/1e11,
+						float64(l.DailyMAtoms)/1e11,
+						float64(st.MonthlySpentMAtoms)/1e11,
+						float64(l.MonthlyMAtoms)/1e11)
+					if st.Held {
+						pf("  payments held waiting for approval")
+					}
+					if st.ApprovedUntil.After(time.Now()) {
+						pf("  approved until %s",
+							st.ApprovedUntil.Format(ISO8601DateTime))
+					}
+				}
+			})
+			return nil
+		},
 	}, {
 		cmd:           "rmpaystats",
 		usableOffline: true,
@@ -4757,6 +6011,51 @@ var commands = []tuicmd{
 			return nil
 		},
 		handler: subcmdNeededHandler,
+	}, {
+		cmd:   "media",
+		descr: "Audio and video message commands",
+		sub:   mediaCmds,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(mediaCmds, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
+	}, {
+		cmd:   "paywall",
+		descr: "Paywall related commands",
+		sub:   paywallCmds,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(paywallCmds, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
+	}, {
+		cmd:           "rpctoken",
+		usableOffline: true,
+		descr:         "Manage the API tokens of the clientrpc interface",
+		sub:           rpcTokenCmds,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(rpcTokenCmds, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
+	}, {
+		cmd:   "call",
+		descr: "Real-time voice call commands",
+		sub:   callCmds,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(callCmds, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
 	}, {
 		cmd:   "profile",
 		descr: "Profiling related commands",
@@ -14,10 +14,12 @@ import (
 	"os/user"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/companyzero/bisonrelay/brclient/internal/version"
+	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/go-socks/socks"
@@ -109,6 +111,7 @@ type config struct {
 	NoLoadChatHistory bool
 	SendRecvReceipts  bool
 	AutoSubPosts      bool
+	SwarmDownloads    bool
 
 	AutoHandshakeInterval       time.Duration
 	AutoRemoveIdleUsersInterval time.Duration
@@ -133,6 +136,7 @@ type config struct {
 	InviteFundsAccount string
 
 	JSONRPCListen      []string
+	GRPCListen         []string
 	RPCCertPath        string
 	RPCKeyPath         string
 	RPCClientCAPath    string
@@ -152,11 +156,20 @@ type config struct {
 
 	ExternalEditorForComments bool
 
-	ResourcesUpstream     string
+	ResourcesUpstream            string
+	ResourcesPaywallPrefix       string
+	ResourcesPaywallCost         float64
+	ResourcesPaywallDuration     time.Duration
+	ResourcesPaywallSubscription string
+
 	SimpleStorePayType    simpleStorePayType
 	SimpleStoreAccount    string
 	SimpleStoreShipCharge float64
 
+	Webhooks []client.WebhookEndpoint
+
+	SpendingLimits []client.SpendingLimit
+
 	dialFunc func(context.Context, string, string) (net.Conn, error)
 }
 
@@ -295,6 +308,10 @@ func loadConfig() (*config, error) {
 	flagCircuitLimit := fs.Uint("circuitlimit", 32, "max number of open connections per proxy connection")
 	var mimetypes cfgStringArray
 	fs.Var(&mimetypes, "mimetype", "List of mimetypes with viewer")
+	var webhookEndpoints cfgStringArray
+	fs.Var(&webhookEndpoints, "webhooks.endpoint", "List of webhook endpoints")
+	var spendingLimitLines cfgStringArray
+	fs.Var(&spendingLimitLines, "spendinglimits.limit", "List of spending limits")
 
 	flagBellCmd := fs.String("bellcmd", "", "Bell command on new msgs")
 	flagSyncFreeList := fs.Bool("syncfreelist", true, "")
@@ -307,6 +324,7 @@ func loadConfig() (*config, error) {
 	flagAutoRemove := fs.String("autoremoveidleusersinterval", "60d", "")
 	flagAutoRemoveIgnoreList := fs.String("autoremoveignorelist", defaultAutoRemoveIgnoreList, "")
 	flagAutoSubPosts := fs.Bool("autosubposts", true, "")
+	flagSwarmDownloads := fs.Bool("swarmdownloads", false, "")
 
 	// log
 	flagMsgRoot := fs.String("log.msglog", defaultMsgRoot, "Root for message log files")
@@ -338,6 +356,7 @@ func loadConfig() (*config, error) {
 
 	// clientrpc
 	flagJSONRPCListen := fs.String("clientrpc.jsonrpclisten", "", "Comma delimited list of JSON-RPC server binding addresses")
+	flagGRPCListen := fs.String("clientrpc.grpclisten", "", "Comma delimited list of gRPC server binding addresses")
 	flagRPCCertPath := fs.String("clientrpc.rpccertpath", defaultRPCCertPath, "")
 	flagRPCKeyPath := fs.String("clientrpc.rpckeypath", defaultRPCKeyPath, "")
 	flagRPCClientCAPath := fs.String("clientrpc.rpcclientcapath", defaultRPCClientCA, "")
@@ -356,6 +375,10 @@ func loadConfig() (*config, error) {
 
 	// resources
 	flagResourcesUpstream := fs.String("resources.upstream", "", "Upstream processor of resource requests")
+	flagResourcesPaywallPrefix := fs.String("resources.paywallprefix", "", "Path prefix of resources that require payment")
+	flagResourcesPaywallCost := fs.Float64("resources.paywallcost", 0, "Cost (in DCR) to access resources behind the paywall")
+	flagResourcesPaywallDuration := fs.String("resources.paywallduration", "", "Duration of access to resources behind the paywall after each payment")
+	flagResourcesPaywallSubscription := fs.String("resources.paywallsubscription", "", "SKU of the simplestore subscription that grants access to the paywall")
 
 	// simplestore
 	flagSimpleStorePayType := fs.String("simplestore.paytype", "", "How to charge for paystore purchases")
@@ -461,6 +484,32 @@ func loadConfig() (*config, error) {
 		mimeMap[spl[0]] = spl[1]
 	}
 
+	webhooks := make([]client.WebhookEndpoint, 0, len(webhookEndpoints))
+	for _, line := range webhookEndpoints {
+		spl := strings.Split(line, ",")
+		if len(spl) < 3 {
+			return nil, fmt.Errorf("invalid webhook endpoint line: %v", line)
+		}
+		for i := range spl {
+			spl[i] = strings.TrimSpace(spl[i])
+		}
+		webhooks = append(webhooks, client.WebhookEndpoint{
+			Name:   spl[0],
+			URL:    spl[1],
+			Secret: spl[2],
+			Events: spl[3:],
+		})
+	}
+
+	spendingLimits := make([]client.SpendingLimit, 0, len(spendingLimitLines))
+	for _, line := range spendingLimitLines {
+		limit, err := parseSpendingLimit(line)
+		if err != nil {
+			return nil, err
+		}
+		spendingLimits = append(spendingLimits, limit)
+	}
+
 	autoRemoveIgnoreList := strings.Split(*flagAutoRemoveIgnoreList, ",")
 	for i := range autoRemoveIgnoreList {
 		autoRemoveIgnoreList[i] = strings.TrimSpace(autoRemoveIgnoreList[i])
@@ -470,6 +519,10 @@ func loadConfig() (*config, error) {
 	if *flagJSONRPCListen != "" {
 		jrpcListen = strings.Split(*flagJSONRPCListen, ",")
 	}
+	var grpcListen []string
+	if *flagGRPCListen != "" {
+		grpcListen = strings.Split(*flagGRPCListen, ",")
+	}
 
 	var lnRPCListen []string
 	if *flagLNRPCListen != "" {
@@ -528,6 +581,18 @@ func loadConfig() (*config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("invalid value for 'tipuser.payretrydelayfactor': %v", err)
 	}
+	var resourcesPaywallDuration time.Duration
+	if *flagResourcesPaywallDuration != "" {
+		resourcesPaywallDuration, err = strduration.ParseDuration(*flagResourcesPaywallDuration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for 'resources.paywallduration': %v", err)
+		}
+	}
+	if *flagResourcesPaywallCost < 0 {
+		return nil, fmt.Errorf("invalid value for 'resources.paywallcost': %f",
+			*flagResourcesPaywallCost)
+	}
+
 	// Return the final cfg object.
 	return &config{
 		ServerAddr:             *flagServerAddr,
@@ -570,6 +635,7 @@ func loadConfig() (*config, error) {
 		WinPin:                 winpin,
 		MimeMap:                mimeMap,
 		JSONRPCListen:          jrpcListen,
+		GRPCListen:             grpcListen,
 		RPCCertPath:            *flagRPCCertPath,
 		RPCKeyPath:             *flagRPCKeyPath,
 		RPCClientCAPath:        *flagRPCClientCAPath,
@@ -591,20 +657,96 @@ func loadConfig() (*config, error) {
 		AutoRemoveIdleUsersInterval: autoRemoveInterval,
 		AutoRemoveIdleUsersIgnore:   autoRemoveIgnoreList,
 		AutoSubPosts:                *flagAutoSubPosts,
+		SwarmDownloads:              *flagSwarmDownloads,
 
 		SyncFreeList:              *flagSyncFreeList,
 		AutoCompact:               *flagAutoCompact,
 		AutoCompactMinAge:         autoCompactMinAge,
 		ExternalEditorForComments: *flagExternalEditorForComments,
 
+		ResourcesPaywallPrefix:       *flagResourcesPaywallPrefix,
+		ResourcesPaywallCost:         *flagResourcesPaywallCost,
+		ResourcesPaywallDuration:     resourcesPaywallDuration,
+		ResourcesPaywallSubscription: *flagResourcesPaywallSubscription,
+
 		SimpleStorePayType:    ssPayType,
 		SimpleStoreAccount:    *flagSimpleStoreAccount,
 		SimpleStoreShipCharge: *flagSimpleStoreShipCharge,
 
+		Webhooks: webhooks,
+
+		SpendingLimits: spendingLimits,
+
 		dialFunc: dialFunc,
 	}, nil
 }
 
+// parseSpendingLimit parses a spending limit config line in the form
+// <name>,<category>,<user id>,<daily dcr>,<monthly dcr>[,<alert pct>[,hardstop]].
+func parseSpendingLimit(line string) (client.SpendingLimit, error) {
+	var limit client.SpendingLimit
+	spl := strings.Split(line, ",")
+	if len(spl) < 5 || len(spl) > 7 {
+		return limit, fmt.Errorf("invalid spending limit line: %v", line)
+	}
+	for i := range spl {
+		spl[i] = strings.TrimSpace(spl[i])
+	}
+
+	limit.Name = spl[0]
+	if spl[1] != "*" {
+		limit.Category = spl[1]
+	}
+	if spl[2] != "" && spl[2] != "*" {
+		var uid clientintf.UserID
+		if err := uid.FromString(spl[2]); err != nil {
+			return limit, fmt.Errorf("invalid user of spending limit %q: %v",
+				limit.Name, err)
+		}
+		limit.User = &uid
+	}
+
+	parseDCR := func(s string) (int64, error) {
+		if s == "" {
+			return 0, nil
+		}
+		dcr, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, err
+		}
+		amount, err := dcrutil.NewAmount(dcr)
+		if err != nil {
+			return 0, err
+		}
+		return int64(amount) * 1000, nil
+	}
+	var err error
+	if limit.DailyMAtoms, err = parseDCR(spl[3]); err != nil {
+		return limit, fmt.Errorf("invalid daily budget of spending limit %q: %v",
+			limit.Name, err)
+	}
+	if limit.MonthlyMAtoms, err = parseDCR(spl[4]); err != nil {
+		return limit, fmt.Errorf("invalid monthly budget of spending limit %q: %v",
+			limit.Name, err)
+	}
+	if len(spl) > 5 && spl[5] != "" {
+		pct, err := strconv.ParseUint(spl[5], 10, 32)
+		if err != nil {
+			return limit, fmt.Errorf("invalid alert percent of spending limit %q: %v",
+				limit.Name, err)
+		}
+		limit.AlertPercent = uint32(pct)
+	}
+	if len(spl) > 6 {
+		if spl[6] != "hardstop" {
+			return limit, fmt.Errorf("invalid mode %q of spending limit %q",
+				spl[6], limit.Name)
+		}
+		limit.HardStop = true
+	}
+	return limit, nil
+}
+
 func saveNewConfig(cfgFile string, cfg *config) error {
 	// Figure out the config file name (which also establishes the data
 	// root).
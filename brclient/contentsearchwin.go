@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/companyzero/bisonrelay/client/clientdb"
+)
+
+// contentSearchWindow shows the results of a content search across remote
+// users.
+type contentSearchWindow struct {
+	as     *appState
+	search clientdb.ContentSearch
+	idx    int
+	status string
+
+	viewport viewport.Model
+}
+
+func (cw contentSearchWindow) Init() tea.Cmd {
+	return nil
+}
+
+func (cw *contentSearchWindow) renderResult(res clientdb.ContentSearchResult,
+	b *strings.Builder, i int) {
+
+	pf := fmt.Sprintf
+	st := cw.as.styles.Load()
+
+	line := pf("%s (%s)", res.Filename, hbytes(int64(res.Size)))
+	if cw.idx == i {
+		b.WriteString(st.focused.Render(line))
+	} else {
+		b.WriteString(st.msg.Render(line))
+	}
+	b.WriteString("\n")
+	if res.Description != "" {
+		b.WriteString(st.help.Render(pf("    %s", res.Description)))
+		b.WriteString("\n")
+	}
+
+	for _, s := range res.Sellers {
+		b.WriteString(st.help.Render("    "))
+		b.WriteString(st.nick.Render(s.Nick))
+		b.WriteString(st.help.Render(pf(" for %.8f DCR - %s",
+			float64(s.Cost)/1e8, s.FID.ShortLogID())))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+}
+
+func (cw *contentSearchWindow) renderResults() {
+	if cw.as.winW > 0 && cw.as.winH > 0 {
+		cw.viewport.YPosition = 4
+		cw.viewport.Width = cw.as.winW
+		cw.viewport.Height = cw.as.winH - 4
+	}
+
+	var minOffset, maxOffset int
+	b := new(strings.Builder)
+	if cw.status != "" {
+		b.WriteString(cw.as.styles.Load().help.Render(cw.status))
+		b.WriteString("\n\n")
+	}
+	if len(cw.search.Results) == 0 {
+		b.WriteString(cw.as.styles.Load().help.Render("No results yet"))
+	}
+	for i, res := range cw.search.Results {
+		if i == cw.idx {
+			minOffset = strings.Count(b.String(), "\n")
+		}
+		cw.renderResult(res, b, i)
+		if i == cw.idx {
+			maxOffset = strings.Count(b.String(), "\n")
+		}
+	}
+
+	cw.viewport.SetContent(b.String())
+
+	// Ensure the currently selected index is visible.
+	if cw.viewport.YOffset > minOffset {
+		cw.viewport.SetYOffset(minOffset)
+	} else if bottom := cw.viewport.YOffset + cw.viewport.Height; bottom < maxOffset {
+		cw.viewport.SetYOffset(cw.viewport.YOffset + (maxOffset - bottom))
+	}
+}
+
+// download starts downloading the selected result from its cheapest seller.
+func (cw *contentSearchWindow) download() {
+	res := cw.search.Results[cw.idx]
+	if len(res.Sellers) == 0 {
+		return
+	}
+	seller := res.Sellers[0]
+	err := cw.as.c.GetUserContent(seller.UID, seller.FID)
+	if err != nil {
+		cw.status = fmt.Sprintf("Unable to download %q: %v", res.Filename, err)
+	} else {
+		cw.status = fmt.Sprintf("Starting to download %q from %s",
+			res.Filename, seller.Nick)
+	}
+	cw.renderResults()
+}
+
+func (cw contentSearchWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	if ss, cmd := maybeShutdown(cw.as, msg); ss != nil {
+		return ss, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg: // resize window
+		cw.as.winW = msg.Width
+		cw.as.winH = msg.Height
+		cw.renderResults()
+
+	case tea.KeyMsg:
+		switch {
+		case msg.Type == tea.KeyEsc:
+			// Return to main window
+			return newMainWindowState(cw.as)
+
+		case msg.Type == tea.KeyUp, msg.String() == "k":
+			if cw.idx > 0 {
+				cw.idx -= 1
+				cw.renderResults()
+			}
+
+		case msg.Type == tea.KeyDown, msg.String() == "j":
+			if cw.idx < len(cw.search.Results)-1 {
+				cw.idx += 1
+				cw.renderResults()
+			}
+
+		case msg.Type == tea.KeyEnter && cw.idx < len(cw.search.Results):
+			cw.download()
+		}
+
+	case contentSearchUpdated:
+		if msg.search.Query == cw.search.Query {
+			cw.search = msg.search
+			cw.idx = min(cw.idx, max(len(cw.search.Results)-1, 0))
+			cw.renderResults()
+		}
+
+	case currentTimeChanged:
+		cw.as.footerInvalidate()
+
+	default:
+		cw.viewport, cmd = cw.viewport.Update(msg)
+	}
+
+	return cw, cmd
+}
+
+func (cw contentSearchWindow) headerView(styles *theme) string {
+	msg := fmt.Sprintf(" Search %q (%d/%d replies) - Press ESC to return - "+
+		"ENTER to download", cw.search.Query, cw.search.Replied,
+		cw.search.Queried)
+	headerMsg := styles.header.Render(msg)
+	spaces := styles.header.Render(strings.Repeat(" ",
+		max(0, cw.as.winW-lipgloss.Width(headerMsg))))
+	return headerMsg + spaces
+}
+
+func (cw contentSearchWindow) footerView(styles *theme) string {
+	return cw.as.footerView(styles, "")
+}
+
+func (cw contentSearchWindow) View() string {
+	styles := cw.as.styles.Load()
+
+	return fmt.Sprintf("%s\n\n%s\n%s",
+		cw.headerView(styles),
+		cw.viewport.View(),
+		cw.footerView(styles),
+	)
+}
+
+func newContentSearchWindow(as *appState, query string) (contentSearchWindow, tea.Cmd) {
+	cw := contentSearchWindow{as: as}
+	search, err := as.c.ContentSearchResults(query)
+	if err != nil {
+		cw.status = fmt.Sprintf("Unable to load search results: %v", err)
+		search.Query = query
+	}
+	cw.search = search
+	cw.renderResults()
+	return cw, nil
+}
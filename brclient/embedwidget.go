@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"mime"
-	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -83,7 +82,7 @@ func (ew *embedWidget) activate() []tea.Cmd {
 func (ew *embedWidget) tryEmbed() error {
 	var args mdembeds.EmbeddedArgs
 
-	args.Alt = url.PathEscape(ew.formEmbed.inputs[1].(*textInputHelper).Value())
+	args.Alt = ew.formEmbed.inputs[1].(*textInputHelper).Value()
 
 	filename, err := homedir.Expand(ew.formEmbed.inputs[0].(*textInputHelper).Value())
 	if err != nil {
@@ -659,6 +659,10 @@ func (mws mainWindowState) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		mws.as.workingCmd = ""
 		return newFeedWindow(mws.as, -1, -1, msg.author)
 
+	case showContentSearchWindow:
+		mws.as.workingCmd = ""
+		return newContentSearchWindow(mws.as, msg.query)
+
 	case msgLNRequestRecv:
 		mws.as.workingCmd = ""
 		return newLNRequestRecvWindow(mws.as, false)
@@ -127,6 +127,17 @@ type showFeedWindow struct {
 	author *clientintf.UserID
 }
 
+// showContentSearchWindow shows the results of the content search for the
+// query.
+type showContentSearchWindow struct {
+	query string
+}
+
+// contentSearchUpdated is sent when a reply to a content search is received.
+type contentSearchUpdated struct {
+	search clientdb.ContentSearch
+}
+
 // feedUpdated when the feed of posts should be updated.
 type feedUpdated struct {
 	summ clientdb.PostSummary
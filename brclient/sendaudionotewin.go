@@ -87,6 +87,8 @@ func (w *sendAudioNoteWin) updateRecordData() error {
 	args.Typ = "audio/ogg"
 	args.Filename = time.Now().Format("2006-01-02-15_04_05") + "-audionote.opus"
 	args.Data = data
+	args.Codec = "opus"
+	args.Duration = time.Duration(w.as.noterec.RecordInfo().DurationMs) * time.Millisecond
 	msg := args.String()
 
 	policy := w.as.serverPolicy()
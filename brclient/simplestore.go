@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/companyzero/bisonrelay/client/resources/simplestore"
 	"github.com/decred/dcrd/dcrutil/v4"
@@ -43,6 +44,19 @@ func handleSimpleStoreOrderStatusChanged(as *appState, order *simplestore.Order,
 	as.pm(cw, msg)
 }
 
+// parseStoreDays parses the optional number of days of store commands
+// in args[i].
+func parseStoreDays(args []string, i int) (int, error) {
+	if len(args) <= i {
+		return 30, nil
+	}
+	days, err := strconv.Atoi(args[i])
+	if err != nil || days <= 0 {
+		return 0, fmt.Errorf("invalid number of days %q", args[i])
+	}
+	return days, nil
+}
+
 func handleNewTransaction(as *appState, tx *lnrpc.Transaction) error {
 	b, err := hex.DecodeString(tx.RawTxHex)
 	if err != nil {
@@ -731,7 +731,7 @@ func handleInitClient(handle uint32, args initClient) error {
 	}
 
 	var rpcServer *rpcserver.Server
-	if len(args.JSONRPCListen) > 0 {
+	if len(args.JSONRPCListen) > 0 || len(args.GRPCListen) > 0 {
 		rpcsLog := logBknd.logger("RPCS")
 		tlsConnCfg := tlsconn.TLSListenersConfig{
 			Addresses:                   args.JSONRPCListen,
@@ -748,13 +748,30 @@ func handleInitClient(handle uint32, args initClient) error {
 		if err != nil {
 			return err
 		}
+		tlsConnCfg.Addresses = args.GRPCListen
+		tlsConnCfg.NextProtos = []string{"h2"}
+		grpcListeners, err := tlsconn.TLSListeners(tlsConnCfg)
+		if err != nil {
+			return err
+		}
 		rpcServer = rpcserver.New(rpcserver.Config{
 			JSONRPCListeners: jsonListeners,
+			GRPCListeners:    grpcListeners,
 			Log:              rpcsLog,
 			RPCUser:          args.RPCUser,
 			RPCPass:          args.RPCPass,
 			AuthMode:         args.RPCAuthMode,
 		})
+		rpcTokens, err := rpcserver.NewTokenStore(filepath.Join(args.DBRoot,
+			"rpctokens.json"), rpcsLog)
+		if err != nil {
+			return err
+		}
+		rpcServer.InitTokenAuth(rpcserver.TokenAuthCfg{
+			Store:     rpcTokens,
+			Client:    c,
+			PayClient: lnpc,
+		})
 		rpcServer.InitVersionService(appName, version.Version)
 		chatRPCServerCfg := rpcserver.ChatServerCfg{
 			Log:               logBknd.logger("RPCS"),
@@ -822,8 +839,9 @@ func handleInitClient(handle uint32, args initClient) error {
 		}
 
 		resServerCfg := rpcserver.ResourcesServerCfg{
-			Log:    logBknd.logger("RPCS"),
-			Client: c,
+			Log:               logBknd.logger("RPCS"),
+			Client:            c,
+			RootReplayMsgLogs: filepath.Join(args.DBRoot, "replaymsglog"),
 		}
 		if args.ResourcesUpstream == "clientrpc" {
 			resServerCfg.Router = resRouter
@@ -842,6 +860,35 @@ func handleInitClient(handle uint32, args initClient) error {
 		if err != nil {
 			return err
 		}
+
+		mediaServerCfg := rpcserver.MediaServerCfg{
+			Log:               logBknd.logger("RPCS"),
+			Client:            c,
+			RootReplayMsgLogs: filepath.Join(args.DBRoot, "replaymsglog"),
+		}
+		err = rpcServer.InitMediaService(mediaServerCfg)
+		if err != nil {
+			return err
+		}
+
+		usersServerCfg := rpcserver.UsersServerCfg{
+			Log:               logBknd.logger("RPCS"),
+			Client:            c,
+			RootReplayMsgLogs: filepath.Join(args.DBRoot, "replaymsglog"),
+		}
+		err = rpcServer.InitUsersService(usersServerCfg)
+		if err != nil {
+			return err
+		}
+
+		lnServerCfg := rpcserver.LNServerCfg{
+			Log:       logBknd.logger("RPCS"),
+			PayClient: lnpc,
+		}
+		err = rpcServer.InitLNService(lnServerCfg)
+		if err != nil {
+			return err
+		}
 	}
 
 	var cancel func()
@@ -2229,6 +2276,21 @@ func handleClientCmd(cc *clientCtx, cmd *cmd) (interface{}, error) {
 
 		return nil, c.CancelDownload(fid)
 
+	case CTStreamDownload:
+		var fid clientintf.FileID
+		if err := cmd.decode(&fid); err != nil {
+			return nil, err
+		}
+
+		// Fail early if the download cannot be streamed.
+		s, err := c.OpenDownloadStream(cc.ctx, fid)
+		if err != nil {
+			return nil, err
+		}
+		s.Close()
+
+		return c.DownloadStreamURL(fid)
+
 	case CTSubAllPosts:
 		err := c.SubscribeToAllRemotePosts(nil)
 		return nil, err
@@ -50,6 +50,7 @@ type initClient struct {
 
 	// New fields for RPC configuration
 	JSONRPCListen      []string `json:"json_rpc_listen"`
+	GRPCListen         []string `json:"grpc_listen"`
 	RPCCertPath        string   `json:"rpc_cert_path"`
 	RPCKeyPath         string   `json:"rpc_key_path"`
 	RPCClientCAPath    string   `json:"rpc_client_ca_path"`
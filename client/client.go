@@ -134,6 +134,19 @@ type Config struct {
 	// If unspecified, a default value of 12 seconds (1/5 minute) is used.
 	TipUserPayRetryDelayFactor time.Duration
 
+	// Webhooks are the HTTP endpoints that receive client events.
+	Webhooks []WebhookEndpoint
+
+	// WebhookRetryDelayFactor is the factor of the exponential delay for
+	// retrying the delivery of events to webhook endpoints.
+	//
+	// If unspecified, a default value of 5 seconds is used.
+	WebhookRetryDelayFactor time.Duration
+
+	// SpendingLimits are the budgets for the payments made automatically
+	// by the client.
+	SpendingLimits []SpendingLimit
+
 	// GCMQMaxLifetime is how long to wait for a message from an user,
 	// after which the GCMQ considers no other messages from this user
 	// will be received.
@@ -213,6 +226,25 @@ type Config struct {
 	// to 7 days.
 	GCInviteExpiration time.Duration
 
+	// GCListChunkSize is the max number of members sent in a single GC
+	// list message. Larger GC lists are split into multiple chunks.
+	// Defaults to 8192.
+	GCListChunkSize int
+
+	// SwarmDownloads flags whether to ask the users known (from previous
+	// content searches) to share the same content when starting a
+	// download, in order to fetch chunks from multiple users in parallel.
+	SwarmDownloads bool
+
+	// SwarmChunkTimeout is how long to wait for a chunk requested from one
+	// of the sources of a multi-source download before requesting it from
+	// a different source. Defaults to 5 minutes.
+	SwarmChunkTimeout time.Duration
+
+	// ContentSearchInterval is the interval between sending the requests
+	// of a content search to successive users. Defaults to 500ms.
+	ContentSearchInterval time.Duration
+
 	// DialFunc specifies a custom dialer
 	DialFunc func(context.Context, string, string) (net.Conn, error)
 
@@ -245,6 +277,10 @@ func (cfg *Config) setDefaults() {
 		cfg.TipUserPayRetryDelayFactor = time.Minute / 5
 	}
 
+	if cfg.WebhookRetryDelayFactor == 0 {
+		cfg.WebhookRetryDelayFactor = 5 * time.Second
+	}
+
 	if cfg.RecentMediateIDThreshold == 0 {
 		cfg.RecentMediateIDThreshold = time.Hour * 24 * 7
 	}
@@ -269,6 +305,18 @@ func (cfg *Config) setDefaults() {
 		cfg.GCInviteExpiration = time.Hour * 24 * 7
 	}
 
+	if cfg.GCListChunkSize == 0 {
+		cfg.GCListChunkSize = 8192
+	}
+
+	if cfg.SwarmChunkTimeout == 0 {
+		cfg.SwarmChunkTimeout = time.Minute * 5
+	}
+
+	if cfg.ContentSearchInterval == 0 {
+		cfg.ContentSearchInterval = 500 * time.Millisecond
+	}
+
 	// These following GCMQ times were obtained by profiling a client
 	// connected over tor to the server and may need tweaking from time to
 	// time.
@@ -401,6 +449,42 @@ type Client struct {
 	unkxdWarningsMtx sync.Mutex
 	unkxdWarnings    map[clientintf.UserID]time.Time
 
+	// gcListChunks tracks partially received chunked GC lists,
+	// gcListRequests tracks the last time members requested GC lists and
+	// gcListRequested tracks the GC lists requested by the local client.
+	gcListMtx       sync.Mutex
+	gcListChunks    map[gcListChunksKey]*gcListChunks
+	gcListRequests  map[gcListChunksKey]time.Time
+	gcListRequested map[gcListChunksKey]time.Time
+
+	// gcHistoryPending tracks history bundles of GCs being joined that
+	// were received before the first GC list.
+	gcHistoryMtx     sync.Mutex
+	gcHistoryPending map[zkidentity.ShortID]pendingGCHistory
+
+	// calls tracks the calls that have not yet ended.
+	callsMtx sync.Mutex
+	calls    map[zkidentity.ShortID]*VoiceCall
+
+	// swarmRetries tracks the multi-source downloads that have a scheduled
+	// check for chunks to request from different sources.
+	swarmMtx     sync.Mutex
+	swarmRetries map[clientdb.FileID]struct{}
+
+	// dlStreams tracks streams of partially downloaded files.
+	dlStreams downloadStreams
+
+	// webhooks are the senders of events to webhook endpoints.
+	webhooks []*webhookSender
+
+	// spending enforces the spending limits. Nil if no limits are
+	// configured.
+	spending *spendingLimiter
+
+	// search is the content search currently in progress.
+	searchMtx sync.Mutex
+	search    *contentSearch
+
 	// onboardRunning tracks whether there's a running onboard instance.
 	onboardMtx        sync.Mutex
 	onboardRunning    bool
@@ -514,6 +598,16 @@ func New(cfg Config) (*Client, error) {
 		newUsersChan:     make(chan *RemoteUser),
 		gcWarnedVersions: &singlesetmap.Map[zkidentity.ShortID]{},
 		unkxdWarnings:    make(map[clientintf.UserID]time.Time),
+		gcListChunks:     make(map[gcListChunksKey]*gcListChunks),
+		gcListRequests:   make(map[gcListChunksKey]time.Time),
+		gcListRequested:  make(map[gcListChunksKey]time.Time),
+		gcHistoryPending: make(map[zkidentity.ShortID]pendingGCHistory),
+		calls:            make(map[zkidentity.ShortID]*VoiceCall),
+		swarmRetries:     make(map[clientdb.FileID]struct{}),
+		dlStreams: downloadStreams{
+			chunkChans: make(map[clientdb.FileID]chan struct{}),
+			priority:   make(map[clientdb.FileID]int),
+		},
 
 		onboardCancelChan: make(chan struct{}, 1),
 
@@ -541,6 +635,21 @@ func New(cfg Config) (*Client, error) {
 	rmqdb.c = c
 	kxl.kxCompleted = c.kxCompleted
 
+	if err := c.initWebhooks(); err != nil {
+		return nil, err
+	}
+
+	if len(cfg.SpendingLimits) > 0 {
+		spending, err := newSpendingLimiter(cfg.SpendingLimits, ntfns,
+			cfg.logger("SPND"))
+		if err != nil {
+			return nil, err
+		}
+		c.spending = spending
+		q.SetPaymentLimiter(spending)
+		rmgr.SetPaymentLimiter(spending)
+	}
+
 	return c, nil
 }
 
@@ -736,6 +845,9 @@ func (c *Client) loadInitialDBData(ctx context.Context) error {
 	if err := c.loadPrevUnsentMsgs(ctx); err != nil {
 		return err
 	}
+	if err := c.loadSpendingLimits(ctx); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -1453,8 +1565,15 @@ func (c *Client) Run(ctx context.Context) error {
 	// Reload cached RGCMs.
 	g.Go(func() error { return c.loadCachedRGCMs(gctx) })
 
+	// Deliver events to webhook endpoints.
+	for _, ws := range c.webhooks {
+		ws := ws
+		g.Go(func() error { return ws.run(gctx) })
+	}
+
 	// Restart tracking tip receiving.
 	g.Go(func() error { return c.restartTrackGeneratedTipInvoices(gctx) })
+	g.Go(func() error { return c.restartTrackPaywallInvoices(gctx) })
 
 	return g.Wait()
 }
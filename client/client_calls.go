@@ -0,0 +1,689 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/client/internal/callstream"
+	"github.com/companyzero/bisonrelay/client/internal/lowlevel"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// Calls are negotiated through regular (ratchet encrypted) RMs. Once a call is
+// accepted, each party pushes packets of Opus frames directly to single-use RV
+// points derived from the call secret, skipping the ratchet and the sendq for
+// lower latency. Each party subscribes to a window of upcoming RV points of
+// the remote party and plays back the received frames through a jitter buffer.
+
+const (
+	// callCodec is the only codec currently supported for calls.
+	callCodec = "opus"
+
+	// callFrameMs is the duration of each frame sent in calls.
+	callFrameMs = 20
+
+	// callPacketFrames is the number of frames sent in each packet. Larger
+	// packets reduce the cost of calls, at the expense of latency.
+	callPacketFrames = 10
+
+	// callBitrate is the expected bitrate of the encoded frames, used to
+	// estimate the cost of calls.
+	callBitrate = 40000
+
+	// callSubWindow is the number of upcoming packets of the remote party
+	// that each party stays subscribed to.
+	callSubWindow = 16
+
+	// callPrebuffer is the number of packets buffered before playback
+	// starts (or restarts after an underrun).
+	callPrebuffer = 2
+
+	// callOfferTimeout is how long a call offer remains valid.
+	callOfferTimeout = time.Minute
+
+	// callIdleTimeout is how long an active call remains open without
+	// receiving packets from the remote party.
+	callIdleTimeout = 30 * time.Second
+)
+
+// ErrCallNotFound is returned when a call does not exist.
+var ErrCallNotFound = errors.New("call not found")
+
+// CallState is the state of a call.
+type CallState int
+
+const (
+	// CallStateOffered is the state of a call that was offered but not yet
+	// answered.
+	CallStateOffered CallState = iota
+
+	// CallStateActive is the state of an accepted call.
+	CallStateActive
+
+	// CallStateEnded is the state of a rejected or hung up call.
+	CallStateEnded
+)
+
+func (s CallState) String() string {
+	switch s {
+	case CallStateOffered:
+		return "offered"
+	case CallStateActive:
+		return "active"
+	case CallStateEnded:
+		return "ended"
+	default:
+		return fmt.Sprintf("unknown state %d", int(s))
+	}
+}
+
+// CallStats are statistics about the packets of an active call.
+type CallStats struct {
+	PacketsSent     uint64
+	PacketsReceived uint64
+	PacketsLost     uint64
+	Duration        time.Duration
+}
+
+// VoiceCall is a real-time voice call with a remote user.
+type VoiceCall struct {
+	// ID is the unique ID of the call.
+	ID zkidentity.ShortID
+
+	// Peer is the remote party of the call.
+	Peer UserID
+
+	// Outgoing is true if the local client offered the call.
+	Outgoing bool
+
+	// Codec, FrameMs and PacketFrames are the parameters of the call.
+	Codec        string
+	FrameMs      uint32
+	PacketFrames uint32
+
+	c          *Client
+	ru         *RemoteUser
+	sendStream *callstream.Stream
+	recvStream *callstream.Stream
+	frames     chan []byte
+	ctx        context.Context
+	cancel     func()
+	subsChan   chan struct{}
+	loopsWg    sync.WaitGroup
+
+	mtx      sync.Mutex
+	state    CallState
+	started  time.Time
+	sendSeq  uint64
+	pending  [][]byte
+	jb       *callstream.JitterBuffer
+	subs     map[uint64]lowlevel.RVID
+	recvd    []uint64
+	nextSub  uint64
+	lastRecv time.Time
+	stats    CallStats
+}
+
+func newVoiceCall(c *Client, ru *RemoteUser, offer *rpc.RMCallOffer, outgoing bool) *VoiceCall {
+	sendDir, recvDir := callstream.DirCalleeToCaller, callstream.DirCallerToCallee
+	if outgoing {
+		sendDir, recvDir = recvDir, sendDir
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	return &VoiceCall{
+		ID:           offer.CallID,
+		Peer:         ru.ID(),
+		Outgoing:     outgoing,
+		Codec:        offer.Codec,
+		FrameMs:      offer.FrameMs,
+		PacketFrames: offer.PacketFrames,
+
+		c:          c,
+		ru:         ru,
+		sendStream: callstream.NewStream(offer.Secret, sendDir),
+		recvStream: callstream.NewStream(offer.Secret, recvDir),
+		frames:     make(chan []byte, int(offer.PacketFrames)*callSubWindow),
+		ctx:        ctx,
+		cancel:     cancel,
+		subsChan:   make(chan struct{}, 1),
+		jb:         callstream.NewJitterBuffer(callSubWindow),
+		subs:       make(map[uint64]lowlevel.RVID, callSubWindow),
+	}
+}
+
+// State returns the current state of the call.
+func (vc *VoiceCall) State() CallState {
+	vc.mtx.Lock()
+	defer vc.mtx.Unlock()
+	return vc.state
+}
+
+// Stats returns the statistics of the call.
+func (vc *VoiceCall) Stats() CallStats {
+	vc.mtx.Lock()
+	defer vc.mtx.Unlock()
+	stats := vc.stats
+	stats.PacketsLost = vc.jb.Lost()
+	if !vc.started.IsZero() {
+		stats.Duration = time.Since(vc.started)
+	}
+	return stats
+}
+
+// Frames returns the channel where the frames received from the remote party
+// are written, in order. A nil frame is written in place of every frame of a
+// lost packet, such that the decoder may conceal the loss. The channel is
+// closed once the call ends.
+//
+// Frames are dropped if the channel is not read in time.
+func (vc *VoiceCall) Frames() <-chan []byte {
+	return vc.frames
+}
+
+// SendFrame sends an encoded frame to the remote party. Frames are buffered
+// until enough frames to fill a packet are available.
+func (vc *VoiceCall) SendFrame(frame []byte) error {
+	if len(frame) > callstream.MaxFrameSize {
+		return fmt.Errorf("frame size %d is greater than max %d",
+			len(frame), callstream.MaxFrameSize)
+	}
+
+	vc.mtx.Lock()
+	if vc.state != CallStateActive {
+		vc.mtx.Unlock()
+		return fmt.Errorf("call is %s", vc.state)
+	}
+	vc.pending = append(vc.pending, frame)
+	if len(vc.pending) < int(vc.PacketFrames) {
+		vc.mtx.Unlock()
+		return nil
+	}
+	frames, seq := vc.pending, vc.sendSeq
+	vc.pending = nil
+	vc.sendSeq += 1
+	vc.stats.PacketsSent += 1
+	vc.mtx.Unlock()
+
+	sealed, err := vc.sendStream.Seal(seq, frames)
+	if err != nil {
+		return err
+	}
+
+	payEvent := fmt.Sprintf("call.%s.packet", vc.ID.ShortLogID())
+	rm := rawRM{
+		pri: priorityPM,
+		msg: sealed,
+		rv:  vc.sendStream.RV(seq),
+		paidRMCB: func(amount, fees int64) {
+			go vc.ru.paidForRM(payEvent, amount, fees)
+		},
+	}
+	return vc.c.q.QueueRM(rm, nil)
+}
+
+// recvPacket handles a packet received from the remote party.
+func (vc *VoiceCall) recvPacket(seq uint64, blob lowlevel.RVBlob) {
+	frames, err := vc.recvStream.Open(seq, blob.Decoded)
+	if err != nil {
+		vc.ru.log.Warnf("Unable to open packet %d of call %s: %v",
+			seq, vc.ID, err)
+		return
+	}
+
+	vc.mtx.Lock()
+	if vc.jb.Push(seq, frames) {
+		vc.stats.PacketsReceived += 1
+		vc.lastRecv = time.Now()
+	}
+	vc.recvd = append(vc.recvd, seq)
+	vc.mtx.Unlock()
+
+	// RVs are single use, so have the subs loop stop listening on this
+	// one.
+	vc.signalUpdateSubs()
+}
+
+// updateSubs subscribes to the RVs of the upcoming packets of the remote party,
+// and unsubscribes from the RVs of packets that were received or considered
+// lost.
+//
+// This is only called from subsLoop, which ensures an RV is never unsubscribed
+// while its subscription is still in progress.
+func (vc *VoiceCall) updateSubs() {
+	vc.mtx.Lock()
+	var toUnsub []lowlevel.RVID
+	for _, seq := range vc.recvd {
+		if rv, ok := vc.subs[seq]; ok {
+			toUnsub = append(toUnsub, rv)
+			delete(vc.subs, seq)
+		}
+	}
+	vc.recvd = nil
+	next := vc.jb.Next()
+	for seq, rv := range vc.subs {
+		if seq < next {
+			toUnsub = append(toUnsub, rv)
+			delete(vc.subs, seq)
+		}
+	}
+	var toSub []uint64
+	for ; vc.nextSub < next+callSubWindow; vc.nextSub++ {
+		vc.subs[vc.nextSub] = vc.recvStream.RV(vc.nextSub)
+		toSub = append(toSub, vc.nextSub)
+	}
+	vc.mtx.Unlock()
+
+	// Subscription changes block until the server acks them, so perform
+	// them concurrently to allow the RV manager to batch them.
+	var wg sync.WaitGroup
+	wg.Add(len(toUnsub) + len(toSub))
+	for _, rv := range toUnsub {
+		go func(rv lowlevel.RVID) {
+			defer wg.Done()
+			_ = vc.c.rmgr.Unsub(rv)
+		}(rv)
+	}
+	for _, seq := range toSub {
+		handler := func(seq uint64) lowlevel.RVHandler {
+			return func(blob lowlevel.RVBlob) error {
+				// Handle the packet in a goroutine so the RV
+				// manager is not blocked.
+				go vc.recvPacket(seq, blob)
+				return nil
+			}
+		}(seq)
+		subPaid := func(amount, fees int64) {
+			payEvent := fmt.Sprintf("call.%s.sub", vc.ID.ShortLogID())
+			go vc.ru.paidForRM(payEvent, amount, fees)
+		}
+		go func(seq uint64) {
+			defer wg.Done()
+			err := vc.c.rmgr.Sub(vc.recvStream.RV(seq), handler, subPaid)
+			if err != nil && !errors.Is(err, lowlevel.ErrRVAlreadySubscribed{}) {
+				vc.ru.log.Warnf("Unable to subscribe to packet %d of "+
+					"call %s: %v", seq, vc.ID, err)
+			}
+		}(seq)
+	}
+	wg.Wait()
+}
+
+// subsLoop updates the subscriptions of the call whenever the playback
+// advances.
+func (vc *VoiceCall) subsLoop() {
+	defer vc.loopsWg.Done()
+	for {
+		select {
+		case <-vc.subsChan:
+			vc.updateSubs()
+		case <-vc.ctx.Done():
+			return
+		}
+	}
+}
+
+// signalUpdateSubs signals the subs loop to update the subscriptions.
+func (vc *VoiceCall) signalUpdateSubs() {
+	select {
+	case vc.subsChan <- struct{}{}:
+	default:
+	}
+}
+
+// unsubAll unsubscribes from all RVs of the call.
+func (vc *VoiceCall) unsubAll() {
+	vc.mtx.Lock()
+	subs := vc.subs
+	vc.subs = make(map[uint64]lowlevel.RVID)
+	vc.mtx.Unlock()
+	for _, rv := range subs {
+		_ = vc.c.rmgr.Unsub(rv)
+	}
+}
+
+// playbackLoop writes the frames of received packets to the frames channel, at
+// the rate they are expected to be played back.
+func (vc *VoiceCall) playbackLoop() {
+	defer vc.loopsWg.Done()
+	defer close(vc.frames)
+
+	packetDuration := time.Duration(vc.FrameMs*vc.PacketFrames) * time.Millisecond
+	ticker := time.NewTicker(packetDuration)
+	defer ticker.Stop()
+
+	buffering, waited := true, 0
+	for {
+		select {
+		case <-ticker.C:
+		case <-vc.ctx.Done():
+			return
+		}
+
+		vc.mtx.Lock()
+		if time.Since(vc.lastRecv) > callIdleTimeout {
+			vc.mtx.Unlock()
+			vc.ru.log.Warnf("No packets received in call %s for %s",
+				vc.ID, callIdleTimeout)
+			go vc.c.HangupCall(vc.ID, "connection lost")
+			return
+		}
+		// Wait (up to callPrebuffer packet durations) for packets to
+		// be buffered before starting playback.
+		buffered := vc.jb.Buffered()
+		if buffering && buffered < callPrebuffer && waited < callPrebuffer {
+			if buffered > 0 {
+				waited += 1
+			}
+			vc.mtx.Unlock()
+			continue
+		}
+		_, frames, lost, ok := vc.jb.Pop()
+		vc.mtx.Unlock()
+		buffering = !ok
+		if !ok {
+			waited = 0
+			continue
+		}
+
+		if lost {
+			frames = make([][]byte, vc.PacketFrames)
+		}
+		for _, f := range frames {
+			select {
+			case vc.frames <- f:
+			default:
+			}
+		}
+		vc.signalUpdateSubs()
+	}
+}
+
+// start starts streaming the call.
+func (vc *VoiceCall) start() {
+	vc.mtx.Lock()
+	vc.state = CallStateActive
+	vc.started = time.Now()
+	vc.lastRecv = vc.started
+	vc.mtx.Unlock()
+
+	vc.loopsWg.Add(2)
+	go vc.subsLoop()
+	go vc.playbackLoop()
+	vc.signalUpdateSubs()
+}
+
+// end ends the call. Returns false if the call had already ended.
+func (vc *VoiceCall) end() bool {
+	vc.mtx.Lock()
+	state := vc.state
+	vc.state = CallStateEnded
+	vc.mtx.Unlock()
+	if state == CallStateEnded {
+		return false
+	}
+
+	vc.cancel()
+	if state == CallStateActive {
+		vc.loopsWg.Wait()
+		vc.unsubAll()
+	} else {
+		close(vc.frames)
+	}
+	return true
+}
+
+// EstimateCallCost returns the estimated cost (in milliatoms) for each minute
+// of a call, given the policy of the currently connected server.
+func (c *Client) EstimateCallCost() (uint64, error) {
+	sess := c.ServerSession()
+	if sess == nil {
+		return 0, errors.New("not connected to server")
+	}
+	policy := sess.Policy()
+	return clientintf.EstimateCallCost(callFrameMs, callPacketFrames,
+		callBitrate, &policy)
+}
+
+// Call returns the call with the given ID.
+func (c *Client) Call(callID zkidentity.ShortID) (*VoiceCall, error) {
+	c.callsMtx.Lock()
+	vc := c.calls[callID]
+	c.callsMtx.Unlock()
+	if vc == nil {
+		return nil, ErrCallNotFound
+	}
+	return vc, nil
+}
+
+// ListCalls lists the calls that have not yet ended.
+func (c *Client) ListCalls() []*VoiceCall {
+	c.callsMtx.Lock()
+	res := make([]*VoiceCall, 0, len(c.calls))
+	for _, vc := range c.calls {
+		res = append(res, vc)
+	}
+	c.callsMtx.Unlock()
+	return res
+}
+
+// removeCall ends the call and removes it from the list of calls. Returns
+// false if the call had already ended.
+func (c *Client) removeCall(vc *VoiceCall) bool {
+	c.callsMtx.Lock()
+	delete(c.calls, vc.ID)
+	c.callsMtx.Unlock()
+	return vc.end()
+}
+
+// expireCallOffer ends the call if it is still not answered after the offer
+// timeout.
+func (c *Client) expireCallOffer(vc *VoiceCall) {
+	select {
+	case <-time.After(callOfferTimeout):
+	case <-vc.ctx.Done():
+		return
+	}
+	if vc.State() != CallStateOffered {
+		return
+	}
+	reason := "call not answered"
+	if err := c.HangupCall(vc.ID, reason); err != nil {
+		vc.ru.log.Warnf("Unable to expire call %s: %v", vc.ID, err)
+	}
+}
+
+// OfferCall offers a voice call to the given user. The call starts once the
+// remote user accepts it.
+func (c *Client) OfferCall(uid UserID) (*VoiceCall, error) {
+	ru, err := c.rul.byID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	offer := rpc.RMCallOffer{
+		Codec:        callCodec,
+		FrameMs:      callFrameMs,
+		PacketFrames: callPacketFrames,
+	}
+	if _, err := rand.Read(offer.CallID[:]); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(offer.Secret[:]); err != nil {
+		return nil, err
+	}
+
+	vc := newVoiceCall(c, ru, &offer, true)
+	c.callsMtx.Lock()
+	c.calls[vc.ID] = vc
+	c.callsMtx.Unlock()
+
+	payEvent := fmt.Sprintf("call.%s.offer", vc.ID.ShortLogID())
+	err = c.sendWithSendQPriority(payEvent, offer, priorityPM, nil, uid)
+	if err != nil {
+		c.removeCall(vc)
+		return nil, err
+	}
+
+	ru.log.Infof("Offered call %s", vc.ID)
+	go c.expireCallOffer(vc)
+	return vc, nil
+}
+
+// answerCall accepts or rejects an incoming call offer.
+func (c *Client) answerCall(callID zkidentity.ShortID, accept bool, reason string) (*VoiceCall, error) {
+	vc, err := c.Call(callID)
+	if err != nil {
+		return nil, err
+	}
+	if vc.Outgoing {
+		return nil, fmt.Errorf("cannot answer outgoing call %s", callID)
+	}
+	if state := vc.State(); state != CallStateOffered {
+		return nil, fmt.Errorf("call %s is %s", callID, state)
+	}
+
+	ans := rpc.RMCallAnswer{CallID: callID, Accepted: accept, Reason: reason}
+	payEvent := fmt.Sprintf("call.%s.answer", callID.ShortLogID())
+	err = c.sendWithSendQPriority(payEvent, ans, priorityPM, nil, vc.Peer)
+	if err != nil {
+		return nil, err
+	}
+
+	if accept {
+		vc.ru.log.Infof("Accepted call %s", callID)
+		vc.start()
+	} else {
+		vc.ru.log.Infof("Rejected call %s", callID)
+		c.removeCall(vc)
+	}
+	return vc, nil
+}
+
+// AcceptCall accepts an incoming call offer.
+func (c *Client) AcceptCall(callID zkidentity.ShortID) (*VoiceCall, error) {
+	return c.answerCall(callID, true, "")
+}
+
+// RejectCall rejects an incoming call offer.
+func (c *Client) RejectCall(callID zkidentity.ShortID, reason string) error {
+	_, err := c.answerCall(callID, false, reason)
+	return err
+}
+
+// HangupCall ends an active call or cancels an outgoing call offer.
+func (c *Client) HangupCall(callID zkidentity.ShortID, reason string) error {
+	vc, err := c.Call(callID)
+	if err != nil {
+		return err
+	}
+	if !c.removeCall(vc) {
+		return nil
+	}
+
+	vc.ru.log.Infof("Hung up call %s (%s)", callID, reason)
+	c.ntfns.notifyCallEnded(vc.ru, vc, reason, false)
+
+	h := rpc.RMCallHangup{CallID: callID, Reason: reason}
+	payEvent := fmt.Sprintf("call.%s.hangup", callID.ShortLogID())
+	return c.sendWithSendQPriority(payEvent, h, priorityPM, nil, vc.Peer)
+}
+
+// handleCallOffer handles a call offer received from a remote user.
+func (c *Client) handleCallOffer(ru *RemoteUser, offer rpc.RMCallOffer, ts time.Time) error {
+	if ru.IsIgnored() {
+		ru.log.Tracef("Ignoring call offer from ignored user")
+		return nil
+	}
+	if time.Since(ts) > callOfferTimeout {
+		return fmt.Errorf("received expired call offer %s (sent %s)",
+			offer.CallID, ts.Format(time.RFC3339))
+	}
+	if offer.Codec != callCodec {
+		return fmt.Errorf("received call offer with unsupported codec %q",
+			offer.Codec)
+	}
+	if offer.FrameMs == 0 || offer.FrameMs > 120 {
+		return fmt.Errorf("received call offer with invalid frame "+
+			"duration %d", offer.FrameMs)
+	}
+	if offer.PacketFrames == 0 || offer.PacketFrames > callstream.MaxFramesPerPacket {
+		return fmt.Errorf("received call offer with invalid number of "+
+			"packet frames %d", offer.PacketFrames)
+	}
+
+	var costPerMinute uint64
+	if sess := c.ServerSession(); sess != nil {
+		policy := sess.Policy()
+		var err error
+		costPerMinute, err = clientintf.EstimateCallCost(offer.FrameMs,
+			offer.PacketFrames, callBitrate, &policy)
+		if err != nil {
+			return err
+		}
+	}
+
+	vc := newVoiceCall(c, ru, &offer, false)
+	c.callsMtx.Lock()
+	if _, ok := c.calls[vc.ID]; ok {
+		c.callsMtx.Unlock()
+		return fmt.Errorf("received duplicated call offer %s", vc.ID)
+	}
+	c.calls[vc.ID] = vc
+	c.callsMtx.Unlock()
+
+	ru.log.Infof("Received call offer %s", vc.ID)
+	go c.expireCallOffer(vc)
+	c.ntfns.notifyCallOffered(ru, vc, costPerMinute)
+	return nil
+}
+
+// handleCallAnswer handles the answer to a call offered by the local client.
+func (c *Client) handleCallAnswer(ru *RemoteUser, ans rpc.RMCallAnswer) error {
+	vc, err := c.Call(ans.CallID)
+	if err != nil {
+		return err
+	}
+	if vc.Peer != ru.ID() || !vc.Outgoing {
+		return fmt.Errorf("received answer for call %s not offered "+
+			"to user", ans.CallID)
+	}
+	if state := vc.State(); state != CallStateOffered {
+		return fmt.Errorf("received answer for call %s that is %s",
+			ans.CallID, state)
+	}
+
+	if ans.Accepted {
+		ru.log.Infof("Call %s accepted", vc.ID)
+		vc.start()
+	} else {
+		ru.log.Infof("Call %s rejected (%s)", vc.ID, ans.Reason)
+		c.removeCall(vc)
+	}
+	c.ntfns.notifyCallAnswered(ru, vc, ans.Accepted, ans.Reason)
+	return nil
+}
+
+// handleCallHangup handles the remote user ending a call.
+func (c *Client) handleCallHangup(ru *RemoteUser, h rpc.RMCallHangup) error {
+	vc, err := c.Call(h.CallID)
+	if err != nil {
+		return err
+	}
+	if vc.Peer != ru.ID() {
+		return fmt.Errorf("received hangup for call %s from user that "+
+			"is not its peer", h.CallID)
+	}
+	if !c.removeCall(vc) {
+		return nil
+	}
+
+	ru.log.Infof("Call %s hung up by remote user (%s)", vc.ID, h.Reason)
+	c.ntfns.notifyCallEnded(ru, vc, h.Reason, true)
+	return nil
+}
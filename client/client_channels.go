@@ -0,0 +1,200 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// Channels are announcement-only GCs: the owner (Members[0]) is the only
+// member allowed to publish messages and subscribers only ever learn about the
+// owner and themselves. This keeps the cost of membership changes constant
+// regardless of the number of subscribers.
+//
+// The channel subscription flow with a shareable invite is:
+//
+//          Alice                                    Bob
+//         -------                                  -----
+//
+//     NewChannel()
+//     ChannelShareInvite()
+//           \-------- (out of band) ------->
+//
+//                                             JoinChannel()
+//                      <-- RMGroupJoin -------------/
+//
+//     handleGCJoin()
+//           \---------> RMGroupList -->
+//                                             handleGroupList()
+//
+
+const (
+	// channelGCVersion is the version of newly created channels.
+	channelGCVersion = 2
+
+	// channelInvitePrefix is the prefix of encoded shareable channel
+	// invites.
+	channelInvitePrefix = "brchannel"
+)
+
+// ChannelInvite is a shareable invite to subscribe to a channel. Any user
+// that has KX'd with the channel owner may use it to subscribe to the channel.
+type ChannelInvite struct {
+	Owner UserID
+	ID    zkidentity.ShortID
+	Token uint64
+	Name  string
+}
+
+// String returns the encoded version of the invite, suitable for sharing
+// with other users.
+func (ci ChannelInvite) String() string {
+	return fmt.Sprintf("%s:%s:%s:%s:%s", channelInvitePrefix, ci.Owner,
+		ci.ID, strconv.FormatUint(ci.Token, 16), ci.Name)
+}
+
+// ParseChannelInvite decodes a channel invite previously encoded with
+// ChannelInvite.String().
+func ParseChannelInvite(s string) (ChannelInvite, error) {
+	var ci ChannelInvite
+	parts := strings.SplitN(strings.TrimSpace(s), ":", 5)
+	if len(parts) != 5 || parts[0] != channelInvitePrefix {
+		return ci, fmt.Errorf("not a channel invite")
+	}
+	if err := ci.Owner.FromString(parts[1]); err != nil {
+		return ci, fmt.Errorf("invalid channel owner: %v", err)
+	}
+	if err := ci.ID.FromString(parts[2]); err != nil {
+		return ci, fmt.Errorf("invalid channel id: %v", err)
+	}
+	token, err := strconv.ParseUint(parts[3], 16, 64)
+	if err != nil {
+		return ci, fmt.Errorf("invalid channel token: %v", err)
+	}
+	ci.Token = token
+	ci.Name = parts[4]
+	return ci, nil
+}
+
+// channelSubscriberList returns the version of the channel definition that
+// is sent to the specified subscriber.
+func channelSubscriberList(gl rpc.RMGroupList, uid clientintf.UserID) rpc.RMGroupList {
+	gl.Members = []zkidentity.ShortID{gl.Members[0], uid}
+	gl.ExtraAdmins = nil
+	return gl
+}
+
+// NewChannel creates a new announcement-only channel with the local client as
+// its owner and sole publisher.
+func (c *Client) NewChannel(name string) (zkidentity.ShortID, error) {
+	return c.newGroupChat(name, channelGCVersion, true)
+}
+
+// ChannelShareInvite returns a shareable invite to subscribe to the given
+// channel. If regen is true, a new token is generated, invalidating any
+// previously shared invites.
+func (c *Client) ChannelShareInvite(gcID zkidentity.ShortID, regen bool) (ChannelInvite, error) {
+	ci := ChannelInvite{Owner: c.PublicID(), ID: gcID}
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		gc, err := c.db.GetGC(tx, gcID)
+		if err != nil {
+			return err
+		}
+		if !gc.Metadata.Channel {
+			return fmt.Errorf("GC %s is not a channel", gcID)
+		}
+		if gc.Metadata.Members[0] != ci.Owner {
+			return fmt.Errorf("local client is not the owner of channel %s", gcID)
+		}
+
+		ci.Name = gc.Metadata.Name
+		if gc.ShareToken != 0 && !regen {
+			ci.Token = gc.ShareToken
+			return nil
+		}
+
+		// Generate a token that does not collide with any outstanding
+		// individual invites.
+		for ci.Token == 0 {
+			ci.Token = c.mustRandomUint64()
+			_, _, _, err := c.db.FindGCInvite(tx, gcID, ci.Token)
+			if err == nil {
+				ci.Token = 0
+			} else if !errors.Is(err, clientdb.ErrNotFound) {
+				return err
+			}
+		}
+		gc.ShareToken = ci.Token
+		return c.db.SaveGC(tx, gc)
+	})
+	return ci, err
+}
+
+// JoinChannel requests to subscribe to the channel specified in the invite.
+// The local client must have already KX'd with the channel owner.
+func (c *Client) JoinChannel(ci ChannelInvite) error {
+	ru, err := c.rul.byID(ci.Owner)
+	if err != nil {
+		return err
+	}
+
+	invite := rpc.RMGroupInvite{
+		ID:      ci.ID,
+		Name:    ci.Name,
+		Token:   ci.Token,
+		Expires: time.Now().Add(c.cfg.GCInviteExpiration).Unix(),
+		Version: channelGCVersion,
+	}
+
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		_, err := c.db.GetGC(tx, ci.ID)
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			if err == nil {
+				err = fmt.Errorf("already subscribed to channel %s",
+					ci.ID)
+			}
+			return err
+		}
+
+		// Store the invite as accepted, so that the first channel
+		// list sent by the owner is processed.
+		iid, err := c.db.AddGCInvite(tx, ci.Owner, invite)
+		if err != nil {
+			return err
+		}
+		return c.db.MarkGCInviteAccepted(tx, iid)
+	})
+	if err != nil {
+		return err
+	}
+
+	join := rpc.RMGroupJoin{
+		ID:    ci.ID,
+		Token: ci.Token,
+	}
+	c.log.Infof("Requesting to join channel %q (%s) from %s", ci.Name, ci.ID, ru)
+	payEvent := fmt.Sprintf("gc.%s.joinchannel", ci.ID.ShortLogID())
+	return ru.sendRM(join, payEvent)
+}
+
+// EstimateGCMCost estimates the cost (in milliatoms) to send the given message
+// to every member of the GC that would receive it. It also returns the number
+// of members the message would be sent to.
+func (c *Client) EstimateGCMCost(gcID zkidentity.ShortID, msg string) (uint64, int, error) {
+	sess := c.ServerSession()
+	if sess == nil {
+		return 0, 0, fmt.Errorf("not connected to server")
+	}
+	policy := sess.Policy()
+
+	nbDests := c.GetGCDestCount(gcID)
+	cost, err := clientintf.EstimateGCMCost(msg, nbDests, &policy)
+	return cost, nbDests, err
+}
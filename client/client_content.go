@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"crypto/sha256"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -93,6 +92,43 @@ func (c *Client) ShareFile(fname string, uid *UserID,
 	return f, md, err
 }
 
+// ShareDir shares all files in the given dir (recursively) as a single bundle
+// with the given user (or to all users if none is specified). The bundle is
+// bought and downloaded as a single file. Symlinks are not followed and are
+// skipped (with a logged warning), as are other non-regular files.
+//
+// Cost is in atoms.
+func (c *Client) ShareDir(dir string, uid *UserID,
+	cost uint64, descr string,
+) (clientdb.SharedFile, rpc.FileMetadata, error) {
+
+	var f clientdb.SharedFile
+	var md rpc.FileMetadata
+	sign := func(hash []byte) ([]byte, error) {
+		sig := c.localID.signMessage(hash)
+		return sig[:], nil
+	}
+
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		f, md, err = c.db.ShareDir(tx, dir, uid, cost, descr, sign)
+		return err
+	})
+	if err != nil {
+		return f, md, err
+	}
+
+	if uid == nil {
+		c.log.Infof("Shared global bundle %q with %d files",
+			filepath.Base(dir), len(md.Files))
+	} else {
+		c.log.Infof("Shared bundle %q with %d files with user %s",
+			filepath.Base(dir), len(md.Files), uid)
+	}
+
+	return f, md, nil
+}
+
 // FindSharedFileID finds the file ID of a shared file with the given filename.
 func (c *Client) FindSharedFileID(fname string) (clientdb.FileID, error) {
 	var fid clientdb.FileID
@@ -201,6 +237,26 @@ func (c *Client) handleFTList(ru *RemoteUser, ftls rpc.RMFTList) error {
 		return err
 	}
 
+	// Only list files that match the filter.
+	if ftls.Filter != "" {
+		re, err := regexp.Compile(ftls.Filter)
+		if err != nil {
+			errStr := fmt.Sprintf("invalid filter: %v", err)
+			return ru.sendRM(rpc.RMFTListReply{
+				Tag:   ftls.Tag,
+				Error: &errStr,
+			}, "ftlistreply")
+		}
+		global = filterFilesByRegexp(global, re)
+		shared = filterFilesByRegexp(shared, re)
+	}
+
+	// Only list files with the requested content hashes.
+	if len(ftls.Hashes) > 0 {
+		global = filterFilesByHash(global, ftls.Hashes)
+		shared = filterFilesByHash(shared, ftls.Hashes)
+	}
+
 	return ru.sendRM(rpc.RMFTListReply{
 		Tag:    ftls.Tag,
 		Global: global,
@@ -208,6 +264,33 @@ func (c *Client) handleFTList(ru *RemoteUser, ftls rpc.RMFTList) error {
 	}, "ftlistreply")
 }
 
+// filterFilesByHash returns the files that have one of the given content
+// hashes.
+func filterFilesByHash(files []rpc.FileMetadata, hashes []string) []rpc.FileMetadata {
+	res := make([]rpc.FileMetadata, 0, len(files))
+	for _, f := range files {
+		for _, h := range hashes {
+			if f.Hash == h {
+				res = append(res, f)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// filterFilesByRegexp returns the files with a filename or description that
+// matches the regexp.
+func filterFilesByRegexp(files []rpc.FileMetadata, re *regexp.Regexp) []rpc.FileMetadata {
+	res := make([]rpc.FileMetadata, 0, len(files))
+	for _, f := range files {
+		if re.MatchString(f.Filename) || re.MatchString(f.Description) {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
 // HasDownloadedFile returns the path to a downloaded file if it exists.
 func (c *Client) HasDownloadedFile(fid zkidentity.ShortID) (string, error) {
 	var res string
@@ -221,13 +304,29 @@ func (c *Client) HasDownloadedFile(fid zkidentity.ShortID) (string, error) {
 
 // handleFTListReply handles a reply for list from a remote user.
 func (c *Client) handleFTListReply(ru *RemoteUser, ftrp rpc.RMFTListReply) error {
+	findingSources := ftrp.Tag == ftListFindSourcesTag
 	if ftrp.Error != nil {
 		err := errors.New(*ftrp.Error)
-		c.ntfns.notifyContentListReceived(ru, nil, err)
+		if ftrp.Tag == ftListSearchTag {
+			// Count the error as an empty reply.
+			c.handleContentSearchReply(ru, nil)
+		} else if !findingSources {
+			c.ntfns.notifyContentListReceived(ru, nil, err)
+		}
 		return err
 	}
 
 	files := append(ftrp.Global, ftrp.Shared...)
+
+	// The user may share the content of outstanding downloads.
+	c.addDownloadSources(ru, files)
+	if findingSources {
+		return nil
+	}
+	if ftrp.Tag == ftListSearchTag {
+		return c.handleContentSearchReply(ru, files)
+	}
+
 	var res []clientdb.RemoteFile
 	err := c.dbView(func(tx clientdb.ReadTx) error {
 		var err error
@@ -243,6 +342,129 @@ func (c *Client) handleFTListReply(ru *RemoteUser, ftrp rpc.RMFTListReply) error
 	return nil
 }
 
+// ftListFindSourcesTag is the tag of the RMFTList messages sent to find
+// additional sources for downloads.
+const ftListFindSourcesTag uint32 = 1
+
+// ftListSearchTag is the tag of the RMFTList messages sent in content
+// searches.
+const ftListSearchTag uint32 = 2
+
+// swarmDiscoveryDelay is how long to wait for replies from other users with
+// the same file before starting to download chunks.
+const swarmDiscoveryDelay = 5 * time.Second
+
+// FindDownloadSources asks the given users whether they share the same content
+// as the given outstanding download. If no users are specified, the users that
+// replied with the same content to previous content searches are asked. Users
+// that do are added as additional sources of the download and chunks may then
+// be requested from them.
+func (c *Client) FindDownloadSources(fid clientdb.FileID, uids []UserID) error {
+	fd, err := c.outstandingDownload(fid)
+	if err != nil {
+		return err
+	}
+	if fd.Metadata == nil {
+		return fmt.Errorf("metadata for download %s not received yet", fid)
+	}
+	if fd.IsSentFile {
+		return fmt.Errorf("download %s is supposed to be uploader-sent", fid)
+	}
+
+	if len(uids) == 0 {
+		err := c.dbView(func(tx clientdb.ReadTx) error {
+			var err error
+			uids, err = c.db.ContentSearchSellers(tx, fd.Metadata.Hash)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	dests := make([]UserID, 0, len(uids))
+	for _, uid := range uids {
+		if _, isSource := fd.Source(uid); isSource {
+			continue
+		}
+		if _, err := c.rul.byID(uid); err != nil {
+			continue
+		}
+		dests = append(dests, uid)
+	}
+	if len(dests) == 0 {
+		return nil
+	}
+
+	c.log.Infof("Looking for sources for download %q (%s) among %d users",
+		fd.Metadata.Filename, fid, len(dests))
+	rm := rpc.RMFTList{
+		Directories: []string{rpc.RMFTDGlobal, rpc.RMFTDShared},
+		Hashes:      []string{fd.Metadata.Hash},
+		Tag:         ftListFindSourcesTag,
+	}
+	payEvent := fmt.Sprintf("ftfindsources.%s", fid.ShortLogID())
+	return c.sendWithSendQ(payEvent, rm, dests...)
+}
+
+// addDownloadSources adds the remote user as an additional source of every
+// outstanding download with the same content as one of the given files.
+func (c *Client) addDownloadSources(ru *RemoteUser, files []rpc.FileMetadata) {
+	if len(files) == 0 {
+		return
+	}
+
+	var added []clientdb.FileDownload
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		fds, err := c.db.ListOutstandingDownloads(tx)
+		if err != nil {
+			return err
+		}
+		for i := range fds {
+			fd := &fds[i]
+			if fd.Metadata == nil || fd.IsSentFile {
+				continue
+			}
+			for _, md := range files {
+				if md.Hash != fd.Metadata.Hash {
+					continue
+				}
+				ok, err := c.db.AddFileDownloadSource(tx, fd, ru.ID(), md)
+				if err != nil {
+					ru.log.Debugf("Unable to add user as source "+
+						"of download %s: %v", fd.FID, err)
+					continue
+				}
+				if ok {
+					added = append(added, *fd)
+				}
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ru.log.Warnf("Unable to add user as download source: %v", err)
+		return
+	}
+
+	for _, fd := range added {
+		ru.log.Infof("Added user as source of download %q (%s)",
+			fd.Metadata.Filename, fd.FID)
+
+		// Downloads that have not started yet will be started once
+		// the discovery of sources completes.
+		if len(fd.ChunkStates) == 0 {
+			continue
+		}
+		go func(fd clientdb.FileDownload) {
+			err := c.downloadChunks(fd)
+			if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+				ru.log.Errorf("Unable to download file chunk: %v", err)
+			}
+		}(fd)
+	}
+}
+
 // GetUserContent starts the process to fetch the given file from the remote
 // user.
 func (c *Client) GetUserContent(uid UserID, fid clientdb.FileID) error {
@@ -275,9 +497,14 @@ func (c *Client) GetUserContent(uid UserID, fid clientdb.FileID) error {
 
 // CancelDownload cancels downloading this file.
 func (c *Client) CancelDownload(fid clientdb.FileID) error {
-	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		return c.db.CancelFileDownload(tx, fid)
 	})
+	if err != nil {
+		return err
+	}
+	c.dlStreams.downloadEnded(fid)
+	return nil
 }
 
 // handleFTGet handles starting the download process for a file.
@@ -327,9 +554,10 @@ func (c *Client) handleFTGet(ru *RemoteUser, ftg rpc.RMFTGet) error {
 }
 
 // requestFileChunk sends a request to a remote host for one chunk of one of
-// its files.
+// its files. The chunk must have already been marked as requested from the
+// remote host.
 func (c *Client) requestFileChunk(ru *RemoteUser, fid clientdb.FileID, chunkIdx int,
-	fm rpc.FileMetadata) error {
+	fm *rpc.FileMetadata) error {
 
 	chunkHash := fm.Manifest[chunkIdx].Hash
 
@@ -347,22 +575,7 @@ func (c *Client) requestFileChunk(ru *RemoteUser, fid clientdb.FileID, chunkIdx
 		Hash:   chunkHash,
 	}
 	payEvent := fmt.Sprintf("ftgetchunk.%s.%d", fid.ShortLogID(), rm.Index)
-	if err := ru.sendRM(rm, payEvent); err != nil {
-		return err
-	}
-
-	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
-		fd, err := c.db.ReadFileDownload(tx, ru.ID(), fid)
-		if err != nil {
-			return err
-		}
-		return c.db.ReplaceFileDownloadChunkState(tx, &fd, chunkIdx,
-			clientdb.ChunkStateRequestedChunk)
-	})
-	if err != nil {
-		return err
-	}
-	return nil
+	return ru.sendRM(rm, payEvent)
 }
 
 // payFileChunkInvoice pays for the invoice to download a chunk.
@@ -391,10 +604,16 @@ func (c *Client) payFileChunkInvoice(ru *RemoteUser, fid clientdb.FileID,
 		return err
 	}
 
-	// Attempt to pay invoice.
-	fees, invErr := c.pc.PayInvoice(c.ctx, invoice)
+	// Attempt to pay invoice, once allowed by the spending limits.
+	uid := ru.ID()
+	var fees int64
+	invErr := c.spending.wait(c.ctx, clientdb.PayPurposeFileChunk, &uid, matoms)
+	if invErr == nil {
+		fees, invErr = c.pc.PayInvoice(c.ctx, invoice)
+	}
 	if invErr == nil {
 		ru.log.Debugf("Paid for chunk %d of file download %s", chunkIdx, fid)
+		c.spending.paid(clientdb.PayPurposeFileChunk, &uid, matoms+fees)
 	}
 
 	// Record result of attempting the payment.
@@ -436,13 +655,94 @@ func (c *Client) payFileChunkInvoice(ru *RemoteUser, fid clientdb.FileID,
 	return err
 }
 
+// downloadSource is a source of a download that is a known remote user.
+type downloadSource struct {
+	clientdb.FileDownloadSource
+	ru *RemoteUser
+}
+
+// downloadSources returns the sources of the download that are still known
+// remote users.
+func (c *Client) downloadSources(fd *clientdb.FileDownload) []downloadSource {
+	srcs := fd.AllSources()
+	res := make([]downloadSource, 0, len(srcs))
+	for _, src := range srcs {
+		ru, err := c.rul.byID(src.UID)
+		if err != nil {
+			continue
+		}
+		res = append(res, downloadSource{FileDownloadSource: src, ru: ru})
+	}
+	return res
+}
+
+// outstandingDownload returns the outstanding download with the given ID.
+func (c *Client) outstandingDownload(fid clientdb.FileID) (clientdb.FileDownload, error) {
+	var fd clientdb.FileDownload
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		fds, err := c.db.ListOutstandingDownloads(tx)
+		if err != nil {
+			return err
+		}
+		for i := range fds {
+			if fds[i].FID == fid {
+				fd = fds[i]
+				return nil
+			}
+		}
+		return fmt.Errorf("outstanding download %s: %w", fid,
+			clientdb.ErrNotFound)
+	})
+	return fd, err
+}
+
+// scheduleSwarmCheck schedules a new pass over the missing chunks of a
+// multi-source download, such that chunks that were not received in time from
+// one source are requested from a different one.
+func (c *Client) scheduleSwarmCheck(fid clientdb.FileID) {
+	c.swarmMtx.Lock()
+	if _, ok := c.swarmRetries[fid]; ok {
+		c.swarmMtx.Unlock()
+		return
+	}
+	c.swarmRetries[fid] = struct{}{}
+	c.swarmMtx.Unlock()
+
+	go func() {
+		select {
+		case <-time.After(c.cfg.SwarmChunkTimeout):
+		case <-c.ctx.Done():
+			return
+		}
+
+		c.swarmMtx.Lock()
+		delete(c.swarmRetries, fid)
+		c.swarmMtx.Unlock()
+
+		fd, err := c.outstandingDownload(fid)
+		if errors.Is(err, clientdb.ErrNotFound) {
+			// Completed or canceled.
+			return
+		}
+		if err == nil {
+			err = c.downloadChunks(fd)
+		}
+		if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+			c.log.Errorf("Unable to download chunks of file %s: %v",
+				fid, err)
+		}
+	}()
+}
+
 // downloadChunks is the main workhorse for chunked file download. It is called
 // both for initial download and for restarting old downloads (on client
 // startup).
 //
 // It determines the state of each chunk of the given download and takes
-// actions as appropriate.
-func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error {
+// actions as appropriate. Chunks are requested from all sources of the
+// download in turn, and chunks that are not received in time from one source
+// are requested from a different one.
+func (c *Client) downloadChunks(fd clientdb.FileDownload) error {
 	if fd.Metadata == nil {
 		// Shouldn't happen, but avoid panic.
 		return fmt.Errorf("unable to start download with nil metadata")
@@ -450,6 +750,11 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 
 	var missing []int
 	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		fd, err = c.db.ReadFileDownload(tx, fd.UID, fd.FID)
+		if err != nil {
+			return err
+		}
 		missing = c.db.MissingFileDownloadChunks(tx, &fd)
 		return nil
 	})
@@ -457,9 +762,21 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 		return err
 	}
 
+	// Fetch chunks starting at the one needed by stream readers (if any).
+	missing = c.dlStreams.orderMissingChunks(&fd, missing)
+
 	c.log.Infof("Starting to downloading %d missing chunks of file %q (%s)",
 		len(missing), fd.Metadata.Filename, fd.FID)
 
+	// Helper func to log errors in goroutines.
+	logErr := func(ru *RemoteUser, err error, msg string) {
+		if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+			ru.log.Errorf(msg, err)
+		}
+	}
+
+	var nextSource int
+	var multiSource bool
 	for _, chunkIdx := range missing {
 		chunkIdx := chunkIdx
 
@@ -468,13 +785,9 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 		var actionToTake string
 		const actRequest = "request invoice"
 		const actSendPayment = "send payment"
-
-		// Helper func to log errors in goroutines.
-		logErr := func(err error, msg string) {
-			if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
-				ru.log.Errorf(msg, err)
-			}
-		}
+		var src downloadSource
+		var payMAtoms int64
+		var invoice string
 
 		// Decide what to do with this missing chunk. This chunk could
 		// be in one of a number of states:
@@ -486,7 +799,7 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 		// - Attempt to pay invoice succeeded, but not received chunk
 		// - Received chunk
 		err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
-			fd, err := c.db.ReadFileDownload(tx, ru.ID(), fd.FID)
+			fd, err := c.db.ReadFileDownload(tx, fd.UID, fd.FID)
 			if err != nil {
 				return err
 			}
@@ -497,7 +810,22 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 					chunkIdx, len(fd.Metadata.Manifest))
 			}
 
-			var payMAtoms int64
+			sources := c.downloadSources(&fd)
+			if len(sources) == 0 {
+				return fmt.Errorf("no known sources for download %s",
+					fd.FID)
+			}
+			multiSource = len(sources) > 1
+
+			// Find the source the chunk was requested from.
+			chunkSrcUID := fd.GetChunkSource(chunkIdx)
+			var chunkSrc *downloadSource
+			for i := range sources {
+				if sources[i].UID == chunkSrcUID {
+					chunkSrc = &sources[i]
+				}
+			}
+
 			chunkState := fd.ChunkStates[chunkIdx]
 			switch chunkState {
 			case "":
@@ -508,28 +836,42 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 				// Request again if it's been at least one day
 				// since we last requested (to avoid sending
 				// multiple redundant requests).
+				//
+				// When there are other sources, request it from
+				// one of them if the source did not reply in
+				// time.
 				var chunkUpdtTime time.Time
 				if fd.ChunkUpdatedTime != nil {
 					chunkUpdtTime = fd.ChunkUpdatedTime[chunkIdx]
 				}
-				if chunkUpdtTime.Before(time.Now().Add(-time.Hour * 24)) {
+				swarmTimeout := time.Now().Add(-c.cfg.SwarmChunkTimeout)
+				if chunkSrc == nil {
+					actionToTake = actRequest
+				} else if chunkUpdtTime.Before(time.Now().Add(-time.Hour * 24)) {
+					actionToTake = actRequest
+				} else if multiSource && chunkUpdtTime.Before(swarmTimeout) {
+					chunkSrc.ru.log.Infof("Chunk %d of file %s "+
+						"not received in time. Requesting "+
+						"from different source",
+						chunkIdx, fd.FID)
 					actionToTake = actRequest
 				}
 
 			case clientdb.ChunkStateHasInvoice:
 				// Have invoice, but haven't tried paying. See
 				// if it's still valid to attempt payment.
-				invoice := fd.GetChunkInvoice(chunkIdx)
+				invoice = fd.GetChunkInvoice(chunkIdx)
 				decoded, err := c.pc.DecodeInvoice(c.ctx, invoice)
 				if err != nil {
 					return fmt.Errorf("unable to decode chunk invoice: %v", err)
 				}
 
-				if decoded.IsExpired(0) {
+				if decoded.IsExpired(0) || chunkSrc == nil {
 					actionToTake = actRequest
 				} else {
 					actionToTake = actSendPayment
 					payMAtoms = decoded.MAtoms
+					src = *chunkSrc
 				}
 
 			case clientdb.ChunkStatePayingInvoice:
@@ -541,7 +883,7 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 				// crashed, so we need to actually check in the
 				// payment client if the payment is in flight,
 				// succeeded or failed.
-				ru.log.Warnf("Chunk %d of file %s has in-flight payment",
+				c.log.Warnf("Chunk %d of file %s has in-flight payment",
 					chunkIdx, fd.FID)
 
 			case clientdb.ChunkStatePaid:
@@ -550,7 +892,14 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 				//
 				// TODO: deal with unresponsive remotes.
 				// Re-request it?  Alert user? Ban remote?
-				ru.log.Warnf("Chunk %d of file %s was paid for "+
+				//
+				// Sequential downloads are checked after every
+				// received chunk, so this is expected for them.
+				logf := c.log.Warnf
+				if fd.Sequential {
+					logf = c.log.Debugf
+				}
+				logf("Chunk %d of file %s was paid for "+
 					"but hasn't been received yet",
 					chunkIdx, fd.FID)
 
@@ -558,35 +907,53 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 				// Already downloaded chunk, nothing to do.
 			}
 
-			// Actually take an action on this chunk.
-			switch actionToTake {
-			case actRequest:
-				// Re-request it.
-				go func() {
-					err := c.requestFileChunk(ru, fd.FID, chunkIdx, *fd.Metadata)
-					logErr(err, "Unable to request file chunk: %v")
-				}()
-
-			case actSendPayment:
-				// Attempt payment.
-				go func() {
-					invoice := fd.GetChunkInvoice(chunkIdx)
-					err := c.payFileChunkInvoice(ru, fd.FID,
-						chunkIdx, invoice, payMAtoms)
-					logErr(err, "unable to pay for chunk: %v")
-				}()
+			if actionToTake != actRequest {
+				return nil
 			}
 
-			return nil
+			// Select the next source to request from, avoiding
+			// the one the chunk was last requested from (if there
+			// are alternatives).
+			src = sources[nextSource%len(sources)]
+			nextSource += 1
+			if chunkState != "" && multiSource && src.UID == chunkSrcUID {
+				src = sources[nextSource%len(sources)]
+				nextSource += 1
+			}
+			return c.db.MarkFileDownloadChunkRequested(tx, &fd,
+				chunkIdx, src.UID)
 		})
 		if err != nil {
 			return err
 		}
 
+		// Actually take an action on this chunk.
+		switch actionToTake {
+		case actRequest:
+			go func() {
+				err := c.requestFileChunk(src.ru, src.FID, chunkIdx, fd.Metadata)
+				logErr(src.ru, err, "Unable to request file chunk: %v")
+			}()
+
+		case actSendPayment:
+			// Attempt payment.
+			go func() {
+				err := c.payFileChunkInvoice(src.ru, fd.FID,
+					chunkIdx, invoice, payMAtoms)
+				logErr(src.ru, err, "unable to pay for chunk: %v")
+			}()
+		}
+
 		// Small sleep to bias downloading sequentially.
 		time.Sleep(100 * time.Millisecond)
 	}
 
+	// Check again later for chunks that should be requested from a
+	// different source.
+	if multiSource && len(missing) > 0 {
+		c.scheduleSwarmCheck(fd.FID)
+	}
+
 	return nil
 }
 
@@ -620,15 +987,27 @@ func (c *Client) handleFTGetReply(ru *RemoteUser, gr rpc.RMFTGetReply) error {
 		if !c.cfg.FileDownloadConfirmer(ru, gr.Metadata) {
 			// Canceled. Remove download.
 			ru.log.Infof("User canceled download of file %s", fid)
-			return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
-				return c.db.CancelFileDownload(tx, fid)
-			})
+			return c.CancelDownload(fid)
 		}
 	}
 
-	// Fetched metadata for the given file. Request chunks.
+	// Fetched metadata for the given file. Look for additional sources (if
+	// enabled) and request chunks.
 	go func() {
-		err := c.downloadChunks(ru, fd)
+		if c.cfg.SwarmDownloads {
+			err := c.FindDownloadSources(fid, nil)
+			if err != nil {
+				ru.log.Warnf("Unable to find sources for file %s: %v",
+					fid, err)
+			} else {
+				select {
+				case <-time.After(swarmDiscoveryDelay):
+				case <-c.ctx.Done():
+					return
+				}
+			}
+		}
+		err := c.downloadChunks(fd)
 		if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
 			ru.log.Errorf("Unable to download file chunk: %v", err)
 		}
@@ -667,9 +1046,14 @@ func (c *Client) sendFileChunk(ru *RemoteUser, sf clientdb.SharedFile,
 	ru.log.Debugf("Sent chunk %d of file %s to remote user", chunkIdx, sf.FID)
 
 	// Sent successfully (to server)! Mark chunk as sent.
-	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		return c.db.MarkChunkUploadSent(tx, ru.ID(), sf.FID, cid, chunkIdx)
 	})
+	if err != nil {
+		return err
+	}
+	c.ntfns.notifyFileChunkUploaded(ru, sf, chunkIdx)
+	return nil
 }
 
 // ftPaymentForChunkCompleted is called as a callback when the payment for the
@@ -872,11 +1256,19 @@ func (c *Client) handleFTPayForChunk(ru *RemoteUser, pfc rpc.RMFTPayForChunk) er
 			return fmt.Errorf("already paid for chunk %d", chunkIdx)
 		}
 
+		// Only pay the source the chunk was requested from.
+		if fd.GetChunkSource(chunkIdx) != ru.ID() {
+			return fmt.Errorf("chunk %d was not requested from user",
+				chunkIdx)
+		}
+		src, _ := fd.Source(ru.ID())
+
 		// TODO: check whether the invoice has a payment attempt in
 		// flight or is already expired.
 
 		// Double check amount to pay for chunk.
-		wantMAtoms := clientintf.FileChunkMAtoms(chunkIdx, fd.Metadata)
+		srcMeta := fd.SourceMetadata(src)
+		wantMAtoms := clientintf.FileChunkMAtoms(chunkIdx, &srcMeta)
 		if uint64(inv.MAtoms) > wantMAtoms {
 			return fmt.Errorf("unexpected value of invoice (got %d, want %d)",
 				inv.MAtoms, wantMAtoms)
@@ -893,8 +1285,16 @@ func (c *Client) handleFTPayForChunk(ru *RemoteUser, pfc rpc.RMFTPayForChunk) er
 		return err
 	}
 
-	// Start to pay for this chunk.
-	return c.payFileChunkInvoice(ru, fid, chunkIdx, pfc.Invoice, inv.MAtoms)
+	// Start to pay for this chunk. This is done outside the handler of
+	// the remote user's messages, because the payment may be held by the
+	// spending limits until approved.
+	go func() {
+		err := c.payFileChunkInvoice(ru, fid, chunkIdx, pfc.Invoice, inv.MAtoms)
+		if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+			ru.log.Errorf("Unable to pay for chunk: %v", err)
+		}
+	}()
+	return nil
 }
 
 // handleFTGetChunkReply is called to handle received chunk data for a download.
@@ -904,10 +1304,13 @@ func (c *Client) handleFTGetChunkReply(ru *RemoteUser, gcr rpc.RMFTGetChunkReply
 		return err
 	}
 
-	// Save the chunk.
+	// Save the chunk. The download is always recorded under the user the
+	// file was originally downloaded from, even when the chunk was sent by
+	// a different source.
 	var fd clientdb.FileDownload
 	var completedFname string
 	var nbMissingChunks int
+	primaryRU := ru
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		var err error
 		fd, err = c.db.ReadFileDownload(tx, ru.ID(), fid)
@@ -915,7 +1318,18 @@ func (c *Client) handleFTGetChunkReply(ru *RemoteUser, gcr rpc.RMFTGetChunkReply
 			return err
 		}
 
-		completedFname, err = c.db.SaveFileDownloadChunk(tx, ru.Nick(), &fd, gcr.Index, gcr.Chunk)
+		if fd.CompletedName != "" {
+			return fmt.Errorf("download %s already completed", fd.FID)
+		}
+
+		if fd.UID != ru.ID() {
+			if pru, err := c.rul.byID(fd.UID); err == nil {
+				primaryRU = pru
+			}
+		}
+
+		completedFname, err = c.db.SaveFileDownloadChunk(tx, primaryRU.Nick(),
+			&fd, gcr.Index, gcr.Chunk)
 		nbMissingChunks = len(c.db.MissingFileDownloadChunks(tx, &fd))
 		return err
 	})
@@ -924,14 +1338,21 @@ func (c *Client) handleFTGetChunkReply(ru *RemoteUser, gcr rpc.RMFTGetChunkReply
 	}
 
 	ru.log.Debugf("Downloaded chunk %d of file %s", gcr.Index, fd.FID)
+	c.dlStreams.chunkReceived(fd.FID)
+
+	if completedFname == "" && fd.Sequential {
+		// Request the next chunks of the sequential download.
+		go c.downloadChunksLogErr(fd)
+	}
 
 	if completedFname != "" {
+		c.dlStreams.downloadEnded(fd.FID)
 		baseName := filepath.Base(completedFname)
 		ru.log.Infof("Completed file download %q (%s, saved as %q",
 			fd.Metadata.Filename, fd.FID, baseName)
-		c.ntfns.notifyFileDownloadCompleted(ru, *fd.Metadata, completedFname)
+		c.ntfns.notifyFileDownloadCompleted(primaryRU, *fd.Metadata, completedFname)
 	} else {
-		c.ntfns.notifyFileDownloadProgress(ru, *fd.Metadata, nbMissingChunks)
+		c.ntfns.notifyFileDownloadProgress(primaryRU, *fd.Metadata, nbMissingChunks)
 	}
 	return err
 }
@@ -957,17 +1378,27 @@ func (c *Client) ListDownloads() ([]clientdb.FileDownload, error) {
 func (c *Client) SendFile(uid UserID, chunkSize uint64, filepath string,
 	progressChan chan SendProgress) error {
 
+	_, err := c.sendFile(uid, chunkSize, filepath, progressChan)
+	return err
+}
+
+// sendFile sends the file to the user and returns the ID of the sent file.
+func (c *Client) sendFile(uid UserID, chunkSize uint64, filepath string,
+	progressChan chan SendProgress) (clientdb.FileID, error) {
+
+	var fid clientdb.FileID
+
 	// Automatically determine chunk size.
 	if chunkSize == 0 {
 		serverSess := c.ServerSession()
 		if serverSess == nil {
-			return fmt.Errorf("cannot use chunksize 0 when not connected to a server")
+			return fid, fmt.Errorf("cannot use chunksize 0 when not connected to a server")
 		}
 
 		maxSizeVersion := serverSess.Policy().MaxMsgSizeVersion
 		maxPayloadSize := rpc.MaxPayloadSizeForVersion(maxSizeVersion)
 		if maxPayloadSize == 0 {
-			return fmt.Errorf("server did not define max payload "+
+			return fid, fmt.Errorf("server did not define max payload "+
 				"size for version %d", maxSizeVersion)
 		}
 		chunkSize = uint64(maxPayloadSize)
@@ -975,7 +1406,7 @@ func (c *Client) SendFile(uid UserID, chunkSize uint64, filepath string,
 
 	ru, err := c.rul.byID(uid)
 	if err != nil {
-		return err
+		return fid, err
 	}
 
 	sign := func(hash []byte) ([]byte, error) {
@@ -991,14 +1422,14 @@ func (c *Client) SendFile(uid UserID, chunkSize uint64, filepath string,
 		return err
 	})
 	if err != nil {
-		return err
+		return fid, err
 	}
 
 	ru.log.Infof("Sending file %s in %d chunks to user (total size %d)",
 		filepath, len(fm.Manifest), fm.Size)
 
-	fmdHash := fm.MetadataHash()
-	fileId := hex.EncodeToString(fmdHash[:])
+	fid = fm.MetadataHash()
+	fileId := fid.String()
 	fileShortId := fileId[:16]
 
 	// Prepare to send file metadata.
@@ -1009,7 +1440,7 @@ func (c *Client) SendFile(uid UserID, chunkSize uint64, filepath string,
 	payEvent := fmt.Sprintf("ftsendfile.%s.fm", fileShortId)
 	sqi, err := c.prepareSendqItem(payEvent, rmSF, priorityUpload, nil, uid)
 	if err != nil {
-		return err
+		return fid, err
 	}
 	sendqItems = append(sendqItems, sqi)
 
@@ -1033,7 +1464,7 @@ func (c *Client) SendFile(uid UserID, chunkSize uint64, filepath string,
 
 		sqi, err := c.prepareSendqItem(payEvent, fc, priorityUpload, nil, uid)
 		if err != nil {
-			return err
+			return fid, err
 		}
 		sendqItems = append(sendqItems, sqi)
 	}
@@ -1044,11 +1475,11 @@ func (c *Client) SendFile(uid UserID, chunkSize uint64, filepath string,
 	// start sending process.
 	err = c.sendPreparedSendqItemListSync(sendqItems, progressChan)
 	if err != nil {
-		return nil
+		return fid, nil
 	}
 
 	ru.log.Infof("Finished sending file %s to user", filepath)
-	return nil
+	return fid, nil
 }
 
 func (c *Client) handleFTSendFile(ru *RemoteUser, sf rpc.RMFTSendFile) error {
@@ -1105,8 +1536,7 @@ func (c *Client) restartDownloads(ctx context.Context) error {
 
 	for _, fd := range fds {
 		fd := fd
-		ru, err := c.rul.byID(fd.UID)
-		if err != nil {
+		if len(c.downloadSources(&fd)) == 0 {
 			// This could happen if we removed the ratchet/user
 			// before the download completed.
 			c.log.Warnf("Outstanding download %s for unknown user %s",
@@ -1118,15 +1548,15 @@ func (c *Client) restartDownloads(ctx context.Context) error {
 			// Skip if it's a remote-user-initiated file (they will
 			// send all chunks).
 			c.log.Debugf("Skiping restart of download %s from %s due to "+
-				"being remotely sent", fd.FID, ru)
+				"being remotely sent", fd.FID, fd.UID)
 			continue
 		}
 
 		// Start to re-process the download.
 		go func() {
-			err := c.downloadChunks(ru, fd)
+			err := c.downloadChunks(fd)
 			if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
-				ru.log.Errorf("Error downloading chunks of file %s: %v",
+				c.log.Errorf("Error downloading chunks of file %s: %v",
 					fd.FID, err)
 			}
 		}()
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// contentSearch tracks a content search in progress.
+type contentSearch struct {
+	query string
+	re    *regexp.Regexp
+
+	// pending are the users that have been (or will be) asked for their
+	// files and have not replied yet.
+	pending map[UserID]struct{}
+}
+
+// contentSearchFilter returns the filter sent to remote users for the given
+// query. Searches are case insensitive.
+func contentSearchFilter(query string) string {
+	return "(?i)" + query
+}
+
+// SearchContent searches for files shared by the given users (or all KX'd
+// users if none are specified) with a filename or description that matches
+// the query regexp. Requests are sent to each user in turn (separated by
+// the configured ContentSearchInterval).
+//
+// Replies are aggregated by the content hash of the files and cached in the
+// DB. The OnContentSearchResultsNtfn notification is called after each reply.
+// Starting a new search stops sending requests for the previous one.
+func (c *Client) SearchContent(query string, uids []UserID) error {
+	if query == "" {
+		return errors.New("empty search query")
+	}
+	re, err := regexp.Compile(contentSearchFilter(query))
+	if err != nil {
+		return fmt.Errorf("invalid search query: %v", err)
+	}
+
+	if len(uids) == 0 {
+		uids = c.rul.userList(true)
+	}
+	rus := make([]*RemoteUser, 0, len(uids))
+	for _, uid := range uids {
+		ru, err := c.rul.byID(uid)
+		if err != nil {
+			return err
+		}
+		rus = append(rus, ru)
+	}
+	if len(rus) == 0 {
+		return errors.New("no users to search")
+	}
+
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		_, err := c.db.NewContentSearch(tx, query, len(rus))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	search := &contentSearch{
+		query:   query,
+		re:      re,
+		pending: make(map[UserID]struct{}, len(rus)),
+	}
+	for _, ru := range rus {
+		search.pending[ru.ID()] = struct{}{}
+	}
+	c.searchMtx.Lock()
+	c.search = search
+	c.searchMtx.Unlock()
+
+	c.log.Infof("Searching for content %q among %d users", query, len(rus))
+
+	rm := rpc.RMFTList{
+		Directories: []string{rpc.RMFTDGlobal, rpc.RMFTDShared},
+		Filter:      contentSearchFilter(query),
+		Tag:         ftListSearchTag,
+	}
+	go func() {
+		for i, ru := range rus {
+			if i > 0 {
+				select {
+				case <-time.After(c.cfg.ContentSearchInterval):
+				case <-c.ctx.Done():
+					return
+				}
+			}
+
+			// Stop if a new search was started.
+			c.searchMtx.Lock()
+			stale := c.search != search
+			c.searchMtx.Unlock()
+			if stale {
+				return
+			}
+
+			err := ru.sendRM(rm, "ftsearch")
+			if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+				ru.log.Warnf("Unable to send content search: %v", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// handleContentSearchReply handles the files sent by a remote user as a reply
+// to the current content search.
+func (c *Client) handleContentSearchReply(ru *RemoteUser, files []rpc.FileMetadata) error {
+	uid := ru.ID()
+	c.searchMtx.Lock()
+	search := c.search
+	if search != nil {
+		if _, ok := search.pending[uid]; ok {
+			delete(search.pending, uid)
+		} else {
+			search = nil
+		}
+	}
+	c.searchMtx.Unlock()
+	if search == nil {
+		return fmt.Errorf("received unexpected content search reply")
+	}
+
+	// Older clients do not filter the list of files, so filter it again.
+	files = filterFilesByRegexp(files, search.re)
+
+	var cs clientdb.ContentSearch
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		cs, err = c.db.AddContentSearchReply(tx, search.query, uid,
+			ru.Nick(), files)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	ru.log.Debugf("Received %d files in reply to content search %q",
+		len(files), search.query)
+	c.ntfns.notifyContentSearchResults(ru, cs)
+	return nil
+}
+
+// ContentSearchResults returns the cached results of the search for the given
+// query.
+func (c *Client) ContentSearchResults(query string) (clientdb.ContentSearch, error) {
+	var cs clientdb.ContentSearch
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		cs, err = c.db.ReadContentSearch(tx, query)
+		return err
+	})
+	return cs, err
+}
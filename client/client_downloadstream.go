@@ -0,0 +1,402 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// sequentialDownloadWindow is the max number of chunks of a sequential
+// download that are fetched at a time.
+const sequentialDownloadWindow = 4
+
+// ErrDownloadStreamClosed is returned by reads of a download stream that was
+// closed while waiting for chunks.
+var ErrDownloadStreamClosed = errors.New("download stream closed")
+
+// downloadStreams tracks the state needed by streams of partially downloaded
+// files.
+type downloadStreams struct {
+	mtx sync.Mutex
+
+	// chunkChans are closed whenever a chunk of the corresponding download
+	// is received.
+	chunkChans map[clientdb.FileID]chan struct{}
+
+	// priority is the index of the first chunk to fetch for a download,
+	// as requested by stream readers.
+	priority map[clientdb.FileID]int
+
+	// serverAddr is the address of the local stream server (if it has
+	// been started) and serverToken is the random token that must be
+	// included in the URL of its requests.
+	serverAddr  string
+	serverToken string
+}
+
+// chunkChan returns a channel that is closed once the next chunk of the
+// download is received.
+func (ds *downloadStreams) chunkChan(fid clientdb.FileID) <-chan struct{} {
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+	c, ok := ds.chunkChans[fid]
+	if !ok {
+		c = make(chan struct{})
+		ds.chunkChans[fid] = c
+	}
+	return c
+}
+
+// chunkReceived alerts streams waiting for chunks of the download.
+func (ds *downloadStreams) chunkReceived(fid clientdb.FileID) {
+	ds.mtx.Lock()
+	if c, ok := ds.chunkChans[fid]; ok {
+		close(c)
+		delete(ds.chunkChans, fid)
+	}
+	ds.mtx.Unlock()
+}
+
+// downloadEnded removes the state of a download that completed or was
+// canceled, alerting streams waiting for its chunks.
+func (ds *downloadStreams) downloadEnded(fid clientdb.FileID) {
+	ds.mtx.Lock()
+	if c, ok := ds.chunkChans[fid]; ok {
+		close(c)
+		delete(ds.chunkChans, fid)
+	}
+	delete(ds.priority, fid)
+	ds.mtx.Unlock()
+}
+
+// setPriority sets the first chunk to fetch for the download. Returns true if
+// the priority changed.
+func (ds *downloadStreams) setPriority(fid clientdb.FileID, chunkIdx int) bool {
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+	if old, ok := ds.priority[fid]; ok && old == chunkIdx {
+		return false
+	}
+	ds.priority[fid] = chunkIdx
+	return true
+}
+
+// orderMissingChunks orders the missing chunks of a download, starting at the
+// chunk with priority set by stream readers (if any). For sequential
+// downloads, only the first chunks (up to the sequential window) are returned.
+func (ds *downloadStreams) orderMissingChunks(fd *clientdb.FileDownload, missing []int) []int {
+	ds.mtx.Lock()
+	prio := ds.priority[fd.FID]
+	ds.mtx.Unlock()
+
+	if prio > 0 {
+		i := sort.SearchInts(missing, prio)
+		missing = append(missing[i:len(missing):len(missing)], missing[:i]...)
+	}
+	if fd.Sequential && len(missing) > sequentialDownloadWindow {
+		missing = missing[:sequentialDownloadWindow]
+	}
+	return missing
+}
+
+// SetDownloadSequential sets whether the chunks of an outstanding download are
+// fetched in order (with a limited number of chunks requested at a time),
+// such that the start of the file is available as soon as possible.
+func (c *Client) SetDownloadSequential(fid clientdb.FileID, sequential bool) error {
+	fd, err := c.outstandingDownload(fid)
+	if err != nil {
+		return err
+	}
+	if fd.Sequential == sequential {
+		return nil
+	}
+
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.SetFileDownloadSequential(tx, &fd, sequential)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Request chunks in the new order.
+	if fd.Metadata != nil && !fd.IsSentFile {
+		go c.downloadChunksLogErr(fd)
+	}
+	return nil
+}
+
+// downloadChunksLogErr calls downloadChunks and logs any errors.
+func (c *Client) downloadChunksLogErr(fd clientdb.FileDownload) {
+	err := c.downloadChunks(fd)
+	if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+		c.log.Errorf("Unable to download chunks of file %s: %v", fd.FID, err)
+	}
+}
+
+// DownloadStream is a read-only stream of the contents of a download. Reads
+// of parts of the file that have not been downloaded yet block until the
+// corresponding chunks are received.
+type DownloadStream struct {
+	c      *Client
+	fd     clientdb.FileDownload
+	ctx    context.Context
+	cancel func()
+
+	// chunkStart are the offsets of each chunk of the file.
+	chunkStart []int64
+	size       int64
+
+	mtx      sync.Mutex
+	offset   int64
+	chunkIdx int
+	chunk    []byte
+}
+
+// OpenDownloadStream opens a stream of the contents of a download (either
+// outstanding or completed). Reads block until the required chunks are
+// downloaded or the context is canceled. Outstanding downloads are switched
+// to sequential mode.
+//
+// Bundles cannot be streamed.
+func (c *Client) OpenDownloadStream(ctx context.Context, fid clientdb.FileID) (*DownloadStream, error) {
+	fds, err := c.ListDownloads()
+	if err != nil {
+		return nil, err
+	}
+	idx := -1
+	for i := range fds {
+		if fds[i].FID == fid {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, fmt.Errorf("download %s: %w", fid, clientdb.ErrNotFound)
+	}
+	fd := fds[idx]
+	if fd.Metadata == nil {
+		return nil, fmt.Errorf("metadata for download %s not received yet", fid)
+	}
+	if fd.Metadata.IsBundle() {
+		return nil, fmt.Errorf("cannot stream bundle %s", fid)
+	}
+	if fd.CompletedName == "" && !fd.Sequential {
+		if err := c.SetDownloadSequential(fid, true); err != nil {
+			return nil, err
+		}
+	}
+
+	s := &DownloadStream{
+		c:          c,
+		fd:         fd,
+		chunkStart: make([]int64, len(fd.Metadata.Manifest)),
+		chunkIdx:   -1,
+	}
+	for i, ch := range fd.Metadata.Manifest {
+		s.chunkStart[i] = s.size
+		s.size += int64(ch.Size)
+	}
+	s.ctx, s.cancel = context.WithCancel(ctx)
+	return s, nil
+}
+
+// Metadata returns the metadata of the streamed file.
+func (s *DownloadStream) Metadata() rpc.FileMetadata {
+	return *s.fd.Metadata
+}
+
+// Size returns the size of the streamed file.
+func (s *DownloadStream) Size() int64 {
+	return s.size
+}
+
+// readChunk reads the given chunk, blocking until it has been downloaded.
+func (s *DownloadStream) readChunk(chunkIdx int) ([]byte, error) {
+	c := s.c
+	fid := s.fd.FID
+	for {
+		// Fetch the chan before attempting to read, to avoid missing
+		// chunks received in between.
+		chunkChan := c.dlStreams.chunkChan(fid)
+
+		var data []byte
+		var fd clientdb.FileDownload
+		var dlErr error
+		err := c.dbView(func(tx clientdb.ReadTx) error {
+			fd, dlErr = c.db.ReadFileDownload(tx, s.fd.UID, fid)
+			if dlErr != nil {
+				return dlErr
+			}
+			var err error
+			data, err = c.db.ReadFileDownloadChunk(tx, &fd, chunkIdx)
+			return err
+		})
+		if errors.Is(dlErr, clientdb.ErrNotFound) {
+			return nil, fmt.Errorf("download %s was canceled: %w", fid, dlErr)
+		}
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return data, err
+		}
+
+		// Chunk not downloaded yet. Ensure it is the next one
+		// fetched.
+		if c.dlStreams.setPriority(fid, chunkIdx) && fd.Metadata != nil {
+			go c.downloadChunksLogErr(fd)
+		}
+
+		select {
+		case <-chunkChan:
+		case <-s.ctx.Done():
+			return nil, ErrDownloadStreamClosed
+		}
+	}
+}
+
+// Read reads data from the stream. It blocks until the data is available.
+func (s *DownloadStream) Read(b []byte) (int, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.offset >= s.size {
+		return 0, io.EOF
+	}
+
+	// Find the chunk that contains the offset.
+	idx := sort.Search(len(s.chunkStart), func(i int) bool {
+		return s.chunkStart[i] > s.offset
+	}) - 1
+	if idx != s.chunkIdx {
+		chunk, err := s.readChunk(idx)
+		if err != nil {
+			return 0, err
+		}
+		s.chunkIdx, s.chunk = idx, chunk
+	}
+
+	n := copy(b, s.chunk[s.offset-s.chunkStart[idx]:])
+	s.offset += int64(n)
+	return n, nil
+}
+
+// Seek sets the offset for the next Read.
+func (s *DownloadStream) Seek(offset int64, whence int) (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.offset
+	case io.SeekEnd:
+		offset += s.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative offset %d", offset)
+	}
+	s.offset = offset
+	return offset, nil
+}
+
+// Close closes the stream. Any blocked reads return with an error.
+func (s *DownloadStream) Close() error {
+	s.cancel()
+	return nil
+}
+
+var _ io.ReadSeekCloser = (*DownloadStream)(nil)
+
+// DownloadStreamHandler returns an HTTP handler that streams the downloads of
+// the client. Downloads are served at "/<token>/<file id>" and support range
+// requests. Requests without the given token are rejected, so that other
+// local processes cannot read the downloads. Requests for parts of a file
+// that have not been downloaded yet block until they are, so that media
+// players may start playback before the download completes.
+//
+// This handler is meant to be served only on a loopback address.
+func (c *Client) DownloadStreamHandler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		reqToken, rawFID, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if token == "" || subtle.ConstantTimeCompare([]byte(reqToken), []byte(token)) != 1 {
+			http.NotFound(w, r)
+			return
+		}
+
+		var fid clientdb.FileID
+		if err := fid.FromString(rawFID); err != nil {
+			http.Error(w, "invalid file id", http.StatusBadRequest)
+			return
+		}
+
+		s, err := c.OpenDownloadStream(r.Context(), fid)
+		if errors.Is(err, clientdb.ErrNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer s.Close()
+
+		http.ServeContent(w, r, s.Metadata().Filename, time.Time{}, s)
+	})
+}
+
+// DownloadStreamURL returns the local URL where the given download can be
+// streamed from by media players. The local stream server is started on first
+// use, listening on a loopback address with a random token that is only valid
+// while the client is running.
+func (c *Client) DownloadStreamURL(fid clientdb.FileID) (string, error) {
+	ds := &c.dlStreams
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	if ds.serverAddr == "" {
+		var rawToken [16]byte
+		if _, err := rand.Read(rawToken[:]); err != nil {
+			return "", err
+		}
+		token := hex.EncodeToString(rawToken[:])
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return "", fmt.Errorf("unable to listen for stream server: %v", err)
+		}
+		server := &http.Server{Handler: c.DownloadStreamHandler(token)}
+		go func() {
+			err := server.Serve(l)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				c.log.Errorf("Download stream server errored: %v", err)
+			}
+		}()
+		go func() {
+			<-c.ctx.Done()
+			server.Close()
+		}()
+		ds.serverAddr, ds.serverToken = l.Addr().String(), token
+		c.log.Infof("Listening for download streams on %s", ds.serverAddr)
+	}
+
+	return fmt.Sprintf("http://%s/%s/%s", ds.serverAddr, ds.serverToken, fid), nil
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// TestDownloadStreamsEnded tests that the state of downloads is removed once
+// they end and that streams waiting for their chunks are alerted.
+func TestDownloadStreamsEnded(t *testing.T) {
+	ds := downloadStreams{
+		chunkChans: make(map[clientdb.FileID]chan struct{}),
+		priority:   make(map[clientdb.FileID]int),
+	}
+	fid1, fid2 := clientdb.FileID{0: 1}, clientdb.FileID{0: 2}
+
+	fd := &clientdb.FileDownload{FID: fid1}
+	assert.BoolIs(t, ds.setPriority(fid1, 3), true)
+	assert.BoolIs(t, ds.setPriority(fid1, 3), false)
+	assert.BoolIs(t, ds.setPriority(fid2, 1), true)
+	assert.DeepEqual(t, ds.orderMissingChunks(fd, []int{1, 2, 3, 4}), []int{3, 4, 1, 2})
+
+	waitChan := ds.chunkChan(fid1)
+	ds.downloadEnded(fid1)
+	select {
+	case <-waitChan:
+	case <-time.After(time.Second):
+		t.Fatal("stream waiting for chunk was not alerted")
+	}
+	assert.DeepEqual(t, len(ds.priority), 1)
+	assert.DeepEqual(t, len(ds.chunkChans), 0)
+	assert.DeepEqual(t, ds.orderMissingChunks(fd, []int{1, 2, 3, 4}), []int{1, 2, 3, 4})
+
+	// Other downloads are not affected.
+	waitChan = ds.chunkChan(fid2)
+	ds.downloadEnded(fid1)
+	select {
+	case <-waitChan:
+		t.Fatal("stream of unrelated download was alerted")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/strescape"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// gcHistoryMaxBytes is the maximum number of message bytes included in a GC
+// history bundle. Older entries are dropped until the bundle fits.
+const gcHistoryMaxBytes = 256 * 1024
+
+// pendingGCHistory is a history bundle received before the first GC list of a
+// GC being joined.
+type pendingGCHistory struct {
+	ru *RemoteUser
+	gh rpc.RMGCHistory
+}
+
+// SetGCShareHistory sets the number of recent messages of the GC that the
+// local client sends to new members that join the GC through it. Setting this
+// to zero disables history sharing for the GC.
+func (c *Client) SetGCShareHistory(gcID zkidentity.ShortID, nbMsgs int) error {
+	if nbMsgs < 0 || nbMsgs > rpc.MaxGCHistoryEntries {
+		return fmt.Errorf("number of history messages must be between "+
+			"0 and %d", rpc.MaxGCHistoryEntries)
+	}
+
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		gc, err := c.db.GetGC(tx, gcID)
+		if err != nil {
+			return err
+		}
+		gc.ShareHistory = nbMsgs
+		return c.db.SaveGC(tx, gc)
+	})
+}
+
+// gcHistoryBundle creates a signed bundle with up to nbMsgs of the most recent
+// messages logged in the given GC.
+func (c *Client) gcHistoryBundle(tx clientdb.ReadTx, gc *clientdb.GroupChat, nbMsgs int) (rpc.RMGCHistory, error) {
+	gh := rpc.RMGCHistory{ID: gc.Metadata.ID}
+
+	// Read some extra entries, given that internal messages are not
+	// shared.
+	logEntries, err := c.db.ReadLogGCMsg(tx, gc.Name(), gc.Metadata.ID, nbMsgs*2, 0)
+	if err != nil {
+		return gh, err
+	}
+
+	// Select the most recent entries, up to the max number of bytes.
+	var totalBytes int
+	for i := len(logEntries) - 1; i >= 0 && len(gh.Entries) < nbMsgs; i-- {
+		e := logEntries[i]
+		if e.Internal {
+			continue
+		}
+		totalBytes += len(e.From) + len(e.Message)
+		if totalBytes > gcHistoryMaxBytes {
+			break
+		}
+		gh.Entries = append(gh.Entries, rpc.RMGCHistoryEntry{
+			From:      e.From,
+			Message:   e.Message,
+			Timestamp: e.Timestamp,
+		})
+	}
+	slices.Reverse(gh.Entries)
+
+	hash := gh.Hash()
+	gh.Signature = c.localID.signMessage(hash[:])
+	return gh, nil
+}
+
+// SendGCHistory sends a signed bundle with up to nbMsgs of the most recent
+// messages of the GC to the specified GC member. The remote client merges
+// the history into its GC log, as long as it has not yet received history
+// for this GC since joining it.
+func (c *Client) SendGCHistory(gcID zkidentity.ShortID, uid UserID, nbMsgs int) error {
+	if nbMsgs <= 0 || nbMsgs > rpc.MaxGCHistoryEntries {
+		return fmt.Errorf("number of history messages must be between "+
+			"1 and %d", rpc.MaxGCHistoryEntries)
+	}
+
+	var gh rpc.RMGCHistory
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		gc, err := c.db.GetGC(tx, gcID)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(gc.Metadata.Members, uid) {
+			return fmt.Errorf("user %s is not a member of GC %q",
+				uid, gc.Name())
+		}
+		if gc.Metadata.Channel && gc.Metadata.Members[0] != c.PublicID() {
+			return fmt.Errorf("only the owner may share the history "+
+				"of channel %q", gc.Name())
+		}
+		gh, err = c.gcHistoryBundle(tx, &gc, nbMsgs)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if len(gh.Entries) == 0 {
+		return fmt.Errorf("no messages to share in GC %s", gcID)
+	}
+
+	c.log.Infof("Sending %d GC history messages of %s to %s",
+		len(gh.Entries), gcID, uid)
+	payEvent := fmt.Sprintf("gc.%s.sendhistory", gcID.ShortLogID())
+	return c.sendWithSendQPriority(payEvent, gh, priorityGC, nil, uid)
+}
+
+// handleGCHistory handles a GC history bundle received from a GC member. The
+// history is only merged if the local client has not merged any history since
+// joining the GC.
+func (c *Client) handleGCHistory(ru *RemoteUser, gh rpc.RMGCHistory) error {
+	if len(gh.Entries) > rpc.MaxGCHistoryEntries {
+		return fmt.Errorf("received GC history with too many entries (%d)",
+			len(gh.Entries))
+	}
+	hash := gh.Hash()
+	if !ru.verifyMessage(hash[:], &gh.Signature) {
+		return fmt.Errorf("received GC history with invalid signature")
+	}
+
+	// The GC list and the history are sent in sequence, but their
+	// handlers may run concurrently. Keep the history of a GC being
+	// joined until its first list is processed.
+	var joining bool
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		_, err := c.db.GetGC(tx, gh.ID)
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return err
+		}
+		invites, err := c.db.ListGCInvites(tx, &gh.ID)
+		if err != nil {
+			return err
+		}
+		joining = slices.ContainsFunc(invites, func(inv *clientdb.GCInvite) bool {
+			return inv.Accepted
+		})
+		if !joining {
+			return fmt.Errorf("received GC history for unknown GC %s", gh.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if joining {
+		c.gcHistoryMtx.Lock()
+		if _, ok := c.gcHistoryPending[gh.ID]; !ok {
+			c.gcHistoryPending[gh.ID] = pendingGCHistory{ru: ru, gh: gh}
+		}
+		c.gcHistoryMtx.Unlock()
+		ru.log.Debugf("Delaying GC history of %s until GC list is "+
+			"received", gh.ID)
+		return nil
+	}
+
+	return c.mergeGCHistory(ru, gh)
+}
+
+// mergePendingGCHistory merges the history bundle received before the first
+// list of the GC (if there is one).
+func (c *Client) mergePendingGCHistory(gcID zkidentity.ShortID) {
+	c.gcHistoryMtx.Lock()
+	pending, ok := c.gcHistoryPending[gcID]
+	delete(c.gcHistoryPending, gcID)
+	c.gcHistoryMtx.Unlock()
+	if !ok {
+		return
+	}
+
+	if err := c.mergeGCHistory(pending.ru, pending.gh); err != nil {
+		pending.ru.log.Warnf("Unable to merge GC history of %s: %v",
+			gcID, err)
+	}
+}
+
+// mergeGCHistory merges a verified GC history bundle into the GC log, unless
+// the history of the GC was already synced.
+func (c *Client) mergeGCHistory(ru *RemoteUser, gh rpc.RMGCHistory) error {
+	var gc clientdb.GroupChat
+	var alreadySynced bool
+	senderNick := strescape.Nick(ru.Nick())
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		if gc, err = c.db.GetGC(tx, gh.ID); err != nil {
+			return err
+		}
+		if gc.Metadata.Channel && gc.Metadata.Members[0] != ru.ID() {
+			return fmt.Errorf("history sender is not the owner of "+
+				"channel %q", gc.Name())
+		}
+		if !slices.Contains(gc.Metadata.Members, ru.ID()) {
+			return fmt.Errorf("history sender is not a member of GC %q",
+				gc.Name())
+		}
+		if !gc.AwaitingHistory {
+			alreadySynced = true
+			return nil
+		}
+
+		gc.AwaitingHistory = false
+		if err := c.db.SaveGC(tx, gc); err != nil {
+			return err
+		}
+
+		// Merge the history into the GC log, surrounded by markers.
+		name, gcID := gc.Name(), gc.Metadata.ID
+		err = c.db.LogGCMsg(tx, name, gcID, true, "",
+			fmt.Sprintf("History from %s (%d messages)", senderNick,
+				len(gh.Entries)), time.Now())
+		if err != nil {
+			return err
+		}
+		for _, e := range gh.Entries {
+			err := c.db.LogGCMsg(tx, name, gcID, false, e.From,
+				e.Message, time.Unix(e.Timestamp, 0))
+			if err != nil {
+				return err
+			}
+		}
+		return c.db.LogGCMsg(tx, name, gcID, true, "",
+			fmt.Sprintf("End of history from %s", senderNick), time.Now())
+	})
+	if err != nil {
+		return err
+	}
+	if alreadySynced {
+		ru.log.Infof("Ignoring GC history for %q: history not expected",
+			gc.Name())
+		return nil
+	}
+
+	ru.log.Infof("Merged %d GC history messages of %q", len(gh.Entries), gc.Name())
+	c.ntfns.notifyGCHistoryReceived(ru, gc.Metadata, gh.Entries)
+	return nil
+}
@@ -0,0 +1,326 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// Membership changes on version 3 GCs are sent as deltas to existing members,
+// instead of resending the full GC list to every member on every change
+// (which grows quadratically with the number of members). Each delta is
+// chained to the previous GC definition by its generation, and members that
+// detect a gap request the full list from the admin that sent the delta.
+//
+// GC lists larger than the configured chunk size are sent as a sequence of
+// RMGroupListChunk messages, which are reassembled by the receiver before
+// being processed as a regular RMGroupList.
+
+const (
+	// gcDeltaVersion is the min GC version where membership changes are
+	// sent as deltas and large lists are sent in chunks. This is distinct
+	// from channelGCVersion, given that clients that support channels do
+	// not necessarily support deltas.
+	gcDeltaVersion = 3
+
+	// gcListRequestInterval is the min interval between full GC list
+	// resends requested by the same member.
+	gcListRequestInterval = time.Minute
+
+	// gcListChunksTimeout is how long partially received chunked GC lists
+	// and GC lists requested by the local client are kept.
+	gcListChunksTimeout = 10 * time.Minute
+)
+
+// errGCDeltaGap is returned when a members delta does not chain to the local
+// definition of the GC.
+var errGCDeltaGap = errors.New("GC members delta does not chain to local GC")
+
+// errGCDeltaApplied is used internally to signal that a members delta was
+// already applied to the local definition of the GC.
+var errGCDeltaApplied = errors.New("GC members delta already applied")
+
+// gcListChunksKey identifies a chunked GC list sent by a remote user.
+type gcListChunksKey struct {
+	uid  clientintf.UserID
+	gcID zkidentity.ShortID
+}
+
+// gcListChunks tracks the chunks received for a GC list.
+type gcListChunks struct {
+	started    time.Time
+	generation uint64
+	members    [][]zkidentity.ShortID
+	got        []bool
+	received   int
+}
+
+// splitGCList splits the GC list into chunks of at most chunkSize members.
+// Returns nil if the list does not need to be split.
+func splitGCList(gl rpc.RMGroupList, chunkSize int) []rpc.RMGroupListChunk {
+	if chunkSize <= 0 || len(gl.Members) <= chunkSize {
+		return nil
+	}
+
+	total := (len(gl.Members) + chunkSize - 1) / chunkSize
+	res := make([]rpc.RMGroupListChunk, 0, total)
+	for i := 0; i < total; i++ {
+		start := i * chunkSize
+		end := min(start+chunkSize, len(gl.Members))
+		chunk := gl
+		chunk.Members = gl.Members[start:end]
+		res = append(res, rpc.RMGroupListChunk{
+			List:        chunk,
+			Chunk:       uint32(i),
+			TotalChunks: uint32(total),
+		})
+	}
+	return res
+}
+
+// sendGCList sends the full GC definition to the given members. Lists of
+// version 3 GCs that are larger than the configured chunk size are sent in
+// multiple chunks.
+func (c *Client) sendGCList(gl rpc.RMGroupList, members []zkidentity.ShortID,
+	payType string) error {
+
+	var chunks []rpc.RMGroupListChunk
+	if gl.Version >= gcDeltaVersion {
+		chunks = splitGCList(gl, c.cfg.GCListChunkSize)
+	}
+	if chunks == nil {
+		return c.sendToGCMembers(gl.ID, members, payType, gl, nil)
+	}
+
+	c.log.Debugf("Sending GC %s list with %d members in %d chunks", gl.ID,
+		len(gl.Members), len(chunks))
+	for _, chunk := range chunks {
+		err := c.sendToGCMembers(gl.ID, members, payType, chunk, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneGCListState removes stale chunked GC lists, list requests and requested
+// lists. Must be called with gcListMtx held.
+func (c *Client) pruneGCListState() {
+	now := time.Now()
+	for key, pending := range c.gcListChunks {
+		if now.Sub(pending.started) > gcListChunksTimeout {
+			delete(c.gcListChunks, key)
+		}
+	}
+	for key, t := range c.gcListRequests {
+		if now.Sub(t) > gcListRequestInterval {
+			delete(c.gcListRequests, key)
+		}
+	}
+	for key, t := range c.gcListRequested {
+		if now.Sub(t) > gcListChunksTimeout {
+			delete(c.gcListRequested, key)
+		}
+	}
+}
+
+// gcListChunkExpected returns an error if the local client is not expecting a
+// (chunked) GC list from the remote user. Lists are expected when they were
+// requested from the remote user or when joining a GC after accepting an
+// invite from them.
+func (c *Client) gcListChunkExpected(ru *RemoteUser, gcID zkidentity.ShortID) error {
+	key := gcListChunksKey{uid: ru.ID(), gcID: gcID}
+	c.gcListMtx.Lock()
+	_, requested := c.gcListRequested[key]
+	c.gcListMtx.Unlock()
+	if requested {
+		return nil
+	}
+
+	return c.dbView(func(tx clientdb.ReadTx) error {
+		_, err := c.db.GetGC(tx, gcID)
+		if err == nil {
+			return fmt.Errorf("list of GC %s was not requested", gcID)
+		}
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return err
+		}
+		invites, err := c.db.ListGCInvites(tx, &gcID)
+		if err != nil {
+			return err
+		}
+		accepted := slices.ContainsFunc(invites, func(inv *clientdb.GCInvite) bool {
+			return inv.Accepted && inv.User == ru.ID()
+		})
+		if !accepted {
+			return fmt.Errorf("no accepted invite for GC %s", gcID)
+		}
+		return nil
+	})
+}
+
+// handleGCListChunk handles a chunk of a GC list. Once all chunks have been
+// received, the reassembled list is handled as a regular GC list.
+func (c *Client) handleGCListChunk(ru *RemoteUser, chunk rpc.RMGroupListChunk, ts time.Time) error {
+	if chunk.TotalChunks < 2 || chunk.TotalChunks > rpc.MaxGroupListChunks ||
+		chunk.Chunk >= chunk.TotalChunks {
+		return fmt.Errorf("invalid GC list chunk %d/%d", chunk.Chunk,
+			chunk.TotalChunks)
+	}
+
+	gl := chunk.List
+	if err := c.gcListChunkExpected(ru, gl.ID); err != nil {
+		return fmt.Errorf("unexpected GC list chunk: %v", err)
+	}
+	key := gcListChunksKey{uid: ru.ID(), gcID: gl.ID}
+
+	c.gcListMtx.Lock()
+	c.pruneGCListState()
+	pending := c.gcListChunks[key]
+	if pending == nil || pending.generation != gl.Generation ||
+		len(pending.members) != int(chunk.TotalChunks) {
+		// First chunk of a new list. Discard any partially received
+		// older list.
+		pending = &gcListChunks{
+			started:    time.Now(),
+			generation: gl.Generation,
+			members:    make([][]zkidentity.ShortID, chunk.TotalChunks),
+			got:        make([]bool, chunk.TotalChunks),
+		}
+		c.gcListChunks[key] = pending
+	}
+	if !pending.got[chunk.Chunk] {
+		pending.got[chunk.Chunk] = true
+		pending.received += 1
+	}
+	pending.members[chunk.Chunk] = gl.Members
+	complete := pending.received == len(pending.members)
+	if complete {
+		delete(c.gcListChunks, key)
+		delete(c.gcListRequested, key)
+	}
+	c.gcListMtx.Unlock()
+
+	if !complete {
+		ru.log.Tracef("Received GC list chunk %d/%d of %s", chunk.Chunk+1,
+			chunk.TotalChunks, gl.ID)
+		return nil
+	}
+
+	gl.Members = slices.Concat(pending.members...)
+	ru.log.Debugf("Reassembled GC %s list with %d members from %d chunks",
+		gl.ID, len(gl.Members), chunk.TotalChunks)
+	return c.handleGCList(ru, gl, ts)
+}
+
+// requestGCList requests the full GC list from the specified admin.
+func (c *Client) requestGCList(ru *RemoteUser, gcID zkidentity.ShortID) error {
+	ru.log.Infof("Requesting full list of GC %s", gcID)
+	key := gcListChunksKey{uid: ru.ID(), gcID: gcID}
+	c.gcListMtx.Lock()
+	c.gcListRequested[key] = time.Now()
+	c.gcListMtx.Unlock()
+	payEvent := fmt.Sprintf("gc.%s.listrequest", gcID.ShortLogID())
+	req := rpc.RMGroupListRequest{ID: gcID}
+	return c.sendWithSendQPriority(payEvent, req, priorityGC, nil, ru.ID())
+}
+
+// handleGCListRequest handles a request from a GC member to resend the full
+// GC list.
+func (c *Client) handleGCListRequest(ru *RemoteUser, req rpc.RMGroupListRequest) error {
+	key := gcListChunksKey{uid: ru.ID(), gcID: req.ID}
+	c.gcListMtx.Lock()
+	c.pruneGCListState()
+	lastReq := c.gcListRequests[key]
+	tooSoon := time.Since(lastReq) < gcListRequestInterval
+	if !tooSoon {
+		c.gcListRequests[key] = time.Now()
+	}
+	c.gcListMtx.Unlock()
+	if tooSoon {
+		return fmt.Errorf("ignoring request for list of GC %s: last "+
+			"request was at %s", req.ID, lastReq.Format(time.RFC3339))
+	}
+
+	uid := ru.ID()
+	return c.ResendGCList(req.ID, &uid)
+}
+
+// handleGCMembersDelta handles an incremental update to the members of a GC.
+func (c *Client) handleGCMembersDelta(ru *RemoteUser, delta rpc.RMGroupMembersDelta, ts time.Time) error {
+	cb := func(gc *clientdb.GroupChat) error {
+		meta := &gc.Metadata
+		if meta.Version < gcDeltaVersion {
+			return fmt.Errorf("received members delta for GC %s with "+
+				"version %d < %d", delta.ID, meta.Version,
+				gcDeltaVersion)
+		}
+
+		// Duplicated or stale deltas are ignored when their changes
+		// are already reflected in the local GC.
+		if delta.Generation <= meta.Generation {
+			hasAll := !slices.ContainsFunc(delta.Added, func(uid zkidentity.ShortID) bool {
+				return !slices.Contains(meta.Members, uid)
+			})
+			hasNone := !slices.ContainsFunc(delta.Removed, func(uid zkidentity.ShortID) bool {
+				return slices.Contains(meta.Members, uid)
+			})
+			if hasAll && hasNone {
+				return errGCDeltaApplied
+			}
+		}
+		if delta.Generation != meta.Generation+1 {
+			return fmt.Errorf("%w (local generation %d, delta "+
+				"generation %d)", errGCDeltaGap, meta.Generation,
+				delta.Generation)
+		}
+
+		for _, uid := range delta.Removed {
+			if uid == meta.Members[0] {
+				return fmt.Errorf("cannot remove members[0] from GC")
+			}
+			isUID := func(id zkidentity.ShortID) bool { return id == uid }
+			meta.Members = slices.DeleteFunc(meta.Members, isUID)
+			meta.ExtraAdmins = slices.DeleteFunc(meta.ExtraAdmins, isUID)
+		}
+		for _, uid := range delta.Added {
+			if !slices.Contains(meta.Members, uid) {
+				meta.Members = append(meta.Members, uid)
+			}
+		}
+		meta.Generation = delta.Generation
+		meta.Timestamp = delta.Timestamp
+		return nil
+	}
+
+	oldGC, newGC, err := c.maybeUpdateGCFunc(ru, delta.ID, cb)
+	switch {
+	case errors.Is(err, errGCDeltaApplied):
+		ru.log.Debugf("Ignoring already applied members delta of GC %s "+
+			"(generation %d)", delta.ID, delta.Generation)
+		return nil
+
+	case errors.Is(err, errGCDeltaGap):
+		ru.log.Warnf("Unable to apply members delta of GC %s: %v",
+			delta.ID, err)
+		return c.requestGCList(ru, delta.ID)
+
+	case err != nil:
+		return err
+	}
+
+	c.log.Infof("Received GC %s (%q) members delta from %s (%d added, "+
+		"%d removed)", delta.ID, oldGC.Name(), ru, len(delta.Added),
+		len(delta.Removed))
+	for _, uid := range delta.Removed {
+		c.ntfns.notifyGCUserParted(delta.ID, uid, delta.Reason, true)
+	}
+	c.notifyUpdatedGC(ru, oldGC.Metadata, newGC.Metadata, ts)
+	return nil
+}
@@ -23,7 +23,7 @@ const (
 	// {min,max}SupportedGCVersion tracks the mininum and maximum versions
 	// the client code handles for GCs.
 	minSupportedGCVersion = 0
-	maxSupportedGCVersion = 1
+	maxSupportedGCVersion = 3
 
 	// newGCVersion is the version of newly created GCs.
 	newGCVersion = 1
@@ -156,6 +156,11 @@ func (c *Client) GCsWithMember(uid UserID) ([]zkidentity.ShortID, error) {
 // NewGroupChatVersion creates a new gc with the local user as admin and the
 // specified version.
 func (c *Client) NewGroupChatVersion(name string, version uint8) (zkidentity.ShortID, error) {
+	return c.newGroupChat(name, version, false)
+}
+
+// newGroupChat creates a new gc (or channel) with the local user as admin.
+func (c *Client) newGroupChat(name string, version uint8, channel bool) (zkidentity.ShortID, error) {
 	var id zkidentity.ShortID
 
 	// Ensure we're not trying to duplicate the name.
@@ -185,6 +190,7 @@ func (c *Client) NewGroupChatVersion(name string, version uint8) (zkidentity.Sho
 			Members: []zkidentity.ShortID{
 				c.PublicID(),
 			},
+			Channel: channel,
 		}}
 		if err = c.db.SaveGC(tx, gc); err != nil {
 			return fmt.Errorf("can't save gc %q (%s): %v", name, id.String(), err)
@@ -213,7 +219,7 @@ func (c *Client) uidHasGCPerm(gc *rpc.RMGroupList, uid clientintf.UserID) error
 		return fmt.Errorf("user %s not version 0 GC admin", uid)
 	}
 
-	if gc.Version == 1 {
+	if gc.Version >= 1 && gc.Version <= maxSupportedGCVersion {
 		if len(gc.Members) > 0 && gc.Members[0].ConstantTimeEq(&uid) {
 			// Update from admin. Accept.
 			return nil
@@ -224,7 +230,7 @@ func (c *Client) uidHasGCPerm(gc *rpc.RMGroupList, uid clientintf.UserID) error
 			return nil
 		}
 
-		return fmt.Errorf("user %s not version 1 GC admin", uid)
+		return fmt.Errorf("user %s not version %d GC admin", uid, gc.Version)
 	}
 
 	return fmt.Errorf("unsupported GC version %d", gc.Version)
@@ -672,6 +678,12 @@ func (c *Client) maybeUpdateGCFunc(ru *RemoteUser, gcid zkidentity.ShortID, f fu
 				"%q to %q", gcid, oldMeta.Name, newMeta.Name)
 		}
 
+		// Ensure a GC is not converted to or from a channel.
+		if newMeta.Channel != oldMeta.Channel {
+			return fmt.Errorf("cannot change channel flag of GC %s",
+				gcid)
+		}
+
 		// Special case changing the owner: only the owner itself
 		// can do it.
 		if len(oldMeta.Members) == 0 || len(newMeta.Members) == 0 {
@@ -761,39 +773,56 @@ func (c *Client) handleGCJoin(ru *RemoteUser, invite rpc.RMGroupJoin) error {
 	updated := false
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		var err error
-		sentInvite, uid, iid, err := c.db.FindGCInvite(tx,
-			invite.ID, invite.Token)
+		gc, err = c.db.GetGC(tx, invite.ID)
 		if err != nil {
 			return err
 		}
 
-		// Ensure the invitation is still valid.
-		expires := time.Unix(sentInvite.Expires, 0)
-		if expires.Before(time.Now()) {
-			return fmt.Errorf("%w %s", ErrGCInvitationExpired,
-				expires.Format(time.RFC3339))
-		}
+		// Channels may be joined by anyone presenting the channel's
+		// share token. Otherwise, the join must match an invite sent
+		// by the local client.
+		uid := ru.ID()
+		var iid uint64
+		viaShareToken := gc.Metadata.Channel && gc.ShareToken != 0 &&
+			gc.ShareToken == invite.Token
+		if viaShareToken && slices.Contains(gc.KickedSubscribers, uid) {
+			return fmt.Errorf("user %s was kicked from channel %q and "+
+				"cannot rejoin with its share token", uid, gc.Name())
+		}
+		if !viaShareToken {
+			var sentInvite rpc.RMGroupInvite
+			sentInvite, uid, iid, err = c.db.FindGCInvite(tx,
+				invite.ID, invite.Token)
+			if err != nil {
+				return err
+			}
+
+			// Ensure the invitation is still valid.
+			expires := time.Unix(sentInvite.Expires, 0)
+			if expires.Before(time.Now()) {
+				return fmt.Errorf("%w %s", ErrGCInvitationExpired,
+					expires.Format(time.RFC3339))
+			}
 
-		// Ensure we received this join from the same user we sent it
-		// to.
-		if uid != ru.ID() {
-			return fmt.Errorf("received GCJoin from user %s when "+
-				"it was sent to user %s", ru.ID(), uid)
+			// Ensure we received this join from the same user we
+			// sent it to.
+			if uid != ru.ID() {
+				return fmt.Errorf("received GCJoin from user %s when "+
+					"it was sent to user %s", ru.ID(), uid)
+			}
 		}
 
 		// Ensure we have permission to add people to GC.
-		gc, err = c.db.GetGC(tx, invite.ID)
-		if err != nil {
-			return err
-		}
 		if err := c.uidHasGCPerm(&gc.Metadata, c.PublicID()); err != nil {
 			return fmt.Errorf("local user does not have permission "+
 				"to add gc member: %v", err)
 		}
 
 		// This invitation is fulfilled.
-		if err = c.db.DelGCInvite(tx, iid); err != nil {
-			return err
+		if !viaShareToken {
+			if err = c.db.DelGCInvite(tx, iid); err != nil {
+				return err
+			}
 		}
 
 		// Ensure user is not on gc yet.
@@ -806,6 +835,8 @@ func (c *Client) handleGCJoin(ru *RemoteUser, invite rpc.RMGroupJoin) error {
 			// Add the new member, increment generation, save the
 			// new gc group.
 			gc.Metadata.Members = append(gc.Metadata.Members, uid)
+			gc.KickedSubscribers = slices.DeleteFunc(gc.KickedSubscribers,
+				func(id UserID) bool { return id == uid })
 			gc.Metadata.Generation += 1
 			gc.Metadata.Timestamp = time.Now().Unix()
 			if err = c.db.SaveGC(tx, gc); err != nil {
@@ -829,12 +860,54 @@ func (c *Client) handleGCJoin(ru *RemoteUser, invite rpc.RMGroupJoin) error {
 
 	c.log.Infof("User %s joined gc %s (%q)", ru, gc.Metadata.ID, gc.Metadata.Name)
 
-	// Join fulfilled. Send new group list to every member except admin
-	// (us).
-	err = c.sendToGCMembers(gc.Metadata.ID, gc.Metadata.Members, "sendlist",
-		gc.Metadata, nil)
-	if err != nil {
-		return err
+	// Channel subscribers only need to know about the owner and
+	// themselves, so send the list only to the new subscriber.
+	if gc.Metadata.Channel {
+		payEvent := fmt.Sprintf("gc.%s.sendlist", gc.Metadata.ID.ShortLogID())
+		gl := channelSubscriberList(gc.Metadata, ru.ID())
+		err := c.sendWithSendQPriority(payEvent, gl, priorityGC, nil, ru.ID())
+		if err != nil {
+			return err
+		}
+	} else if gc.Metadata.Version >= gcDeltaVersion {
+		// Existing members only need to know about the new member,
+		// while the new member needs the full list.
+		delta := rpc.RMGroupMembersDelta{
+			ID:         gc.Metadata.ID,
+			Generation: gc.Metadata.Generation,
+			Timestamp:  gc.Metadata.Timestamp,
+			Added:      []zkidentity.ShortID{ru.ID()},
+		}
+		oldMembers := gc.Metadata.Members[:len(gc.Metadata.Members)-1]
+		err = c.sendToGCMembers(gc.Metadata.ID, oldMembers, "delta",
+			delta, nil)
+		if err != nil {
+			return err
+		}
+		err = c.sendGCList(gc.Metadata, []zkidentity.ShortID{ru.ID()},
+			"sendlist")
+		if err != nil {
+			return err
+		}
+	} else {
+		// Join fulfilled. Send new group list to every member except
+		// admin (us).
+		err = c.sendToGCMembers(gc.Metadata.ID, gc.Metadata.Members,
+			"sendlist", gc.Metadata, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Share the recent history with the new member if this GC is
+	// configured to do so. This is sent after the list, so that the new
+	// member already knows about the GC when the history arrives.
+	if gc.ShareHistory > 0 {
+		err := c.SendGCHistory(gc.Metadata.ID, ru.ID(), gc.ShareHistory)
+		if err != nil {
+			c.log.Warnf("Unable to send GC history of %s to %s: %v",
+				gc.Metadata.ID, ru, err)
+		}
 	}
 
 	c.ntfns.notifyGCInviteAccepted(ru, gc.Metadata)
@@ -936,8 +1009,9 @@ func (c *Client) saveJoinedGC(ru *RemoteUser, gl rpc.RMGroupList) (string, error
 			return fmt.Errorf("unable to del gc invite: %v", err)
 		}
 
-		// Start preparing GroupChat structure.
-		gc := clientdb.GroupChat{Metadata: gl}
+		// Start preparing GroupChat structure. Any member may send
+		// the recent history of the GC to the new member.
+		gc := clientdb.GroupChat{Metadata: gl, AwaitingHistory: true}
 
 		// Figure out the GC name (deduplicate name into alias).
 		aliased := false
@@ -970,6 +1044,11 @@ func (c *Client) saveJoinedGC(ru *RemoteUser, gl rpc.RMGroupList) (string, error
 func (c *Client) handleGCList(ru *RemoteUser, gl rpc.RMGroupList, ts time.Time) error {
 	var gcName string
 
+	if gl.Channel && gl.Version < channelGCVersion {
+		return fmt.Errorf("received channel list for GC %s with "+
+			"version %d < %d", gl.ID, gl.Version, channelGCVersion)
+	}
+
 	// Check if GC exists to determine if it's the first GC list.
 	_, err := c.GetGC(gl.ID)
 	isNewGC := err != nil && errors.Is(err, clientdb.ErrNotFound)
@@ -998,6 +1077,7 @@ func (c *Client) handleGCList(ru *RemoteUser, gl rpc.RMGroupList, ts time.Time)
 	c.log.Infof("Received first GC list of %s (%q) from %s", gl.ID, gcName, ru)
 	c.logGCEvent(gl.ID, ts, "Admin %s added local client to GC", strescape.Nick(ru.Nick()))
 	c.ntfns.notifyOnJoinedGC(gl)
+	c.mergePendingGCHistory(gl.ID)
 
 	// Start kx with unknown members. They are relying on us performing
 	// transitive KX via an admin.
@@ -1079,6 +1159,10 @@ func (c *Client) GCMessage(gcID zkidentity.ShortID, msg string, mode rpc.Message
 		if gc, err = c.db.GetGC(tx, gcID); err != nil {
 			return err
 		}
+		if gc.Metadata.Channel && gc.Metadata.Members[0] != c.PublicID() {
+			return fmt.Errorf("only the owner may publish in channel %q",
+				gc.Name())
+		}
 		if gcBlockList, err = c.db.GetGCBlockList(tx, gcID); err != nil {
 			return err
 		}
@@ -1114,7 +1198,7 @@ func (c *Client) handleGCMessage(ru *RemoteUser, gcm rpc.RMGroupMessage, ts time
 	}
 
 	var gc clientdb.GroupChat
-	var found, isBlocked bool
+	var found, isBlocked, isChannelSubscriber bool
 
 	// Create the local cached structure for a received GCM. The MsgID is
 	// just a random id used for caching purposes.
@@ -1141,6 +1225,10 @@ func (c *Client) handleGCMessage(ru *RemoteUser, gcm rpc.RMGroupMessage, ts time
 		if !found {
 			return nil
 		}
+		if gc.Metadata.Channel && gc.Metadata.Members[0] != ru.ID() {
+			isChannelSubscriber = true
+			return nil
+		}
 
 		gcBlockList, err := c.db.GetGCBlockList(tx, gcm.ID)
 		if err != nil {
@@ -1188,6 +1276,13 @@ func (c *Client) handleGCMessage(ru *RemoteUser, gcm rpc.RMGroupMessage, ts time
 		return nil
 	}
 
+	if isChannelSubscriber {
+		// Only the channel owner may publish messages.
+		c.log.Warnf("Received message in channel %q from subscriber %s",
+			gc.Name(), ru)
+		return nil
+	}
+
 	if filter, _ := c.FilterGCM(ru.ID(), gc.Metadata.ID, gcm.Message); filter {
 		return nil
 	}
@@ -1262,16 +1357,13 @@ func (c *Client) GetGCDestCount(gcID zkidentity.ShortID) int {
 
 	myID := c.PublicID()
 	members := gcBlockList.FilterMembers(gc.Metadata.Members)
-	for i := 0; i < len(members); {
+	var count int
+	for i := range members {
 		if members[i] != myID {
-			i += 1
+			count += 1
 		}
-		if i < len(members)-1 {
-			members[i] = members[len(members)-1]
-		}
-		members = members[:len(members)-1]
 	}
-	return len(members)
+	return count
 }
 
 // ListGCs lists all local GCs the user is participating in.
@@ -1339,6 +1431,12 @@ func (c *Client) removeFromGC(gcID zkidentity.ShortID, uid UserID,
 			// Only bump generation when removing as an admin.
 			gc.Metadata.Generation += 1
 		}
+		if localUserMustBeAdmin && gc.Metadata.Channel &&
+			!slices.Contains(gc.KickedSubscribers, uid) {
+			// Kicked subscribers cannot rejoin with the share
+			// token.
+			gc.KickedSubscribers = append(gc.KickedSubscribers, uid)
+		}
 		if err = c.db.SaveGC(tx, gc); err != nil {
 			return err
 		}
@@ -1391,6 +1489,35 @@ func (c *Client) GCKick(gcID zkidentity.ShortID, uid UserID, reason string) erro
 	// Notify user was kicked.
 	c.ntfns.notifyGCUserParted(gcID, uid, reason, true)
 
+	// Other channel subscribers do not track the list of members, so only
+	// the kicked subscriber needs to be told about it.
+	if gc.Channel {
+		rmgk.NewGroupList.Members = gc.Members[:1]
+		payEvent := fmt.Sprintf("gc.%s.kick", gcID.ShortLogID())
+		return c.sendWithSendQPriority(payEvent, rmgk, priorityGC, nil, uid)
+	}
+
+	// Remaining members of version 3 GCs receive a delta, while the kickee
+	// receives a list with only the owner, which is enough for it to
+	// remove the GC.
+	if gc.Version >= gcDeltaVersion {
+		delta := rpc.RMGroupMembersDelta{
+			ID:         gcID,
+			Generation: gc.Generation,
+			Timestamp:  gc.Timestamp,
+			Removed:    []zkidentity.ShortID{uid},
+			Reason:     reason,
+		}
+		err := c.sendToGCMembers(gcID, gc.Members, "delta", delta, nil)
+		if err != nil {
+			return err
+		}
+		rmgk.NewGroupList.Members = gc.Members[:1]
+		rmgk.NewGroupList.ExtraAdmins = nil
+		return c.sendToGCMembers(gcID, []zkidentity.ShortID{uid}, "kick",
+			rmgk, nil)
+	}
+
 	// Saved updated GC members list. Send kick event to list of old
 	// members (which includes the kickee).
 	return c.sendToGCMembers(gcID, oldMembers, "kick", rmgk, nil)
@@ -1679,14 +1806,30 @@ func (c *Client) ResendGCList(gcid zkidentity.ShortID, uid *UserID) error {
 	}
 
 	payType := "resendGCList"
+	payEvent := fmt.Sprintf("gc.%s.%s", gcid.ShortLogID(), payType)
+	if gc.Metadata.Channel {
+		// Each channel subscriber receives its own version of the
+		// list.
+		dests := gc.Metadata.Members[1:]
+		if !allMembers {
+			dests = []clientintf.UserID{*uid}
+		}
+		c.log.Infof("Resending channel %s list to %d subscribers", gcid,
+			len(dests))
+		for _, dest := range dests {
+			gl := channelSubscriberList(gc.Metadata, dest)
+			if err := c.sendWithSendQ(payEvent, gl, dest); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if allMembers {
 		c.log.Infof("Resending GC %s list to all members", gcid)
-		c.sendToGCMembers(gcid, gc.Metadata.Members, payType, gc, nil)
-		return nil
+		return c.sendGCList(gc.Metadata, gc.Metadata.Members, payType)
 	}
 	ru.log.Infof("Resending GC %s list to user", gcid)
-	payEvent := fmt.Sprintf("gc.%s.%s", gcid.ShortLogID(), payType)
-	return c.sendWithSendQ(payEvent, gc, *uid)
+	return c.sendGCList(gc.Metadata, []zkidentity.ShortID{*uid}, payType)
 }
 
 // UpgradeGC upgrades the version of the GC to the specified one. The local
@@ -1766,6 +1909,9 @@ func (c *Client) ModifyGCAdmins(gcid zkidentity.ShortID, extraAdmins []zkidentit
 		if gc.Metadata.Version < 1 {
 			return fmt.Errorf("cannot modify extra admins for GC with version < 1")
 		}
+		if gc.Metadata.Channel {
+			return fmt.Errorf("channels cannot have extra admins")
+		}
 		for _, uid := range extraAdmins {
 			if !slices.Contains(gc.Metadata.Members, uid) {
 				return fmt.Errorf("cannot make non-member an admin")
@@ -1808,6 +1954,9 @@ func (c *Client) ModifyGCOwner(gcid zkidentity.ShortID, newOwner clientintf.User
 		if gc.Metadata.Version < 1 {
 			return fmt.Errorf("cannot modify extra admins for GC with version < 1")
 		}
+		if gc.Metadata.Channel {
+			return fmt.Errorf("cannot change the owner of a channel")
+		}
 		newOwnerIdx := slices.Index(gc.Metadata.Members, newOwner)
 		if newOwnerIdx < 0 {
 			return fmt.Errorf("new owner is not a member of the GC")
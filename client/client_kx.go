@@ -522,7 +522,7 @@ func (c *Client) Ignore(uid UserID, ignore bool) error {
 
 	ru.SetIgnored(ignore)
 
-	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		ab, err := c.db.GetAddressBookEntry(tx, ru.ID())
 		if err != nil {
 			return err
@@ -531,6 +531,16 @@ func (c *Client) Ignore(uid UserID, ignore bool) error {
 		ab.Ignored = ignore
 		return c.db.UpdateAddressBookEntry(tx, ab)
 	})
+	if err != nil {
+		return err
+	}
+
+	change := AddressBookChangeUnignored
+	if ignore {
+		change = AddressBookChangeIgnored
+	}
+	c.ntfns.notifyAddressBookChanged(ru, change)
+	return nil
 }
 
 // Block blocks a uid.
@@ -552,9 +562,15 @@ func (c *Client) Block(uid UserID) error {
 	// Delete user
 	c.rul.del(ru)
 	ru.stop()
-	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		return c.db.RemoveUser(tx, ru.ID(), true)
 	})
+	if err != nil {
+		return err
+	}
+
+	c.ntfns.notifyAddressBookChanged(ru, AddressBookChangeBlocked)
+	return nil
 }
 
 // handleRMBlock handles an incoming block message.
@@ -592,7 +608,7 @@ func (c *Client) RenameUser(uid UserID, newNick string) error {
 	ru.log.Infof("Renaming user to %q", newNick)
 	c.rul.modifyUserNick(ru, newNick)
 
-	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		ab, err := c.db.GetAddressBookEntry(tx, ru.ID())
 		if err != nil {
 			return err
@@ -602,6 +618,12 @@ func (c *Client) RenameUser(uid UserID, newNick string) error {
 		ab.NickAlias = newNick
 		return c.db.UpdateAddressBookEntry(tx, ab)
 	})
+	if err != nil {
+		return err
+	}
+
+	c.ntfns.notifyAddressBookChanged(ru, AddressBookChangeRenamed)
+	return nil
 }
 
 // SuggestKX sends a message to invitee suggesting they KX with target (through
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/mediamsg"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// MediaInlineMaxSize is the max size of media that is sent inline (embedded in
+// the message). Larger media is sent through the file transfer subsystem and
+// the message only references the sent file.
+const MediaInlineMaxSize = 512 * 1024
+
+// prepareMedia validates the media and reads its data if it is small enough to
+// be sent inline. If inline is false, the media must be sent separately
+// through the file transfer subsystem.
+func prepareMedia(m *mediamsg.Media) (inline bool, err error) {
+	if err := m.Validate(); err != nil {
+		return false, err
+	}
+
+	inline = m.Data != nil && len(m.Data) <= MediaInlineMaxSize
+	if !inline && m.Data == nil && m.Size <= MediaInlineMaxSize {
+		if err := m.ReadData(); err != nil {
+			return false, err
+		}
+		inline = len(m.Data) <= MediaInlineMaxSize
+	}
+	if !inline && m.Path == "" {
+		return false, fmt.Errorf("media of size %d is too large to "+
+			"be sent inline and does not have a local file", len(m.Data))
+	}
+	return inline, nil
+}
+
+// mediaMsg returns the message that embeds the given media.
+func mediaMsg(m *mediamsg.Media, inline bool, caption string) string {
+	msg := m.EmbedArgs(inline).String()
+	if caption != "" {
+		msg = caption + "\n\n" + msg
+	}
+	return msg
+}
+
+// SendMediaPM sends an audio or video message to the given user. Small media
+// is embedded in the message, while larger media is sent with SendFile and
+// the message only includes its metadata and the ID of the sent file.
+//
+// Returns true if the media was sent inline.
+func (c *Client) SendMediaPM(uid UserID, m mediamsg.Media, caption string) (bool, error) {
+	inline, err := prepareMedia(&m)
+	if err != nil {
+		return false, err
+	}
+
+	if !inline {
+		if m.FileID, err = c.sendFile(uid, 0, m.Path, nil); err != nil {
+			return false, err
+		}
+	}
+	return inline, c.PM(uid, mediaMsg(&m, inline, caption))
+}
+
+// gcMediaDests returns the members of the GC that media files are sent to.
+func (c *Client) gcMediaDests(gcID zkidentity.ShortID) ([]UserID, error) {
+	var members []UserID
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		gc, err := c.db.GetGC(tx, gcID)
+		if err != nil {
+			return err
+		}
+		bl, err := c.db.GetGCBlockList(tx, gcID)
+		if err != nil {
+			return err
+		}
+		members = bl.FilterMembers(gc.Metadata.Members)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	myID := c.PublicID()
+	dests := make([]UserID, 0, len(members))
+	for _, uid := range members {
+		if uid == myID {
+			continue
+		}
+		if _, err := c.rul.byID(uid); err != nil {
+			c.log.Warnf("Unable to send media file to GC %s "+
+				"member %s: %v", gcID, uid, err)
+			continue
+		}
+		dests = append(dests, uid)
+	}
+	return dests, nil
+}
+
+// EstimateMediaGCMCost estimates the cost (in milliatoms) to send the media to
+// the GC with SendMediaGCM. This includes the cost to send the media file to
+// every member when the media is too large to be sent inline. It also returns
+// the number of members the message would be sent to.
+func (c *Client) EstimateMediaGCMCost(gcID zkidentity.ShortID, m mediamsg.Media,
+	caption string) (uint64, int, error) {
+
+	sess := c.ServerSession()
+	if sess == nil {
+		return 0, 0, fmt.Errorf("not connected to server")
+	}
+	policy := sess.Policy()
+
+	inline, err := prepareMedia(&m)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !inline {
+		// Account for the file id in the embed.
+		m.FileID[0] = 0xff
+	}
+	cost, nbDests, err := c.EstimateGCMCost(gcID, mediaMsg(&m, inline, caption))
+	if err != nil || inline {
+		return cost, nbDests, err
+	}
+
+	dests, err := c.gcMediaDests(gcID)
+	if err != nil {
+		return 0, 0, err
+	}
+	uploadCost, err := clientintf.EstimateUploadCost(int64(m.Size), &policy)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cost + uploadCost*uint64(len(dests)), nbDests, nil
+}
+
+// SendMediaGCM sends an audio or video message to the given GC. Small media is
+// embedded in the message, while larger media is sent with SendFile to every
+// member of the GC and the message only includes its metadata and the ID of
+// the sent file. EstimateMediaGCMCost may be used to estimate the cost of
+// sending the media.
+//
+// Returns true if the media was sent inline.
+func (c *Client) SendMediaGCM(gcID zkidentity.ShortID, m mediamsg.Media, caption string) (bool, error) {
+	inline, err := prepareMedia(&m)
+	if err != nil {
+		return false, err
+	}
+
+	if !inline {
+		dests, err := c.gcMediaDests(gcID)
+		if err != nil {
+			return false, err
+		}
+
+		// The ID of the file is the same for every member.
+		for _, uid := range dests {
+			if m.FileID, err = c.sendFile(uid, 0, m.Path, nil); err != nil {
+				return false, err
+			}
+		}
+	}
+	return inline, c.GCMessage(gcID, mediaMsg(&m, inline, caption),
+		rpc.MessageModeNormal, nil)
+}
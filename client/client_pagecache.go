@@ -0,0 +1,189 @@
+package client
+
+import (
+	"errors"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/strescape"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// ResourceMetaCached is set in the meta of replies served from the local cache
+// instead of fetched from the remote user. Its value is either "fresh" or
+// "stale" (when the cached resource is used while offline).
+const ResourceMetaCached = "X-Bisonrelay-Cached"
+
+// cacheControl is the caching policy of a fetched resource.
+type cacheControl struct {
+	noStore bool
+	noCache bool
+	maxAge  time.Duration
+}
+
+// parseCacheControl parses the value of a cache control meta key. Unknown
+// directives are ignored.
+func parseCacheControl(s string) cacheControl {
+	var cc cacheControl
+	for _, d := range strings.Split(s, ",") {
+		d = strings.ToLower(strings.TrimSpace(d))
+		switch {
+		case d == "no-store":
+			cc.noStore = true
+		case d == "no-cache":
+			cc.noCache = true
+		case strings.HasPrefix(d, "max-age="):
+			secs, err := strconv.ParseInt(d[len("max-age="):], 10, 64)
+			if err == nil && secs > 0 {
+				cc.maxAge = time.Duration(secs) * time.Second
+			}
+		}
+	}
+	return cc
+}
+
+// updateResourceCache updates the cached version of the resource with the reply
+// to a request. When the reply indicates the cached version is still current,
+// the cached reply is returned in its place.
+func (c *Client) updateResourceCache(tx clientdb.ReadWriteTx, uid UserID,
+	req *rpc.RMFetchResource, reply rpc.RMFetchResourceReply) (rpc.RMFetchResourceReply, error) {
+
+	if req.RequestMethod() != rpc.ResourceMethodGet {
+		return reply, nil
+	}
+
+	now := time.Now()
+	key := clientdb.ResourceCacheKeyFor(req)
+	cr, err := c.db.ReadCachedResource(tx, uid, key)
+	if err != nil && !errors.Is(err, clientdb.ErrNotFound) {
+		return reply, err
+	}
+	hasCached := err == nil
+
+	var cc cacheControl
+	switch {
+	case reply.Status == rpc.ResourceStatusNotModified && hasCached:
+		// Cached version is still current. Use the cached reply,
+		// updating its freshness.
+		cc = parseCacheControl(reply.Meta[rpc.ResourceMetaCacheControl])
+		if v, ok := reply.Meta[rpc.ResourceMetaCacheControl]; ok {
+			cr.Response.Meta = maps.Clone(cr.Response.Meta)
+			cr.Response.Meta[rpc.ResourceMetaCacheControl] = v
+		}
+		tag := reply.Tag
+		reply = cr.Response
+		reply.Tag = tag
+
+	case reply.Status == rpc.ResourceStatusOk:
+		cc = parseCacheControl(reply.Meta[rpc.ResourceMetaCacheControl])
+		cr = clientdb.CachedResource{
+			UID:      uid,
+			Path:     key.Path,
+			Meta:     key.Meta,
+			Index:    key.Index,
+			Count:    key.Count,
+			Response: reply,
+		}
+
+	default:
+		return reply, nil
+	}
+
+	if cc.noStore {
+		if hasCached {
+			err = c.db.RemoveCachedResource(tx, uid, key)
+		}
+		return reply, err
+	}
+
+	cr.Fetched = now
+	cr.Expires = time.Time{}
+	if !cc.noCache && cc.maxAge > 0 {
+		cr.Expires = now.Add(cc.maxAge)
+	}
+	return reply, c.db.StoreCachedResource(tx, &cr)
+}
+
+// fetchCachedResource serves a request for a resource from the local cache.
+func (c *Client) fetchCachedResource(ru *RemoteUser, cr clientdb.CachedResource,
+	sess, parentPage clientintf.PagesSessionID, rm *rpc.RMFetchResource,
+	stale bool) (rpc.ResourceTag, error) {
+
+	reply := cr.Response
+	reply.Meta = maps.Clone(reply.Meta)
+	if reply.Meta == nil {
+		reply.Meta = make(map[string]string, 1)
+	}
+	if stale {
+		reply.Meta[ResourceMetaCached] = "stale"
+	} else {
+		reply.Meta[ResourceMetaCached] = "fresh"
+	}
+
+	uid := ru.ID()
+	var fr clientdb.FetchedResource
+	var overv clientdb.PageSessionOverview
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		err := c.db.StoreResourceRequest(tx, uid, sess, parentPage, rm)
+		if err != nil {
+			return err
+		}
+		reply.Tag = rm.Tag
+		fr, overv, err = c.db.StoreFetchedResource(tx, uid, rm.Tag, reply)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	ru.log.Infof("Using cached resource tag %s path %s (stale: %v)",
+		rm.Tag, strescape.ResourcesPath(rm.Path), stale)
+	go c.ntfns.notifyResourceFetched(ru, fr, overv)
+	return rm.Tag, nil
+}
+
+// CachedResource returns the cached version of the resource with the given
+// key fetched from the remote user.
+func (c *Client) CachedResource(uid UserID, key clientdb.ResourceCacheKey) (clientdb.CachedResource, error) {
+	var cr clientdb.CachedResource
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		cr, err = c.db.ReadCachedResource(tx, uid, key)
+		return err
+	})
+	return cr, err
+}
+
+// ListCachedResources lists the cached resources fetched from the given user
+// (or from all users if nil).
+func (c *Client) ListCachedResources(uid *UserID) ([]clientdb.CachedResource, error) {
+	var res []clientdb.CachedResource
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListCachedResources(tx, uid)
+		return err
+	})
+	return res, err
+}
+
+// ClearResourceCache removes the cached resources fetched from the given user
+// (or from all users if nil).
+func (c *Client) ClearResourceCache(uid *UserID) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		crs, err := c.db.ListCachedResources(tx, uid)
+		if err != nil {
+			return err
+		}
+		for _, cr := range crs {
+			err := c.db.RemoveCachedResource(tx, cr.UID, cr.Key())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
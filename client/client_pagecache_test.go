@@ -0,0 +1,65 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// TestResourceCacheKey tests that resources are cached by their path, meta and
+// chunk, but not by conditional request meta.
+func TestResourceCacheKey(t *testing.T) {
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	db := testDB(t, id, nil)
+	runTestDB(t, db)
+	c, err := New(Config{
+		DB:            db,
+		LocalIDIniter: fixedIDIniter(id),
+	})
+	assert.NilErr(t, err)
+	uid := UserID{0: 1}
+
+	path := []string{"page"}
+	reqs := []rpc.RMFetchResource{
+		{Path: path},
+		{Path: path, Index: 1, Count: 2},
+		{Path: path, Index: 0, Count: 2},
+		{Path: path, Meta: map[string]string{"lang": "en"}},
+	}
+	for i := range reqs {
+		reply := rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusOk,
+			Data:   []byte{byte(i)},
+		}
+		err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+			_, err := c.updateResourceCache(tx, uid, &reqs[i], reply)
+			return err
+		})
+		assert.NilErr(t, err)
+	}
+
+	// Every request is cached independently.
+	for i := range reqs {
+		cr, err := c.CachedResource(uid, clientdb.ResourceCacheKeyFor(&reqs[i]))
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, cr.Response.Data, []byte{byte(i)})
+		assert.DeepEqual(t, cr.Index, reqs[i].Index)
+		assert.DeepEqual(t, cr.Count, reqs[i].Count)
+	}
+
+	// Conditional meta is not part of the key.
+	cond := reqs[1]
+	cond.Meta = map[string]string{rpc.ResourceMetaIfNoneMatch: `"etag"`}
+	cr, err := c.CachedResource(uid, clientdb.ResourceCacheKeyFor(&cond))
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, cr.Response.Data, []byte{1})
+
+	// Clearing the cache removes every chunk.
+	assert.NilErr(t, c.ClearResourceCache(&uid))
+	crs, err := c.ListCachedResources(&uid)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(crs), 0)
+}
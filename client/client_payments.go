@@ -2,8 +2,11 @@ package client
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientdb"
@@ -288,12 +291,23 @@ func (c *Client) handleTipUserPaymentResult(ru *RemoteUser, tag int32, payErr er
 
 // payTipInvoice starts the payment process for a received invoice.
 func (c *Client) payTipInvoice(ru *RemoteUser, invoice string, amtMAtoms int64, tag int32) {
-	fees, payErr := c.pc.PayInvoice(c.ctx, invoice)
+	uid := ru.ID()
+	var fees int64
+	payErr := c.spending.wait(c.ctx, clientdb.PayPurposeTip, &uid, amtMAtoms)
+	if payErr == nil {
+		fees, payErr = c.pc.PayInvoice(c.ctx, invoice)
+	}
+	if payErr == nil {
+		c.spending.paid(clientdb.PayPurposeTip, &uid, amtMAtoms+fees)
+	}
 	c.handleTipUserPaymentResult(ru, tag, payErr, fees)
 }
 
 // handleInvoice handles received RMInvoice calls.
 func (c *Client) handleInvoice(ru *RemoteUser, invoice rpc.RMInvoice) error {
+	if invoice.Paywall != "" {
+		return c.handlePaywallInvoice(ru, invoice)
+	}
 
 	// Decode invoice to determine if it's valid.
 	var decoded clientintf.DecodedInvoice
@@ -793,6 +807,62 @@ func (c *Client) ClearPayStats(uid *UserID) error {
 	})
 }
 
+// RecordUserPayEvent records a payment made to (negative amount) or received
+// from (positive amount) the given user, outside of the standard client
+// operations. Amounts are in milli-atoms.
+func (c *Client) RecordUserPayEvent(uid UserID, event string, amount, fees int64) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.RecordUserPayEvent(tx, uid, event, amount, fees)
+	})
+}
+
+// ListPayLedger returns the entries of the payment ledger recorded in the
+// [start, end) interval. A zero start or end means the interval is unbounded
+// on that side.
+//
+// Unlike payment stats, the payment ledger is never cleared.
+func (c *Client) ListPayLedger(start, end time.Time) ([]clientdb.PayLedgerEntry, error) {
+	var res []clientdb.PayLedgerEntry
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPayLedger(tx, start, end)
+		return err
+	})
+	return res, err
+}
+
+// WritePayLedgerCSV writes the given payment ledger entries as CSV to w.
+func (c *Client) WritePayLedgerCSV(w io.Writer, entries []clientdb.PayLedgerEntry) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"timestamp", "uid", "nick", "direction",
+		"purpose", "event", "amount_matoms", "fee_matoms"})
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		nick, _ := c.UserNick(e.UID)
+		dir := "in"
+		if e.Amount < 0 {
+			dir = "out"
+		}
+		err := cw.Write([]string{
+			e.Timestamp.UTC().Format(time.RFC3339),
+			e.UID.String(),
+			nick,
+			dir,
+			e.Purpose,
+			e.Event,
+			strconv.FormatInt(e.Amount, 10),
+			strconv.FormatInt(e.PayFee, 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
 // restartTrackGeneratedTipInvoices restarts tracking of invoices generated
 // for tipping.
 func (c *Client) restartTrackGeneratedTipInvoices(ctx context.Context) error {
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+)
+
+// TestPayLedger tests that recorded payments are added to the payment ledger
+// and that the ledger is kept after the payment stats are cleared.
+func TestPayLedger(t *testing.T) {
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	db := testDB(t, id, nil)
+	runTestDB(t, db)
+	c, err := New(Config{
+		DB:            db,
+		LocalIDIniter: fixedIDIniter(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	orFatal(t, c.loadLocalID(ctx))
+	orFatal(t, c.loadAddressBook(ctx))
+
+	uid := testID(t, rnd, "bob").Public.Identity
+	events := []struct {
+		event   string
+		amount  int64
+		fees    int64
+		purpose string
+	}{
+		{"tip", 1000, 0, clientdb.PayPurposeTip},
+		{"paytip", -2000, -10, clientdb.PayPurposeTip},
+		{"ftpaychunk.abcd.1", -3000, -1, clientdb.PayPurposeFileChunk},
+		{"sub.abcd", -100, 0, clientdb.PayPurposeSubFee},
+		{"pm.abcd", -200, 0, clientdb.PayPurposePushFee},
+		{"store.order.00000001", 5000, 0, clientdb.PayPurposeStoreOrder},
+	}
+	for _, e := range events {
+		if err := c.RecordUserPayEvent(uid, e.event, e.amount, e.fees); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.ClearPayStats(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := c.ListPayLedger(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(events) {
+		t.Fatalf("unexpected nb of entries: got %d, want %d",
+			len(entries), len(events))
+	}
+	for i, e := range events {
+		got := entries[i]
+		if got.UID != uid || got.Event != e.event || got.Amount != e.amount ||
+			got.PayFee != e.fees || got.Purpose != e.purpose {
+			t.Fatalf("unexpected entry %d: %+v", i, got)
+		}
+	}
+
+	// Entries outside the interval are not listed.
+	entries, err = c.ListPayLedger(time.Now().Add(time.Hour), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("unexpected nb of entries: got %d, want 0", len(entries))
+	}
+	entries, err = c.ListPayLedger(time.Time{}, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("unexpected nb of entries: got %d, want 0", len(entries))
+	}
+
+	// Export as CSV.
+	entries, err = c.ListPayLedger(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := c.WritePayLedgerCSV(&b, entries); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&b).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != len(events)+1 {
+		t.Fatalf("unexpected nb of csv records: got %d, want %d",
+			len(records), len(events)+1)
+	}
+	if records[2][3] != "out" || records[2][6] != "-2000" {
+		t.Fatalf("unexpected csv record: %v", records[2])
+	}
+}
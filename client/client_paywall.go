@@ -0,0 +1,351 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// Paywall payment flow is:
+//
+//          Alice                                    Bob
+//         -------                                  -----
+//
+//   FetchResource()
+//       \-------- RMFetchResource -->
+//
+//                                        RequestPaywallPayment()
+//                               <-- RMInvoice --------/
+//                    <-- RMFetchResourceReply (402) --/
+//
+//   PayPaywallInvoice()
+//     (out-of-band payment)
+//                                        trackPaywallInvoice()
+//   FetchResource()
+//       \-------- RMFetchResource -->
+//                    <-- RMFetchResourceReply (200) --/
+
+// PaywallTerms are the terms for accessing the resources behind a paywall.
+type PaywallTerms struct {
+	// Name identifies the paywall. Access grants are tracked per paywall
+	// name.
+	Name string
+
+	// MilliAtoms is the amount to pay for access.
+	MilliAtoms int64
+
+	// Duration is how long access lasts after payment. If zero, a single
+	// payment grants permanent access.
+	Duration time.Duration
+}
+
+// PaywallAccess returns the access record of the remote user to the given
+// local paywall.
+func (c *Client) PaywallAccess(paywall string, uid UserID) (clientdb.PaywallAccess, error) {
+	var pa clientdb.PaywallAccess
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		pa, err = c.db.ReadPaywallAccess(tx, paywall, uid)
+		return err
+	})
+	return pa, err
+}
+
+// ListPaywallAccess lists the access records of the given local paywall (or
+// of all paywalls if empty).
+func (c *Client) ListPaywallAccess(paywall string) ([]clientdb.PaywallAccess, error) {
+	var res []clientdb.PaywallAccess
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPaywallAccess(tx, paywall)
+		return err
+	})
+	return res, err
+}
+
+// SetPaywallAccess grants the remote user access to the given local paywall
+// until expires, without requiring payment. If expires is zero, access does
+// not expire. Setting expires to a time in the past revokes access.
+func (c *Client) SetPaywallAccess(paywall string, uid UserID, expires time.Time) error {
+	if paywall == "" {
+		return errors.New("empty paywall name")
+	}
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		pa, err := c.db.ReadPaywallAccess(tx, paywall, uid)
+		if errors.Is(err, clientdb.ErrNotFound) {
+			pa = clientdb.PaywallAccess{Paywall: paywall, UID: uid}
+		} else if err != nil {
+			return err
+		}
+		if pa.Granted.IsZero() {
+			pa.Granted = time.Now()
+		}
+		pa.Expires = expires
+		return c.db.StorePaywallAccess(tx, &pa)
+	})
+}
+
+// ExtendPaywallAccess grants the remote user access to the given local paywall
+// at least until expires, without requiring payment. Existing access that
+// lasts longer (including permanent access) is kept unchanged.
+func (c *Client) ExtendPaywallAccess(paywall string, uid UserID, expires time.Time) error {
+	if paywall == "" {
+		return errors.New("empty paywall name")
+	}
+	if expires.IsZero() {
+		return errors.New("empty access expiration")
+	}
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		pa, err := c.db.ReadPaywallAccess(tx, paywall, uid)
+		if errors.Is(err, clientdb.ErrNotFound) {
+			pa = clientdb.PaywallAccess{Paywall: paywall, UID: uid}
+		} else if err != nil {
+			return err
+		}
+		if pa.Granted.IsZero() {
+			pa.Granted = time.Now()
+		} else if pa.Expires.IsZero() || !expires.After(pa.Expires) {
+			// Already has permanent or longer access.
+			return nil
+		}
+		pa.Expires = expires
+		return c.db.StorePaywallAccess(tx, &pa)
+	})
+}
+
+// RequestPaywallPayment returns an invoice that grants the remote user access
+// to the resources behind the paywall with the given terms once paid. An
+// outstanding invoice for the same terms is reused if it has not expired yet.
+// Otherwise, a new invoice is generated and sent to the user.
+//
+// Every outstanding invoice remains valid until it expires, even after newer
+// invoices are generated, so that paying any invoice sent to the user grants
+// access.
+func (c *Client) RequestPaywallPayment(uid UserID, terms PaywallTerms) (string, error) {
+	if terms.Name == "" {
+		return "", errors.New("empty paywall name")
+	}
+	if terms.MilliAtoms <= 0 {
+		return "", fmt.Errorf("invalid paywall cost %d", terms.MilliAtoms)
+	}
+	ru, err := c.rul.byID(uid)
+	if err != nil {
+		return "", err
+	}
+
+	pa, err := c.PaywallAccess(terms.Name, uid)
+	if err != nil && !errors.Is(err, clientdb.ErrNotFound) {
+		return "", err
+	}
+
+	// Reuse an outstanding invoice if possible.
+	for _, inv := range pa.Invoices {
+		if inv.MAtoms != terms.MilliAtoms || inv.Duration != terms.Duration {
+			continue
+		}
+		decoded, err := c.pc.DecodeInvoice(c.ctx, inv.Invoice)
+		if err == nil && !decoded.IsExpired(time.Minute) {
+			return inv.Invoice, nil
+		}
+	}
+
+	invoice, err := c.pc.GetInvoice(c.ctx, terms.MilliAtoms, nil)
+	if err != nil {
+		dcrAmount := float64(terms.MilliAtoms) / 1e11
+		c.ntfns.notifyInvoiceGenFailed(ru, dcrAmount, err)
+		return "", fmt.Errorf("unable to generate paywall invoice: %v", err)
+	}
+
+	inv := clientdb.PaywallInvoice{
+		Invoice:  invoice,
+		MAtoms:   terms.MilliAtoms,
+		Created:  time.Now(),
+		Duration: terms.Duration,
+	}
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		pa, err := c.db.ReadPaywallAccess(tx, terms.Name, uid)
+		if errors.Is(err, clientdb.ErrNotFound) {
+			pa = clientdb.PaywallAccess{Paywall: terms.Name, UID: uid}
+		} else if err != nil {
+			return err
+		}
+		pa.Invoices = append(pa.Invoices, inv)
+		return c.db.StorePaywallAccess(tx, &pa)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ru.log.Infof("Generated invoice for access to paywall %q "+
+		"(%.8f DCR)", terms.Name, float64(terms.MilliAtoms)/1e11)
+	go c.trackPaywallInvoice(c.ctx, terms.Name, uid, inv)
+
+	reply := rpc.RMInvoice{
+		Invoice: invoice,
+		Paywall: terms.Name,
+	}
+	err = c.sendWithSendQ("paywallinvoice", reply, uid)
+	return invoice, err
+}
+
+// trackPaywallInvoice tracks an invoice generated for access to a paywall. This
+// blocks until the invoice is paid or expires.
+func (c *Client) trackPaywallInvoice(ctx context.Context, paywall string,
+	uid UserID, inv clientdb.PaywallInvoice) {
+
+	var err error
+	defer func() {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			c.log.Errorf("Unable to track paywall invoice: %v", err)
+		}
+	}()
+
+	receivedMAtoms, err := c.pc.TrackInvoice(ctx, inv.Invoice, inv.MAtoms)
+	expired := errors.Is(err, clientintf.ErrInvoiceExpired)
+	if err != nil && !expired {
+		return
+	}
+
+	var pa clientdb.PaywallAccess
+	var tracked bool
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		pa, err = c.db.ReadPaywallAccess(tx, paywall, uid)
+		if err != nil {
+			return err
+		}
+		i := slices.IndexFunc(pa.Invoices, func(pi clientdb.PaywallInvoice) bool {
+			return pi.Invoice == inv.Invoice
+		})
+		if i < 0 {
+			// Already handled.
+			return nil
+		}
+		tracked = true
+		pa.Invoices = slices.Delete(pa.Invoices, i, i+1)
+
+		if !expired {
+			// Renewals extend the current access period.
+			now := time.Now()
+			start := now
+			if pa.HasAccess(now) && !pa.Expires.IsZero() {
+				start = pa.Expires
+			}
+			permanent := pa.HasAccess(now) && pa.Expires.IsZero()
+			if pa.Granted.IsZero() {
+				pa.Granted = now
+			}
+			if inv.Duration == 0 {
+				pa.Expires = time.Time{}
+			} else if !permanent {
+				pa.Expires = start.Add(inv.Duration)
+			}
+			pa.PaidMAtoms += receivedMAtoms
+			payEvent := "paywall." + pa.Paywall
+			err := c.db.RecordUserPayEvent(tx, pa.UID, payEvent, receivedMAtoms, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		return c.db.StorePaywallAccess(tx, &pa)
+	})
+	if err != nil || expired || !tracked {
+		return
+	}
+
+	ru, err := c.rul.byID(pa.UID)
+	if err != nil {
+		return
+	}
+	ru.log.Infof("Received %.8f DCR for access to paywall %q",
+		float64(receivedMAtoms)/1e11, pa.Paywall)
+	c.ntfns.notifyPaywallAccessGranted(ru, pa)
+}
+
+// restartTrackPaywallInvoices restarts tracking of invoices generated for
+// access to local paywalls.
+func (c *Client) restartTrackPaywallInvoices(ctx context.Context) error {
+	<-c.abLoaded
+
+	var pas []clientdb.PaywallAccess
+	err := c.db.View(ctx, func(tx clientdb.ReadTx) error {
+		var err error
+		pas, err = c.db.ListPaywallAccess(tx, "")
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var nb int
+	for _, pa := range pas {
+		for _, inv := range pa.Invoices {
+			nb++
+			go c.trackPaywallInvoice(ctx, pa.Paywall, pa.UID, inv)
+		}
+	}
+	if nb > 0 {
+		c.log.Infof("Tracking %d invoices for paywall access", nb)
+	}
+	return nil
+}
+
+// handlePaywallInvoice handles an invoice received from a remote user for
+// access to one of their paywalls.
+func (c *Client) handlePaywallInvoice(ru *RemoteUser, invoice rpc.RMInvoice) error {
+	if invoice.Error != nil {
+		ru.log.Warnf("Received error instead of invoice for paywall %q: %s",
+			invoice.Paywall, *invoice.Error)
+		return nil
+	}
+
+	decoded, err := c.pc.DecodeInvoice(c.ctx, invoice.Invoice)
+	if err != nil {
+		return fmt.Errorf("unable to decode paywall invoice: %v", err)
+	}
+	if decoded.IsExpired(0) {
+		return fmt.Errorf("received expired invoice for paywall %q",
+			invoice.Paywall)
+	}
+
+	ru.log.Infof("Received invoice of %.8f DCR for access to paywall %q",
+		float64(decoded.MAtoms)/1e11, invoice.Paywall)
+	c.ntfns.notifyPaywallInvoice(ru, invoice.Paywall, invoice.Invoice, decoded)
+	return nil
+}
+
+// PayPaywallInvoice pays an invoice received from the remote user for access
+// to one of their paywalls.
+func (c *Client) PayPaywallInvoice(uid UserID, paywall, invoice string) error {
+	ru, err := c.rul.byID(uid)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := c.pc.DecodeInvoice(c.ctx, invoice)
+	if err != nil {
+		return err
+	}
+	if decoded.IsExpired(0) {
+		return fmt.Errorf("paywall invoice expired")
+	}
+
+	fees, err := c.pc.PayInvoice(c.ctx, invoice)
+	if err != nil {
+		return err
+	}
+
+	ru.log.Infof("Paid %.8f DCR for access to paywall %q",
+		float64(decoded.MAtoms)/1e11, paywall)
+	payEvent := "paywall." + paywall
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.RecordUserPayEvent(tx, uid, payEvent, -decoded.MAtoms, -fees)
+	})
+}
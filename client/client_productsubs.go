@@ -0,0 +1,39 @@
+package client
+
+import (
+	"time"
+)
+
+// ProductSubscription is the subscription of a remote user to a recurring
+// product sold by a local resources provider (for example, a simplestore).
+type ProductSubscription struct {
+	// UID is the subscriber.
+	UID UserID
+
+	// Provider identifies the kind of provider that sold the product.
+	Provider string
+
+	// Product is the id of the product (e.g. its SKU).
+	Product string
+
+	// Title is the name of the product.
+	Title string
+
+	// Active is true while the user has paid for the current period of
+	// the subscription.
+	Active bool
+
+	// Expires is the end of the current period of the subscription.
+	Expires time.Time
+}
+
+// NotifyProductSubscriptionChanged notifies the OnProductSubscriptionChanged
+// handlers that the subscription of a remote user changed. This is called by
+// providers that sell recurring products, so that other parts of the client
+// (for example, paywalls and GC admins) may grant or revoke access.
+func (c *Client) NotifyProductSubscriptionChanged(sub ProductSubscription) {
+	c.log.Debugf("Subscription of %s to %s %q changed (active %v, expires %s)",
+		sub.UID, sub.Provider, sub.Product, sub.Active,
+		sub.Expires.Format(time.RFC3339))
+	c.ntfns.notifyProductSubscriptionChanged(sub)
+}
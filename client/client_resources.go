@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientdb"
@@ -15,6 +16,22 @@ import (
 	"github.com/decred/slog"
 )
 
+// newFetchResourceRequest creates a request for the given resource. Requests
+// with data are form submissions.
+func newFetchResourceRequest(path []string, meta map[string]string, data json.RawMessage) rpc.RMFetchResource {
+	rm := rpc.RMFetchResource{
+		Path:   path,
+		Meta:   meta,
+		Data:   data,
+		Method: rpc.ResourceMethodGet,
+	}
+	if len(data) > 0 {
+		rm.Method = rpc.ResourceMethodPost
+		rm.ContentType = rpc.ResourceContentTypeJSON
+	}
+	return rm
+}
+
 func (c *Client) NewPagesSession() (clientintf.PagesSessionID, error) {
 	var id clientintf.PagesSessionID
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
@@ -33,11 +50,7 @@ func (c *Client) FetchLocalResource(path []string, meta map[string]string, data
 		return fmt.Errorf("resources provider not configured")
 	}
 
-	rm := rpc.RMFetchResource{
-		Path: path,
-		Meta: meta,
-		Data: data,
-	}
+	rm := newFetchResourceRequest(path, meta, data)
 	reqTS := time.Now()
 
 	res, err := c.cfg.ResourcesProvider.Fulfill(c.ctx, c.PublicID(), &rm)
@@ -74,10 +87,29 @@ func (c *Client) FetchResource(uid UserID, path []string, meta map[string]string
 		return 0, err
 	}
 
-	rm := rpc.RMFetchResource{
-		Path: path,
-		Meta: meta,
-		Data: data,
+	rm := newFetchResourceRequest(path, meta, data)
+
+	// Use the cached resource when it is still fresh or when offline.
+	// Otherwise, ask the remote user to only send the resource if it
+	// changed.
+	if rm.Method == rpc.ResourceMethodGet {
+		cr, err := c.CachedResource(uid, clientdb.ResourceCacheKeyFor(&rm))
+		if err != nil && !errors.Is(err, clientdb.ErrNotFound) {
+			return 0, err
+		}
+		if err == nil {
+			offline := c.ServerSession() == nil
+			if offline || cr.IsFresh(time.Now()) {
+				return c.fetchCachedResource(ru, cr, sess, parentPage, &rm, offline)
+			}
+			if etag := cr.ETag(); etag != "" {
+				rm.Meta = maps.Clone(meta)
+				if rm.Meta == nil {
+					rm.Meta = make(map[string]string, 1)
+				}
+				rm.Meta[rpc.ResourceMetaIfNoneMatch] = etag
+			}
+		}
 	}
 
 	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
@@ -161,7 +193,15 @@ func (c *Client) handleFetchResourceReply(ru *RemoteUser, frr rpc.RMFetchResourc
 	var fr clientdb.FetchedResource
 	var sess clientdb.PageSessionOverview
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
-		var err error
+		rr, err := c.db.ReadResourceRequest(tx, ru.ID(), frr.Tag)
+		if err != nil {
+			return err
+		}
+		req = rr.Request
+		frr, err = c.updateResourceCache(tx, ru.ID(), &req, frr)
+		if err != nil {
+			return err
+		}
 		fr, sess, err = c.db.StoreFetchedResource(tx, ru.ID(), frr.Tag, frr)
 		return err
 	})
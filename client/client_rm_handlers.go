@@ -185,6 +185,15 @@ func (c *Client) innerHandleUserRM(ru *RemoteUser, h *rpc.RMHeader,
 	case rpc.RMGroupList:
 		return c.handleGCList(ru, p, ts)
 
+	case rpc.RMGroupListChunk:
+		return c.handleGCListChunk(ru, p, ts)
+
+	case rpc.RMGroupListRequest:
+		return c.handleGCListRequest(ru, p)
+
+	case rpc.RMGroupMembersDelta:
+		return c.handleGCMembersDelta(ru, p, ts)
+
 	case rpc.RMGroupUpgradeVersion:
 		return c.handleGCUpgradeVersion(ru, p, ts)
 
@@ -263,6 +272,18 @@ func (c *Client) innerHandleUserRM(ru *RemoteUser, h *rpc.RMHeader,
 	case rpc.RMReceiveReceipt:
 		return c.handleReceiveReceipt(ru, p, ts)
 
+	case rpc.RMGCHistory:
+		return c.handleGCHistory(ru, p)
+
+	case rpc.RMCallOffer:
+		return c.handleCallOffer(ru, p, ts)
+
+	case rpc.RMCallAnswer:
+		return c.handleCallAnswer(ru, p)
+
+	case rpc.RMCallHangup:
+		return c.handleCallHangup(ru, p)
+
 	case rpc.RMGroupKick:
 		return c.handleGCKick(ru, p, ts)
 
@@ -0,0 +1,530 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/internal/lowlevel"
+	"github.com/decred/slog"
+)
+
+// Periods of the spending limits.
+const (
+	SpendingPeriodDaily   = "daily"
+	SpendingPeriodMonthly = "monthly"
+)
+
+// SpendingLimit is a budget for the payments the client makes automatically:
+// server push and subscription fees, file chunk invoices and tips.
+type SpendingLimit struct {
+	// Name identifies the limit in alerts and approvals.
+	Name string
+
+	// Category restricts the limit to payments of the given purpose. It
+	// must be one of clientdb.PayPurposeTip, clientdb.PayPurposeFileChunk,
+	// clientdb.PayPurposeSubFee or clientdb.PayPurposePushFee. If empty,
+	// the limit applies to all automatic payments.
+	Category string
+
+	// User restricts the limit to payments related to the given user. If
+	// nil, the limit applies to payments related to any user. Note that
+	// subscription fees are not attributed to users.
+	User *UserID
+
+	// DailyMAtoms and MonthlyMAtoms are the budgets (in milli-atoms) for
+	// the current day and month. Zero means no budget for the period.
+	DailyMAtoms   int64
+	MonthlyMAtoms int64
+
+	// AlertPercent is the percentage of a budget that triggers an alert
+	// once crossed. If zero, an alert is only triggered once the budget is
+	// exceeded.
+	AlertPercent uint32
+
+	// HardStop holds payments that would exceed a budget until they are
+	// approved with ApproveSpendingLimit or until the period of the budget
+	// ends. This causes sends and downloads that require these payments to
+	// be queued.
+	HardStop bool
+}
+
+// SpendingLimitAlert is an alert about the spending of a spending limit.
+type SpendingLimitAlert struct {
+	Limit        string
+	Period       string
+	BudgetMAtoms int64
+	SpentMAtoms  int64
+
+	// Exceeded is true if the budget was exceeded or if a held payment
+	// would exceed it.
+	Exceeded bool
+
+	// Held is true if payments are being held until approved with
+	// ApproveSpendingLimit or until the period ends.
+	Held bool
+}
+
+// SpendingLimitStatus is the current status of a spending limit.
+type SpendingLimitStatus struct {
+	Limit              SpendingLimit
+	DailySpentMAtoms   int64
+	MonthlySpentMAtoms int64
+
+	// Held is true if payments are being held until approved.
+	Held bool
+
+	// ApprovedUntil is the time until which the budgets of the limit are
+	// not enforced, due to a previous approval.
+	ApprovedUntil time.Time
+}
+
+// Alert levels of a spending limit period.
+const (
+	spendAlertNone = iota
+	spendAlertThreshold
+	spendAlertExceeded
+)
+
+// spendingLimitState tracks the spending of a single limit.
+type spendingLimitState struct {
+	limit SpendingLimit
+
+	day, month           time.Time
+	daySpent, monthSpent int64
+	dayAlert, monthAlert int
+
+	approvedUntil time.Time
+
+	// held is closed when held payments are approved or when the period
+	// whose budget would be exceeded by them (heldPeriod) ends. heldTimer
+	// releases the held payments at the end of the period.
+	held       chan struct{}
+	heldPeriod string
+	heldTimer  *time.Timer
+}
+
+// release releases the held payments (if any).
+func (st *spendingLimitState) release() {
+	if st.held == nil {
+		return
+	}
+	close(st.held)
+	st.held = nil
+	if st.heldTimer != nil {
+		st.heldTimer.Stop()
+		st.heldTimer = nil
+	}
+}
+
+// periodEnd returns the end of the current period of the given kind.
+func (st *spendingLimitState) periodEnd(period string) time.Time {
+	if period == SpendingPeriodMonthly {
+		return st.month.AddDate(0, 1, 0)
+	}
+	return st.day.AddDate(0, 0, 1)
+}
+
+// roll resets the spending of periods that ended. Payments held due to the
+// budget of a period that ended are released.
+func (st *spendingLimitState) roll(now time.Time) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if !day.Equal(st.day) {
+		st.day = day
+		st.daySpent = 0
+		st.dayAlert = spendAlertNone
+		if st.heldPeriod == SpendingPeriodDaily {
+			st.release()
+		}
+	}
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if !month.Equal(st.month) {
+		st.month = month
+		st.monthSpent = 0
+		st.monthAlert = spendAlertNone
+		st.release()
+	}
+}
+
+// isAutoPayPurpose returns true if payments with the given purpose are made
+// automatically by the client.
+func isAutoPayPurpose(purpose string) bool {
+	switch purpose {
+	case clientdb.PayPurposeTip, clientdb.PayPurposeFileChunk,
+		clientdb.PayPurposeSubFee, clientdb.PayPurposePushFee:
+		return true
+	default:
+		return false
+	}
+}
+
+// matches returns true if the limit applies to a payment with the given
+// purpose, related to the given (optional) user.
+func (st *spendingLimitState) matches(purpose string, uid *UserID) bool {
+	l := &st.limit
+	if l.Category == "" && !isAutoPayPurpose(purpose) {
+		return false
+	}
+	if l.Category != "" && l.Category != purpose {
+		return false
+	}
+	return l.User == nil || (uid != nil && *uid == *l.User)
+}
+
+// spendingLimiter enforces the configured spending limits on the automatic
+// payments made by the client.
+type spendingLimiter struct {
+	log       slog.Logger
+	ntfns     *NotificationManager
+	now       func() time.Time
+	afterFunc func(time.Duration, func()) *time.Timer
+
+	mtx    sync.Mutex
+	limits []*spendingLimitState
+}
+
+func newSpendingLimiter(limits []SpendingLimit, ntfns *NotificationManager,
+	log slog.Logger) (*spendingLimiter, error) {
+
+	names := make(map[string]struct{}, len(limits))
+	states := make([]*spendingLimitState, len(limits))
+	for i, l := range limits {
+		if l.Name == "" {
+			return nil, fmt.Errorf("spending limit %d has empty name", i)
+		}
+		if _, ok := names[l.Name]; ok {
+			return nil, fmt.Errorf("duplicated spending limit %q", l.Name)
+		}
+		names[l.Name] = struct{}{}
+		if l.Category != "" && !isAutoPayPurpose(l.Category) {
+			return nil, fmt.Errorf("invalid category %q of spending limit %q",
+				l.Category, l.Name)
+		}
+		if l.DailyMAtoms < 0 || l.MonthlyMAtoms < 0 {
+			return nil, fmt.Errorf("spending limit %q has negative budget",
+				l.Name)
+		}
+		states[i] = &spendingLimitState{limit: l}
+	}
+
+	return &spendingLimiter{
+		log:       log,
+		ntfns:     ntfns,
+		now:       time.Now,
+		afterFunc: time.AfterFunc,
+		limits:    states,
+	}, nil
+}
+
+// load loads the spending of the current periods from the entries of the
+// payment ledger.
+func (sl *spendingLimiter) load(entries []clientdb.PayLedgerEntry) {
+	sl.mtx.Lock()
+	defer sl.mtx.Unlock()
+
+	now := sl.now()
+	for _, st := range sl.limits {
+		st.roll(now)
+	}
+	for _, e := range entries {
+		if e.Amount >= 0 {
+			// Inbound payment.
+			continue
+		}
+		amt := -(e.Amount + e.PayFee)
+
+		// Subscription fees are not attributed to users, even though
+		// the ledger records the user they were paid for, to match
+		// the accounting of SubsPaid.
+		var uid *UserID
+		if e.Purpose != clientdb.PayPurposeSubFee {
+			entryUID := e.UID
+			uid = &entryUID
+		}
+		for _, st := range sl.limits {
+			if !st.matches(e.Purpose, uid) || e.Timestamp.Before(st.month) {
+				continue
+			}
+			st.monthSpent += amt
+			if !e.Timestamp.Before(st.day) {
+				st.daySpent += amt
+			}
+		}
+	}
+
+	// Avoid alerting for the spending that happened before the client
+	// started.
+	for _, st := range sl.limits {
+		st.dayAlert = sl.alertLevel(st.limit, st.limit.DailyMAtoms, st.daySpent)
+		st.monthAlert = sl.alertLevel(st.limit, st.limit.MonthlyMAtoms, st.monthSpent)
+	}
+}
+
+// alertLevel returns the alert level for the spending of a period.
+func (sl *spendingLimiter) alertLevel(l SpendingLimit, budget, spent int64) int {
+	switch {
+	case budget <= 0:
+		return spendAlertNone
+	case spent > budget:
+		return spendAlertExceeded
+	case l.AlertPercent > 0 && spent*100 >= budget*int64(l.AlertPercent):
+		return spendAlertThreshold
+	default:
+		return spendAlertNone
+	}
+}
+
+// hold returns a channel if a payment of amt milli-atoms with the given
+// purpose is not currently allowed by all limits. The channel is closed when
+// the payment may be reconsidered. Payments are also held while a limit that
+// applies to them has held payments, so that they are made in order.
+func (sl *spendingLimiter) hold(purpose string, uid *UserID, amt int64) chan struct{} {
+	if sl == nil {
+		return nil
+	}
+
+	var held chan struct{}
+	var alerts []SpendingLimitAlert
+
+	sl.mtx.Lock()
+	now := sl.now()
+	for _, st := range sl.limits {
+		if !st.limit.HardStop || !st.matches(purpose, uid) {
+			continue
+		}
+		st.roll(now)
+		if st.held != nil {
+			held = st.held
+			break
+		}
+		if st.approvedUntil.After(now) {
+			continue
+		}
+
+		var period string
+		var budget, spent int64
+		switch {
+		case st.limit.MonthlyMAtoms > 0 && st.monthSpent+amt > st.limit.MonthlyMAtoms:
+			period, budget, spent = SpendingPeriodMonthly, st.limit.MonthlyMAtoms, st.monthSpent
+		case st.limit.DailyMAtoms > 0 && st.daySpent+amt > st.limit.DailyMAtoms:
+			period, budget, spent = SpendingPeriodDaily, st.limit.DailyMAtoms, st.daySpent
+		default:
+			continue
+		}
+
+		// Release the held payments once the period ends, even if
+		// they are not approved.
+		st.held = make(chan struct{})
+		st.heldPeriod = period
+		st.heldTimer = sl.afterFunc(st.periodEnd(period).Sub(now), sl.rollAll)
+		alerts = append(alerts, SpendingLimitAlert{
+			Limit:        st.limit.Name,
+			Period:       period,
+			BudgetMAtoms: budget,
+			SpentMAtoms:  spent,
+			Exceeded:     true,
+			Held:         true,
+		})
+		held = st.held
+		break
+	}
+	sl.mtx.Unlock()
+
+	for _, alert := range alerts {
+		sl.log.Warnf("Holding payments of spending limit %q: %s "+
+			"budget would be exceeded", alert.Limit, alert.Period)
+		sl.ntfns.notifySpendingLimitAlert(alert)
+	}
+	return held
+}
+
+// rollAll resets the spending of the periods of all limits that ended,
+// releasing the payments held due to them.
+func (sl *spendingLimiter) rollAll() {
+	sl.mtx.Lock()
+	now := sl.now()
+	for _, st := range sl.limits {
+		st.roll(now)
+	}
+	sl.mtx.Unlock()
+}
+
+// wait blocks until a payment of amt milli-atoms with the given purpose is
+// allowed by all limits.
+func (sl *spendingLimiter) wait(ctx context.Context, purpose string, uid *UserID, amt int64) error {
+	for {
+		held := sl.hold(purpose, uid, amt)
+		if held == nil {
+			return nil
+		}
+
+		select {
+		case <-held:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// paid records that a payment of amt milli-atoms (including fees) with the
+// given purpose was made.
+func (sl *spendingLimiter) paid(purpose string, uid *UserID, amt int64) {
+	if sl == nil {
+		return
+	}
+
+	var alerts []SpendingLimitAlert
+	sl.mtx.Lock()
+	now := sl.now()
+	for _, st := range sl.limits {
+		if !st.matches(purpose, uid) {
+			continue
+		}
+		st.roll(now)
+		st.daySpent += amt
+		st.monthSpent += amt
+
+		level := sl.alertLevel(st.limit, st.limit.DailyMAtoms, st.daySpent)
+		if level > st.dayAlert {
+			st.dayAlert = level
+			alerts = append(alerts, SpendingLimitAlert{
+				Limit:        st.limit.Name,
+				Period:       SpendingPeriodDaily,
+				BudgetMAtoms: st.limit.DailyMAtoms,
+				SpentMAtoms:  st.daySpent,
+				Exceeded:     level == spendAlertExceeded,
+			})
+		}
+		level = sl.alertLevel(st.limit, st.limit.MonthlyMAtoms, st.monthSpent)
+		if level > st.monthAlert {
+			st.monthAlert = level
+			alerts = append(alerts, SpendingLimitAlert{
+				Limit:        st.limit.Name,
+				Period:       SpendingPeriodMonthly,
+				BudgetMAtoms: st.limit.MonthlyMAtoms,
+				SpentMAtoms:  st.monthSpent,
+				Exceeded:     level == spendAlertExceeded,
+			})
+		}
+	}
+	sl.mtx.Unlock()
+
+	for _, alert := range alerts {
+		sl.log.Infof("Spending limit %q crossed %s alert threshold "+
+			"(spent %d of %d MAtoms)", alert.Limit, alert.Period,
+			alert.SpentMAtoms, alert.BudgetMAtoms)
+		sl.ntfns.notifySpendingLimitAlert(alert)
+	}
+}
+
+// approve releases the payments held by the given limit. The budgets of the
+// limit are not enforced until the end of the period that was exceeded.
+func (sl *spendingLimiter) approve(name string) error {
+	sl.mtx.Lock()
+	defer sl.mtx.Unlock()
+
+	for _, st := range sl.limits {
+		if st.limit.Name != name {
+			continue
+		}
+		if st.held == nil {
+			return fmt.Errorf("spending limit %q has no held payments", name)
+		}
+		st.approvedUntil = st.periodEnd(st.heldPeriod)
+		st.release()
+		return nil
+	}
+	return fmt.Errorf("spending limit %q not found", name)
+}
+
+// status returns the status of all limits.
+func (sl *spendingLimiter) status() []SpendingLimitStatus {
+	sl.mtx.Lock()
+	defer sl.mtx.Unlock()
+
+	now := sl.now()
+	res := make([]SpendingLimitStatus, len(sl.limits))
+	for i, st := range sl.limits {
+		st.roll(now)
+		res[i] = SpendingLimitStatus{
+			Limit:              st.limit,
+			DailySpentMAtoms:   st.daySpent,
+			MonthlySpentMAtoms: st.monthSpent,
+			Held:               st.held != nil,
+			ApprovedUntil:      st.approvedUntil,
+		}
+	}
+	return res
+}
+
+// pushUID returns the user related to an outbound RM, if it is known.
+func pushUID(orm lowlevel.OutboundRM) *UserID {
+	if rm, ok := orm.(*remoteUserRM); ok {
+		uid := rm.ru.ID()
+		return &uid
+	}
+	return nil
+}
+
+func (sl *spendingLimiter) HoldPushPayment(orm lowlevel.OutboundRM, amt int64) <-chan struct{} {
+	if held := sl.hold(clientdb.PayPurposePushFee, pushUID(orm), amt); held != nil {
+		return held
+	}
+	return nil
+}
+
+func (sl *spendingLimiter) PushPaid(orm lowlevel.OutboundRM, amt, fees int64) {
+	sl.paid(clientdb.PayPurposePushFee, pushUID(orm), amt+fees)
+}
+
+func (sl *spendingLimiter) WaitSubsPayment(ctx context.Context, amt int64) error {
+	return sl.wait(ctx, clientdb.PayPurposeSubFee, nil, amt)
+}
+
+func (sl *spendingLimiter) SubsPaid(amt, fees int64) {
+	sl.paid(clientdb.PayPurposeSubFee, nil, amt+fees)
+}
+
+var _ lowlevel.PaymentLimiter = (*spendingLimiter)(nil)
+
+// loadSpendingLimits loads the spending of the current month from the payment
+// ledger.
+func (c *Client) loadSpendingLimits(ctx context.Context) error {
+	if c.spending == nil {
+		return nil
+	}
+
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	var entries []clientdb.PayLedgerEntry
+	err := c.db.View(ctx, func(tx clientdb.ReadTx) error {
+		var err error
+		entries, err = c.db.ListPayLedger(tx, start, time.Time{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	c.spending.load(entries)
+	return nil
+}
+
+// SpendingLimitsStatus returns the status of the configured spending limits.
+func (c *Client) SpendingLimitsStatus() []SpendingLimitStatus {
+	if c.spending == nil {
+		return nil
+	}
+	return c.spending.status()
+}
+
+// ApproveSpendingLimit approves the payments held by the given spending limit.
+// The budgets of the limit are not enforced until the end of the period that
+// was exceeded.
+func (c *Client) ApproveSpendingLimit(name string) error {
+	if c.spending == nil {
+		return fmt.Errorf("spending limit %q not found", name)
+	}
+	return c.spending.approve(name)
+}
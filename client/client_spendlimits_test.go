@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/decred/slog"
+)
+
+// TestSpendingLimiter tests that spending limits alert when their thresholds
+// are crossed and hold payments until approved or until the period ends.
+func TestSpendingLimiter(t *testing.T) {
+	rnd := testRand(t)
+	uid := testID(t, rnd, "bob").Public.Identity
+	otherUID := testID(t, rnd, "charlie").Public.Identity
+
+	ntfns := NewNotificationManager()
+	alerts := make(chan SpendingLimitAlert, 10)
+	ntfns.RegisterSync(OnSpendingLimitAlertNtfn(func(alert SpendingLimitAlert) {
+		alerts <- alert
+	}))
+	sl, err := newSpendingLimiter([]SpendingLimit{{
+		Name:         "bob",
+		Category:     clientdb.PayPurposeFileChunk,
+		User:         &uid,
+		DailyMAtoms:  1000,
+		AlertPercent: 50,
+		HardStop:     true,
+	}}, ntfns, slog.Disabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.Local)
+	sl.now = func() time.Time { return now }
+	type rollTimer struct {
+		d time.Duration
+		f func()
+	}
+	rollTimers := make(chan rollTimer, 10)
+	sl.afterFunc = func(d time.Duration, f func()) *time.Timer {
+		rollTimers <- rollTimer{d: d, f: f}
+		return time.NewTimer(d)
+	}
+
+	assertAlert := func(period string, spent int64, exceeded, held bool) {
+		t.Helper()
+		select {
+		case alert := <-alerts:
+			if alert.Period != period || alert.SpentMAtoms != spent ||
+				alert.Exceeded != exceeded || alert.Held != held {
+				t.Fatalf("unexpected alert: %+v", alert)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for alert")
+		}
+	}
+	assertNoAlert := func() {
+		t.Helper()
+		select {
+		case alert := <-alerts:
+			t.Fatalf("unexpected alert: %+v", alert)
+		default:
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Payments of other users or categories are not limited.
+	sl.paid(clientdb.PayPurposeFileChunk, &otherUID, 5000)
+	sl.paid(clientdb.PayPurposeTip, &uid, 5000)
+	orFatal(t, sl.wait(ctx, clientdb.PayPurposeFileChunk, &otherUID, 5000))
+	assertNoAlert()
+
+	// Crossing the alert threshold.
+	orFatal(t, sl.wait(ctx, clientdb.PayPurposeFileChunk, &uid, 600))
+	sl.paid(clientdb.PayPurposeFileChunk, &uid, 600)
+	assertAlert(SpendingPeriodDaily, 600, false, false)
+
+	// A payment that would exceed the budget is held until approved.
+	waitErr := make(chan error, 1)
+	go func() {
+		waitErr <- sl.wait(ctx, clientdb.PayPurposeFileChunk, &uid, 500)
+	}()
+	assertAlert(SpendingPeriodDaily, 600, true, true)
+	select {
+	case err := <-waitErr:
+		t.Fatalf("payment not held: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if st := sl.status(); !st[0].Held || st[0].DailySpentMAtoms != 600 {
+		t.Fatalf("unexpected status: %+v", st[0])
+	}
+	orFatal(t, sl.approve("bob"))
+	select {
+	case err := <-waitErr:
+		orFatal(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for approved payment")
+	}
+	sl.paid(clientdb.PayPurposeFileChunk, &uid, 500)
+	assertAlert(SpendingPeriodDaily, 1100, true, false)
+
+	// Further payments are allowed until the end of the day.
+	orFatal(t, sl.wait(ctx, clientdb.PayPurposeFileChunk, &uid, 500))
+
+	// On the next day, the budget is enforced again.
+	now = now.AddDate(0, 0, 1)
+	orFatal(t, sl.wait(ctx, clientdb.PayPurposeFileChunk, &uid, 900))
+	ctxTimeout, cancelTimeout := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancelTimeout()
+	err = sl.wait(ctxTimeout, clientdb.PayPurposeFileChunk, &uid, 1100)
+	if err == nil {
+		t.Fatal("payment exceeding budget was not held")
+	}
+	assertAlert(SpendingPeriodDaily, 0, true, true)
+
+	// While payments are held, other payments of the limit are held as
+	// well, even if they would not exceed the budget.
+	go func() {
+		waitErr <- sl.wait(ctx, clientdb.PayPurposeFileChunk, &uid, 50)
+	}()
+	select {
+	case err := <-waitErr:
+		t.Fatalf("payment not held: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Held payments are released at the end of the period, without
+	// approval.
+	var timer rollTimer
+	for len(rollTimers) > 0 {
+		timer = <-rollTimers
+	}
+	if timer.d != 12*time.Hour {
+		t.Fatalf("unexpected roll timer duration: %s", timer.d)
+	}
+	sl.mtx.Lock()
+	now = now.AddDate(0, 0, 1)
+	sl.mtx.Unlock()
+	timer.f()
+	select {
+	case err := <-waitErr:
+		orFatal(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for released payment")
+	}
+	if st := sl.status(); st[0].Held {
+		t.Fatalf("unexpected status: %+v", st[0])
+	}
+}
+
+// TestSpendingLimiterLoad tests that the spending loaded from the payment
+// ledger is accounted in the same way as live payments.
+func TestSpendingLimiterLoad(t *testing.T) {
+	rnd := testRand(t)
+	uid := testID(t, rnd, "bob").Public.Identity
+
+	limits := []SpendingLimit{{
+		Name:          "all",
+		MonthlyMAtoms: 1e6,
+	}, {
+		Name:          "bob",
+		User:          &uid,
+		MonthlyMAtoms: 1e6,
+	}}
+	newLimiter := func() *spendingLimiter {
+		t.Helper()
+		sl, err := newSpendingLimiter(limits, NewNotificationManager(), slog.Disabled)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sl
+	}
+
+	// Live payments.
+	live := newLimiter()
+	live.paid(clientdb.PayPurposeTip, &uid, 1000)
+	live.SubsPaid(100, 1)
+	live.paid(clientdb.PayPurposeFileChunk, &uid, 10)
+
+	// The same payments, loaded from the ledger.
+	now := time.Now()
+	loaded := newLimiter()
+	loaded.load([]clientdb.PayLedgerEntry{
+		{Timestamp: now, UID: uid, Purpose: clientdb.PayPurposeTip, Amount: -1000},
+		{Timestamp: now, UID: uid, Purpose: clientdb.PayPurposeSubFee, Amount: -100, PayFee: -1},
+		{Timestamp: now, UID: uid, Purpose: clientdb.PayPurposeFileChunk, Amount: -10},
+	})
+
+	liveStatus, loadedStatus := live.status(), loaded.status()
+	for i := range liveStatus {
+		if liveStatus[i].MonthlySpentMAtoms != loadedStatus[i].MonthlySpentMAtoms {
+			t.Fatalf("%s: unexpected loaded spending: got %d, want %d",
+				limits[i].Name, loadedStatus[i].MonthlySpentMAtoms,
+				liveStatus[i].MonthlySpentMAtoms)
+		}
+	}
+	if got := loadedStatus[1].MonthlySpentMAtoms; got != 1010 {
+		t.Fatalf("unexpected user spending: got %d, want 1010", got)
+	}
+}
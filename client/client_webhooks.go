@@ -0,0 +1,385 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/slog"
+)
+
+// Types of events that may be delivered to webhook endpoints.
+const (
+	WebhookEventPM                = "pm"
+	WebhookEventGCM               = "gcm"
+	WebhookEventTip               = "tip"
+	WebhookEventKXCompleted       = "kx_completed"
+	WebhookEventDownloadCompleted = "download_completed"
+)
+
+// Headers set on the requests to webhook endpoints.
+const (
+	// WebhookEventHeader is the header with the type of the event.
+	WebhookEventHeader = "X-BR-Event"
+
+	// WebhookSequenceIDHeader is the header with the sequence id of the
+	// event. Events are delivered in order of sequence id, and an event
+	// may be delivered more than once if the endpoint does not ack it.
+	WebhookSequenceIDHeader = "X-BR-Sequence-Id"
+
+	// WebhookSignatureHeader is the header with the signature of the
+	// body of the request. It is in the form "sha256=<hex>", where <hex>
+	// is the HMAC-SHA256 of the body, keyed by the secret of the
+	// endpoint.
+	WebhookSignatureHeader = "X-BR-Signature"
+)
+
+// maxWebhookRetryDelay is the maximum delay between delivery attempts of an
+// event.
+const maxWebhookRetryDelay = time.Hour
+
+// WebhookEndpoint is an HTTP endpoint that receives client events.
+type WebhookEndpoint struct {
+	// Name identifies the endpoint. Events that were not yet delivered
+	// are stored in the DB under this name.
+	Name string
+
+	// URL is the URL where events are POSTed.
+	URL string
+
+	// Secret is the key used to sign the requests to the endpoint.
+	Secret string
+
+	// Events is the list of event types sent to the endpoint. If empty,
+	// all events are sent.
+	Events []string
+}
+
+// WebhookRequest is the body of requests to webhook endpoints.
+type WebhookRequest struct {
+	SequenceID uint64          `json:"sequence_id"`
+	Type       string          `json:"type"`
+	Timestamp  int64           `json:"timestamp"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// WebhookPM is the payload of WebhookEventPM events.
+type WebhookPM struct {
+	UID       clientintf.UserID `json:"uid"`
+	Nick      string            `json:"nick"`
+	Message   string            `json:"message"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// WebhookGCM is the payload of WebhookEventGCM events.
+type WebhookGCM struct {
+	GC        zkidentity.ShortID `json:"gc"`
+	UID       clientintf.UserID  `json:"uid"`
+	Nick      string             `json:"nick"`
+	Message   string             `json:"message"`
+	Timestamp int64              `json:"timestamp"`
+}
+
+// WebhookTip is the payload of WebhookEventTip events.
+type WebhookTip struct {
+	UID          clientintf.UserID `json:"uid"`
+	Nick         string            `json:"nick"`
+	AmountMAtoms int64             `json:"amount_matoms"`
+}
+
+// WebhookKXCompleted is the payload of WebhookEventKXCompleted events.
+type WebhookKXCompleted struct {
+	UID   clientintf.UserID `json:"uid"`
+	Nick  string            `json:"nick"`
+	IsNew bool              `json:"is_new"`
+}
+
+// WebhookDownloadCompleted is the payload of WebhookEventDownloadCompleted
+// events.
+type WebhookDownloadCompleted struct {
+	UID      clientintf.UserID `json:"uid"`
+	Nick     string            `json:"nick"`
+	Filename string            `json:"filename"`
+	Hash     string            `json:"hash"`
+	Size     uint64            `json:"size"`
+	DiskPath string            `json:"disk_path"`
+}
+
+// SignWebhookBody returns the signature of the body of a request to a webhook
+// endpoint with the given secret, as set in the WebhookSignatureHeader.
+func SignWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookSender queues and delivers events to a webhook endpoint.
+type webhookSender struct {
+	c          *Client
+	ep         WebhookEndpoint
+	log        slog.Logger
+	httpClient *http.Client
+
+	// queued is signalled when a new event is queued.
+	queued chan struct{}
+}
+
+// queue stores the event in the DB, to be delivered by run().
+func (ws *webhookSender) queue(typ string, payload interface{}) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		ws.log.Errorf("Unable to encode %s event: %v", typ, err)
+		return
+	}
+	ev := &clientdb.WebhookEvent{
+		Type:    typ,
+		Payload: data,
+		Created: time.Now(),
+	}
+	err = ws.c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return ws.c.db.QueueWebhookEvent(tx, ws.ep.Name, ev)
+	})
+	if err != nil {
+		ws.log.Errorf("Unable to queue %s event: %v", typ, err)
+		return
+	}
+	ws.log.Tracef("Queued %s event %d", typ, ev.ID)
+
+	select {
+	case ws.queued <- struct{}{}:
+	default:
+	}
+}
+
+// deliver sends the event to the endpoint. The endpoint acks the event by
+// replying with a 2xx status code.
+func (ws *webhookSender) deliver(ctx context.Context, ev *clientdb.WebhookEvent) error {
+	body, err := json.Marshal(WebhookRequest{
+		SequenceID: ev.ID,
+		Type:       ev.Type,
+		Timestamp:  ev.Created.Unix(),
+		Payload:    ev.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ws.ep.URL,
+		bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, ev.Type)
+	req.Header.Set(WebhookSequenceIDHeader, strconv.FormatUint(ev.ID, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookBody(ws.ep.Secret, body))
+
+	res, err := ws.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("endpoint replied with status %s", res.Status)
+	}
+	return nil
+}
+
+// retryDelay returns the delay until the next delivery attempt of an event
+// that failed the given number of attempts.
+func (ws *webhookSender) retryDelay(attempts uint32) time.Duration {
+	delay := ws.c.cfg.WebhookRetryDelayFactor
+	for i := uint32(1); i < attempts && delay < maxWebhookRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxWebhookRetryDelay {
+		delay = maxWebhookRetryDelay
+	}
+	return delay
+}
+
+// run delivers the queued events, in order, until the context is canceled.
+// An event is only removed from the queue once the endpoint acks it, so
+// events queued while the endpoint is unreachable are delivered once it
+// comes back.
+func (ws *webhookSender) run(ctx context.Context) error {
+	for {
+		var ev clientdb.WebhookEvent
+		err := ws.c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+			var err error
+			ev, err = ws.c.db.FirstWebhookEvent(tx, ws.ep.Name)
+			return err
+		})
+		if errors.Is(err, clientdb.ErrNotFound) {
+			select {
+			case <-ws.queued:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if wait := time.Until(ev.NextAttempt); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		err = ws.deliver(ctx, &ev)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err == nil {
+			ws.log.Debugf("Delivered %s event %d", ev.Type, ev.ID)
+			err = ws.c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+				return ws.c.db.RemoveWebhookEvent(tx, ws.ep.Name, ev.ID)
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		ev.Attempts += 1
+		ev.LastError = err.Error()
+		delay := ws.retryDelay(ev.Attempts)
+		ev.NextAttempt = time.Now().Add(delay)
+		ws.log.Warnf("Unable to deliver %s event %d (attempt %d): %v. "+
+			"Retrying in %s", ev.Type, ev.ID, ev.Attempts, err, delay)
+		err = ws.c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+			return ws.c.db.UpdateWebhookEvent(tx, ws.ep.Name, &ev)
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// register registers the handlers for the events sent to the endpoint on the
+// client's notification manager.
+func (ws *webhookSender) register(events map[string]bool) {
+	nmgr := ws.c.ntfns
+	if events[WebhookEventPM] {
+		nmgr.RegisterSync(OnPMNtfn(func(ru *RemoteUser, pm rpc.RMPrivateMessage, ts time.Time) {
+			ws.queue(WebhookEventPM, WebhookPM{
+				UID:       ru.ID(),
+				Nick:      ru.Nick(),
+				Message:   pm.Message,
+				Timestamp: ts.Unix(),
+			})
+		}))
+	}
+	if events[WebhookEventGCM] {
+		nmgr.RegisterSync(OnGCMNtfn(func(ru *RemoteUser, gcm rpc.RMGroupMessage, ts time.Time) {
+			ws.queue(WebhookEventGCM, WebhookGCM{
+				GC:        gcm.ID,
+				UID:       ru.ID(),
+				Nick:      ru.Nick(),
+				Message:   gcm.Message,
+				Timestamp: ts.Unix(),
+			})
+		}))
+	}
+	if events[WebhookEventTip] {
+		nmgr.RegisterSync(OnTipReceivedNtfn(func(ru *RemoteUser, amountMAtoms int64) {
+			ws.queue(WebhookEventTip, WebhookTip{
+				UID:          ru.ID(),
+				Nick:         ru.Nick(),
+				AmountMAtoms: amountMAtoms,
+			})
+		}))
+	}
+	if events[WebhookEventKXCompleted] {
+		nmgr.RegisterSync(OnKXCompleted(func(_ *clientintf.RawRVID, ru *RemoteUser, isNew bool) {
+			ws.queue(WebhookEventKXCompleted, WebhookKXCompleted{
+				UID:   ru.ID(),
+				Nick:  ru.Nick(),
+				IsNew: isNew,
+			})
+		}))
+	}
+	if events[WebhookEventDownloadCompleted] {
+		nmgr.RegisterSync(OnFileDownloadCompleted(func(ru *RemoteUser, fm rpc.FileMetadata, diskPath string) {
+			ws.queue(WebhookEventDownloadCompleted, WebhookDownloadCompleted{
+				UID:      ru.ID(),
+				Nick:     ru.Nick(),
+				Filename: fm.Filename,
+				Hash:     fm.Hash,
+				Size:     fm.Size,
+				DiskPath: diskPath,
+			})
+		}))
+	}
+}
+
+// initWebhooks creates the senders for the configured webhook endpoints.
+func (c *Client) initWebhooks() error {
+	allEvents := []string{WebhookEventPM, WebhookEventGCM, WebhookEventTip,
+		WebhookEventKXCompleted, WebhookEventDownloadCompleted}
+
+	names := make(map[string]bool, len(c.cfg.Webhooks))
+	for _, ep := range c.cfg.Webhooks {
+		if ep.Name == "" {
+			return fmt.Errorf("webhook endpoint for %s does not have a name", ep.URL)
+		}
+		if names[ep.Name] {
+			return fmt.Errorf("duplicate webhook endpoint name %q", ep.Name)
+		}
+		names[ep.Name] = true
+		if ep.URL == "" {
+			return fmt.Errorf("webhook endpoint %q does not have an URL", ep.Name)
+		}
+
+		events := make(map[string]bool, len(allEvents))
+		if len(ep.Events) == 0 {
+			ep.Events = allEvents
+		}
+		for _, e := range ep.Events {
+			valid := false
+			for _, ae := range allEvents {
+				valid = valid || e == ae
+			}
+			if !valid {
+				return fmt.Errorf("unknown event %q in webhook "+
+					"endpoint %q", e, ep.Name)
+			}
+			events[e] = true
+		}
+
+		ws := &webhookSender{
+			c:   c,
+			ep:  ep,
+			log: c.cfg.logger("WHKS"),
+			httpClient: &http.Client{
+				Timeout: time.Minute,
+				Transport: &http.Transport{
+					DialContext:         c.cfg.DialFunc,
+					TLSHandshakeTimeout: 10 * time.Second,
+				},
+			},
+			queued: make(chan struct{}, 1),
+		}
+		ws.register(events)
+		c.webhooks = append(c.webhooks, ws)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+)
+
+// TestWebhookDelivery tests that queued webhook events are delivered in order,
+// signed, and retried until the endpoint acks them.
+func TestWebhookDelivery(t *testing.T) {
+	const secret = "secret"
+	reqs := make(chan WebhookRequest, 10)
+	fail := true
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if got := r.Header.Get(WebhookSignatureHeader); got != SignWebhookBody(secret, body) {
+			t.Errorf("unexpected signature %q", got)
+		}
+		var req WebhookRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Error(err)
+			return
+		}
+
+		// Fail the first request to force a retry.
+		if fail {
+			fail = false
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		reqs <- req
+	}))
+	defer svr.Close()
+
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	db := testDB(t, id, nil)
+	runTestDB(t, db)
+	c, err := New(Config{
+		DB:                      db,
+		LocalIDIniter:           fixedIDIniter(id),
+		WebhookRetryDelayFactor: 10 * time.Millisecond,
+		Webhooks: []WebhookEndpoint{{
+			Name:   "test",
+			URL:    svr.URL,
+			Secret: secret,
+			Events: []string{WebhookEventTip},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws := c.webhooks[0]
+
+	// Queue events before the sender runs.
+	ws.queue(WebhookEventTip, WebhookTip{AmountMAtoms: 1})
+	ws.queue(WebhookEventTip, WebhookTip{AmountMAtoms: 2})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go ws.run(ctx)
+
+	ws.queue(WebhookEventTip, WebhookTip{AmountMAtoms: 3})
+
+	for i := 1; i <= 3; i++ {
+		var req WebhookRequest
+		select {
+		case req = <-reqs:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for webhook request")
+		}
+		var tip WebhookTip
+		if err := json.Unmarshal(req.Payload, &tip); err != nil {
+			t.Fatal(err)
+		}
+		if req.SequenceID != uint64(i) || tip.AmountMAtoms != int64(i) {
+			t.Fatalf("unexpected request: got seq %d amount %d, want %d",
+				req.SequenceID, tip.AmountMAtoms, i)
+		}
+	}
+
+	// Acked events are removed from the queue.
+	time.Sleep(50 * time.Millisecond)
+	var events []clientdb.WebhookEvent
+	err = c.dbView(func(tx clientdb.ReadTx) error {
+		events, err = c.db.ListWebhookEvents(tx, "test")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("unexpected nb of queued events: got %d, want 0", len(events))
+	}
+}
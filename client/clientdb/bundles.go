@@ -0,0 +1,200 @@
+package clientdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/companyzero/bisonrelay/internal/strescape"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// multiFileReader reads the contents of a list of files in sequence, opening
+// each file only when it starts to be read.
+type multiFileReader struct {
+	paths []string
+	f     *os.File
+}
+
+func (r *multiFileReader) Read(b []byte) (int, error) {
+	for {
+		if r.f == nil {
+			if len(r.paths) == 0 {
+				return 0, io.EOF
+			}
+			f, err := os.Open(r.paths[0])
+			if err != nil {
+				return 0, err
+			}
+			r.f = f
+			r.paths = r.paths[1:]
+		}
+
+		n, err := r.f.Read(b)
+		if errors.Is(err, io.EOF) {
+			r.f.Close()
+			r.f = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// Close closes the currently open file (if any).
+func (r *multiFileReader) Close() error {
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+// scanBundleDir lists the regular files in dir (recursively, in lexical
+// order). Returns the list of bundle files, their paths in the local disk and
+// the hash of the concatenation of all files.
+//
+// Symlinks (to files or dirs) are not followed and, like other non-regular
+// files (devices, sockets, etc), are not included in the bundle. Their paths
+// (relative to dir) are returned in skipped.
+func scanBundleDir(dir string) (files []rpc.BundleFile, paths []string,
+	bundleHash []byte, skipped []string, err error) {
+
+	bundleHasher := sha256.New()
+	err = filepath.WalkDir(dir, func(fpath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, fpath)
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			skipped = append(skipped, filepath.ToSlash(relPath))
+			return nil
+		}
+
+		f, err := os.Open(fpath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		fileHasher := sha256.New()
+		size, err := io.Copy(io.MultiWriter(fileHasher, bundleHasher), f)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, rpc.BundleFile{
+			Path: filepath.ToSlash(relPath),
+			Size: uint64(size),
+			Hash: hex.EncodeToString(fileHasher.Sum(nil)),
+		})
+		paths = append(paths, fpath)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return files, paths, bundleHasher.Sum(nil), skipped, nil
+}
+
+// validateBundleFiles validates that the list of files of a bundle only
+// reference paths inside the bundle dir and that their sizes match the total
+// size of the bundle.
+func validateBundleFiles(md *rpc.FileMetadata) error {
+	var size uint64
+	seen := make(map[string]struct{}, len(md.Files))
+	for _, f := range md.Files {
+		if f.Path == "" || path.IsAbs(f.Path) || path.Clean(f.Path) != f.Path ||
+			!filepath.IsLocal(filepath.FromSlash(f.Path)) {
+			return fmt.Errorf("invalid bundle file path %q", f.Path)
+		}
+		if _, ok := seen[f.Path]; ok {
+			return fmt.Errorf("duplicated bundle file path %q", f.Path)
+		}
+		seen[f.Path] = struct{}{}
+		size += f.Size
+	}
+	if size != md.Size {
+		return fmt.Errorf("size of bundle files %d does not match bundle "+
+			"size %d", size, md.Size)
+	}
+	return nil
+}
+
+// assembleBundle assembles the downloaded chunks of a bundle into a directory
+// tree inside the downloads dir. Returns the path to the root dir of the
+// bundle.
+func (db *DB) assembleBundle(user string, fd *FileDownload, chunkDir string) (string, error) {
+	if err := validateBundleFiles(fd.Metadata); err != nil {
+		return "", err
+	}
+
+	// Figure out the final dir name.
+	baseDestDir := filepath.Join(db.downloadsDir, escapeNickForFname(user),
+		strescape.PathElement(fd.Metadata.Filename))
+	destDir := baseDestDir
+	for i := 1; fileExists(destDir); i++ {
+		destDir = fmt.Sprintf("%s_%.2d", baseDestDir, i)
+	}
+
+	// Read the chunks in sequence, splitting them into the bundle files.
+	chunkPaths := make([]string, len(fd.Metadata.Manifest))
+	for i, ch := range fd.Metadata.Manifest {
+		chunkPaths[i] = filepath.Join(chunkDir, hex.EncodeToString(ch.Hash))
+	}
+	r := &multiFileReader{paths: chunkPaths}
+	defer r.Close()
+	bundleHasher := sha256.New()
+	tr := io.TeeReader(r, bundleHasher)
+
+	for _, bf := range fd.Metadata.Files {
+		destFileName := filepath.Join(destDir, filepath.FromSlash(bf.Path))
+		if err := os.MkdirAll(filepath.Dir(destFileName), 0o700); err != nil {
+			return "", err
+		}
+		destFile, err := os.Create(destFileName)
+		if err != nil {
+			return "", err
+		}
+
+		fileHasher := sha256.New()
+		_, err = io.CopyN(io.MultiWriter(destFile, fileHasher), tr, int64(bf.Size))
+		closeErr := destFile.Close()
+		if err != nil {
+			return "", err
+		}
+		if closeErr != nil {
+			return "", closeErr
+		}
+
+		hashStr := hex.EncodeToString(fileHasher.Sum(nil))
+		if hashStr != bf.Hash {
+			return "", fmt.Errorf("unexpected hash of bundle file %q "+
+				"(got %s, want %s)", bf.Path, hashStr, bf.Hash)
+		}
+	}
+
+	// Ensure final bundle hash is correct.
+	hashStr := hex.EncodeToString(bundleHasher.Sum(nil))
+	if hashStr != fd.Metadata.Hash {
+		return "", fmt.Errorf("unexpected final bundle hash (got %s, want %s)",
+			hashStr, fd.Metadata.Hash)
+	}
+
+	return destDir, nil
+}
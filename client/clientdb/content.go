@@ -1,6 +1,7 @@
 package clientdb
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"time"
 
@@ -26,6 +28,7 @@ const (
 	contentHashSuffix     = ".filehash"
 	contentMetaHashSuffix = ".metahash"
 	downloadingDir        = "downloading"
+	downloadSourceExt     = ".cr-source"
 )
 
 // chunkFile creates a directory with appropriate chunks of the source file.
@@ -42,7 +45,13 @@ func (db *DB) chunkFile(srcFile, chunkDir string) ([]rpc.FileManifest, []byte, u
 		return nil, nil, 0, err
 	}
 
-	fsize := uint64(fi.Size())
+	return db.chunkReader(f, uint64(fi.Size()), srcFile, chunkDir)
+}
+
+// chunkReader creates a directory with appropriate chunks of the data read
+// from r, which is expected to have fsize bytes. Returns the full hash of the
+// data and final size.
+func (db *DB) chunkReader(r io.Reader, fsize uint64, srcName, chunkDir string) ([]rpc.FileManifest, []byte, uint64, error) {
 	chunkSize := uint64(db.cfg.ChunkSize)
 	if chunkSize == 0 || chunkSize > fsize {
 		chunkSize = fsize
@@ -51,7 +60,7 @@ func (db *DB) chunkFile(srcFile, chunkDir string) ([]rpc.FileManifest, []byte, u
 	var (
 		chunks uint64
 		fm     = make([]rpc.FileManifest, 0,
-			(uint64(fi.Size())/chunkSize)+1)
+			(fsize/max(chunkSize, 1))+1)
 		fHasher = sha256.New()
 		size    uint64
 	)
@@ -62,8 +71,8 @@ func (db *DB) chunkFile(srcFile, chunkDir string) ([]rpc.FileManifest, []byte, u
 
 	buffer := make([]byte, chunkSize)
 	for {
-		n, err := f.Read(buffer)
-		if err != nil && err != io.EOF {
+		n, err := io.ReadFull(r, buffer)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
 			return nil, nil, 0, err
 		}
 		if n == 0 {
@@ -95,7 +104,7 @@ func (db *DB) chunkFile(srcFile, chunkDir string) ([]rpc.FileManifest, []byte, u
 		fHasher.Write(chunk)
 	}
 	db.log.Debugf("Chunked file %s total size %d into %d chunks of max size %d",
-		srcFile, fsize, len(fm), chunkSize)
+		srcName, fsize, len(fm), chunkSize)
 	return fm, fHasher.Sum(nil), size, nil
 }
 
@@ -244,8 +253,118 @@ func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
 		}
 	}
 
-	// Create or update the list of people this file is shared with.
 	f.FID = md.MetadataHash()
+	if err := db.addFileShare(f, chunksPath, uid); err != nil {
+		return f, md, err
+	}
+
+	return f, md, nil
+}
+
+// ShareDir registers all files in the given dir (recursively) as a single
+// shared bundle. The bundle is downloaded (and paid for) as a single file and
+// is assembled back into a directory tree by the downloader. Symlinks and other
+// non-regular files are skipped with a logged warning.
+//
+// If uid is nil, then the bundle is registered as shared among all users.
+func (db *DB) ShareDir(tx ReadWriteTx, dir string, uid *UserID,
+	cost uint64, descr string, sign func([]byte) ([]byte, error)) (SharedFile, rpc.FileMetadata, error) {
+
+	var f SharedFile
+	var md rpc.FileMetadata
+
+	dir = filepath.Clean(dir)
+	baseName := filepath.Base(dir)
+	if baseName == "" || baseName == "." || baseName == string(filepath.Separator) {
+		return f, md, fmt.Errorf("invalid basename for dir %s", dir)
+	}
+
+	files, paths, bundleHash, skipped, err := scanBundleDir(dir)
+	if err != nil {
+		return f, md, err
+	}
+	for _, p := range skipped {
+		db.log.Warnf("Skipping non-regular file %q (symlinks are not "+
+			"followed) when sharing dir %s", p, dir)
+	}
+	if len(files) == 0 {
+		return f, md, fmt.Errorf("dir %s does not have any files", dir)
+	}
+
+	// The bundle is stored as if it was a single file with the name of the
+	// dir.
+	f.Filename = baseName
+	copy(f.FileHash[:], bundleHash)
+	chunksPath := filepath.Join(db.root, contentDir, baseName)
+	metaFname := filepath.Join(chunksPath, f.FileHash.String()+contentHashSuffix)
+	if fileExists(chunksPath) {
+		if !fileExists(metaFname) {
+			return f, md, fmt.Errorf("already shared a different file with name %s",
+				baseName)
+		}
+
+		// The same bundle is being shared again (possibly to a
+		// different user). Read the existing metadata.
+		if err := db.readJsonFile(metaFname, &md); err != nil {
+			return f, md, fmt.Errorf("unable to read existing file metadata: %v", err)
+		}
+		if !md.IsBundle() || !slices.Equal(md.Files, files) {
+			return f, md, fmt.Errorf("already shared a different file with name %s",
+				baseName)
+		}
+	} else {
+		md = rpc.FileMetadata{
+			Version:     rpc.FileMetadataVersionBundle,
+			Description: descr,
+			Cost:        cost,
+			Filename:    baseName,
+			Files:       files,
+		}
+
+		// Bundle is being shared for the first time. Chunk the
+		// contents of all files.
+		var totalSize uint64
+		for _, bf := range files {
+			totalSize += bf.Size
+		}
+		r := &multiFileReader{paths: paths}
+		var fhash []byte
+		md.Manifest, fhash, md.Size, err = db.chunkReader(r, totalSize, dir, chunksPath)
+		r.Close()
+		if err != nil {
+			return f, md, err
+		}
+		if !bytes.Equal(fhash, bundleHash) {
+			return f, md, fmt.Errorf("contents of dir %s changed while "+
+				"sharing", dir)
+		}
+		md.Hash = f.FileHash.String()
+
+		// Sign the hash of the bundle (including its list of files).
+		sig, err := sign(md.BundleSigHash(fhash))
+		if err != nil {
+			return f, md, fmt.Errorf("unable to sign hash of bundle: %w", err)
+		}
+		md.Signature = hex.EncodeToString(sig)
+
+		// Save the content metadata in the special file.
+		if err := db.saveJsonFile(metaFname, md); err != nil {
+			return f, md, err
+		}
+	}
+
+	f.FID = md.MetadataHash()
+	if err := db.addFileShare(f, chunksPath, uid); err != nil {
+		return f, md, err
+	}
+
+	return f, md, nil
+}
+
+// addFileShare records that the file (with content stored in chunksPath) is
+// shared with the given user (or globally if uid is nil).
+func (db *DB) addFileShare(f SharedFile, chunksPath string, uid *UserID) error {
+	// Create or update the list of people this file is shared with.
 	metaMetaFname := filepath.Join(chunksPath, f.FID.String()+contentMetaHashSuffix)
 	var shares []string
 	thisShare := sharedEveryone
@@ -254,7 +373,7 @@ func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
 	}
 	if fileExists(metaMetaFname) {
 		if err := db.readJsonFile(metaMetaFname, &shares); err != nil {
-			return f, md, err
+			return err
 		}
 		found := false
 		for _, s := range shares {
@@ -270,7 +389,7 @@ func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
 		shares = append(shares, thisShare)
 	}
 	if err := db.saveJsonFile(metaMetaFname, shares); err != nil {
-		return f, md, err
+		return err
 	}
 
 	// Now deal with the actual sharing of the file. If it's a global share,
@@ -282,10 +401,10 @@ func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
 	shareDir = filepath.Join(db.root, shareDir)
 	shareFname := filepath.Join(shareDir, f.FID.String())
 	if err := db.saveJsonFile(shareFname, f); err != nil {
-		return f, md, err
+		return err
 	}
 
-	return f, md, nil
+	return nil
 }
 
 // FindSharedFileID is used to find the file ID of a file shared with the given
@@ -782,28 +901,148 @@ func (db *DB) StartFileDownload(tx ReadWriteTx, uid UserID, fid FileID, isSentFi
 	return fd, nil
 }
 
+// resolveDownloadFID returns the ID of the download for which the file with the
+// given ID is an additional source, or fid itself if it is not an additional
+// source of any download.
+func (db *DB) resolveDownloadFID(fid FileID) FileID {
+	diskDir := filepath.Join(db.root, downloadingDir)
+	srcPath := filepath.Join(diskDir, fid.String()+downloadSourceExt)
+	var dlFID FileID
+	if err := db.readJsonFile(srcPath, &dlFID); err != nil {
+		return fid
+	}
+	return dlFID
+}
+
+// ReadFileDownload reads the download of the given file from the given user.
+// The file ID may be either the ID of the download or the ID of the file
+// shared by an additional source of the download.
 func (db *DB) ReadFileDownload(tx ReadTx, uid UserID, fid FileID) (FileDownload, error) {
 	var fd FileDownload
 
 	diskDir := filepath.Join(db.root, downloadingDir)
-	metaPath := filepath.Join(diskDir, fid.String()+contentMetaExt)
+	metaPath := filepath.Join(diskDir, db.resolveDownloadFID(fid).String()+contentMetaExt)
 	if err := db.readJsonFile(metaPath, &fd); err != nil {
 		return fd, err
 	}
 
-	// TODO: support downloading from multiple users at the same time?
-	if fd.UID != uid {
-		return fd, fmt.Errorf("specified user not the download user")
+	src, ok := fd.Source(uid)
+	if !ok {
+		return fd, fmt.Errorf("specified user not a download source")
+	}
+	if src.FID != fid && fd.FID != fid {
+		return fd, fmt.Errorf("specified file is not the one shared by " +
+			"the download source")
 	}
 	return fd, nil
 }
 
+// manifestsMatch returns true if both manifests have the same chunks.
+func manifestsMatch(a, b []rpc.FileManifest) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Index != b[i].Index || a[i].Size != b[i].Size ||
+			!bytes.Equal(a[i].Hash, b[i].Hash) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddFileDownloadSource adds the given user as an additional source for the
+// download. The file shared by the user (described by md) must have the same
+// content hash and chunk manifest as the download and must not cost more than
+// the file of the original source, whose price was agreed to by the local
+// user.
+//
+// Returns false if the user was already a source of the download.
+func (db *DB) AddFileDownloadSource(tx ReadWriteTx, fd *FileDownload,
+	uid UserID, md rpc.FileMetadata) (bool, error) {
+
+	if fd.Metadata == nil {
+		return false, fmt.Errorf("file metadata is nil")
+	}
+	if fd.CompletedName != "" {
+		return false, fmt.Errorf("download %s already completed", fd.FID)
+	}
+	if _, ok := fd.Source(uid); ok {
+		return false, nil
+	}
+	if md.Hash != fd.Metadata.Hash {
+		return false, fmt.Errorf("file hash %s does not match download "+
+			"hash %s", md.Hash, fd.Metadata.Hash)
+	}
+	if !manifestsMatch(md.Manifest, fd.Metadata.Manifest) {
+		return false, fmt.Errorf("file manifest does not match download " +
+			"manifest")
+	}
+	if md.Cost > fd.Metadata.Cost {
+		return false, fmt.Errorf("file cost %d is higher than download "+
+			"cost %d", md.Cost, fd.Metadata.Cost)
+	}
+
+	src := FileDownloadSource{
+		UID:  uid,
+		FID:  md.MetadataHash(),
+		Cost: md.Cost,
+	}
+	fd.Sources = append(fd.Sources, src)
+
+	// Track the file ID of the source so that messages referencing it may
+	// be matched to the download.
+	diskDir := filepath.Join(db.root, downloadingDir)
+	srcPath := filepath.Join(diskDir, src.FID.String()+downloadSourceExt)
+	if err := db.saveJsonFile(srcPath, fd.FID); err != nil {
+		return false, err
+	}
+
+	metaPath := filepath.Join(diskDir, fd.FID.String()+contentMetaExt)
+	if err := db.saveJsonFile(metaPath, fd); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MarkFileDownloadChunkRequested records that the given chunk was requested
+// from the given source of the download. Any outstanding invoice for the chunk
+// is discarded.
+func (db *DB) MarkFileDownloadChunkRequested(tx ReadWriteTx, fd *FileDownload,
+	chunkIdx int, uid UserID) error {
+
+	if _, ok := fd.Source(uid); !ok {
+		return fmt.Errorf("user %s is not a source of download %s",
+			uid, fd.FID)
+	}
+
+	if fd.ChunkSources == nil {
+		fd.ChunkSources = make(map[int]UserID)
+	}
+	fd.ChunkSources[chunkIdx] = uid
+	delete(fd.Invoices, chunkIdx)
+	return db.ReplaceFileDownloadChunkState(tx, fd, chunkIdx,
+		ChunkStateRequestedChunk)
+}
+
 // CancelFileDownload removes the in-progress download from the DB.
 func (db *DB) CancelFileDownload(tx ReadWriteTx, fid FileID) error {
 	diskDir := filepath.Join(db.root, downloadingDir)
 	metaPath := filepath.Join(diskDir, fid.String()+contentMetaExt)
 	chunkDir := filepath.Join(diskDir, fid.String()+chunkDirSuffix)
 
+	// Remove the references to the files of additional sources.
+	var fd FileDownload
+	if err := db.readJsonFile(metaPath, &fd); err == nil {
+		for _, src := range fd.Sources {
+			srcPath := filepath.Join(diskDir, src.FID.String()+downloadSourceExt)
+			if err := os.Remove(srcPath); err != nil && !os.IsNotExist(err) {
+				db.log.Warnf("Unable to remove source %s of "+
+					"download %s: %v", src.FID, fid, err)
+			}
+		}
+	}
+
 	err := os.Remove(metaPath)
 	if os.IsNotExist(err) {
 		return fmt.Errorf("download of file %s: %v", fid, ErrNotFound)
@@ -824,6 +1063,11 @@ func (db *DB) UpdateFileDownloadMetadata(tx ReadWriteTx, fd *FileDownload,
 	if fd.Metadata != nil {
 		return fmt.Errorf("cannot update file metadata: metadata already filled")
 	}
+	if md.IsBundle() {
+		if err := validateBundleFiles(&md); err != nil {
+			return err
+		}
+	}
 	fd.Metadata = &md
 
 	diskDir := filepath.Join(db.root, downloadingDir)
@@ -922,6 +1166,15 @@ func (db *DB) SaveFileDownloadChunk(tx ReadWriteTx, user string, fd *FileDownloa
 		return "", nil
 	}
 
+	// Bundles are assembled into a directory tree.
+	if fd.Metadata.IsBundle() {
+		destDir, err := db.assembleBundle(user, fd, chunkDir)
+		if err != nil {
+			return "", err
+		}
+		return destDir, db.completeFileDownload(fd, destDir, chunkDir)
+	}
+
 	// Assemble final file. First: figure out final name.
 	baseDestFileName := filepath.Join(db.downloadsDir, escapeNickForFname(user),
 		strescape.PathElement(fd.Metadata.Filename))
@@ -964,11 +1217,18 @@ func (db *DB) SaveFileDownloadChunk(tx ReadWriteTx, user string, fd *FileDownloa
 		return "", fmt.Errorf("unexpected final file hash (got %s, want %s)",
 			hashStr, fd.Metadata.Hash)
 	}
-	fd.CompletedName = filepath.Base(destFileName)
-	fd.DiskPath = destFileName
+	return destFileName, db.completeFileDownload(fd, destFileName, chunkDir)
+}
+
+// completeFileDownload marks the download as completed, with its contents
+// assembled into diskPath, and removes its chunks.
+func (db *DB) completeFileDownload(fd *FileDownload, diskPath, chunkDir string) error {
+	fd.CompletedName = filepath.Base(diskPath)
+	fd.DiskPath = diskPath
+	diskDir := filepath.Join(db.root, downloadingDir)
 	metaPath := filepath.Join(diskDir, fd.FID.String()+contentMetaExt)
 	if err := db.saveJsonFile(metaPath, fd); err != nil {
-		return "", err
+		return err
 	}
 
 	// Finally, clean up the chunks.
@@ -976,7 +1236,7 @@ func (db *DB) SaveFileDownloadChunk(tx ReadWriteTx, user string, fd *FileDownloa
 		db.log.Errorf("Unable to remove chunk dir of completed download: %v", err)
 	}
 
-	return destFileName, nil
+	return nil
 }
 
 func (db *DB) MissingFileDownloadChunks(tx ReadTx, fd *FileDownload) []int {
@@ -1004,11 +1264,64 @@ func (db *DB) MissingFileDownloadChunks(tx ReadTx, fd *FileDownload) []int {
 	return res
 }
 
+// SetFileDownloadSequential sets whether the chunks of the download should be
+// fetched in sequential order.
+func (db *DB) SetFileDownloadSequential(tx ReadWriteTx, fd *FileDownload, sequential bool) error {
+	fd.Sequential = sequential
+	diskDir := filepath.Join(db.root, downloadingDir)
+	metaPath := filepath.Join(diskDir, fd.FID.String()+contentMetaExt)
+	return db.saveJsonFile(metaPath, fd)
+}
+
+// ReadFileDownloadChunk reads the data of the given chunk of the download.
+// The chunk is read from the assembled file when the download has completed.
+// Returns ErrNotFound if the chunk has not been downloaded yet.
+func (db *DB) ReadFileDownloadChunk(tx ReadTx, fd *FileDownload, chunkIdx int) ([]byte, error) {
+	if fd.Metadata == nil {
+		return nil, fmt.Errorf("file metadata is nil")
+	}
+	if chunkIdx < 0 || chunkIdx >= len(fd.Metadata.Manifest) {
+		return nil, fmt.Errorf("chunk %d does not exist", chunkIdx)
+	}
+	chunk := fd.Metadata.Manifest[chunkIdx]
+
+	if fd.CompletedName == "" {
+		diskDir := filepath.Join(db.root, downloadingDir)
+		chunkDir := filepath.Join(diskDir, fd.FID.String()+chunkDirSuffix)
+		chunkPath := filepath.Join(chunkDir, hex.EncodeToString(chunk.Hash))
+		data, err := os.ReadFile(chunkPath)
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("chunk %d: %w", chunkIdx, ErrNotFound)
+		}
+		return data, err
+	}
+
+	if fd.Metadata.IsBundle() {
+		return nil, fmt.Errorf("cannot read chunks of completed bundle")
+	}
+
+	// Read from the assembled file.
+	var offset int64
+	for _, ch := range fd.Metadata.Manifest[:chunkIdx] {
+		offset += int64(ch.Size)
+	}
+	f, err := os.Open(fd.DiskPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	data := make([]byte, chunk.Size)
+	if _, err := f.ReadAt(data, offset); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
 // HasDownloadedFile returns the path to the completed downloaded file (if it
 // exists).
 func (db *DB) HasDownloadedFile(tx ReadTx, fid zkidentity.ShortID) (string, error) {
 	downDir := filepath.Join(db.root, downloadingDir)
-	metaFname := filepath.Join(downDir, fid.String()+contentMetaExt)
+	metaFname := filepath.Join(downDir, db.resolveDownloadFID(fid).String()+contentMetaExt)
 	if !fileExists(metaFname) {
 		return "", nil
 	}
@@ -1049,7 +1362,8 @@ func (db *DB) HasDownloadedFiles(tx ReadTx, user string, uid UserID, files []rpc
 			UID:      uid,
 		}
 
-		metaFname := filepath.Join(downDir, res[i].FID.String()+contentMetaExt)
+		dlFID := db.resolveDownloadFID(res[i].FID)
+		metaFname := filepath.Join(downDir, dlFID.String()+contentMetaExt)
 		if !fileExists(metaFname) {
 			continue
 		}
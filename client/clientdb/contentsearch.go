@@ -0,0 +1,136 @@
+package clientdb
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+const contentSearchDir = "contentsearch"
+
+// contentSearchFname returns the name of the file where the results of the
+// search for the given query are stored.
+func (db *DB) contentSearchFname(query string) string {
+	h := sha256.Sum256([]byte(query))
+	return filepath.Join(db.root, contentSearchDir, hex.EncodeToString(h[:]))
+}
+
+// NewContentSearch starts a new cached content search, replacing any previous
+// results for the same query.
+func (db *DB) NewContentSearch(tx ReadWriteTx, query string, queried int) (ContentSearch, error) {
+	now := time.Now()
+	cs := ContentSearch{
+		Query:   query,
+		Started: now,
+		Updated: now,
+		Queried: queried,
+	}
+	return cs, db.saveJsonFile(db.contentSearchFname(query), cs)
+}
+
+// ReadContentSearch returns the cached results of the search for the given
+// query.
+func (db *DB) ReadContentSearch(tx ReadTx, query string) (ContentSearch, error) {
+	var cs ContentSearch
+	err := db.readJsonFile(db.contentSearchFname(query), &cs)
+	return cs, err
+}
+
+// AddContentSearchReply adds the files returned by a remote user to the
+// results of the search for the given query. Results are aggregated by the
+// content hash of the files.
+func (db *DB) AddContentSearchReply(tx ReadWriteTx, query string, uid UserID,
+	nick string, files []rpc.FileMetadata) (ContentSearch, error) {
+
+	cs, err := db.ReadContentSearch(tx, query)
+	if err != nil {
+		return cs, err
+	}
+
+	for _, fm := range files {
+		seller := ContentSearchSeller{
+			UID:      uid,
+			Nick:     nick,
+			FID:      fm.MetadataHash(),
+			Filename: fm.Filename,
+			Cost:     fm.Cost,
+		}
+
+		i := slices.IndexFunc(cs.Results, func(r ContentSearchResult) bool {
+			return r.Hash == fm.Hash
+		})
+		if i < 0 {
+			cs.Results = append(cs.Results, ContentSearchResult{
+				Hash:        fm.Hash,
+				Filename:    fm.Filename,
+				Description: fm.Description,
+				Size:        fm.Size,
+			})
+			i = len(cs.Results) - 1
+		}
+
+		res := &cs.Results[i]
+		j := slices.IndexFunc(res.Sellers, func(s ContentSearchSeller) bool {
+			return s.UID == uid
+		})
+		if j < 0 {
+			res.Sellers = append(res.Sellers, seller)
+		} else {
+			res.Sellers[j] = seller
+		}
+	}
+
+	// Results shared by more users first, then by filename. Sellers
+	// ordered by cost.
+	for i := range cs.Results {
+		slices.SortStableFunc(cs.Results[i].Sellers, func(a, b ContentSearchSeller) int {
+			return cmp.Compare(a.Cost, b.Cost)
+		})
+	}
+	slices.SortStableFunc(cs.Results, func(a, b ContentSearchResult) int {
+		if len(a.Sellers) != len(b.Sellers) {
+			return len(b.Sellers) - len(a.Sellers)
+		}
+		return strings.Compare(a.Filename, b.Filename)
+	})
+
+	cs.Replied += 1
+	cs.Updated = time.Now()
+	return cs, db.saveJsonFile(db.contentSearchFname(query), cs)
+}
+
+// ContentSearchSellers returns the users that replied to any of the cached
+// content searches with a file with the given content hash.
+func (db *DB) ContentSearchSellers(tx ReadTx, hash string) ([]UserID, error) {
+	files, err := filepath.Glob(filepath.Join(db.root, contentSearchDir, "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []UserID
+	for _, fname := range files {
+		var cs ContentSearch
+		if err := db.readJsonFile(fname, &cs); err != nil {
+			db.log.Warnf("Unable to read content search file %s: %v",
+				fname, err)
+			continue
+		}
+		for _, r := range cs.Results {
+			if r.Hash != hash {
+				continue
+			}
+			for _, s := range r.Sellers {
+				if !slices.Contains(res, s.UID) {
+					res = append(res, s.UID)
+				}
+			}
+		}
+	}
+	return res, nil
+}
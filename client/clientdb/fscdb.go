@@ -51,6 +51,7 @@ const (
 	postKXActionsDir    = "postkxactions"
 	initKXActionsDir    = "initkxactions"
 	payStatsFile        = "paystats.json"
+	payLedgerDir        = "payledger"
 	unackedRMsDir       = "unackedrms"
 	lastConnDateFile    = "lastconndate.json"
 	tipsDir             = "tips"
@@ -2,8 +2,10 @@ package clientdb
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"time"
 
@@ -153,6 +155,26 @@ type GroupChat struct {
 	// Alias is a local alias for the GC. This may be empty, in which
 	// case the Metadata.Name field is used.
 	Alias string `json:"alias"`
+
+	// ShareToken is the token that remote users may present to subscribe
+	// to a channel owned by the local client. Zero if the GC is not a
+	// channel or no shareable invite has been created for it.
+	ShareToken uint64 `json:"share_token,omitempty"`
+
+	// KickedSubscribers are the users kicked from a channel owned by the
+	// local client. They may not subscribe again using the share token,
+	// only through a direct invite.
+	KickedSubscribers []UserID `json:"kicked_subscribers,omitempty"`
+
+	// ShareHistory is the number of recent messages the local client
+	// sends to members that join the GC through it. Zero disables history
+	// sharing.
+	ShareHistory int `json:"share_history,omitempty"`
+
+	// AwaitingHistory is set when the local client joins the GC and is
+	// cleared once it merges a history bundle received from a member, so
+	// that only one bundle is merged.
+	AwaitingHistory bool `json:"awaiting_history,omitempty"`
 }
 
 // DeepCopy makes a deep copy of this GC so that the copy can be modified.
@@ -229,6 +251,69 @@ type FileDownload struct {
 	ChunkUpdatedTime map[int]time.Time  `json:"chunkupdttimes"`
 	IsSentFile       bool               `json:"is_sent_file"`
 	DiskPath         string             `json:"disk_path"` // Set when completed
+
+	// Sources are the additional users (besides UID) that share the same
+	// content and that chunks may be fetched from.
+	Sources []FileDownloadSource `json:"sources,omitempty"`
+
+	// ChunkSources tracks which user each chunk was requested from.
+	ChunkSources map[int]UserID `json:"chunksources,omitempty"`
+
+	// Sequential is set when chunks should be fetched in order, with only
+	// a limited number of chunks requested at a time, such that the
+	// start of the file is available as early as possible.
+	Sequential bool `json:"sequential,omitempty"`
+}
+
+// FileDownloadSource is an additional remote user that shares the content of a
+// download. The file ID and cost are specific to the remote user's share.
+type FileDownloadSource struct {
+	UID  UserID `json:"uid"`
+	FID  FileID `json:"fid"`
+	Cost uint64 `json:"cost"`
+}
+
+// Source returns the source of the download that corresponds to the given
+// user (which may be the original user the download was started from).
+func (fd *FileDownload) Source(uid UserID) (FileDownloadSource, bool) {
+	if uid == fd.UID {
+		var cost uint64
+		if fd.Metadata != nil {
+			cost = fd.Metadata.Cost
+		}
+		return FileDownloadSource{UID: fd.UID, FID: fd.FID, Cost: cost}, true
+	}
+	for _, src := range fd.Sources {
+		if src.UID == uid {
+			return src, true
+		}
+	}
+	return FileDownloadSource{}, false
+}
+
+// AllSources returns every source of the download, starting with the original
+// user the download was started from.
+func (fd *FileDownload) AllSources() []FileDownloadSource {
+	src, _ := fd.Source(fd.UID)
+	return append([]FileDownloadSource{src}, fd.Sources...)
+}
+
+// GetChunkSource returns the user the given chunk was requested from. Chunks
+// of downloads started before multi-source downloads were supported are
+// assumed to be requested from the original user.
+func (fd *FileDownload) GetChunkSource(chunkIdx int) UserID {
+	if uid, ok := fd.ChunkSources[chunkIdx]; ok {
+		return uid
+	}
+	return fd.UID
+}
+
+// SourceMetadata returns the metadata of the download with the cost of the
+// given source.
+func (fd *FileDownload) SourceMetadata(src FileDownloadSource) rpc.FileMetadata {
+	md := *fd.Metadata
+	md.Cost = src.Cost
+	return md
 }
 
 func (fd *FileDownload) GetChunkState(chunkIdx int) ChunkState {
@@ -281,6 +366,73 @@ type RemoteFile struct {
 	Metadata rpc.FileMetadata `json:"metadata"`
 }
 
+// ContentSearchSeller is a user that shares a file found in a content search.
+type ContentSearchSeller struct {
+	UID      UserID `json:"uid"`
+	Nick     string `json:"nick"`
+	FID      FileID `json:"fid"`
+	Filename string `json:"filename"`
+	Cost     uint64 `json:"cost"`
+}
+
+// ContentSearchResult is a file found in a content search. Files with the same
+// content shared by multiple users are aggregated in a single result.
+type ContentSearchResult struct {
+	Hash        string                `json:"hash"`
+	Filename    string                `json:"filename"`
+	Description string                `json:"description"`
+	Size        uint64                `json:"size"`
+	Sellers     []ContentSearchSeller `json:"sellers"`
+}
+
+// ContentSearch is the cached state of a search for content across remote
+// users.
+type ContentSearch struct {
+	Query   string                `json:"query"`
+	Started time.Time             `json:"started"`
+	Updated time.Time             `json:"updated"`
+	Queried int                   `json:"queried"`
+	Replied int                   `json:"replied"`
+	Results []ContentSearchResult `json:"results"`
+}
+
+// PaywallAccess tracks the access of a remote user to the resources behind a
+// local paywall.
+type PaywallAccess struct {
+	Paywall string `json:"paywall"`
+	UID     UserID `json:"uid"`
+
+	// Granted is the time access was first granted. It is zero if the
+	// user never paid for access.
+	Granted time.Time `json:"granted"`
+
+	// Expires is the time access expires. It is zero if access does not
+	// expire.
+	Expires time.Time `json:"expires"`
+
+	// PaidMAtoms is the total amount paid by the user for access.
+	PaidMAtoms int64 `json:"paid_matoms"`
+
+	// Invoices are the outstanding invoices sent to the user. Paying any
+	// of them grants access according to the terms of that invoice.
+	Invoices []PaywallInvoice `json:"invoices,omitempty"`
+}
+
+// PaywallInvoice is an invoice sent to a user for access to a paywall and the
+// terms of the access it grants.
+type PaywallInvoice struct {
+	Invoice  string        `json:"invoice"`
+	MAtoms   int64         `json:"matoms"`
+	Created  time.Time     `json:"created"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+// HasAccess returns true if the user has access to the paywall at the given
+// time.
+func (pa *PaywallAccess) HasAccess(now time.Time) bool {
+	return !pa.Granted.IsZero() && (pa.Expires.IsZero() || now.Before(pa.Expires))
+}
+
 type PostSummary struct {
 	ID           PostID    `json:"id"`
 	From         UserID    `json:"from"`
@@ -423,6 +575,32 @@ type PayStatsSummary struct {
 	Total  int64  `json:"total"`
 }
 
+// Purposes of payments recorded in the payment ledger.
+const (
+	PayPurposeTip        = "tip"
+	PayPurposeFileChunk  = "file_chunk"
+	PayPurposePaywall    = "paywall"
+	PayPurposeStoreOrder = "store_order"
+	PayPurposeSubFee     = "sub_fee"
+	PayPurposePushFee    = "push_fee"
+)
+
+// PayLedgerEntry is an entry in the append-only ledger of payments made and
+// received by the local client.
+type PayLedgerEntry struct {
+	Timestamp time.Time `json:"ts"`
+	UID       UserID    `json:"uid"`
+	Event     string    `json:"event"`
+	Purpose   string    `json:"purpose"`
+
+	// Amount is the amount (in milli-atoms) of the payment. It is negative
+	// for outbound payments and positive for inbound payments.
+	Amount int64 `json:"amount"`
+
+	// PayFee is the (negative) amount of LN fees paid, in milli-atoms.
+	PayFee int64 `json:"pay_fee"`
+}
+
 // UnackedRM is an already encrypted but unacked RM.
 type UnackedRM struct {
 	UID       UserID  `json:"uid"`
@@ -470,6 +648,69 @@ type FetchedResource struct {
 	Response   rpc.RMFetchResourceReply  `json:"response"`
 }
 
+// CachedResource is a resource fetched from a remote user that is cached
+// locally.
+// ResourceCacheKey identifies a cached resource of a remote user.
+type ResourceCacheKey struct {
+	Path  []string          `json:"path"`
+	Meta  map[string]string `json:"meta"`
+	Index uint32            `json:"index,omitempty"`
+	Count uint32            `json:"count,omitempty"`
+}
+
+// ResourceCacheKeyFor returns the key of the cached resource for the request.
+// Conditional request meta is not part of the key.
+func ResourceCacheKeyFor(rm *rpc.RMFetchResource) ResourceCacheKey {
+	meta := maps.Clone(rm.Meta)
+	delete(meta, rpc.ResourceMetaIfNoneMatch)
+	if len(meta) == 0 {
+		meta = nil
+	}
+	return ResourceCacheKey{
+		Path:  rm.Path,
+		Meta:  meta,
+		Index: rm.Index,
+		Count: rm.Count,
+	}
+}
+
+type CachedResource struct {
+	UID     UserID            `json:"uid"`
+	Path    []string          `json:"path"`
+	Meta    map[string]string `json:"meta"`
+	Index   uint32            `json:"index,omitempty"`
+	Count   uint32            `json:"count,omitempty"`
+	Fetched time.Time         `json:"fetched"`
+
+	// Expires is the time until which the resource may be used without
+	// revalidating it with the remote user. If zero, the resource must
+	// always be revalidated (but may still be used while offline).
+	Expires time.Time `json:"expires"`
+
+	Response rpc.RMFetchResourceReply `json:"response"`
+}
+
+// Key returns the key of the cached resource.
+func (cr *CachedResource) Key() ResourceCacheKey {
+	return ResourceCacheKey{
+		Path:  cr.Path,
+		Meta:  cr.Meta,
+		Index: cr.Index,
+		Count: cr.Count,
+	}
+}
+
+// IsFresh returns true if the cached resource may be used without revalidating
+// it at the given time.
+func (cr *CachedResource) IsFresh(now time.Time) bool {
+	return now.Before(cr.Expires)
+}
+
+// ETag returns the version of the cached resource (if set by the remote user).
+func (cr *CachedResource) ETag() string {
+	return cr.Response.Meta[rpc.ResourceMetaETag]
+}
+
 // PageSessionOverviewRequest is the overview of a fetch resource request.
 type PageSessionOverviewRequest struct {
 	UID clientintf.UserID `json:"uid"`
@@ -571,6 +812,30 @@ type EarlyPostStatus struct {
 	Status rpc.RMPostShare `json:"status"`
 }
 
+// WebhookEvent is a client event queued for delivery to a webhook endpoint.
+type WebhookEvent struct {
+	// ID is the sequence number of the event in the queue of the endpoint.
+	ID uint64 `json:"id"`
+
+	// Type is the type of the event.
+	Type string `json:"type"`
+
+	// Payload is the JSON-encoded data of the event.
+	Payload json.RawMessage `json:"payload"`
+
+	// Created is the time the event was queued.
+	Created time.Time `json:"created"`
+
+	// Attempts is the number of failed delivery attempts.
+	Attempts uint32 `json:"attempts"`
+
+	// NextAttempt is the time of the next delivery attempt.
+	NextAttempt time.Time `json:"next_attempt"`
+
+	// LastError is the error of the last failed delivery attempt.
+	LastError string `json:"last_error,omitempty"`
+}
+
 var (
 	ErrLocalIDEmpty         = errors.New("local ID is not initialized")
 	ErrServerIDEmpty        = errors.New("server ID is not known")
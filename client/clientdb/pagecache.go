@@ -0,0 +1,72 @@
+package clientdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const pageCacheDir = "pagecache"
+
+// cachedResourceFname returns the name of the file where the resource of the
+// user with the given key is cached.
+func (db *DB) cachedResourceFname(uid UserID, key ResourceCacheKey) string {
+	if len(key.Meta) == 0 {
+		key.Meta = nil
+	}
+	keyData, _ := json.Marshal(key)
+	h := sha256.Sum256(keyData)
+	return filepath.Join(db.root, pageCacheDir, uid.String(),
+		hex.EncodeToString(h[:]))
+}
+
+// ReadCachedResource returns the cached resource of the user with the given
+// key.
+func (db *DB) ReadCachedResource(tx ReadTx, uid UserID, key ResourceCacheKey) (CachedResource, error) {
+	var cr CachedResource
+	err := db.readJsonFile(db.cachedResourceFname(uid, key), &cr)
+	return cr, err
+}
+
+// StoreCachedResource stores the resource in the cache, replacing any
+// previously cached version.
+func (db *DB) StoreCachedResource(tx ReadWriteTx, cr *CachedResource) error {
+	return db.saveJsonFile(db.cachedResourceFname(cr.UID, cr.Key()), cr)
+}
+
+// RemoveCachedResource removes the resource from the cache.
+func (db *DB) RemoveCachedResource(tx ReadWriteTx, uid UserID, key ResourceCacheKey) error {
+	return removeIfExists(db.cachedResourceFname(uid, key))
+}
+
+// ListCachedResources lists the cached resources of the given user (or of all
+// users if nil), sorted by path.
+func (db *DB) ListCachedResources(tx ReadTx, uid *UserID) ([]CachedResource, error) {
+	dir := "*"
+	if uid != nil {
+		dir = uid.String()
+	}
+	files, err := filepath.Glob(filepath.Join(db.root, pageCacheDir, dir, "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]CachedResource, 0, len(files))
+	for _, fname := range files {
+		var cr CachedResource
+		if err := db.readJsonFile(fname, &cr); err != nil {
+			db.log.Warnf("Unable to read cached resource %s: %v",
+				fname, err)
+			continue
+		}
+		res = append(res, cr)
+	}
+	slices.SortFunc(res, func(a, b CachedResource) int {
+		return strings.Compare(strings.Join(a.Path, "/"),
+			strings.Join(b.Path, "/"))
+	})
+	return res, nil
+}
@@ -2,6 +2,8 @@ package clientdb
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -26,6 +28,18 @@ func (db *DB) RecordUserPayEvent(tx ReadWriteTx, user UserID, event string, amou
 		return err
 	}
 
+	entry := PayLedgerEntry{
+		Timestamp: time.Unix(evnt.Timestamp, 0),
+		UID:       user,
+		Event:     event,
+		Purpose:   PayEventPurpose(event),
+		Amount:    amount,
+		PayFee:    payFee,
+	}
+	if err := db.appendPayLedgerEntry(entry); err != nil {
+		return err
+	}
+
 	uid := user.String()
 	userStats := db.payStats[uid]
 	if amount < 0 {
@@ -117,7 +131,7 @@ func (db *DB) SummarizeUserPayStats(tx ReadTx, uid UserID) ([]PayStatsSummary, e
 }
 
 // ClearPayStats removes pay stats for the given user or for all users if user
-// equals nil.
+// equals nil. The payment ledger is not modified.
 func (db *DB) ClearPayStats(tx ReadWriteTx, user *UserID) error {
 	if user == nil {
 		// Remove stats summary file.
@@ -157,3 +171,110 @@ func (db *DB) ClearPayStats(tx ReadWriteTx, user *UserID) error {
 
 	return nil
 }
+
+// payLedgerMonthLayout is the layout of the name of the payment ledger files.
+// Each file holds the entries of one month (in UTC).
+const payLedgerMonthLayout = "2006-01"
+
+// PayEventPurpose returns the purpose of a payment, given the pay event it was
+// recorded with.
+func PayEventPurpose(event string) string {
+	segments := strings.Split(event, ".")
+	switch segments[0] {
+	case "tip", "paytip":
+		return PayPurposeTip
+	case "ftpaychunk", "ftrecvforchunk":
+		return PayPurposeFileChunk
+	case "paywall":
+		return PayPurposePaywall
+	case "store":
+		return PayPurposeStoreOrder
+	}
+	for _, seg := range segments {
+		if seg == "sub" {
+			return PayPurposeSubFee
+		}
+	}
+	return PayPurposePushFee
+}
+
+// appendPayLedgerEntry appends the entry to the payment ledger.
+func (db *DB) appendPayLedgerEntry(entry PayLedgerEntry) error {
+	month := entry.Timestamp.UTC().Format(payLedgerMonthLayout)
+	fname := filepath.Join(db.root, payLedgerDir, month+".json")
+	return db.appendToJsonFile(fname, entry)
+}
+
+// ListPayLedger lists the entries of the payment ledger recorded in the
+// [start, end) interval, in the order they were recorded. A zero start or end
+// means the interval is unbounded on that side.
+func (db *DB) ListPayLedger(tx ReadTx, start, end time.Time) ([]PayLedgerEntry, error) {
+	dir := filepath.Join(db.root, payLedgerDir)
+	files, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res []PayLedgerEntry
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || filepath.Ext(name) != ".json" {
+			continue
+		}
+		monthStart, err := time.Parse(payLedgerMonthLayout,
+			strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			continue
+		}
+
+		// Skip files with no entries in the interval.
+		if !start.IsZero() && !monthStart.AddDate(0, 1, 0).After(start) {
+			continue
+		}
+		if !end.IsZero() && !monthStart.Before(end) {
+			continue
+		}
+
+		fname := filepath.Join(dir, name)
+		entries, err := db.readPayLedgerFile(fname, start, end)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, entries...)
+	}
+	return res, nil
+}
+
+// readPayLedgerFile reads the entries of a payment ledger file recorded in the
+// [start, end) interval.
+func (db *DB) readPayLedgerFile(fname string, start, end time.Time) ([]PayLedgerEntry, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var res []PayLedgerEntry
+	dec := json.NewDecoder(f)
+	for {
+		var entry PayLedgerEntry
+		err := dec.Decode(&entry)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			db.log.Warnf("Unable to decode pay ledger file %s: %v",
+				fname, err)
+			break
+		}
+		if !start.IsZero() && entry.Timestamp.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !entry.Timestamp.Before(end) {
+			continue
+		}
+		res = append(res, entry)
+	}
+	return res, nil
+}
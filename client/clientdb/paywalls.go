@@ -0,0 +1,55 @@
+package clientdb
+
+import (
+	"path/filepath"
+
+	"github.com/companyzero/bisonrelay/internal/strescape"
+)
+
+const paywallsDir = "paywalls"
+
+// paywallAccessFname returns the name of the file where the access of the user
+// to the given paywall is stored.
+func (db *DB) paywallAccessFname(paywall string, uid UserID) string {
+	return filepath.Join(db.root, paywallsDir, strescape.PathElement(paywall),
+		uid.String()+".json")
+}
+
+// ReadPaywallAccess returns the access record of the user to the given
+// paywall.
+func (db *DB) ReadPaywallAccess(tx ReadTx, paywall string, uid UserID) (PaywallAccess, error) {
+	var pa PaywallAccess
+	err := db.readJsonFile(db.paywallAccessFname(paywall, uid), &pa)
+	return pa, err
+}
+
+// StorePaywallAccess stores the access record of a user to a paywall.
+func (db *DB) StorePaywallAccess(tx ReadWriteTx, pa *PaywallAccess) error {
+	return db.saveJsonFile(db.paywallAccessFname(pa.Paywall, pa.UID), pa)
+}
+
+// ListPaywallAccess lists the access records of the given paywall. If paywall
+// is empty, the records of all paywalls are returned.
+func (db *DB) ListPaywallAccess(tx ReadTx, paywall string) ([]PaywallAccess, error) {
+	dir := "*"
+	if paywall != "" {
+		dir = strescape.PathElement(paywall)
+	}
+	pattern := filepath.Join(db.root, paywallsDir, dir, "*.json")
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]PaywallAccess, 0, len(files))
+	for _, fname := range files {
+		var pa PaywallAccess
+		if err := db.readJsonFile(fname, &pa); err != nil {
+			db.log.Warnf("Unable to read paywall access file %s: %v",
+				fname, err)
+			continue
+		}
+		res = append(res, pa)
+	}
+	return res, nil
+}
@@ -63,9 +63,9 @@ func (db *DB) StoreResourceRequest(tx ReadWriteTx, uid UserID,
 	return nil
 }
 
-// readResourceRequest returns the resource request corresponding to the
+// ReadResourceRequest returns the resource request corresponding to the
 // specified tag.
-func (db *DB) readResourceRequest(tx ReadTx, uid UserID,
+func (db *DB) ReadResourceRequest(tx ReadTx, uid UserID,
 	tag rpc.ResourceTag) (ResourceRequest, error) {
 
 	dir := filepath.Join(db.root, inboundDir, uid.String(), reqResourcesDir)
@@ -110,7 +110,7 @@ func (db *DB) StoreFetchedResource(tx ReadWriteTx, uid UserID, tag rpc.ResourceT
 	var sess PageSessionOverview
 
 	// Double check request exists.
-	req, err := db.readResourceRequest(tx, uid, tag)
+	req, err := db.ReadResourceRequest(tx, uid, tag)
 	if err != nil {
 		return fr, sess, err
 	}
@@ -0,0 +1,124 @@
+package clientdb
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/companyzero/bisonrelay/internal/jsonfile"
+	"github.com/companyzero/bisonrelay/internal/strescape"
+)
+
+const (
+	webhooksDir        = "webhooks"
+	webhookLastSeqFile = "lastseq.json"
+)
+
+var webhookEventsFnamePattern = jsonfile.MakeDecimalFilePattern("", ".json", false)
+
+// webhookDir returns the dir where the queue of the given webhook endpoint is
+// stored.
+func (db *DB) webhookDir(endpoint string) string {
+	return filepath.Join(db.root, webhooksDir, strescape.PathElement(endpoint))
+}
+
+// QueueWebhookEvent adds the event to the end of the queue of the given
+// webhook endpoint. The ID of the event is set to the next sequence number of
+// the endpoint.
+//
+// Sequence numbers are never reused, even after the queue is emptied.
+func (db *DB) QueueWebhookEvent(tx ReadWriteTx, endpoint string, ev *WebhookEvent) error {
+	dir := db.webhookDir(endpoint)
+	seqFname := filepath.Join(dir, webhookLastSeqFile)
+	var lastSeq uint64
+	err := db.readJsonFile(seqFname, &lastSeq)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	// Also check the queued files, in case the seq file was lost.
+	last, err := webhookEventsFnamePattern.Last(dir)
+	if err != nil {
+		return err
+	}
+	if last.ID > lastSeq {
+		lastSeq = last.ID
+	}
+
+	ev.ID = lastSeq + 1
+	fname := filepath.Join(dir, webhookEventsFnamePattern.FilenameFor(ev.ID))
+	if err := db.saveJsonFile(fname, ev); err != nil {
+		return err
+	}
+	return db.saveJsonFile(seqFname, ev.ID)
+}
+
+// UpdateWebhookEvent updates a queued webhook event.
+func (db *DB) UpdateWebhookEvent(tx ReadWriteTx, endpoint string, ev *WebhookEvent) error {
+	fname := filepath.Join(db.webhookDir(endpoint),
+		webhookEventsFnamePattern.FilenameFor(ev.ID))
+	if !fileExists(fname) {
+		return ErrNotFound
+	}
+	return db.saveJsonFile(fname, ev)
+}
+
+// RemoveWebhookEvent removes the event with the given ID from the queue of the
+// webhook endpoint.
+func (db *DB) RemoveWebhookEvent(tx ReadWriteTx, endpoint string, id uint64) error {
+	fname := filepath.Join(db.webhookDir(endpoint),
+		webhookEventsFnamePattern.FilenameFor(id))
+	return removeIfExists(fname)
+}
+
+// FirstWebhookEvent returns the oldest event queued for the given webhook
+// endpoint. Returns ErrNotFound if the queue is empty.
+//
+// Event files that cannot be read are renamed out of the queue, so that they do
+// not block delivery of the next events.
+func (db *DB) FirstWebhookEvent(tx ReadWriteTx, endpoint string) (WebhookEvent, error) {
+	dir := db.webhookDir(endpoint)
+	for {
+		first, err := webhookEventsFnamePattern.First(dir)
+		if err != nil {
+			return WebhookEvent{}, err
+		}
+		if first.Filename == "" {
+			return WebhookEvent{}, ErrNotFound
+		}
+
+		fname := filepath.Join(dir, first.Filename)
+		var ev WebhookEvent
+		err = db.readJsonFile(fname, &ev)
+		if err == nil {
+			return ev, nil
+		}
+		db.log.Warnf("Unable to read webhook event file %s: %v", fname, err)
+		if err := os.Rename(fname, fname+".invalid"); err != nil {
+			return WebhookEvent{}, err
+		}
+	}
+}
+
+// ListWebhookEvents lists the events queued for the given webhook endpoint, in
+// the order they were queued.
+func (db *DB) ListWebhookEvents(tx ReadTx, endpoint string) ([]WebhookEvent, error) {
+	dir := db.webhookDir(endpoint)
+	files, err := webhookEventsFnamePattern.MatchFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]WebhookEvent, 0, len(files))
+	for _, f := range files {
+		fname := filepath.Join(dir, f.Filename)
+		var ev WebhookEvent
+		if err := db.readJsonFile(fname, &ev); err != nil {
+			db.log.Warnf("Unable to read webhook event file %s: %v",
+				fname, err)
+			continue
+		}
+		res = append(res, ev)
+	}
+	return res, nil
+}
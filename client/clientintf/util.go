@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/companyzero/bisonrelay/client/internal/callstream"
 	"github.com/companyzero/bisonrelay/ratchet"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
@@ -272,3 +273,46 @@ func EstimatePMCost(msg string, policy *ServerPolicy) (uint64, error) {
 	cost, err := policy.CalcPushCostMAtoms(rmSize)
 	return uint64(cost), err
 }
+
+// EstimateGCMCost returns the estimated cost (in milliatoms) to send the given
+// message to nbDests members of a GC.
+func EstimateGCMCost(msg string, nbDests int, policy *ServerPolicy) (uint64, error) {
+	// Use a medium level compression level for the estimate.
+	const compressLevel = 4
+
+	// Encode it and return the size of an encrypted version of it. The
+	// generation is set to the max value to account for the largest
+	// possible encoding.
+	rmgm := rpc.RMGroupMessage{Generation: math.MaxUint64, Message: msg}
+	rm, err := rpc.ComposeCompressedRM(dummySigner, rmgm, compressLevel)
+	if err != nil {
+		return 0, err
+	}
+
+	rmSize := ratchet.EncryptedSize(len(rm))
+	cost, err := policy.CalcPushCostMAtoms(rmSize)
+	return uint64(cost) * uint64(nbDests), err
+}
+
+// EstimateCallCost returns the estimated cost (in milliatoms) for one minute of
+// a call, from the point of view of one of its parties. Each party pays to push
+// its own media packets and to subscribe to the packets of the remote party.
+//
+// Each packet contains packetFrames frames of frameMs duration, encoded with
+// the given bitrate (in bps).
+func EstimateCallCost(frameMs, packetFrames, bitrate uint32, policy *ServerPolicy) (uint64, error) {
+	if frameMs == 0 || packetFrames == 0 {
+		return 0, fmt.Errorf("frame duration and packet frames must be " +
+			"greater than zero")
+	}
+
+	frameBytes := int(bitrate) * int(frameMs) / 8000
+	packetSize := callstream.Overhead + int(packetFrames)*(2+frameBytes)
+	pushCost, err := policy.CalcPushCostMAtoms(packetSize)
+	if err != nil {
+		return 0, err
+	}
+
+	packetsPerMinute := uint64(60*1000) / uint64(frameMs*packetFrames)
+	return packetsPerMinute * (uint64(pushCost) + policy.SubPayRate), nil
+}
@@ -0,0 +1,160 @@
+// Package callstream implements the encoding, encryption and reordering of the
+// media packets exchanged during real-time calls.
+//
+// Each call has a random secret, shared between the parties in the (ratchet
+// encrypted) call offer. Each direction of the call uses its own encryption
+// key and a sequence of single-use RV points, all derived from the secret.
+// Packets are pushed as-is to the RV point of their sequence number, which
+// allows the receiver to subscribe to upcoming packets in advance and to
+// detect lost packets.
+package callstream
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/companyzero/bisonrelay/ratchet"
+	"github.com/decred/dcrd/crypto/blake256"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+// SecretSize is the size of the call secret.
+const SecretSize = 32
+
+// MaxFramesPerPacket is the max number of frames in a single packet.
+const MaxFramesPerPacket = 50
+
+// MaxFrameSize is the max size of an individual frame.
+const MaxFrameSize = 4000
+
+// Overhead is the number of bytes added to the frames when sealing a packet
+// (excluding the 2 byte length prefix of each frame).
+const Overhead = secretbox.Overhead + 8 + 1
+
+// Direction is the direction of the stream of packets in a call.
+type Direction byte
+
+const (
+	// DirCallerToCallee is the direction of packets sent by the party
+	// that offered the call.
+	DirCallerToCallee Direction = 0
+
+	// DirCalleeToCaller is the direction of packets sent by the party
+	// that accepted the call.
+	DirCalleeToCaller Direction = 1
+)
+
+var (
+	errShortPacket  = errors.New("packet is too short")
+	errDecryptFail  = errors.New("unable to decrypt packet")
+	errWrongSeq     = errors.New("packet sequence does not match expected one")
+	errTooManyFrame = errors.New("packet has too many frames")
+)
+
+// Stream is one direction of a call.
+type Stream struct {
+	secret [SecretSize]byte
+	dir    Direction
+	key    [32]byte
+}
+
+// NewStream creates a new stream for the given call secret and direction.
+func NewStream(secret [SecretSize]byte, dir Direction) *Stream {
+	s := &Stream{secret: secret, dir: dir}
+	s.key = s.derive("key", 0)
+	return s
+}
+
+// derive derives a 32 byte value for the given purpose and sequence number.
+func (s *Stream) derive(purpose string, seq uint64) [32]byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], seq)
+	h := blake256.New()
+	h.Write(s.secret[:])
+	h.Write([]byte(purpose))
+	h.Write([]byte{byte(s.dir)})
+	h.Write(b[:])
+	var res [32]byte
+	copy(res[:], h.Sum(nil))
+	return res
+}
+
+// RV returns the RV point where the packet with the given sequence number is
+// pushed.
+func (s *Stream) RV(seq uint64) ratchet.RVPoint {
+	return ratchet.RVPoint(s.derive("rv", seq))
+}
+
+// nonce returns the nonce used to seal the packet with the given sequence
+// number.
+func (s *Stream) nonce(seq uint64) *[24]byte {
+	var nonce [24]byte
+	nonce[0] = byte(s.dir)
+	binary.BigEndian.PutUint64(nonce[1:], seq)
+	return &nonce
+}
+
+// SealedSize returns the size of a sealed packet with the given frames.
+func SealedSize(frames [][]byte) int {
+	size := Overhead
+	for _, f := range frames {
+		size += 2 + len(f)
+	}
+	return size
+}
+
+// Seal encodes and encrypts the frames of the packet with the given sequence
+// number.
+func (s *Stream) Seal(seq uint64, frames [][]byte) ([]byte, error) {
+	if len(frames) > MaxFramesPerPacket {
+		return nil, errTooManyFrame
+	}
+
+	plain := make([]byte, 0, SealedSize(frames)-secretbox.Overhead)
+	plain = binary.BigEndian.AppendUint64(plain, seq)
+	plain = append(plain, byte(len(frames)))
+	for _, f := range frames {
+		if len(f) > MaxFrameSize {
+			return nil, fmt.Errorf("frame size %d is greater than "+
+				"max %d", len(f), MaxFrameSize)
+		}
+		plain = binary.BigEndian.AppendUint16(plain, uint16(len(f)))
+		plain = append(plain, f...)
+	}
+
+	return secretbox.Seal(nil, plain, s.nonce(seq), &s.key), nil
+}
+
+// Open decrypts and decodes the packet with the given sequence number.
+func (s *Stream) Open(seq uint64, sealed []byte) ([][]byte, error) {
+	plain, ok := secretbox.Open(nil, sealed, s.nonce(seq), &s.key)
+	if !ok {
+		return nil, errDecryptFail
+	}
+	if len(plain) < 9 {
+		return nil, errShortPacket
+	}
+	if binary.BigEndian.Uint64(plain) != seq {
+		return nil, errWrongSeq
+	}
+	nb := int(plain[8])
+	if nb > MaxFramesPerPacket {
+		return nil, errTooManyFrame
+	}
+
+	plain = plain[9:]
+	frames := make([][]byte, nb)
+	for i := range frames {
+		if len(plain) < 2 {
+			return nil, errShortPacket
+		}
+		l := int(binary.BigEndian.Uint16(plain))
+		if len(plain) < 2+l {
+			return nil, errShortPacket
+		}
+		frames[i] = plain[2 : 2+l]
+		plain = plain[2+l:]
+	}
+	return frames, nil
+}
@@ -0,0 +1,85 @@
+package callstream
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// TestSealOpen tests sealing and opening packets.
+func TestSealOpen(t *testing.T) {
+	var secret [SecretSize]byte
+	secret[0] = 0x01
+	send := NewStream(secret, DirCallerToCallee)
+	recv := NewStream(secret, DirCallerToCallee)
+	other := NewStream(secret, DirCalleeToCaller)
+
+	frames := [][]byte{{0x01, 0x02}, {}, bytes.Repeat([]byte{0xff}, 300)}
+	sealed, err := send.Seal(10, frames)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(sealed), SealedSize(frames))
+
+	got, err := recv.Open(10, sealed)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, got, frames)
+
+	// Opening with the wrong sequence number or in the other direction
+	// fails.
+	_, err = recv.Open(11, sealed)
+	assert.ErrorIs(t, err, errDecryptFail)
+	_, err = other.Open(10, sealed)
+	assert.ErrorIs(t, err, errDecryptFail)
+
+	// RVs are different for each sequence number and direction.
+	if send.RV(1) == send.RV(2) || send.RV(1) == other.RV(1) {
+		t.Fatal("RVs are not unique")
+	}
+	assert.DeepEqual(t, send.RV(1), recv.RV(1))
+
+	// Too many frames.
+	_, err = send.Seal(1, make([][]byte, MaxFramesPerPacket+1))
+	assert.ErrorIs(t, err, errTooManyFrame)
+}
+
+// TestJitterBuffer tests reordering and loss detection of the jitter buffer.
+func TestJitterBuffer(t *testing.T) {
+	jb := NewJitterBuffer(4)
+	f := func(b byte) [][]byte { return [][]byte{{b}} }
+
+	// Nothing to play.
+	_, _, _, ok := jb.Pop()
+	assert.DeepEqual(t, ok, false)
+
+	// Out of order packets are played in order.
+	assert.DeepEqual(t, jb.Push(1, f(1)), true)
+	assert.DeepEqual(t, jb.Push(0, f(0)), true)
+	assert.DeepEqual(t, jb.Push(0, f(0)), false) // Duplicate.
+	assert.DeepEqual(t, jb.Push(4, f(4)), false) // Outside window.
+	for i := byte(0); i < 2; i++ {
+		seq, frames, lost, ok := jb.Pop()
+		assert.DeepEqual(t, ok, true)
+		assert.DeepEqual(t, lost, false)
+		assert.DeepEqual(t, seq, uint64(i))
+		assert.DeepEqual(t, frames, f(i))
+	}
+
+	// Packet 2 is lost once packet 3 is received.
+	_, _, _, ok = jb.Pop()
+	assert.DeepEqual(t, ok, false)
+	assert.DeepEqual(t, jb.Push(3, f(3)), true)
+	seq, frames, lost, ok := jb.Pop()
+	assert.DeepEqual(t, ok, true)
+	assert.DeepEqual(t, lost, true)
+	assert.DeepEqual(t, seq, uint64(2))
+	assert.DeepEqual(t, len(frames), 0)
+	seq, frames, lost, _ = jb.Pop()
+	assert.DeepEqual(t, lost, false)
+	assert.DeepEqual(t, seq, uint64(3))
+	assert.DeepEqual(t, frames, f(3))
+
+	// Late packets are rejected.
+	assert.DeepEqual(t, jb.Push(2, f(2)), false)
+	assert.DeepEqual(t, jb.Lost(), uint64(1))
+	assert.DeepEqual(t, jb.Next(), uint64(4))
+}
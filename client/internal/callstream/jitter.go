@@ -0,0 +1,85 @@
+package callstream
+
+// JitterBuffer reorders received packets so that their frames may be played
+// back in sequence, and detects lost packets.
+//
+// It is not safe for concurrent access.
+type JitterBuffer struct {
+	next    uint64
+	window  uint64
+	packets map[uint64][][]byte
+	lost    uint64
+}
+
+// NewJitterBuffer creates a new jitter buffer that accepts packets up to window
+// sequence numbers ahead of the next packet to be played.
+func NewJitterBuffer(window int) *JitterBuffer {
+	return &JitterBuffer{
+		window:  uint64(window),
+		packets: make(map[uint64][][]byte, window),
+	}
+}
+
+// Next returns the sequence number of the next packet to be played.
+func (jb *JitterBuffer) Next() uint64 {
+	return jb.next
+}
+
+// Buffered returns the number of packets in the buffer.
+func (jb *JitterBuffer) Buffered() int {
+	return len(jb.packets)
+}
+
+// Lost returns the number of packets that were skipped because they were not
+// received in time.
+func (jb *JitterBuffer) Lost() uint64 {
+	return jb.lost
+}
+
+// Push adds a packet to the buffer. Returns false if the packet was not added
+// because it is a duplicate, arrived too late to be played, or is too far
+// ahead of the playback window.
+func (jb *JitterBuffer) Push(seq uint64, frames [][]byte) bool {
+	if seq < jb.next || seq >= jb.next+jb.window {
+		return false
+	}
+	if _, ok := jb.packets[seq]; ok {
+		return false
+	}
+	jb.packets[seq] = frames
+	return true
+}
+
+// hasLater returns true if a packet after the next one was received.
+func (jb *JitterBuffer) hasLater() bool {
+	for seq := range jb.packets {
+		if seq > jb.next {
+			return true
+		}
+	}
+	return false
+}
+
+// Pop returns the frames of the next packet to be played and advances the
+// buffer.
+//
+// When the next packet has not been received but later ones have, it is
+// considered lost: ok is true, lost is true and the buffer is advanced past
+// it.
+//
+// When no packet is available (i.e. the buffer underran), ok is false and the
+// buffer is not advanced.
+func (jb *JitterBuffer) Pop() (seq uint64, frames [][]byte, lost, ok bool) {
+	seq = jb.next
+	if frames, ok = jb.packets[seq]; ok {
+		delete(jb.packets, seq)
+		jb.next++
+		return seq, frames, false, true
+	}
+	if !jb.hasLater() {
+		return seq, nil, false, false
+	}
+	jb.next++
+	jb.lost++
+	return seq, nil, true, true
+}
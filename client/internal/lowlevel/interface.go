@@ -1,5 +1,7 @@
 package lowlevel
 
+import "context"
+
 // OutboundRM is the interface for sending routed messages via the rmq.
 type OutboundRM interface {
 	EncryptedLen() uint32
@@ -7,3 +9,22 @@ type OutboundRM interface {
 	Priority() uint
 	PaidForRM(int64, int64)
 }
+
+// PaymentLimiter limits the automatic payments made to the server.
+type PaymentLimiter interface {
+	// HoldPushPayment is called before an RM that requires paying amt
+	// milli-atoms to be pushed is sent. It returns nil if the payment is
+	// allowed. Otherwise, it returns a channel that is closed once the
+	// payment may be reconsidered, and the RM is held until then.
+	HoldPushPayment(orm OutboundRM, amt int64) <-chan struct{}
+
+	// PushPaid is called after the payment to push an RM completes.
+	PushPaid(orm OutboundRM, amt, fees int64)
+
+	// WaitSubsPayment is called before paying amt milli-atoms to subscribe
+	// to RVs. It may block until the payment is allowed.
+	WaitSubsPayment(ctx context.Context, amt int64) error
+
+	// SubsPaid is called after the payment to subscribe to RVs completes.
+	SubsPaid(amt, fees int64)
+}
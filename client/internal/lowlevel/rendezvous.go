@@ -96,6 +96,9 @@ type RVManager struct {
 	// subscription set and avoid sending multiple subscription requests to
 	// the server in a very short time frame.
 	subsDelayer func() <-chan time.Time
+
+	// limiter, if set, limits the payments made for subscriptions.
+	limiter PaymentLimiter
 }
 
 func NewRVManager(log slog.Logger, db RVManagerDB, subsDelayer func() <-chan time.Time, subDoneCB func()) *RVManager {
@@ -127,6 +130,12 @@ func NewRVManager(log slog.Logger, db RVManagerDB, subsDelayer func() <-chan tim
 	}
 }
 
+// SetPaymentLimiter sets the limiter of the payments made for subscriptions.
+// This must be called before the RVManager is run.
+func (rmgr *RVManager) SetPaymentLimiter(limiter PaymentLimiter) {
+	rmgr.limiter = limiter
+}
+
 // Sub informs the manager to subscribe to the given rendezvous point and to
 // call handler once a message is received in the given point.
 //
@@ -399,13 +408,27 @@ func (rmgr *RVManager) payForSubs(ctx context.Context, subsNeedPay map[ratchet.R
 		return nil, err
 	}
 
-	// Fetch invoice if needed.
-	pc := sess.PayClient()
-	needsInvoice := false
 	if len(unpaidRVs) == 0 {
 		// No need to pay.
 		return nil, nil
-	} else if nextInvoice == "" {
+	}
+
+	// Wait until the payment is allowed.
+	subPayRate := sess.Policy().SubPayRate
+	amt := len(unpaidRVs) * int(subPayRate)
+	if rmgr.limiter != nil {
+		ctx, cancel := multiCtx(ctx, sess.Context())
+		err := rmgr.limiter.WaitSubsPayment(ctx, int64(amt))
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Fetch invoice if needed.
+	pc := sess.PayClient()
+	needsInvoice := false
+	if nextInvoice == "" {
 		needsInvoice = true
 	} else {
 		// Decode invoice, check if it's expired.
@@ -426,9 +449,6 @@ func (rmgr *RVManager) payForSubs(ctx context.Context, subsNeedPay map[ratchet.R
 		}
 	}
 
-	subPayRate := sess.Policy().SubPayRate
-	amt := len(unpaidRVs) * int(subPayRate)
-
 	// Pay for it.
 	ctx, cancel := multiCtx(ctx, sess.Context())
 	rmgr.log.Debugf("Attempting to pay %d MAtoms for new subs %s", amt,
@@ -438,6 +458,9 @@ func (rmgr *RVManager) payForSubs(ctx context.Context, subsNeedPay map[ratchet.R
 
 	// If the payment completed, track the stats for the previously unpaid
 	// subs.
+	if err == nil && rmgr.limiter != nil {
+		rmgr.limiter.SubsPaid(int64(amt), totalFees)
+	}
 	if err == nil {
 		for i, id := range unpaidRVs {
 			sub, ok := subsNeedPay[id]
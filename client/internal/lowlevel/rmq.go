@@ -85,6 +85,9 @@ type RMQ struct {
 	// beforeFetchInvoiceHook is called before attempting to fetch an
 	// invoice. This is only used in some tests.
 	beforeFetchInvoiceHook func()
+
+	// limiter, if set, limits the payments made to push RMs.
+	limiter PaymentLimiter
 }
 
 func NewRMQ(log slog.Logger, db RMQDB) *RMQ {
@@ -109,6 +112,12 @@ func NewRMQ(log slog.Logger, db RMQDB) *RMQ {
 	return q
 }
 
+// SetPaymentLimiter sets the limiter of the payments made to push RMs. This
+// must be called before the RMQ is run.
+func (q *RMQ) SetPaymentLimiter(limiter PaymentLimiter) {
+	q.limiter = limiter
+}
+
 // MaxMsgSize returns the current max message size of the RMQ.
 func (q *RMQ) MaxMsgSize() uint32 {
 	return q.maxMsgSize.Load()
@@ -324,6 +333,23 @@ func (q *RMQ) isRVInvoicePaid(ctx context.Context, rv RVID, amt int64, pc client
 	return fees, decoded.ID
 }
 
+// holdPushPayment returns a non-nil channel if the payment limiter requires
+// holding the RM until the returned channel is closed.
+func (q *RMQ) holdPushPayment(rmm *rmmsg, sess clientintf.ServerSessionIntf) <-chan struct{} {
+	if q.limiter == nil {
+		return nil
+	}
+	payloadSize := rmm.orm.EncryptedLen()
+	serverPolicy := sess.Policy()
+	amt, err := serverPolicy.CalcPushCostMAtoms(int(payloadSize))
+	if err != nil {
+		// Should not happen because this is validated during server
+		// welcome. payForRM will fail with the same error.
+		return nil
+	}
+	return q.limiter.HoldPushPayment(rmm.orm, amt)
+}
+
 // payForRM pays for the given rm on the server.
 func (q *RMQ) payForRM(ctx context.Context, rmm *rmmsg, invoice string,
 	sess clientintf.ServerSessionIntf) error {
@@ -392,6 +418,9 @@ func (q *RMQ) payForRM(ctx context.Context, rmm *rmmsg, invoice string,
 		rmm.paidHash = decoded.ID
 		rmm.mtx.Unlock()
 		rmm.orm.PaidForRM(amt, fees)
+		if q.limiter != nil {
+			q.limiter.PushPaid(rmm.orm, amt, fees)
+		}
 	}
 	return err
 }
@@ -656,6 +685,13 @@ func (q *RMQ) sendLoop(ctx context.Context) error {
 	// and that can be used to pay for the next one.
 	invoices := &genericlist.List[string]{}
 
+	// held tracks the RMs held by the payment limiter, in the order they
+	// were received, by the channel that releases them. Held RMs do not
+	// count towards the pending RMs, so that they do not block sending
+	// other RMs.
+	held := make(map[<-chan struct{}][]*rmmsg)
+	releasedChan := make(chan (<-chan struct{}))
+
 loop:
 	for {
 		select {
@@ -713,6 +749,28 @@ loop:
 			}
 
 		case rmm := <-sendChan:
+			// Hold the RM if the payment to push it is not
+			// currently allowed.
+			if holdChan := q.holdPushPayment(rmm, sess); holdChan != nil {
+				if _, ok := held[holdChan]; !ok {
+					go func() {
+						select {
+						case <-holdChan:
+						case <-ctx.Done():
+							return
+						}
+						select {
+						case releasedChan <- holdChan:
+						case <-ctx.Done():
+						}
+					}()
+				}
+				held[holdChan] = append(held[holdChan], rmm)
+				q.log.Debugf("Holding RM %s until its payment is allowed",
+					rmm.orm)
+				continue loop
+			}
+
 			// Prepare the msg. This is done synchronously so that
 			// RMs sent to the same user are sent in ratchet
 			// sendcount order.
@@ -775,6 +833,23 @@ loop:
 				invoices.PushBack(reply.nextInvoice)
 			}
 
+		case holdChan := <-releasedChan:
+			// Requeue the released RMs, in order, so that they
+			// are sent according to their priority.
+			rmmsToRequeue := held[holdChan]
+			delete(held, holdChan)
+			q.log.Debugf("Requeueing %d RMs released by payment limiter",
+				len(rmmsToRequeue))
+			go func() {
+				for _, rmm := range rmmsToRequeue {
+					select {
+					case q.rmChan <- rmm:
+					case <-q.enqueueDone:
+						go rmm.sendReply(errRMQExiting)
+					}
+				}
+			}()
+
 		case c := <-q.sendLenChan:
 			c <- len(rmms)
 
@@ -787,6 +862,11 @@ loop:
 	for rmm := range rmms {
 		go rmm.sendReply(errRMQExiting)
 	}
+	for _, rmmsHeld := range held {
+		for _, rmm := range rmmsHeld {
+			go rmm.sendReply(errRMQExiting)
+		}
+	}
 
 	return ctx.Err()
 }
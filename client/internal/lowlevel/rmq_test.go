@@ -757,3 +757,64 @@ func TestConcurrentRMQSends(t *testing.T) {
 		t.Fatalf("Unexpected queue len: got %d, want 0", gotLen)
 	}
 }
+
+// mockPushLimiter is a PaymentLimiter that holds the push payments of RMs
+// listed in held until their channel is closed.
+type mockPushLimiter struct {
+	held map[mockRM]chan struct{}
+}
+
+func (l *mockPushLimiter) HoldPushPayment(orm OutboundRM, amt int64) <-chan struct{} {
+	c, ok := l.held[orm.(mockRM)]
+	if !ok {
+		return nil
+	}
+	select {
+	case <-c:
+		// Released.
+		return nil
+	default:
+		return c
+	}
+}
+
+func (l *mockPushLimiter) PushPaid(orm OutboundRM, amt, fees int64)             {}
+func (l *mockPushLimiter) WaitSubsPayment(ctx context.Context, amt int64) error { return nil }
+func (l *mockPushLimiter) SubsPaid(amt, fees int64)                             {}
+
+// TestRMQHeldPaymentDoesNotBlock asserts that RMs held by the payment limiter
+// do not block sending other RMs and are sent once released.
+func TestRMQHeldPaymentDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	releaseChan := make(chan struct{})
+	q := NewRMQ(nil, newMockRMQDB())
+	q.SetPaymentLimiter(&mockPushLimiter{
+		held: map[mockRM]chan struct{}{"held": releaseChan},
+	})
+	runErr := make(chan error)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { runErr <- q.Run(ctx) }()
+
+	// Bind to the server. Only one RM may be pending at a time.
+	sess := newMockServerSession()
+	q.BindToSession(sess)
+
+	// Send the held RM, then another one. The second one is sent.
+	heldErrChan := make(chan error, 1)
+	go func() { heldErrChan <- q.SendRM(mockRM("held")) }()
+	time.Sleep(50 * time.Millisecond)
+	rmErrChan := make(chan error, 1)
+	go func() { rmErrChan <- q.SendRM(mockRM("other")) }()
+	sess.replyNextPRPC(t, &rpc.GetInvoiceReply{})
+	sess.replyNextPRPC(t, &rpc.RouteMessageReply{})
+	assert.NilErrFromChan(t, rmErrChan)
+	assert.ChanNotWritten(t, heldErrChan, 100*time.Millisecond)
+
+	// Release the held RM. It is sent.
+	close(releaseChan)
+	sess.replyNextPRPC(t, &rpc.GetInvoiceReply{})
+	sess.replyNextPRPC(t, &rpc.RouteMessageReply{})
+	assert.NilErrFromChan(t, heldErrChan)
+}
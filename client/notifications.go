@@ -168,6 +168,104 @@ type OnGCAdminsChangedNtfn func(ru *RemoteUser, gc rpc.RMGroupList, added, remov
 
 func (_ OnGCAdminsChangedNtfn) typ() string { return onGCAdminsChangedNtfnType }
 
+const onGCHistoryReceivedNtfnType = "onGCHistoryReceived"
+
+// OnGCHistoryReceivedNtfn is a handler for GC history bundles received from
+// other GC members and merged into the local GC log.
+type OnGCHistoryReceivedNtfn func(ru *RemoteUser, gc rpc.RMGroupList, entries []rpc.RMGCHistoryEntry)
+
+func (_ OnGCHistoryReceivedNtfn) typ() string { return onGCHistoryReceivedNtfnType }
+
+const onCallOfferedNtfnType = "onCallOffered"
+
+// OnCallOfferedNtfn is a handler for calls offered by remote users.
+// costPerMinute is the estimated cost (in milliatoms) for each minute of the
+// call if it is accepted.
+type OnCallOfferedNtfn func(ru *RemoteUser, call *VoiceCall, costPerMinute uint64)
+
+func (_ OnCallOfferedNtfn) typ() string { return onCallOfferedNtfnType }
+
+const onCallAnsweredNtfnType = "onCallAnswered"
+
+// OnCallAnsweredNtfn is a handler for remote users answering calls offered by
+// the local client.
+type OnCallAnsweredNtfn func(ru *RemoteUser, call *VoiceCall, accepted bool, reason string)
+
+func (_ OnCallAnsweredNtfn) typ() string { return onCallAnsweredNtfnType }
+
+const onCallEndedNtfnType = "onCallEnded"
+
+// OnCallEndedNtfn is a handler for calls that were hung up, either by the local
+// client or by the remote user.
+type OnCallEndedNtfn func(ru *RemoteUser, call *VoiceCall, reason string, byRemote bool)
+
+func (_ OnCallEndedNtfn) typ() string { return onCallEndedNtfnType }
+
+const onContentSearchResultsNtfnType = "onContentSearchResults"
+
+// OnContentSearchResultsNtfn is a handler for replies received from remote
+// users to a content search. The search includes the aggregated results of all
+// replies received so far.
+type OnContentSearchResultsNtfn func(ru *RemoteUser, search clientdb.ContentSearch)
+
+func (_ OnContentSearchResultsNtfn) typ() string { return onContentSearchResultsNtfnType }
+
+const onPaywallInvoiceNtfnType = "onPaywallInvoice"
+
+// OnPaywallInvoiceNtfn is a handler for invoices received from remote users
+// that grant access to resources behind one of their paywalls.
+type OnPaywallInvoiceNtfn func(ru *RemoteUser, paywall string, invoice string, decoded clientintf.DecodedInvoice)
+
+func (_ OnPaywallInvoiceNtfn) typ() string { return onPaywallInvoiceNtfnType }
+
+const onPaywallAccessGrantedNtfnType = "onPaywallAccessGranted"
+
+// OnPaywallAccessGrantedNtfn is a handler for remote users that paid for
+// access to resources behind a local paywall.
+type OnPaywallAccessGrantedNtfn func(ru *RemoteUser, access clientdb.PaywallAccess)
+
+func (_ OnPaywallAccessGrantedNtfn) typ() string { return onPaywallAccessGrantedNtfnType }
+
+const onProductSubscriptionChangedNtfnType = "onProductSubscriptionChanged"
+
+// OnProductSubscriptionChangedNtfn is a handler for changes to the
+// subscriptions of remote users to recurring products sold by local
+// providers.
+type OnProductSubscriptionChangedNtfn func(sub ProductSubscription)
+
+func (_ OnProductSubscriptionChangedNtfn) typ() string {
+	return onProductSubscriptionChangedNtfnType
+}
+
+const onAddressBookChangedNtfnType = "onAddressBookChanged"
+
+// AddressBookChange is a change made by the local client to an entry of the
+// address book.
+type AddressBookChange int
+
+const (
+	// AddressBookChangeRenamed is a user that had its local nick changed.
+	AddressBookChangeRenamed AddressBookChange = iota
+
+	// AddressBookChangeIgnored is a user that is now ignored.
+	AddressBookChangeIgnored
+
+	// AddressBookChangeUnignored is a user that is no longer ignored.
+	AddressBookChangeUnignored
+
+	// AddressBookChangeBlocked is a user that was blocked (and removed
+	// from the address book) by the local client.
+	AddressBookChangeBlocked
+)
+
+// OnAddressBookChangedNtfn is a handler for changes made by the local client
+// to the address book. Users added to the address book are notified by
+// OnKXCompleted and users removed by their request are notified by
+// OnBlockNtfn.
+type OnAddressBookChangedNtfn func(ru *RemoteUser, change AddressBookChange)
+
+func (_ OnAddressBookChangedNtfn) typ() string { return onAddressBookChangedNtfnType }
+
 const onKXSearchCompletedNtfnType = "kxSearchCompleted"
 
 // OnKXSearchCompleted is a handler for completed KX search procedures.
@@ -325,6 +423,23 @@ type OnFileDownloadProgress func(user *RemoteUser, fm rpc.FileMetadata, nbMissin
 
 func (_ OnFileDownloadProgress) typ() string { return onFileDownloadProgress }
 
+const onFileChunkUploaded = "onFileChunkUploaded"
+
+// OnFileChunkUploaded is called whenever a chunk of a shared file is sent to
+// a remote user.
+type OnFileChunkUploaded func(user *RemoteUser, sf clientdb.SharedFile, chunkIdx int)
+
+func (_ OnFileChunkUploaded) typ() string { return onFileChunkUploaded }
+
+const onSpendingLimitAlertNtfnType = "onSpendingLimitAlert"
+
+// OnSpendingLimitAlertNtfn is called when the spending of a configured
+// spending limit crosses its alert threshold or budget, or when a payment is
+// held waiting for the user to approve it.
+type OnSpendingLimitAlertNtfn func(alert SpendingLimitAlert)
+
+func (_ OnSpendingLimitAlertNtfn) typ() string { return onSpendingLimitAlertNtfnType }
+
 const onRMReceived = "onRMReceived"
 
 // OnRMReceived is a notification sent whenever a remote user receives an RM.
@@ -858,6 +973,52 @@ func (nmgr *NotificationManager) notifyGCAdminsChanged(ru *RemoteUser, gc rpc.RM
 		visit(func(h OnGCAdminsChangedNtfn) { h(ru, gc, added, removed) })
 }
 
+func (nmgr *NotificationManager) notifyGCHistoryReceived(ru *RemoteUser, gc rpc.RMGroupList,
+	entries []rpc.RMGCHistoryEntry) {
+	nmgr.handlers[onGCHistoryReceivedNtfnType].(*handlersFor[OnGCHistoryReceivedNtfn]).
+		visit(func(h OnGCHistoryReceivedNtfn) { h(ru, gc, entries) })
+}
+
+func (nmgr *NotificationManager) notifyCallOffered(ru *RemoteUser, call *VoiceCall, costPerMinute uint64) {
+	nmgr.handlers[onCallOfferedNtfnType].(*handlersFor[OnCallOfferedNtfn]).
+		visit(func(h OnCallOfferedNtfn) { h(ru, call, costPerMinute) })
+}
+
+func (nmgr *NotificationManager) notifyCallAnswered(ru *RemoteUser, call *VoiceCall, accepted bool, reason string) {
+	nmgr.handlers[onCallAnsweredNtfnType].(*handlersFor[OnCallAnsweredNtfn]).
+		visit(func(h OnCallAnsweredNtfn) { h(ru, call, accepted, reason) })
+}
+
+func (nmgr *NotificationManager) notifyCallEnded(ru *RemoteUser, call *VoiceCall, reason string, byRemote bool) {
+	nmgr.handlers[onCallEndedNtfnType].(*handlersFor[OnCallEndedNtfn]).
+		visit(func(h OnCallEndedNtfn) { h(ru, call, reason, byRemote) })
+}
+
+func (nmgr *NotificationManager) notifyContentSearchResults(ru *RemoteUser, search clientdb.ContentSearch) {
+	nmgr.handlers[onContentSearchResultsNtfnType].(*handlersFor[OnContentSearchResultsNtfn]).
+		visit(func(h OnContentSearchResultsNtfn) { h(ru, search) })
+}
+
+func (nmgr *NotificationManager) notifyPaywallInvoice(ru *RemoteUser, paywall string, invoice string, decoded clientintf.DecodedInvoice) {
+	nmgr.handlers[onPaywallInvoiceNtfnType].(*handlersFor[OnPaywallInvoiceNtfn]).
+		visit(func(h OnPaywallInvoiceNtfn) { h(ru, paywall, invoice, decoded) })
+}
+
+func (nmgr *NotificationManager) notifyPaywallAccessGranted(ru *RemoteUser, access clientdb.PaywallAccess) {
+	nmgr.handlers[onPaywallAccessGrantedNtfnType].(*handlersFor[OnPaywallAccessGrantedNtfn]).
+		visit(func(h OnPaywallAccessGrantedNtfn) { h(ru, access) })
+}
+
+func (nmgr *NotificationManager) notifyAddressBookChanged(ru *RemoteUser, change AddressBookChange) {
+	nmgr.handlers[onAddressBookChangedNtfnType].(*handlersFor[OnAddressBookChangedNtfn]).
+		visit(func(h OnAddressBookChangedNtfn) { h(ru, change) })
+}
+
+func (nmgr *NotificationManager) notifyProductSubscriptionChanged(sub ProductSubscription) {
+	nmgr.handlers[onProductSubscriptionChangedNtfnType].(*handlersFor[OnProductSubscriptionChangedNtfn]).
+		visit(func(h OnProductSubscriptionChangedNtfn) { h(sub) })
+}
+
 func (nmgr *NotificationManager) notifyTipAttemptProgress(ru *RemoteUser, amtMAtoms int64, completed bool, attempt int, attemptErr error, willRetry bool) {
 	nmgr.handlers[onTipAttemptProgressNtfnType].(*handlersFor[OnTipAttemptProgressNtfn]).
 		visit(func(h OnTipAttemptProgressNtfn) { h(ru, amtMAtoms, completed, attempt, attemptErr, willRetry) })
@@ -950,6 +1111,16 @@ func (nmgr *NotificationManager) notifyFileDownloadProgress(user *RemoteUser, fm
 		visit(func(h OnFileDownloadProgress) { h(user, fm, nbMissingChunks) })
 }
 
+func (nmgr *NotificationManager) notifyFileChunkUploaded(user *RemoteUser, sf clientdb.SharedFile, chunkIdx int) {
+	nmgr.handlers[onFileChunkUploaded].(*handlersFor[OnFileChunkUploaded]).
+		visit(func(h OnFileChunkUploaded) { h(user, sf, chunkIdx) })
+}
+
+func (nmgr *NotificationManager) notifySpendingLimitAlert(alert SpendingLimitAlert) {
+	nmgr.handlers[onSpendingLimitAlertNtfnType].(*handlersFor[OnSpendingLimitAlertNtfn]).
+		visit(func(h OnSpendingLimitAlertNtfn) { h(alert) })
+}
+
 func (nmgr *NotificationManager) notifyRMReceived(ru *RemoteUser, rmh *rpc.RMHeader, p interface{}, ts time.Time) {
 	nmgr.handlers[onRMReceived].(*handlersFor[OnRMReceived]).
 		visit(func(h OnRMReceived) { h(ru, rmh, p, ts) })
@@ -1013,23 +1184,25 @@ func NewNotificationManager() *NotificationManager {
 			onTransitiveEventType:    &handlersFor[OnTransitiveEvent]{},
 			onUINtfnType:             &handlersFor[OnUINotification]{},
 
-			onPostSubscriberUpdated:    &handlersFor[OnPostSubscriberUpdated]{},
-			onPostsListReceived:        &handlersFor[OnPostsListReceived]{},
-			onGCVersionWarningType:     &handlersFor[OnGCVersionWarning]{},
-			onJoinedGCNtfnType:         &handlersFor[OnJoinedGCNtfn]{},
-			onAddedGCMembersNtfnType:   &handlersFor[OnAddedGCMembersNtfn]{},
-			onRemovedGCMembersNtfnType: &handlersFor[OnRemovedGCMembersNtfn]{},
-			onGCUpgradedNtfnType:       &handlersFor[OnGCUpgradedNtfn]{},
-			onInvitedToGCNtfnType:      &handlersFor[OnInvitedToGCNtfn]{},
-			onGCInviteAcceptedNtfnType: &handlersFor[OnGCInviteAcceptedNtfn]{},
-			onGCUserPartedNtfnType:     &handlersFor[OnGCUserPartedNtfn]{},
-			onGCKilledNtfnType:         &handlersFor[OnGCKilledNtfn]{},
-			onGCAdminsChangedNtfnType:  &handlersFor[OnGCAdminsChangedNtfn]{},
-			onContentListReceived:      &handlersFor[OnContentListReceived]{},
-			onFileDownloadCompleted:    &handlersFor[OnFileDownloadCompleted]{},
-			onFileDownloadProgress:     &handlersFor[OnFileDownloadProgress]{},
-			onServerUnwelcomeError:     &handlersFor[OnServerUnwelcomeError]{},
-			onRequestingMediateIDType:  &handlersFor[OnRequestingMediateID]{},
+			onPostSubscriberUpdated:      &handlersFor[OnPostSubscriberUpdated]{},
+			onPostsListReceived:          &handlersFor[OnPostsListReceived]{},
+			onGCVersionWarningType:       &handlersFor[OnGCVersionWarning]{},
+			onJoinedGCNtfnType:           &handlersFor[OnJoinedGCNtfn]{},
+			onAddedGCMembersNtfnType:     &handlersFor[OnAddedGCMembersNtfn]{},
+			onRemovedGCMembersNtfnType:   &handlersFor[OnRemovedGCMembersNtfn]{},
+			onGCUpgradedNtfnType:         &handlersFor[OnGCUpgradedNtfn]{},
+			onInvitedToGCNtfnType:        &handlersFor[OnInvitedToGCNtfn]{},
+			onGCInviteAcceptedNtfnType:   &handlersFor[OnGCInviteAcceptedNtfn]{},
+			onGCUserPartedNtfnType:       &handlersFor[OnGCUserPartedNtfn]{},
+			onGCKilledNtfnType:           &handlersFor[OnGCKilledNtfn]{},
+			onGCAdminsChangedNtfnType:    &handlersFor[OnGCAdminsChangedNtfn]{},
+			onContentListReceived:        &handlersFor[OnContentListReceived]{},
+			onFileDownloadCompleted:      &handlersFor[OnFileDownloadCompleted]{},
+			onFileDownloadProgress:       &handlersFor[OnFileDownloadProgress]{},
+			onFileChunkUploaded:          &handlersFor[OnFileChunkUploaded]{},
+			onSpendingLimitAlertNtfnType: &handlersFor[OnSpendingLimitAlertNtfn]{},
+			onServerUnwelcomeError:       &handlersFor[OnServerUnwelcomeError]{},
+			onRequestingMediateIDType:    &handlersFor[OnRequestingMediateID]{},
 
 			onKXSearchCompletedNtfnType:       &handlersFor[OnKXSearchCompleted]{},
 			onInvoiceGenFailedNtfnType:        &handlersFor[OnInvoiceGenFailedNtfn]{},
@@ -1044,6 +1217,16 @@ func NewNotificationManager() *NotificationManager {
 			onGCWithUnkxdMemberNtfnType:       &handlersFor[OnGCWithUnkxdMemberNtfn]{},
 			onMessageContentFilteredNtfType:   &handlersFor[OnMsgContentFilteredNtfn]{},
 			onUnsubscribingIdleRemoteClient:   &handlersFor[OnUnsubscribingIdleRemoteClient]{},
+			onGCHistoryReceivedNtfnType:       &handlersFor[OnGCHistoryReceivedNtfn]{},
+			onCallOfferedNtfnType:             &handlersFor[OnCallOfferedNtfn]{},
+			onCallAnsweredNtfnType:            &handlersFor[OnCallAnsweredNtfn]{},
+			onCallEndedNtfnType:               &handlersFor[OnCallEndedNtfn]{},
+			onContentSearchResultsNtfnType:    &handlersFor[OnContentSearchResultsNtfn]{},
+			onPaywallInvoiceNtfnType:          &handlersFor[OnPaywallInvoiceNtfn]{},
+			onPaywallAccessGrantedNtfnType:    &handlersFor[OnPaywallAccessGrantedNtfn]{},
+
+			onProductSubscriptionChangedNtfnType: &handlersFor[OnProductSubscriptionChangedNtfn]{},
+			onAddressBookChangedNtfnType:         &handlersFor[OnAddressBookChangedNtfn]{},
 		},
 	}
 	if !nmgr.uiTimer.Stop() {
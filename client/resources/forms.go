@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"strconv"
+
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// IsFormSubmission returns true if the request is a form submission (i.e. a
+// POST request).
+func IsFormSubmission(req *rpc.RMFetchResource) bool {
+	return req.RequestMethod() == rpc.ResourceMethodPost
+}
+
+// jsonFormValue converts a json form value to its string representations.
+func jsonFormValue(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case json.Number:
+		return []string{v.String()}, nil
+	case bool:
+		return []string{strconv.FormatBool(v)}, nil
+	case []interface{}:
+		var res []string
+		for _, e := range v {
+			ev, err := jsonFormValue(e)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, ev...)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported form value of type %T", v)
+	}
+}
+
+// ParseForm parses the fields submitted in the request. Both json objects (as
+// submitted by the UIs) and url encoded forms are supported. Json values are
+// converted to their string representations and json arrays to multiple
+// values of the same field.
+func ParseForm(req *rpc.RMFetchResource) (url.Values, error) {
+	body, err := req.Body()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return url.Values{}, nil
+	}
+
+	contentType, _, err := mime.ParseMediaType(req.RequestContentType())
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type: %v", err)
+	}
+
+	switch contentType {
+	case rpc.ResourceContentTypeForm:
+		return url.ParseQuery(string(body))
+
+	case rpc.ResourceContentTypeJSON:
+		var fields map[string]interface{}
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		if err := dec.Decode(&fields); err != nil {
+			return nil, fmt.Errorf("form data is not a json object: %v", err)
+		}
+		res := make(url.Values, len(fields))
+		for k, v := range fields {
+			vs, err := jsonFormValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %v", k, err)
+			}
+			if len(vs) > 0 {
+				res[k] = vs
+			}
+		}
+		return res, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported form content type %q", contentType)
+	}
+}
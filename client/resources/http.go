@@ -34,18 +34,37 @@ func httpHeadersToMeta(h http.Header) map[string]string {
 
 func (h *HttpProvider) Fulfill(ctx context.Context, uid clientintf.UserID, request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
 	path := strings.Join(append([]string{h.baseURL}, request.Path...), "/")
+	reqBody, err := request.Body()
+	if err != nil {
+		return &rpc.RMFetchResourceReply{
+			Tag:    request.Tag,
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte(err.Error()),
+		}, nil
+	}
+	method := request.RequestMethod()
+	if method != rpc.ResourceMethodGet && method != rpc.ResourceMethodPost {
+		return &rpc.RMFetchResourceReply{
+			Tag:    request.Tag,
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte("unsupported request method"),
+		}, nil
+	}
 	var body io.Reader
-	if request.Data != nil {
-		body = bytes.NewBuffer(request.Data)
+	if reqBody != nil {
+		body = bytes.NewReader(reqBody)
 	}
-	upReq, err := http.NewRequestWithContext(ctx, "GET", path, body)
+	upReq, err := http.NewRequestWithContext(ctx, method, path, body)
 	if err != nil {
 		return nil, err
 	}
 	for k, v := range request.Meta {
 		upReq.Header.Add(k, v)
 	}
-	upReq.Header.Add("X-BisonRelay-UID", uid.String())
+	if contentType := request.RequestContentType(); contentType != "" {
+		upReq.Header.Set("Content-Type", contentType)
+	}
+	upReq.Header.Set("X-BisonRelay-UID", uid.String())
 
 	upRes, err := h.c.Do(upReq)
 	if err != nil {
@@ -74,7 +93,12 @@ func (h *HttpProvider) Fulfill(ctx context.Context, uid clientintf.UserID, reque
 func NewHttpProvider(baseURL string) *HttpProvider {
 	hp := &HttpProvider{
 		baseURL: baseURL,
-		c:       &http.Client{},
+		c: &http.Client{
+			// Redirects are relayed to the remote client.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
 	}
 	return hp
 }
@@ -0,0 +1,193 @@
+// Package paywall implements a resources provider that requires remote users
+// to pay before accessing the resources of a wrapped provider.
+package paywall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/client/resources"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/decred/slog"
+)
+
+// Meta keys set in replies to requests from users without access.
+const (
+	MetaInvoice    = "paywall-invoice"
+	MetaName       = "paywall-name"
+	MetaMilliAtoms = "paywall-milliatoms"
+	MetaDuration   = "paywall-duration"
+)
+
+// Config holds the configuration for a paywall.
+type Config struct {
+	// Client is used to issue invoices and track access grants.
+	Client *client.Client
+
+	// Provider is the wrapped provider. It is called only for requests of
+	// users that have access to the paywall.
+	Provider resources.Provider
+
+	// Name identifies the paywall. Access is tracked per paywall name, so
+	// multiple paywalls with the same name share their grants.
+	Name string
+
+	// MilliAtoms is the cost of access.
+	MilliAtoms int64
+
+	// Duration is how long access lasts after each payment (i.e. the
+	// period of a subscription). If zero, a single payment grants
+	// permanent access.
+	Duration time.Duration
+
+	// SubscriptionProduct is the id of a recurring product sold by a
+	// local provider (e.g. the SKU of a simplestore subscription). If set,
+	// users also get access while their subscription to this product is
+	// active.
+	SubscriptionProduct string
+
+	Log slog.Logger
+}
+
+// Paywall is a resources provider that only fulfills requests of users that
+// paid for access. Other users are sent an invoice (as an RMInvoice) and
+// receive a reply with the ResourceStatusPaymentRequired status.
+type Paywall struct {
+	cfg Config
+	c   *client.Client
+	log slog.Logger
+}
+
+// New creates a new paywall.
+func New(cfg Config) (*Paywall, error) {
+	if cfg.Client == nil {
+		return nil, errors.New("client not specified")
+	}
+	if cfg.Provider == nil {
+		return nil, errors.New("wrapped provider not specified")
+	}
+	if cfg.Name == "" {
+		return nil, errors.New("paywall name not specified")
+	}
+	if cfg.MilliAtoms <= 0 {
+		return nil, fmt.Errorf("invalid paywall cost %d", cfg.MilliAtoms)
+	}
+	if cfg.Duration < 0 {
+		return nil, fmt.Errorf("invalid paywall duration %s", cfg.Duration)
+	}
+
+	log := slog.Disabled
+	if cfg.Log != nil {
+		log = cfg.Log
+	}
+	p := &Paywall{
+		cfg: cfg,
+		c:   cfg.Client,
+		log: log,
+	}
+	if cfg.SubscriptionProduct != "" {
+		nmgr := cfg.Client.NotificationManager()
+		nmgr.Register(client.OnProductSubscriptionChangedNtfn(p.subscriptionChanged))
+	}
+	return p, nil
+}
+
+// subscriptionChanged grants access to the paywall while the subscription of
+// an user to the configured product is active. Access is never shortened, so
+// that access paid for directly is kept. Access granted by the subscription
+// ends by itself when the subscription lapses.
+func (p *Paywall) subscriptionChanged(sub client.ProductSubscription) {
+	if sub.Product != p.cfg.SubscriptionProduct {
+		return
+	}
+
+	if !sub.Active {
+		p.log.Infof("Subscription of %s to paywall %q lapsed",
+			sub.UID, p.cfg.Name)
+		return
+	}
+	if err := p.c.ExtendPaywallAccess(p.cfg.Name, sub.UID, sub.Expires); err != nil {
+		p.log.Errorf("Unable to update access to paywall %q of %s: %v",
+			p.cfg.Name, sub.UID, err)
+		return
+	}
+	p.log.Infof("Extended access to paywall %q of %s until %s due to "+
+		"subscription", p.cfg.Name, sub.UID, sub.Expires.Format(time.RFC3339))
+}
+
+// HasAccess returns true if the user currently has access to the paywall.
+func (p *Paywall) HasAccess(uid clientintf.UserID) (bool, error) {
+	if uid == p.c.PublicID() {
+		// Local requests always have access.
+		return true, nil
+	}
+
+	pa, err := p.c.PaywallAccess(p.cfg.Name, uid)
+	if errors.Is(err, clientdb.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return pa.HasAccess(time.Now()), nil
+}
+
+// paymentRequiredPage returns the page sent to users without access.
+func (p *Paywall) paymentRequiredPage(invoice string) []byte {
+	var b strings.Builder
+	b.WriteString("# Payment Required\n\n")
+	cost := float64(p.cfg.MilliAtoms) / 1e11
+	if p.cfg.Duration > 0 {
+		fmt.Fprintf(&b, "Access to this page costs %.8f DCR for %s.\n\n",
+			cost, p.cfg.Duration)
+	} else {
+		fmt.Fprintf(&b, "Access to this page costs %.8f DCR.\n\n", cost)
+	}
+	b.WriteString("An invoice was sent to you. Reload this page after " +
+		"paying it.\n\n")
+	fmt.Fprintf(&b, "[Pay invoice](lnpay://%s)\n", invoice)
+	return []byte(b.String())
+}
+
+// Fulfill is part of the resources.Provider interface.
+func (p *Paywall) Fulfill(ctx context.Context, uid clientintf.UserID,
+	req *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	hasAccess, err := p.HasAccess(uid)
+	if err != nil {
+		return nil, err
+	}
+	if hasAccess {
+		return p.cfg.Provider.Fulfill(ctx, uid, req)
+	}
+
+	terms := client.PaywallTerms{
+		Name:       p.cfg.Name,
+		MilliAtoms: p.cfg.MilliAtoms,
+		Duration:   p.cfg.Duration,
+	}
+	invoice, err := p.c.RequestPaywallPayment(uid, terms)
+	if err != nil {
+		p.log.Warnf("Unable to request payment for paywall %q from %s: %v",
+			p.cfg.Name, uid, err)
+		return nil, err
+	}
+
+	return &rpc.RMFetchResourceReply{
+		Tag:    req.Tag,
+		Status: rpc.ResourceStatusPaymentRequired,
+		Meta: map[string]string{
+			MetaInvoice:    invoice,
+			MetaName:       p.cfg.Name,
+			MetaMilliAtoms: fmt.Sprintf("%d", p.cfg.MilliAtoms),
+			MetaDuration:   p.cfg.Duration.String(),
+		},
+		Data: p.paymentRequiredPage(invoice),
+	}, nil
+}
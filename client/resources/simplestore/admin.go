@@ -21,6 +21,9 @@ func (s *Store) handleAdminIndex(ctx context.Context, uid clientintf.UserID,
 	w := &bytes.Buffer{}
 	w.WriteString("# Admin Section\n\n")
 	w.WriteString("[Recent Orders](/admin/orders)\n\n")
+	w.WriteString("[Inventory](/admin/inventory)\n\n")
+	w.WriteString("[Subscriptions](/admin/subscriptions)\n\n")
+	w.WriteString("[Stats](/admin/stats)\n\n")
 	w.WriteString("[Back to Index](/)\n\n")
 	return &rpc.RMFetchResourceReply{
 		Data:   w.Bytes(),
@@ -80,6 +83,58 @@ func (s *Store) handleAdminOrders(ctx context.Context, uid clientintf.UserID,
 	}, nil
 }
 
+func (s *Store) handleAdminInventory(ctx context.Context, uid clientintf.UserID,
+	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	skus := make([]string, 0, len(s.products))
+	for sku := range s.products {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	w := &bytes.Buffer{}
+	w.WriteString("# Inventory\n\n")
+	for _, sku := range skus {
+		prod := s.products[sku]
+		avail, err := s.availableStock(prod, nil)
+		if err != nil {
+			return nil, err
+		}
+		reserved := s.reservedQty(func(item *CartItem) bool {
+			return item.Product.SKU == sku
+		})
+		stock := "unlimited"
+		if avail >= 0 {
+			stock = fmt.Sprintf("%d available", avail)
+		}
+		fmt.Fprintf(w, "  - %s - %s - %s - %d reserved\n", sku,
+			prod.Title, stock, reserved)
+	}
+
+	// Variants with their own stock.
+	keys := make([]string, 0, len(s.inventory))
+	for key := range s.inventory {
+		if _, ok := s.products[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		w.WriteString("\n## Variants\n\n")
+		for _, key := range keys {
+			fmt.Fprintf(w, "  - %s - %d in stock\n", key, s.inventory[key])
+		}
+	}
+
+	w.WriteString("\n[Back to Admin](/admin)\n")
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
+
 func (s *Store) handleAdminViewOrder(ctx context.Context, _ clientintf.UserID,
 	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
 	s.mtx.Lock()
@@ -0,0 +1,529 @@
+package simplestore
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/jsonfile"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+const (
+	// defaultStatsDays is the number of days included in the admin stats
+	// page and exports when not specified.
+	defaultStatsDays = 30
+
+	// statsDayLayout is the format of days in stats and exports.
+	statsDayLayout = "2006-01-02"
+)
+
+// IsPaid returns true if the order has been paid.
+func (order *Order) IsPaid() bool {
+	switch order.Status {
+	case StatusPaid, StatusShipped, StatusCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPending returns true if the order is waiting for payment at the given
+// time.
+func (order *Order) IsPending(now time.Time) bool {
+	if order.Status != StatusPlaced {
+		return false
+	}
+	return order.Invoice == "" || now.Before(order.ExpiresTS)
+}
+
+// IsExpired returns true if the order was canceled or its invoice expired
+// before being paid.
+func (order *Order) IsExpired(now time.Time) bool {
+	if order.Status == StatusCanceled {
+		return true
+	}
+	return order.Status == StatusPlaced && order.Invoice != "" &&
+		!now.Before(order.ExpiresTS)
+}
+
+// PaidTS returns the time when the order was paid (or placed, for orders
+// paid before their payment time was tracked).
+func (order *Order) PaidTS() time.Time {
+	if order.ResolvedTS != nil {
+		return *order.ResolvedTS
+	}
+	return order.PlacedTS
+}
+
+// DailyRevenue is the revenue of the store on one day.
+type DailyRevenue struct {
+	Day          string `json:"day"`
+	Orders       int    `json:"orders"`
+	RevenueCents int64  `json:"revenue_cents"`
+}
+
+// ProductSales are the sales of one product.
+type ProductSales struct {
+	SKU      string `json:"sku"`
+	Title    string `json:"title"`
+	Quantity uint64 `json:"quantity"`
+
+	// RevenueCents is the revenue of the product, before coupon
+	// discounts.
+	RevenueCents int64 `json:"revenue_cents"`
+}
+
+// Stats are the statistics of the store in a time range.
+type Stats struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	// Orders is the number of orders placed in the range.
+	Orders int `json:"orders"`
+
+	// PaidOrders, PendingOrders and ExpiredOrders are the number of
+	// orders placed in the range that were paid, that are waiting for
+	// payment and that were canceled or whose invoice expired.
+	PaidOrders    int `json:"paid_orders"`
+	PendingOrders int `json:"pending_orders"`
+	ExpiredOrders int `json:"expired_orders"`
+
+	// RenewalOrders is the number of orders created to renew
+	// subscriptions.
+	RenewalOrders int `json:"renewal_orders"`
+
+	// OpenCarts is the number of carts updated in the range that were not
+	// yet checked out.
+	OpenCarts int `json:"open_carts"`
+
+	// Conversion is the ratio of carts that were checked out and paid to
+	// all carts (checked out or not). Renewal orders are not included.
+	Conversion float64 `json:"conversion"`
+
+	// RevenueCents is the total amount of the orders placed in the range
+	// that were paid, including shipping charges. DiscountCents and
+	// ShippingCents are the coupon discounts and shipping charges of these
+	// orders.
+	RevenueCents  int64 `json:"revenue_cents"`
+	DiscountCents int64 `json:"discount_cents"`
+	ShippingCents int64 `json:"shipping_cents"`
+
+	// ByDay is the revenue of the paid orders, grouped by the day (in
+	// UTC) they were placed.
+	ByDay     []DailyRevenue `json:"by_day"`
+	ByProduct []ProductSales `json:"by_product"`
+}
+
+// listOrders lists the orders placed in the [from, to) range, sorted by
+// placement time. Must be called with the mutex held.
+func (s *Store) listOrders(from, to time.Time) ([]*Order, error) {
+	pattern := filepath.Join(s.root, ordersDir, "*", "*.json")
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*Order, 0, len(files))
+	for _, f := range files {
+		order := new(Order)
+		if err := jsonfile.Read(f, order); err != nil {
+			s.log.Warnf("Unable to decode order file %s: %v", f, err)
+			continue
+		}
+		if order.PlacedTS.Before(from) || !order.PlacedTS.Before(to) {
+			continue
+		}
+		orders = append(orders, order)
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].PlacedTS.Before(orders[j].PlacedTS)
+	})
+	return orders, nil
+}
+
+// countOpenCarts returns the number of non-empty carts updated in the
+// [from, to) range. Must be called with the mutex held.
+func (s *Store) countOpenCarts(from, to time.Time) (int, error) {
+	files, err := filepath.Glob(filepath.Join(s.root, cartsDir, "*"))
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, f := range files {
+		var cart Cart
+		if err := jsonfile.Read(f, &cart); err != nil {
+			s.log.Warnf("Unable to decode cart file %s: %v", f, err)
+			continue
+		}
+		if len(cart.Items) == 0 || cart.Updated.Before(from) ||
+			!cart.Updated.Before(to) {
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Orders returns the orders placed in the [from, to) range, sorted by
+// placement time.
+func (s *Store) Orders(from, to time.Time) ([]*Order, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.listOrders(from, to)
+}
+
+// Stats returns the statistics of the orders placed in the [from, to) range.
+func (s *Store) Stats(from, to time.Time) (*Stats, error) {
+	s.mtx.Lock()
+	orders, err := s.listOrders(from, to)
+	var openCarts int
+	if err == nil {
+		openCarts, err = s.countOpenCarts(from, to)
+	}
+	s.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	stats := &Stats{
+		From:      from,
+		To:        to,
+		Orders:    len(orders),
+		OpenCarts: openCarts,
+	}
+	days := make(map[string]*DailyRevenue)
+	products := make(map[string]*ProductSales)
+	var checkedOut, checkedOutPaid int
+	for _, order := range orders {
+		if order.Renewal {
+			stats.RenewalOrders++
+		} else {
+			checkedOut++
+		}
+
+		switch {
+		case order.IsPending(now):
+			stats.PendingOrders++
+		case order.IsExpired(now):
+			stats.ExpiredOrders++
+		}
+		if !order.IsPaid() {
+			continue
+		}
+
+		stats.PaidOrders++
+		if !order.Renewal {
+			checkedOutPaid++
+		}
+		total := order.TotalCents()
+		stats.RevenueCents += total
+		stats.DiscountCents += order.Cart.DiscountCents()
+		if order.ShipCharge > 0 {
+			stats.ShippingCents += int64(order.ShipCharge * 100)
+		}
+
+		day := order.PlacedTS.UTC().Format(statsDayLayout)
+		dr := days[day]
+		if dr == nil {
+			dr = &DailyRevenue{Day: day}
+			days[day] = dr
+		}
+		dr.Orders++
+		dr.RevenueCents += total
+
+		for _, item := range order.Cart.Items {
+			ps := products[item.Product.SKU]
+			if ps == nil {
+				ps = &ProductSales{
+					SKU:   item.Product.SKU,
+					Title: item.Product.Title,
+				}
+				products[item.Product.SKU] = ps
+			}
+			ps.Quantity += uint64(item.Quantity)
+			ps.RevenueCents += item.TotalCents()
+		}
+	}
+
+	if carts := checkedOut + openCarts; carts > 0 {
+		stats.Conversion = float64(checkedOutPaid) / float64(carts)
+	}
+
+	stats.ByDay = make([]DailyRevenue, 0, len(days))
+	for _, dr := range days {
+		stats.ByDay = append(stats.ByDay, *dr)
+	}
+	sort.Slice(stats.ByDay, func(i, j int) bool {
+		return stats.ByDay[i].Day < stats.ByDay[j].Day
+	})
+
+	stats.ByProduct = make([]ProductSales, 0, len(products))
+	for _, ps := range products {
+		stats.ByProduct = append(stats.ByProduct, *ps)
+	}
+	sort.Slice(stats.ByProduct, func(i, j int) bool {
+		pi, pj := &stats.ByProduct[i], &stats.ByProduct[j]
+		if pi.RevenueCents != pj.RevenueCents {
+			return pi.RevenueCents > pj.RevenueCents
+		}
+		return pi.SKU < pj.SKU
+	})
+
+	return stats, nil
+}
+
+// ordersCSVHeader is the header of orders exported as CSV. Each row is one
+// item of an order.
+var ordersCSVHeader = []string{"order", "user", "status", "placed",
+	"paid", "sku", "title", "options", "quantity", "unit_price", "item_total",
+	"coupon", "discount", "shipping", "order_total", "exchange_rate",
+	"pay_type", "ship_name", "ship_address1", "ship_address2", "ship_city",
+	"ship_state", "ship_postal_code", "ship_country", "ship_phone"}
+
+// csvText escapes a free-text CSV cell that could be interpreted as a formula
+// by spreadsheet applications, by prefixing it with a single quote.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// WriteOrdersCSV writes the orders as CSV, with one row per order item. Times
+// are written in UTC and free-text cells that could be interpreted as formulas
+// are escaped.
+func WriteOrdersCSV(w io.Writer, orders []*Order) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ordersCSVHeader); err != nil {
+		return err
+	}
+
+	cents := func(v int64) string {
+		return fmt.Sprintf("%.2f", float64(v)/100)
+	}
+	for _, order := range orders {
+		var paid, coupon string
+		if order.IsPaid() {
+			paid = order.PaidTS().UTC().Format(time.RFC3339)
+		}
+		if order.Cart.Coupon != nil {
+			coupon = order.Cart.Coupon.Code
+		}
+		var shipping int64
+		if order.ShipCharge > 0 {
+			shipping = int64(order.ShipCharge * 100)
+		}
+		addr := order.ShipAddr
+		if addr == nil {
+			addr = &ShippingAddress{}
+		}
+
+		for _, item := range order.Cart.Items {
+			row := []string{
+				fmt.Sprintf("%s/%s", order.User, order.ID),
+				order.User.String(),
+				string(order.Status),
+				order.PlacedTS.UTC().Format(time.RFC3339),
+				paid,
+				csvText(item.Product.SKU),
+				csvText(item.Product.Title),
+				csvText(item.OptionsString()),
+				strconv.FormatUint(uint64(item.Quantity), 10),
+				cents(item.UnitPriceCents()),
+				cents(item.TotalCents()),
+				csvText(coupon),
+				cents(order.Cart.DiscountCents()),
+				cents(shipping),
+				cents(order.TotalCents()),
+				strconv.FormatFloat(order.ExchangeRate, 'f', 2, 64),
+				string(order.PayType),
+				csvText(addr.Name),
+				csvText(addr.Address1),
+				csvText(addr.Address2),
+				csvText(addr.City),
+				csvText(addr.State),
+				csvText(addr.PostalCode),
+				csvText(addr.CountryCode),
+				csvText(addr.Phone),
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteOrdersJSON writes the orders as an indented JSON array.
+func WriteOrdersJSON(w io.Writer, orders []*Order) error {
+	if orders == nil {
+		orders = []*Order{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(orders)
+}
+
+// ExportOrders writes the orders placed in the [from, to) range to the given
+// file, as CSV or as JSON (depending on whether the file name ends in
+// ".json").
+func (s *Store) ExportOrders(fname string, from, to time.Time) error {
+	orders, err := s.Orders(from, to)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(filepath.Ext(fname), ".json") {
+		err = WriteOrdersJSON(f, orders)
+	} else {
+		err = WriteOrdersCSV(f, orders)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// statsRange returns the number of days specified in the path element at
+// index i (or the default number of days) and the range that covers them,
+// ending now.
+func statsRange(path []string, i int) (int, time.Time, time.Time, error) {
+	days := defaultStatsDays
+	if len(path) > i {
+		var err error
+		days, err = strconv.Atoi(path[i])
+		if err != nil || days <= 0 {
+			return 0, time.Time{}, time.Time{}, errors.New("invalid number of days")
+		}
+	}
+	from, to := LastDays(days)
+	return days, from, to, nil
+}
+
+// LastDays returns the range that covers the last number of UTC days
+// (including today), for use with Stats and Orders.
+func LastDays(days int) (time.Time, time.Time) {
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0,
+		time.UTC).AddDate(0, 0, 1-days)
+	return from, now.Add(time.Second)
+}
+
+func (s *Store) handleAdminStats(ctx context.Context, uid clientintf.UserID,
+	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	days, from, to, err := statsRange(request.Path, 2)
+	if err != nil {
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte(err.Error()),
+		}, nil
+	}
+	stats, err := s.Stats(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	usd := func(v int64) string {
+		return fmt.Sprintf("$%.2f", float64(v)/100)
+	}
+	w := &bytes.Buffer{}
+	w.WriteString("# Store Stats\n\n")
+	fmt.Fprintf(w, "Since %s UTC ([7 days](/admin/stats/7), "+
+		"[30 days](/admin/stats/30), [365 days](/admin/stats/365))\n\n",
+		from.Format(statsDayLayout))
+	fmt.Fprintf(w, "  - Revenue: %s (discounts: %s, shipping: %s)\n",
+		usd(stats.RevenueCents), usd(stats.DiscountCents),
+		usd(stats.ShippingCents))
+	fmt.Fprintf(w, "  - Orders: %d (%d paid, %d pending, %d expired, "+
+		"%d renewals)\n", stats.Orders, stats.PaidOrders,
+		stats.PendingOrders, stats.ExpiredOrders, stats.RenewalOrders)
+	fmt.Fprintf(w, "  - Open carts: %d\n", stats.OpenCarts)
+	fmt.Fprintf(w, "  - Cart to paid conversion: %.1f%%\n",
+		stats.Conversion*100)
+
+	w.WriteString("\n## Revenue by Day\n\n")
+	if len(stats.ByDay) == 0 {
+		w.WriteString("No paid orders.\n")
+	}
+	for _, dr := range stats.ByDay {
+		fmt.Fprintf(w, "  - %s - %d orders - %s\n", dr.Day, dr.Orders,
+			usd(dr.RevenueCents))
+	}
+
+	w.WriteString("\n## Revenue by Product\n\n")
+	if len(stats.ByProduct) == 0 {
+		w.WriteString("No products sold.\n")
+	}
+	for _, ps := range stats.ByProduct {
+		fmt.Fprintf(w, "  - %s - %s - %d units - %s\n", ps.SKU, ps.Title,
+			ps.Quantity, usd(ps.RevenueCents))
+	}
+
+	fmt.Fprintf(w, "\nExport orders: [CSV](/admin/export/csv/%d), "+
+		"[JSON](/admin/export/json/%d)\n", days, days)
+	w.WriteString("\n[Back to Admin](/admin)\n")
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
+
+func (s *Store) handleAdminExport(ctx context.Context, uid clientintf.UserID,
+	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	if len(request.Path) < 3 {
+		return nil, fmt.Errorf("path has < 3 elements")
+	}
+	_, from, to, err := statsRange(request.Path, 3)
+	if err != nil {
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte(err.Error()),
+		}, nil
+	}
+	orders, err := s.Orders(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &bytes.Buffer{}
+	switch request.Path[2] {
+	case "csv":
+		err = WriteOrdersCSV(w, orders)
+	case "json":
+		err = WriteOrdersJSON(w, orders)
+	default:
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte("unknown export format"),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
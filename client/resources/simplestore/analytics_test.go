@@ -0,0 +1,124 @@
+package simplestore
+
+import (
+	"bytes"
+	"encoding/csv"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/jsonfile"
+)
+
+// setTestOrderPlaced changes the placement time of an order stored on disk.
+func setTestOrderPlaced(t testing.TB, s *Store, order *Order, placed time.Time) {
+	t.Helper()
+	order.PlacedTS = placed
+	fname := filepath.Join(s.root, ordersDir, order.User.String(),
+		orderFnamePattern.FilenameFor(uint64(order.ID)))
+	assert.NilErr(t, jsonfile.Write(fname, order, s.log))
+}
+
+// TestStats tests the statistics of orders in a range.
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	s := newTestStore(t, Config{})
+	alice, bob := clientintf.UserID{0: 1}, clientintf.UserID{0: 2}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2)
+
+	// Paid on the first day. The order is placed on the second day in the
+	// local time of the store, but days are grouped in UTC.
+	tz := time.FixedZone("UTC+10", 10*60*60)
+	order := addTestOrder(t, s, alice, testCart(t, s, "mug", 2, nil))
+	setTestOrderPlaced(t, s, order, from.Add(20*time.Hour).In(tz))
+	payTestOrder(t, s, order)
+
+	// Paid on the second day.
+	order = addTestOrder(t, s, bob, testCart(t, s, "shirt", 1, nil))
+	setTestOrderPlaced(t, s, order, from.Add(34*time.Hour))
+	payTestOrder(t, s, order)
+
+	// Pending, expired and outside the range.
+	order = addTestOrder(t, s, alice, testCart(t, s, "mug", 1, nil))
+	setTestOrderPlaced(t, s, order, from.Add(36*time.Hour))
+	order = addTestOrder(t, s, bob, testCart(t, s, "mug", 1, nil))
+	order.ExpiresTS = from
+	setTestOrderPlaced(t, s, order, from.Add(time.Hour))
+	order = addTestOrder(t, s, bob, testCart(t, s, "mug", 1, nil))
+	setTestOrderPlaced(t, s, order, to)
+
+	stats, err := s.Stats(from, to)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, stats.Orders, 4)
+	assert.DeepEqual(t, stats.PaidOrders, 2)
+	assert.DeepEqual(t, stats.PendingOrders, 1)
+	assert.DeepEqual(t, stats.ExpiredOrders, 1)
+	assert.DeepEqual(t, stats.RevenueCents, int64(2000))
+	assert.DeepEqual(t, stats.Conversion, 0.5)
+	assert.DeepEqual(t, stats.ByDay, []DailyRevenue{
+		{Day: "2026-01-01", Orders: 1, RevenueCents: 1000},
+		{Day: "2026-01-02", Orders: 1, RevenueCents: 1000},
+	})
+	assert.DeepEqual(t, stats.ByProduct, []ProductSales{
+		{SKU: "mug", Title: "Mug", Quantity: 2, RevenueCents: 1000},
+		{SKU: "shirt", Title: "Shirt", Quantity: 1, RevenueCents: 1000},
+	})
+}
+
+// TestLastDays tests that the stats range starts at the start of a UTC day.
+func TestLastDays(t *testing.T) {
+	t.Parallel()
+
+	from, to := LastDays(7)
+	assert.DeepEqual(t, from.Location(), time.UTC)
+	assert.DeepEqual(t, from, from.Truncate(24*time.Hour))
+	if d := to.Sub(from); d <= 6*24*time.Hour || d > 7*24*time.Hour+time.Second {
+		t.Fatalf("unexpected range duration %s", d)
+	}
+}
+
+// TestWriteOrdersCSV tests that orders are exported with times in UTC and with
+// free-text cells escaped.
+func TestWriteOrdersCSV(t *testing.T) {
+	t.Parallel()
+
+	tz := time.FixedZone("UTC-3", -3*60*60)
+	order := &Order{
+		User:     clientintf.UserID{0: 1},
+		ID:       1,
+		Status:   StatusPlaced,
+		PlacedTS: time.Date(2026, 1, 1, 22, 0, 0, 0, tz),
+		Cart: Cart{Items: []*CartItem{{
+			Product: &Product{SKU: "-sku", Title: "=HYPERLINK(\"x\")",
+				Price: 1},
+			Quantity: 1,
+		}}},
+		ShipAddr: &ShippingAddress{
+			Name:     "@SUM(A1)",
+			Address1: "+1 Main St",
+			City:     "Safe City",
+		},
+	}
+
+	var b bytes.Buffer
+	assert.NilErr(t, WriteOrdersCSV(&b, []*Order{order}))
+	rows, err := csv.NewReader(&b).ReadAll()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(rows), 2)
+	row := make(map[string]string, len(ordersCSVHeader))
+	for i, col := range ordersCSVHeader {
+		row[col] = rows[1][i]
+	}
+
+	assert.DeepEqual(t, row["placed"], "2026-01-02T01:00:00Z")
+	assert.DeepEqual(t, row["sku"], "'-sku")
+	assert.DeepEqual(t, row["title"], "'=HYPERLINK(\"x\")")
+	assert.DeepEqual(t, row["ship_name"], "'@SUM(A1)")
+	assert.DeepEqual(t, row["ship_address1"], "'+1 Main St")
+	assert.DeepEqual(t, row["ship_city"], "Safe City")
+	assert.DeepEqual(t, row["unit_price"], "1.00")
+}
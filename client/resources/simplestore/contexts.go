@@ -11,6 +11,13 @@ type indexContext struct {
 	IsAdmin  bool
 }
 
+type productContext struct {
+	*Product
+
+	// Stock is the number of units available, or -1 if unlimited.
+	Stock int64
+}
+
 type addToCartContext struct {
 	Product *Product
 	Cart    *Cart
@@ -0,0 +1,198 @@
+package simplestore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/internal/jsonfile"
+	"github.com/companyzero/bisonrelay/internal/strescape"
+	"github.com/pelletier/go-toml"
+	"golang.org/x/exp/slices"
+)
+
+// Coupon is a discount code that may be applied to carts.
+type Coupon struct {
+	Code string `json:"code"`
+
+	// Percent is the percentage of the amount of the eligible items that
+	// is discounted.
+	Percent float64 `json:"percent,omitempty"`
+
+	// Amount is a fixed discount (in USD), capped at the amount of the
+	// eligible items.
+	Amount float64 `json:"amount,omitempty"`
+
+	// MinTotal is the minimum amount of the eligible items for the coupon
+	// to be applied.
+	MinTotal float64 `json:"min_total,omitempty"`
+
+	// MaxUses is the maximum number of paid orders (plus orders waiting
+	// for payment) that may use the coupon. Zero means unlimited uses.
+	MaxUses uint32 `json:"max_uses,omitempty"`
+
+	Expires  time.Time `json:"expires,omitempty"`
+	Disabled bool      `json:"disabled,omitempty"`
+
+	// SKUs restricts the coupon to the listed products. If empty, the
+	// coupon applies to every product.
+	SKUs []string `json:"skus,omitempty"`
+}
+
+// appliesTo returns true if the coupon applies to the given item.
+func (cp *Coupon) appliesTo(item *CartItem) bool {
+	return len(cp.SKUs) == 0 || slices.Contains(cp.SKUs, item.Product.SKU)
+}
+
+// eligibleCents returns the amount of the items of the cart the coupon applies
+// to.
+func (cp *Coupon) eligibleCents(cart *Cart) int64 {
+	var total int64
+	for _, item := range cart.Items {
+		if cp.appliesTo(item) {
+			total += item.TotalCents()
+		}
+	}
+	return total
+}
+
+// discountCents returns the discount of the coupon when applied to the cart.
+func (cp *Coupon) discountCents(cart *Cart) int64 {
+	eligible := cp.eligibleCents(cart)
+	var discount int64
+	if cp.Percent > 0 {
+		discount += int64(float64(eligible) * cp.Percent / 100)
+	}
+	if cp.Amount > 0 {
+		discount += int64(cp.Amount * 100)
+	}
+	return min(discount, eligible)
+}
+
+// check returns an error if the coupon cannot be applied to the cart.
+func (cp *Coupon) check(cart *Cart, uses uint32, now time.Time) error {
+	switch {
+	case cp.Disabled:
+		return errors.New("coupon is disabled")
+	case !cp.Expires.IsZero() && now.After(cp.Expires):
+		return errors.New("coupon has expired")
+	case cp.MaxUses > 0 && uses >= cp.MaxUses:
+		return errors.New("coupon has been fully redeemed")
+	}
+
+	eligible := cp.eligibleCents(cart)
+	if eligible == 0 {
+		return errors.New("coupon does not apply to any item in the cart")
+	}
+	if eligible < int64(cp.MinTotal*100) {
+		return fmt.Errorf("coupon requires a minimum purchase of $%.2f",
+			cp.MinTotal)
+	}
+	return nil
+}
+
+type couponsFile struct {
+	Coupons []*Coupon
+}
+
+// couponKey normalizes a coupon code.
+func couponKey(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// loadCoupons loads the coupons defined in the coupons file of the store.
+func loadCoupons(root string) (map[string]*Coupon, error) {
+	fname := filepath.Join(root, couponsFname)
+	f, err := os.Open(fname)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to load coupons file: %v", err)
+	}
+	defer f.Close()
+
+	var cf couponsFile
+	if err := toml.NewDecoder(f).Decode(&cf); err != nil {
+		return nil, fmt.Errorf("unable to decode coupons file: %v", err)
+	}
+
+	coupons := make(map[string]*Coupon, len(cf.Coupons))
+	for _, cp := range cf.Coupons {
+		key := couponKey(cp.Code)
+		if key == "" {
+			return nil, errors.New("coupon with empty code")
+		}
+		if _, ok := coupons[key]; ok {
+			return nil, fmt.Errorf("duplicated coupon code %s", cp.Code)
+		}
+		if cp.Percent < 0 || cp.Percent > 100 || cp.Amount < 0 {
+			return nil, fmt.Errorf("coupon %s has invalid discount",
+				cp.Code)
+		}
+		cp.Code = key
+		coupons[key] = cp
+	}
+	return coupons, nil
+}
+
+// couponUsesFname is the name of the file that tracks how many paid orders
+// used the coupon.
+func (s *Store) couponUsesFname(code string) string {
+	return filepath.Join(s.root, couponUsesDir, strescape.PathElement(couponKey(code)))
+}
+
+// couponUses returns how many paid orders used the coupon. Must be called with
+// the mutex held.
+func (s *Store) couponUses(code string) (uint32, error) {
+	var uses uint32
+	err := jsonfile.Read(s.couponUsesFname(code), &uses)
+	if errors.Is(err, jsonfile.ErrNotFound) {
+		err = nil
+	}
+	return uses, err
+}
+
+// pendingCouponUses returns how many orders waiting for payment use the
+// coupon. Must be called with the mutex held.
+func (s *Store) pendingCouponUses(code string) uint32 {
+	var uses uint32
+	for _, order := range s.pending {
+		cp := order.Cart.Coupon
+		if cp != nil && couponKey(cp.Code) == couponKey(code) {
+			uses += 1
+		}
+	}
+	return uses
+}
+
+// findCoupon returns the coupon with the given code if it can be applied to
+// the cart. Orders waiting for payment count as uses of the coupon. Must be
+// called with the mutex held.
+func (s *Store) findCoupon(code string, cart *Cart) (*Coupon, error) {
+	cp := s.coupons[couponKey(code)]
+	if cp == nil {
+		return nil, errors.New("coupon does not exist")
+	}
+	uses, err := s.couponUses(cp.Code)
+	if err != nil {
+		return nil, err
+	}
+	uses += s.pendingCouponUses(cp.Code)
+	if err := cp.check(cart, uses, time.Now()); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// redeemCoupon records that a paid order used the coupon. Must be called with
+// the mutex held.
+func (s *Store) redeemCoupon(code string) error {
+	uses, err := s.couponUses(code)
+	if err != nil {
+		return err
+	}
+	return jsonfile.Write(s.couponUsesFname(code), uses+1, s.log)
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/client/resources"
 	"github.com/companyzero/bisonrelay/internal/jsonfile"
 	"github.com/companyzero/bisonrelay/internal/strescape"
 	"github.com/companyzero/bisonrelay/rpc"
@@ -54,14 +55,26 @@ func (s *Store) handleProduct(ctx context.Context, uid clientintf.UserID,
 
 	s.mtx.Lock()
 	prod := s.products[request.Path[1]]
+	var stock int64
+	var err error
+	if prod != nil {
+		stock, err = s.availableStock(prod, nil)
+	}
 	s.mtx.Unlock()
 
 	if prod == nil {
 		return s.handleNotFound(ctx, uid, request)
 	}
+	if err != nil {
+		return nil, err
+	}
 
+	tmplCtx := &productContext{
+		Product: prod,
+		Stock:   stock,
+	}
 	w := &bytes.Buffer{}
-	err := s.tmpl.ExecuteTemplate(w, prodTmplFile, prod)
+	err = s.tmpl.ExecuteTemplate(w, prodTmplFile, tmplCtx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to execute product template: %v", err)
 	}
@@ -82,15 +95,18 @@ func (s *Store) handleAddToCart(ctx context.Context, uid clientintf.UserID,
 		}, nil
 	}
 
-	formData := struct {
-		SKU string `json:"sku"`
-		Qty uint32 `json:"qty"`
-	}{}
-
-	if err := json.Unmarshal(request.Data, &formData); err != nil {
+	form, err := resources.ParseForm(request)
+	if err != nil {
 		return &rpc.RMFetchResourceReply{
 			Status: rpc.ResourceStatusBadRequest,
-			Data:   []byte("request data not valid json"),
+			Data:   []byte("request data not valid form"),
+		}, nil
+	}
+	qty, err := strconv.ParseUint(form.Get("qty"), 10, 32)
+	if err != nil {
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte("invalid quantity"),
 		}, nil
 	}
 	fname := filepath.Join(s.root, cartsDir, uid.String())
@@ -99,32 +115,57 @@ func (s *Store) handleAddToCart(ctx context.Context, uid clientintf.UserID,
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
-	prod, ok := s.products[formData.SKU]
+	prod, ok := s.products[form.Get("sku")]
 	if !ok {
 		return nil, fmt.Errorf("product does not exist")
 	}
 
-	err := jsonfile.Read(fname, &cart)
+	// Selected variant options.
+	var options map[string]string
+	if len(prod.Options) > 0 {
+		options = make(map[string]string, len(prod.Options))
+		for _, opt := range prod.Options {
+			options[opt.Name] = strings.TrimSpace(form.Get(opt.Name))
+		}
+		if err := prod.checkOptions(options); err != nil {
+			return &rpc.RMFetchResourceReply{
+				Status: rpc.ResourceStatusBadRequest,
+				Data:   []byte(err.Error()),
+			}, nil
+		}
+	}
+
+	err = jsonfile.Read(fname, &cart)
 	if err != nil && !errors.Is(err, jsonfile.ErrNotFound) {
 		return nil, err
 	}
 
-	hasItem := false
+	key := variantKey(prod.SKU, options)
+	var cartItem *CartItem
 	for _, item := range cart.Items {
-		if item.Product.SKU == prod.SKU {
-			item.Quantity += formData.Qty
-			hasItem = true
+		if item.VariantKey() == key {
+			cartItem = item
 			break
 		}
 	}
 
-	if !hasItem {
-		newItem := &CartItem{
-			Product:  prod,
-			Quantity: formData.Qty,
+	if cartItem == nil {
+		cartItem = &CartItem{
+			Product: prod,
+			Options: options,
 		}
-		cart.Items = append(cart.Items, newItem)
+		cart.Items = append(cart.Items, cartItem)
+	}
+
+	// Ensure there is enough stock for the new quantity.
+	newQty := cartItem.Quantity + uint32(qty)
+	if err := s.checkStock(prod, options, newQty); err != nil {
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte(err.Error()),
+		}, nil
 	}
+	cartItem.Quantity = newQty
 	cart.Updated = time.Now()
 
 	err = jsonfile.Write(fname, &cart, s.log)
@@ -195,6 +236,88 @@ func (s *Store) handleCart(ctx context.Context, uid clientintf.UserID,
 	}, nil
 }
 
+func (s *Store) handleApplyCoupon(ctx context.Context, uid clientintf.UserID,
+	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	form, err := resources.ParseForm(request)
+	if err != nil {
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte("request data not valid form"),
+		}, nil
+	}
+
+	fname := filepath.Join(s.root, cartsDir, uid.String())
+	var cart Cart
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	err = jsonfile.Read(fname, &cart)
+	if err != nil && !errors.Is(err, jsonfile.ErrNotFound) {
+		return nil, err
+	}
+
+	cp, err := s.findCoupon(form.Get("code"), &cart)
+	if err != nil {
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte(err.Error()),
+		}, nil
+	}
+	cart.Coupon = cp
+	cart.Updated = time.Now()
+
+	if err := jsonfile.Write(fname, &cart, s.log); err != nil {
+		return nil, err
+	}
+
+	w := &bytes.Buffer{}
+	err = s.tmpl.ExecuteTemplate(w, cartTmplFile, &cart)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute cart template: %v", err)
+	}
+
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
+
+func (s *Store) handleRemoveCoupon(ctx context.Context, uid clientintf.UserID,
+	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	fname := filepath.Join(s.root, cartsDir, uid.String())
+	var cart Cart
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	err := jsonfile.Read(fname, &cart)
+	if err != nil && !errors.Is(err, jsonfile.ErrNotFound) {
+		return nil, err
+	}
+
+	if cart.Coupon != nil {
+		cart.Coupon = nil
+		cart.Updated = time.Now()
+		if err := jsonfile.Write(fname, &cart, s.log); err != nil {
+			return nil, err
+		}
+	}
+
+	w := &bytes.Buffer{}
+	err = s.tmpl.ExecuteTemplate(w, cartTmplFile, &cart)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute cart template: %v", err)
+	}
+
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
+
 func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
 
@@ -238,6 +361,12 @@ func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 				Data:   []byte(fmt.Sprintf("SKU %q does not exist", item.Product.SKU)),
 			}, nil
 		}
+		if err := prod.checkOptions(item.Options); err != nil {
+			return &rpc.RMFetchResourceReply{
+				Status: rpc.ResourceStatusBadRequest,
+				Data:   []byte(fmt.Sprintf("SKU %q: %v", prod.SKU, err)),
+			}, nil
+		}
 		// If a product requires shipping, ensure a shipping address
 		// was sent.
 		if shipAddr == nil && prod.Shipping {
@@ -264,8 +393,56 @@ func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 			shipAddr = &formData
 		}
 	}
+	if err := s.checkCartStock(&cart); err != nil {
+		return &rpc.RMFetchResourceReply{
+			Status: rpc.ResourceStatusBadRequest,
+			Data:   []byte(err.Error()),
+		}, nil
+	}
+
+	// Ensure the coupon is still valid, using its current terms.
+	if cart.Coupon != nil {
+		cp, err := s.findCoupon(cart.Coupon.Code, &cart)
+		if err != nil {
+			return &rpc.RMFetchResourceReply{
+				Status: rpc.ResourceStatusBadRequest,
+				Data: []byte(fmt.Sprintf("coupon %s: %v",
+					cart.Coupon.Code, err)),
+			}, nil
+		}
+		cart.Coupon = cp
+	}
 
 	// Create the order.
+	order, err := s.createOrder(ctx, uid, cart, shipAddr, exchangeRate, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clear cart.
+	if err := jsonfile.RemoveIfExists(cartFname); err != nil {
+		return nil, err
+	}
+
+	// Render result.
+	w := &bytes.Buffer{}
+	err = s.tmpl.ExecuteTemplate(w, orderPlacedTmplFile, &order)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute product template: %v", err)
+	}
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
+
+// createOrder creates a new order for the items of the cart and generates its
+// invoice. The message sent to the user starts with preamble (if not empty).
+// Must be called with the mutex held.
+func (s *Store) createOrder(ctx context.Context, uid clientintf.UserID, cart Cart,
+	shipAddr *ShippingAddress, exchangeRate float64, preamble string,
+	renewal bool) (*Order, error) {
+
 	orderDir := filepath.Join(s.root, ordersDir, uid.String())
 	lastID, err := orderFnamePattern.Last(orderDir)
 	if err != nil {
@@ -283,6 +460,7 @@ func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 		ShipAddr:     shipAddr,
 		ExpiresTS:    time.Now().Add(time.Hour),
 		ExchangeRate: exchangeRate,
+		Renewal:      renewal,
 	}
 
 	// Build the message to send to the remote user, and present it to the
@@ -303,7 +481,11 @@ func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 		userNick = strescape.Nick(ru.Nick())
 	}
 
-	wpm("Thank you for placing your order #%d\n", order.ID)
+	if preamble != "" {
+		wpm("%s\n", preamble)
+	} else {
+		wpm("Thank you for placing your order #%d\n", order.ID)
+	}
 	if order.ShipAddr != nil {
 		shipAddr := order.ShipAddr
 		wpm("Shipping address:\n")
@@ -319,11 +501,17 @@ func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 	}
 	wpm("The following were the items in your order:\n")
 	for _, item := range order.Cart.Items {
-		totalItemUSDCents := int64(item.Quantity) * int64(item.Product.Price*100)
+		title := item.Product.Title
+		if opts := item.OptionsString(); opts != "" {
+			title += " (" + opts + ")"
+		}
 		wpm("  SKU %s - %s - %d units - $%.2f/item - $%.2f\n",
-			item.Product.SKU, item.Product.Title,
-			item.Quantity, item.Product.Price,
-			float64(totalItemUSDCents)/100)
+			item.Product.SKU, title, item.Quantity,
+			item.UnitPrice(), float64(item.TotalCents())/100)
+	}
+	if discount := order.Cart.DiscountCents(); discount > 0 {
+		wpm("Coupon %s discount: -$%.2f USD\n", order.Cart.Coupon.Code,
+			float64(discount)/100)
 	}
 
 	if order.Cart.HasCharges() && s.cfg.ShipCharge > 0 {
@@ -397,10 +585,13 @@ func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 
 	// Track pending invoice or onchain addr for payment.
 	if order.Invoice != "" {
-		pendingFname := filepath.Join(s.root, pendingInvoicesDir, fmt.Sprintf("%s-%s", uid, order.ID))
+		pendingFname := filepath.Join(s.root, pendingInvoicesDir, pendingOrderKey(order))
 		if err := jsonfile.Write(pendingFname, "", s.log); err != nil {
 			return nil, fmt.Errorf("unable to write pending invoice file: %v", err)
 		}
+
+		// Reserve the items of the order until it is paid or expires.
+		s.pending[pendingOrderKey(order)] = order
 		select {
 		case s.invoiceCreatedChan <- order:
 		case <-s.runCtx.Done():
@@ -420,21 +611,7 @@ func (s *Store) handlePlaceOrder(ctx context.Context, uid clientintf.UserID,
 		return nil, err
 	}
 
-	// Clear cart.
-	if err := jsonfile.RemoveIfExists(cartFname); err != nil {
-		return nil, err
-	}
-
-	// Render result.
-	w := &bytes.Buffer{}
-	err = s.tmpl.ExecuteTemplate(w, orderPlacedTmplFile, &order)
-	if err != nil {
-		return nil, fmt.Errorf("unable to execute product template: %v", err)
-	}
-	return &rpc.RMFetchResourceReply{
-		Data:   w.Bytes(),
-		Status: rpc.ResourceStatusOk,
-	}, nil
+	return order, nil
 }
 
 func (s *Store) handleOrders(ctx context.Context, uid clientintf.UserID,
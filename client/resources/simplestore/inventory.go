@@ -0,0 +1,286 @@
+package simplestore
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+// inventoryFile is the format of the file that tracks the stock of products.
+// Stock is keyed by the product SKU or by the variant key (SKU followed by
+// the selected options, as in "SKU/color=red,size=L"). Products that are not
+// in the inventory have unlimited stock.
+type inventoryFile struct {
+	Stock map[string]int64 `toml:"stock"`
+}
+
+// loadInventory loads the inventory file of the store.
+func loadInventory(root string) (map[string]int64, error) {
+	fname := filepath.Join(root, inventoryFname)
+	f, err := os.Open(fname)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to load inventory file: %v", err)
+	}
+	defer f.Close()
+
+	var inv inventoryFile
+	if err := toml.NewDecoder(f).Decode(&inv); err != nil {
+		return nil, fmt.Errorf("unable to decode inventory file: %v", err)
+	}
+	return inv.Stock, nil
+}
+
+// writeInventory writes the current inventory of the store. Must be called
+// with the mutex held.
+func (s *Store) writeInventory() error {
+	data, err := toml.Marshal(inventoryFile{Stock: s.inventory})
+	if err != nil {
+		return err
+	}
+	fname := filepath.Join(s.root, inventoryFname)
+	tempFname := fname + ".new"
+	if err := os.WriteFile(tempFname, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tempFname, fname)
+}
+
+// inventoryKey returns the key used to track the stock of the given variant
+// of the product and whether its stock is tracked at all. Must be called with
+// the mutex held.
+func (s *Store) inventoryKey(sku string, options map[string]string) (string, bool) {
+	if key := variantKey(sku, options); key != sku {
+		if _, ok := s.inventory[key]; ok {
+			return key, true
+		}
+	}
+	_, ok := s.inventory[sku]
+	return sku, ok
+}
+
+// licenseKeysFname returns the full path to the license keys file of the
+// product.
+func (s *Store) licenseKeysFname(prod *Product) string {
+	if filepath.IsAbs(prod.LicenseKeysFile) {
+		return prod.LicenseKeysFile
+	}
+	return filepath.Join(s.root, prod.LicenseKeysFile)
+}
+
+// readLicenseKeys returns the license keys still available to be sold for the
+// product. Empty lines and lines starting with '#' are ignored.
+func (s *Store) readLicenseKeys(prod *Product) ([]string, error) {
+	data, err := os.ReadFile(s.licenseKeysFname(prod))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var keys []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		keys = append(keys, line)
+	}
+	return keys, scanner.Err()
+}
+
+// takeLicenseKeys removes up to n license keys from the keys file of the
+// product and returns them. Must be called with the mutex held.
+func (s *Store) takeLicenseKeys(prod *Product, n uint32) ([]string, error) {
+	keys, err := s.readLicenseKeys(prod)
+	if err != nil {
+		return nil, err
+	}
+	n = min(n, uint32(len(keys)))
+	if n == 0 {
+		return nil, nil
+	}
+	taken, remaining := keys[:n], keys[n:]
+
+	var b strings.Builder
+	for _, key := range remaining {
+		b.WriteString(key)
+		b.WriteRune('\n')
+	}
+	fname := s.licenseKeysFname(prod)
+	tempFname := fname + ".new"
+	if err := os.WriteFile(tempFname, []byte(b.String()), 0o600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tempFname, fname); err != nil {
+		return nil, err
+	}
+	return taken, nil
+}
+
+// reservedQty returns how many units of the items that match f are in orders
+// still waiting for payment. Must be called with the mutex held.
+func (s *Store) reservedQty(f func(item *CartItem) bool) int64 {
+	var total int64
+	for _, order := range s.pending {
+		for _, item := range order.Cart.Items {
+			if f(item) {
+				total += int64(item.Quantity)
+			}
+		}
+	}
+	return total
+}
+
+// availableStock returns how many units of the given variant of the product
+// may still be sold, or -1 if the stock is unlimited. Units in orders that
+// are waiting for payment are not available. Must be called with the mutex
+// held.
+func (s *Store) availableStock(prod *Product, options map[string]string) (int64, error) {
+	avail := int64(-1)
+	if key, ok := s.inventoryKey(prod.SKU, options); ok {
+		reserved := s.reservedQty(func(item *CartItem) bool {
+			itemKey, _ := s.inventoryKey(item.Product.SKU, item.Options)
+			return itemKey == key
+		})
+		avail = max(s.inventory[key]-reserved, 0)
+	}
+
+	if prod.LicenseKeysFile != "" {
+		nbKeys, err := s.availableLicenseKeys(prod)
+		if err != nil {
+			return 0, err
+		}
+		if avail < 0 || nbKeys < avail {
+			avail = nbKeys
+		}
+	}
+
+	return avail, nil
+}
+
+// availableLicenseKeys returns how many license keys of the product (across
+// all of its variants) may still be sold. Keys needed by orders that are
+// waiting for payment are not available. Must be called with the mutex held.
+func (s *Store) availableLicenseKeys(prod *Product) (int64, error) {
+	keys, err := s.readLicenseKeys(prod)
+	if err != nil {
+		return 0, err
+	}
+	reserved := s.reservedQty(func(item *CartItem) bool {
+		return item.Product.SKU == prod.SKU
+	})
+	return max(int64(len(keys))-reserved, 0), nil
+}
+
+// checkCartStock returns an error if there are not enough units available
+// for all items of the cart. License keys are shared among the variants of a
+// product, so the total quantity of each product is checked against its
+// keys. Must be called with the mutex held.
+func (s *Store) checkCartStock(cart *Cart) error {
+	skuQty := make(map[string]int64, len(cart.Items))
+	for _, item := range cart.Items {
+		prod, ok := s.products[item.Product.SKU]
+		if !ok {
+			return fmt.Errorf("SKU %q does not exist", item.Product.SKU)
+		}
+		if err := s.checkStock(prod, item.Options, item.Quantity); err != nil {
+			return err
+		}
+		skuQty[prod.SKU] += int64(item.Quantity)
+	}
+
+	for sku, qty := range skuQty {
+		prod := s.products[sku]
+		if prod.LicenseKeysFile == "" {
+			continue
+		}
+		nbKeys, err := s.availableLicenseKeys(prod)
+		if err != nil {
+			return err
+		}
+		if qty > nbKeys {
+			return fmt.Errorf("only %d license keys of %q available",
+				nbKeys, prod.Title)
+		}
+	}
+	return nil
+}
+
+// checkStock returns an error if there are not enough units of the item
+// available. Must be called with the mutex held.
+func (s *Store) checkStock(prod *Product, options map[string]string, qty uint32) error {
+	avail, err := s.availableStock(prod, options)
+	if err != nil {
+		return err
+	}
+	if avail >= 0 && int64(qty) > avail {
+		if avail == 0 {
+			return fmt.Errorf("%q is out of stock", prod.Title)
+		}
+		return fmt.Errorf("only %d units of %q available", avail, prod.Title)
+	}
+	return nil
+}
+
+// orderPaid updates the inventory, redeems the coupon and extends the
+// subscriptions of an order that was paid. License keys are assigned to the
+// items of the order. Returns the subscriptions that were extended. Must be
+// called with the mutex held.
+func (s *Store) orderPaid(order *Order) ([]*Subscription, error) {
+	var invChanged bool
+	var subs []*Subscription
+	for _, item := range order.Cart.Items {
+		if item.Product.IsSubscription() {
+			sub, err := s.extendSubscription(order, item)
+			if err != nil {
+				return subs, fmt.Errorf("unable to extend subscription: %v", err)
+			}
+			subs = append(subs, sub)
+		}
+
+		if key, ok := s.inventoryKey(item.Product.SKU, item.Options); ok {
+			stock := s.inventory[key] - int64(item.Quantity)
+			if stock < 0 {
+				s.log.Warnf("Order %s/%s oversold %s by %d units",
+					order.User.ShortLogID(), order.ID, key, -stock)
+				stock = 0
+			}
+			s.inventory[key] = stock
+			invChanged = true
+		}
+
+		if item.Product.LicenseKeysFile != "" {
+			keys, err := s.takeLicenseKeys(item.Product, item.Quantity)
+			if err != nil {
+				return subs, fmt.Errorf("unable to take license keys: %v", err)
+			}
+			if len(keys) < int(item.Quantity) {
+				s.log.Warnf("Order %s/%s needed %d license keys for "+
+					"SKU %s but only %d were available",
+					order.User.ShortLogID(), order.ID,
+					item.Quantity, item.Product.SKU, len(keys))
+			}
+			item.LicenseKeys = keys
+		}
+	}
+
+	if invChanged {
+		if err := s.writeInventory(); err != nil {
+			return subs, fmt.Errorf("unable to write inventory: %v", err)
+		}
+	}
+
+	if order.Cart.Coupon != nil {
+		if err := s.redeemCoupon(order.Cart.Coupon.Code); err != nil {
+			return subs, fmt.Errorf("unable to redeem coupon: %v", err)
+		}
+	}
+	return subs, nil
+}
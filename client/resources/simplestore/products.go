@@ -2,42 +2,173 @@ package simplestore
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/decred/dcrd/dcrutil/v4"
+	"golang.org/x/exp/slices"
 )
 
+// ProductOption is an option that must be selected when buying a product (for
+// example, its size or color). Each combination of option values is a
+// different variant of the product.
+type ProductOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+
+	// Prices are the extra amounts (in USD) charged when the
+	// corresponding value is selected.
+	Prices map[string]float64 `json:"prices,omitempty"`
+}
+
+// HasValue returns true if v is a valid value for this option.
+func (opt *ProductOption) HasValue(v string) bool {
+	return slices.Contains(opt.Values, v)
+}
+
 type Product struct {
-	Title        string   `json:"title"`
-	SKU          string   `json:"sku"`
-	Description  string   `json:"description"`
-	Tags         []string `json:"tags"`
-	Price        float64  `json:"price"`
-	Disabled     bool     `json:"disabled,omitempty"`
-	Shipping     bool     `json:"shipping"`
-	SendFilename string   `json:"send_filename"`
+	Title         string          `json:"title"`
+	SKU           string          `json:"sku"`
+	Description   string          `json:"description"`
+	Tags          []string        `json:"tags"`
+	Price         float64         `json:"price"`
+	Disabled      bool            `json:"disabled,omitempty"`
+	Shipping      bool            `json:"shipping"`
+	SendFilename  string          `json:"send_filename"`
+	SendFilenames []string        `json:"send_filenames,omitempty"`
+	Options       []ProductOption `json:"options,omitempty"`
+
+	// SubscriptionDays is the period of recurring products. Buying the
+	// product subscribes the user for this number of days (per unit) and
+	// the subscription is renewed with a new order before it expires.
+	SubscriptionDays uint32 `json:"subscription_days,omitempty"`
+
+	// LicenseKeysFile is the name of a file with one license key per
+	// line. One key is sent to the user for each unit of the product
+	// bought.
+	LicenseKeysFile string `json:"license_keys_file,omitempty"`
+}
+
+// IsSubscription returns true if the product is a recurring subscription.
+func (prod *Product) IsSubscription() bool {
+	return prod.SubscriptionDays > 0
+}
+
+// SubscriptionPeriod is the duration of one period of the subscription.
+func (prod *Product) SubscriptionPeriod() time.Duration {
+	return time.Duration(prod.SubscriptionDays) * 24 * time.Hour
+}
+
+// Filenames returns the list of files sent to users that buy the product.
+func (prod *Product) Filenames() []string {
+	if prod.SendFilename == "" {
+		return prod.SendFilenames
+	}
+	return append([]string{prod.SendFilename}, prod.SendFilenames...)
+}
+
+// checkOptions returns an error if the selected options are not valid for
+// the product.
+func (prod *Product) checkOptions(options map[string]string) error {
+	for _, opt := range prod.Options {
+		v, ok := options[opt.Name]
+		if !ok || v == "" {
+			return fmt.Errorf("option %q not selected", opt.Name)
+		}
+		if !opt.HasValue(v) {
+			return fmt.Errorf("invalid value %q for option %q", v, opt.Name)
+		}
+	}
+	if len(options) != len(prod.Options) {
+		return fmt.Errorf("product does not have %d options", len(options))
+	}
+	return nil
 }
 
 type productsFile struct {
 	Products []*Product
 }
 
+// variantKey returns the key that identifies the variant of the product with
+// the given options. This is the SKU for products without options.
+func variantKey(sku string, options map[string]string) string {
+	if len(options) == 0 {
+		return sku
+	}
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = name + "=" + options[name]
+	}
+	return sku + "/" + strings.Join(names, ",")
+}
+
 type CartItem struct {
-	Product  *Product `json:"product"`
-	Quantity uint32   `json:"quantity"`
+	Product  *Product          `json:"product"`
+	Quantity uint32            `json:"quantity"`
+	Options  map[string]string `json:"options,omitempty"`
+
+	// LicenseKeys are the keys delivered to the user once the order was
+	// paid.
+	LicenseKeys []string `json:"license_keys,omitempty"`
+}
+
+// VariantKey returns the key that identifies the variant of the product of
+// this item.
+func (item *CartItem) VariantKey() string {
+	return variantKey(item.Product.SKU, item.Options)
+}
+
+// OptionsString returns the selected options as a string suitable for
+// display.
+func (item *CartItem) OptionsString() string {
+	var b strings.Builder
+	for _, opt := range item.Product.Options {
+		if b.Len() > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s: %s", opt.Name, item.Options[opt.Name])
+	}
+	return b.String()
+}
+
+// UnitPriceCents returns the price of a single unit of the item, including the
+// extra price of the selected options.
+func (item *CartItem) UnitPriceCents() int64 {
+	cents := int64(item.Product.Price * 100)
+	for _, opt := range item.Product.Options {
+		cents += int64(opt.Prices[item.Options[opt.Name]] * 100)
+	}
+	return cents
+}
+
+// UnitPrice returns the price of a single unit of the item in USD.
+func (item *CartItem) UnitPrice() float64 {
+	return float64(item.UnitPriceCents()) / 100
+}
+
+// TotalCents returns the total amount of the item, with 2 decimal places
+// accuracy.
+func (item *CartItem) TotalCents() int64 {
+	return int64(item.Quantity) * item.UnitPriceCents()
 }
 
 type Cart struct {
 	Items   []*CartItem `json:"items"`
+	Coupon  *Coupon     `json:"coupon,omitempty"`
 	Updated time.Time   `json:"updated"`
 }
 
 // HasCharges returns true if at least one item has a positive charge amount.
 func (cart *Cart) HasCharges() bool {
 	for _, item := range cart.Items {
-		if item.Quantity > 0 && item.Product.Price > 0 {
+		if item.Quantity > 0 && item.UnitPriceCents() > 0 {
 			return true
 		}
 	}
@@ -45,16 +176,40 @@ func (cart *Cart) HasCharges() bool {
 	return false
 }
 
-// Total returns the total amount, with 2 decimal places accuracy.
-func (cart *Cart) TotalCents() int64 {
+// SubtotalCents returns the amount of the items, before discounts, with 2
+// decimal places accuracy.
+func (cart *Cart) SubtotalCents() int64 {
 	var totalUSDCents int64
 	for _, item := range cart.Items {
-		totalItemUSDCents := int64(item.Quantity) * int64(item.Product.Price*100)
-		totalUSDCents += totalItemUSDCents
+		totalUSDCents += item.TotalCents()
 	}
 	return totalUSDCents
 }
 
+// Subtotal returns the amount of the items, before discounts, in USD.
+func (cart *Cart) Subtotal() float64 {
+	return float64(cart.SubtotalCents()) / 100
+}
+
+// DiscountCents returns the discount of the cart's coupon, with 2 decimal
+// places accuracy.
+func (cart *Cart) DiscountCents() int64 {
+	if cart.Coupon == nil {
+		return 0
+	}
+	return cart.Coupon.discountCents(cart)
+}
+
+// Discount returns the discount of the cart's coupon in USD.
+func (cart *Cart) Discount() float64 {
+	return float64(cart.DiscountCents()) / 100
+}
+
+// Total returns the total amount, with 2 decimal places accuracy.
+func (cart *Cart) TotalCents() int64 {
+	return cart.SubtotalCents() - cart.DiscountCents()
+}
+
 // Total returns the total cart amount in USD.
 func (cart *Cart) Total() float64 {
 	return float64(cart.TotalCents()) / 100
@@ -116,6 +271,10 @@ type Order struct {
 	ShipAddr     *ShippingAddress  `json:"shipping"`
 	Comments     []OrderComment    `json:"comments"`
 	ExpiresTS    time.Time         `json:"expires_ts"`
+
+	// Renewal is true for orders created to renew a subscription (instead
+	// of placed by the user from their cart).
+	Renewal bool `json:"renewal,omitempty"`
 }
 
 // Total returns the total amount, with 2 decimal places accuracy.
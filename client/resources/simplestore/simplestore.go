@@ -36,6 +36,10 @@ const (
 	cartsDir            = "carts"
 	ordersDir           = "orders"
 	pendingInvoicesDir  = "pendinginvoices"
+	couponUsesDir       = "couponuses"
+	couponsFname        = "coupons.toml"
+	inventoryFname      = "inventory.toml"
+	subscriptionsDir    = "subscriptions"
 	indexTmplFile       = "index.tmpl"
 	prodTmplFile        = "product.tmpl"
 	addToCartTmplFile   = "addtocart.tmpl"
@@ -68,6 +72,15 @@ type Config struct {
 	LNPayClient   *client.DcrlnPaymentClient
 
 	ExchangeRateProvider func() float64
+
+	// SubscriptionChanged is called when a subscription is started,
+	// renewed or lapses. Changes are also notified through the client's
+	// OnProductSubscriptionChangedNtfn handlers.
+	SubscriptionChanged func(sub *Subscription)
+
+	// SubscriptionRenewBefore is how long before a subscription expires
+	// the renewal order is sent to the user. Defaults to 3 days.
+	SubscriptionRenewBefore time.Duration
 }
 
 // Store is a simple store instance. A simple store can render a front page
@@ -82,13 +95,23 @@ type Store struct {
 	runCancel   func()
 	chainParams *chaincfg.Params
 
-	mtx      sync.Mutex
-	products map[string]*Product
-	tmpl     *template.Template
+	mtx       sync.Mutex
+	products  map[string]*Product
+	tmpl      *template.Template
+	coupons   map[string]*Coupon
+	inventory map[string]int64
+
+	// pending are the orders waiting for payment, keyed by
+	// pendingOrderKey().
+	pending map[string]*Order
 
 	invoiceSettledChan  chan string
 	invoiceCanceledChan chan string
 	invoiceCreatedChan  chan *Order
+
+	// pendingLoaded is closed once the orders with pending invoices are
+	// loaded by the invoice watcher.
+	pendingLoaded chan struct{}
 }
 
 // New creates a new simple store.
@@ -105,6 +128,7 @@ func New(cfg Config) (*Store, error) {
 		log:       log,
 		root:      cfg.Root,
 		products:  make(map[string]*Product),
+		pending:   make(map[string]*Order),
 		tmpl:      template.New("*root"),
 		lnpc:      cfg.LNPayClient,
 		runCtx:    runCtx,
@@ -113,6 +137,7 @@ func New(cfg Config) (*Store, error) {
 		invoiceSettledChan:  make(chan string),
 		invoiceCanceledChan: make(chan string),
 		invoiceCreatedChan:  make(chan *Order),
+		pendingLoaded:       make(chan struct{}),
 	}
 
 	if err := s.reloadStore(); err != nil {
@@ -186,13 +211,36 @@ func (s *Store) reloadStore() error {
 					prod.SKU, fname)
 			}
 
+			for _, opt := range prod.Options {
+				if opt.Name == "" || len(opt.Values) == 0 {
+					return fmt.Errorf("product %s in %s has "+
+						"invalid option %q", prod.SKU,
+						fname, opt.Name)
+				}
+			}
+
 			products[prod.SKU] = prod
 		}
 	}
 
+	// Load coupons and inventory.
+	coupons, err := loadCoupons(s.root)
+	if err != nil {
+		return err
+	}
+	inventory, err := loadInventory(s.root)
+	if err != nil {
+		return err
+	}
+	if inventory == nil {
+		inventory = make(map[string]int64)
+	}
+
 	s.mtx.Lock()
 	s.products = products
 	s.tmpl = tmpl
+	s.coupons = coupons
+	s.inventory = inventory
 	s.mtx.Unlock()
 
 	return nil
@@ -211,6 +259,14 @@ func (s *Store) Fulfill(ctx context.Context, uid clientintf.UserID,
 			return s.handleAdminIndex(ctx, uid, request)
 		case pathEquals(request.Path, "admin", "orders"):
 			return s.handleAdminOrders(ctx, uid, request)
+		case pathEquals(request.Path, "admin", "inventory"):
+			return s.handleAdminInventory(ctx, uid, request)
+		case pathEquals(request.Path, "admin", "subscriptions"):
+			return s.handleAdminSubscriptions(ctx, uid, request)
+		case pathHasPrefix(request.Path, "admin", "stats"):
+			return s.handleAdminStats(ctx, uid, request)
+		case pathHasPrefix(request.Path, "admin", "export"):
+			return s.handleAdminExport(ctx, uid, request)
 		case pathHasPrefix(request.Path, "admin", "order"):
 			return s.handleAdminViewOrder(ctx, uid, request)
 		case pathHasPrefix(request.Path, "admin", "orderaddcomment"):
@@ -233,10 +289,16 @@ func (s *Store) Fulfill(ctx context.Context, uid clientintf.UserID,
 		return s.handleClearCart(ctx, uid)
 	case len(request.Path) == 1 && request.Path[0] == "cart":
 		return s.handleCart(ctx, uid, request)
+	case pathEquals(request.Path, "applyCoupon"):
+		return s.handleApplyCoupon(ctx, uid, request)
+	case pathEquals(request.Path, "removeCoupon"):
+		return s.handleRemoveCoupon(ctx, uid, request)
 	case len(request.Path) == 1 && request.Path[0] == "placeOrder":
 		return s.handlePlaceOrder(ctx, uid, request)
 	case len(request.Path) == 1 && request.Path[0] == "orders":
 		return s.handleOrders(ctx, uid, request)
+	case pathEquals(request.Path, "subscriptions"):
+		return s.handleSubscriptions(ctx, uid, request)
 	case len(request.Path) == 2 && request.Path[0] == "order":
 		return s.handleOrderStatus(ctx, uid, request)
 	case len(request.Path) == 2 && request.Path[0] == "orderaddcomment":
@@ -384,11 +446,18 @@ func (s *Store) runOnChainInvoiceWatcher(ctx context.Context) error {
 	}
 }
 
+// pendingOrderKey is the key of an order in the list of orders with pending
+// invoice.
+func pendingOrderKey(order *Order) string {
+	return fmt.Sprintf("%s-%s", order.User, order.ID)
+}
+
 // removePendingInvoice removes an order from the list of orders with pending
 // invoice.
 func (s *Store) removePendingInvoice(order *Order) {
+	delete(s.pending, pendingOrderKey(order))
 	dir := filepath.Join(s.root, pendingInvoicesDir)
-	fname := filepath.Join(dir, fmt.Sprintf("%s-%s", order.User, order.ID))
+	fname := filepath.Join(dir, pendingOrderKey(order))
 	err := jsonfile.RemoveIfExists(fname)
 	if err != nil {
 		s.log.Warnf("Unable to remove pending order %s: %v",
@@ -396,12 +465,11 @@ func (s *Store) removePendingInvoice(order *Order) {
 	}
 }
 
-// invoiceSettled is called when an invoice for a given order was settled (paid)
-// by the user.
-func (s *Store) invoiceSettled(ctx context.Context, order *Order) {
-	s.mtx.Lock()
-	defer s.mtx.Unlock()
-
+// settleOrder removes the order from the list of orders with pending invoice
+// and marks it as paid, updating the inventory and subscriptions and assigning
+// license keys to its items. Returns the updated order and the subscriptions
+// that were extended. Must be called with the mutex held.
+func (s *Store) settleOrder(order *Order) (*Order, []*Subscription, error) {
 	// Remove pending invoice if exists.
 	s.removePendingInvoice(order)
 
@@ -410,16 +478,57 @@ func (s *Store) invoiceSettled(ctx context.Context, order *Order) {
 	orderFname := filepath.Join(orderDir, orderFnamePattern.FilenameFor(uint64(order.ID)))
 	order = new(Order)
 	if err := jsonfile.Read(orderFname, order); err != nil {
-		s.log.Warnf("Unable to read order %s: %v", orderFname, err)
-		return
+		return nil, nil, fmt.Errorf("unable to read order %s: %v", orderFname, err)
 	}
 
-	// Now update status.
+	// Now update status, inventory, subscriptions and deliver license
+	// keys.
+	now := time.Now()
 	order.Status = StatusPaid
+	order.ResolvedTS = &now
+	subs, err := s.orderPaid(order)
+	if err != nil {
+		s.log.Errorf("Unable to process paid order %s/%s: %v",
+			order.User.ShortLogID(), order.ID, err)
+	}
 	if err := jsonfile.Write(orderFname, order, s.log); err != nil {
-		s.log.Warnf("Unable to write order %s: %v", orderFname, err)
+		return nil, subs, fmt.Errorf("unable to write order %s: %v", orderFname, err)
+	}
+	return order, subs, nil
+}
+
+// invoiceSettled is called when an invoice for a given order was settled (paid)
+// by the user.
+func (s *Store) invoiceSettled(ctx context.Context, order *Order) {
+	// The subscription hooks may call back into the store, so they are
+	// only called after the mutex is released.
+	var subs []*Subscription
+	defer func() {
+		for _, sub := range subs {
+			s.subscriptionChanged(sub)
+		}
+	}()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	paid, subs, err := s.settleOrder(order)
+	if err != nil {
+		s.log.Warnf("Unable to settle order %s/%s: %v",
+			order.User.ShortLogID(), order.ID, err)
 		return
 	}
+	order = paid
+
+	// Track the payment in the client's payment ledger.
+	if amount := order.TotalDCR(); amount > 0 {
+		payEvent := fmt.Sprintf("store.order.%s", order.ID)
+		err := s.c.RecordUserPayEvent(order.User, payEvent, int64(amount)*1000, 0)
+		if err != nil {
+			s.log.Warnf("Unable to record payment of order %s/%s: %v",
+				order.User.ShortLogID(), order.ID, err)
+		}
+	}
 
 	ru, err := s.c.UserByID(order.User)
 	if err != nil {
@@ -439,31 +548,49 @@ func (s *Store) invoiceSettled(ctx context.Context, order *Order) {
 	wpm("Your order %s/%s has been identified as paid",
 		order.User.ShortLogID(), order.ID)
 
-	// If the order has files attached to it, send them to the user.
+	for _, sub := range subs {
+		wpm("\nYour subscription to %q is active until %s", sub.Title,
+			sub.Expires.Format("Mon, 02 Jan 2006 15:04 MST"))
+	}
+
+	// If the order has license keys, send them to the user.
 	for _, item := range order.Cart.Items {
-		fname := item.Product.SendFilename
-		if item.Product.SendFilename == "" {
+		if item.Product.LicenseKeysFile == "" {
 			continue
 		}
-
-		// Relative paths are set to be from the root of the simplestore.
-		if !filepath.IsAbs(fname) {
-			fname = filepath.Join(s.root, fname)
+		wpm("\nLicense keys for %s:", item.Product.Title)
+		for _, key := range item.LicenseKeys {
+			wpm("\n  %s", key)
 		}
-		wpm("\nSending you the file %s included in your order",
-			filepath.Base(fname))
-		go func() {
-			err := s.c.SendFile(order.User, 0, fname, nil)
-			if err != nil {
-				s.log.Errorf("Unable to send file %s to user %s due to order %s/%s: %v",
-					fname, strescape.Nick(ru.Nick()),
-					order.User.ShortLogID(), order.ID, err)
-			} else {
-				s.log.Infof("Successfully sent file %v to user %s due to order %s/%s",
-					fname, strescape.Nick(ru.Nick()),
-					order.User.ShortLogID(), order.ID)
+		if missing := int(item.Quantity) - len(item.LicenseKeys); missing > 0 {
+			wpm("\n  (%d keys were out of stock; contact the "+
+				"store to receive them or a refund)", missing)
+		}
+	}
+
+	// If the order has files attached to it, send them to the user.
+	for _, item := range order.Cart.Items {
+		for _, fname := range item.Product.Filenames() {
+			// Relative paths are set to be from the root of the
+			// simplestore.
+			if !filepath.IsAbs(fname) {
+				fname = filepath.Join(s.root, fname)
 			}
-		}()
+			wpm("\nSending you the file %s included in your order",
+				filepath.Base(fname))
+			go func() {
+				err := s.c.SendFile(order.User, 0, fname, nil)
+				if err != nil {
+					s.log.Errorf("Unable to send file %s to user %s due to order %s/%s: %v",
+						fname, strescape.Nick(ru.Nick()),
+						order.User.ShortLogID(), order.ID, err)
+				} else {
+					s.log.Infof("Successfully sent file %v to user %s due to order %s/%s",
+						fname, strescape.Nick(ru.Nick()),
+						order.User.ShortLogID(), order.ID)
+				}
+			}()
+		}
 	}
 
 	if s.cfg.StatusChanged != nil {
@@ -471,29 +598,44 @@ func (s *Store) invoiceSettled(ctx context.Context, order *Order) {
 	}
 }
 
-// invoiceExpired is called when the invoice of an order has expired.
-func (s *Store) invoiceExpired(ctx context.Context, order *Order) {
-	s.mtx.Lock()
-	defer s.mtx.Unlock()
-
+// cancelOrder removes the order from the list of orders with pending invoice,
+// which releases the items reserved by it, and marks the order as canceled.
+// Returns the updated order. Must be called with the mutex held.
+func (s *Store) cancelOrder(order *Order) (*Order, error) {
 	// Remove pending invoice if exists.
 	s.removePendingInvoice(order)
 
-	// Mark order as paid. First, reload the full order from disk.
+	// Mark order as canceled. First, reload the full order from disk.
 	orderDir := filepath.Join(s.root, ordersDir, order.User.String())
 	orderFname := filepath.Join(orderDir, orderFnamePattern.FilenameFor(uint64(order.ID)))
 	order = new(Order)
 	if err := jsonfile.Read(orderFname, order); err != nil {
-		s.log.Warnf("Unable to read order %s: %v", orderFname, err)
-		return
+		return nil, fmt.Errorf("unable to read order %s: %v", orderFname, err)
 	}
 
 	// Now update status.
-	order.Status = StatusPaid
+	now := time.Now()
+	order.Status = StatusCanceled
+	order.ResolvedTS = &now
 	if err := jsonfile.Write(orderFname, order, s.log); err != nil {
-		s.log.Warnf("Unable to write order %s: %v", orderFname, err)
+		return nil, fmt.Errorf("unable to write order %s: %v", orderFname, err)
+	}
+	return order, nil
+}
+
+// invoiceExpired is called when the invoice of an order has expired or was
+// canceled. Reason is either "expired" or "canceled".
+func (s *Store) invoiceExpired(ctx context.Context, order *Order, reason string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	canceled, err := s.cancelOrder(order)
+	if err != nil {
+		s.log.Warnf("Unable to cancel order %s/%s: %v",
+			order.User.ShortLogID(), order.ID, err)
 		return
 	}
+	order = canceled
 
 	ru, err := s.c.UserByID(order.User)
 	if err != nil {
@@ -502,16 +644,17 @@ func (s *Store) invoiceExpired(ctx context.Context, order *Order) {
 		return
 	}
 
-	s.log.Infof("Detected order %s/%s from user %s as expired",
-		order.User.ShortLogID(), order.ID, strescape.Nick(ru.Nick()))
+	s.log.Infof("Detected order %s/%s from user %s as %s",
+		order.User.ShortLogID(), order.ID, strescape.Nick(ru.Nick()),
+		reason)
 
 	// Finally, send a message to user noting the expiration.
 	var b strings.Builder
 	wpm := func(f string, args ...interface{}) {
 		b.WriteString(fmt.Sprintf(f, args...))
 	}
-	wpm("Your order %s/%s has been identified as expired",
-		order.User.ShortLogID(), order.ID)
+	wpm("Your order %s/%s has been identified as %s",
+		order.User.ShortLogID(), order.ID, reason)
 
 	if s.cfg.StatusChanged != nil {
 		s.cfg.StatusChanged(order, b.String())
@@ -558,7 +701,7 @@ func (s *Store) runInvoiceWatcher(ctx context.Context) error {
 			continue
 		}
 		if order.Invoice == "" || order.ExpiresTS.Before(time.Now()) {
-			go s.invoiceExpired(ctx, order)
+			go s.invoiceExpired(ctx, order, "expired")
 			continue
 		}
 		if order.Status != StatusPlaced {
@@ -566,8 +709,10 @@ func (s *Store) runInvoiceWatcher(ctx context.Context) error {
 			continue
 		}
 		invoices[order.invoiceDiscriminator()] = order
+		s.pending[pendingOrderKey(order)] = order
 	}
 	s.mtx.Unlock()
+	close(s.pendingLoaded)
 
 	// Timer that is triggered on the next time one of the invoices needs
 	// to be timed out.
@@ -600,7 +745,10 @@ func (s *Store) runInvoiceWatcher(ctx context.Context) error {
 			}
 
 		case inv := <-s.invoiceCanceledChan:
-			delete(invoices, inv)
+			if order := invoices[inv]; order != nil {
+				delete(invoices, inv)
+				go s.invoiceExpired(ctx, order, "canceled")
+			}
 
 		case <-nextExpiresTimer.C:
 			now := time.Now()
@@ -609,7 +757,7 @@ func (s *Store) runInvoiceWatcher(ctx context.Context) error {
 					continue
 				}
 				delete(invoices, order.invoiceDiscriminator())
-				go s.invoiceExpired(ctx, order)
+				go s.invoiceExpired(ctx, order, "expired")
 			}
 			resetNextExpiresTimer()
 
@@ -650,6 +798,7 @@ func (s *Store) Run(ctx context.Context) error {
 	g.Go(func() error { return s.runLNInvoiceWatcher(ctx) })
 	g.Go(func() error { return s.runOnChainInvoiceWatcher(ctx) })
 	g.Go(func() error { return s.runInvoiceWatcher(ctx) })
+	g.Go(func() error { return s.runSubscriptionsWatcher(ctx) })
 
 	return g.Wait()
 }
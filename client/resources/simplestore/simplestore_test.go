@@ -0,0 +1,261 @@
+package simplestore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/jsonfile"
+	"github.com/companyzero/bisonrelay/internal/testutils"
+)
+
+const testProducts = `
+[[products]]
+title = "Shirt"
+sku = "shirt"
+price = 10.00
+
+  [[products.options]]
+  name = "size"
+  values = ["S", "M"]
+
+[[products]]
+title = "Mug"
+sku = "mug"
+price = 5.00
+
+[[products]]
+title = "Software"
+sku = "soft"
+price = 20.00
+licensekeysfile = "keys.txt"
+
+[[products]]
+title = "Membership"
+sku = "member"
+price = 5.00
+subscriptiondays = 30
+`
+
+const testInventory = `
+[stock]
+"mug" = 2
+"shirt/size=M" = 1
+`
+
+const testCoupons = `
+[[coupons]]
+code = "once"
+percent = 10.0
+maxuses = 1
+`
+
+// newTestStore creates a store with the test products, inventory, coupons and
+// license keys.
+func newTestStore(t testing.TB, cfg Config) *Store {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		filepath.Join(productsDir, "products.toml"): testProducts,
+		inventoryFname: testInventory,
+		couponsFname:   testCoupons,
+		"keys.txt":     "key1\n# comment\n\nkey2\n",
+	}
+	for fname, data := range files {
+		fname = filepath.Join(root, fname)
+		assert.NilErr(t, os.MkdirAll(filepath.Dir(fname), 0o700))
+		assert.NilErr(t, os.WriteFile(fname, []byte(data), 0o600))
+	}
+
+	cfg.Root = root
+	cfg.Log = testutils.TestLoggerSys(t, "SSTR")
+	s, err := New(cfg)
+	assert.NilErr(t, err)
+	return s
+}
+
+// testCart returns a cart with qty units of the product with the given SKU.
+func testCart(t testing.TB, s *Store, sku string, qty uint32, options map[string]string) Cart {
+	t.Helper()
+	prod := s.products[sku]
+	if prod == nil {
+		t.Fatalf("product %s not found", sku)
+	}
+	return Cart{
+		Items:   []*CartItem{{Product: prod, Quantity: qty, Options: options}},
+		Updated: time.Now(),
+	}
+}
+
+// addTestOrder adds an order waiting for payment for the cart, the same way
+// createOrder does once the invoice for the order is generated.
+func addTestOrder(t testing.TB, s *Store, uid clientintf.UserID, cart Cart) *Order {
+	t.Helper()
+	orderDir := filepath.Join(s.root, ordersDir, uid.String())
+	lastID, err := orderFnamePattern.Last(orderDir)
+	assert.NilErr(t, err)
+
+	order := &Order{
+		User:      uid,
+		Cart:      cart,
+		ID:        OrderID(lastID.ID + 1),
+		Status:    StatusPlaced,
+		PlacedTS:  time.Now(),
+		ExpiresTS: time.Now().Add(time.Hour),
+		PayType:   PayTypeLN,
+		Invoice:   "lninvoice",
+	}
+	fname := filepath.Join(orderDir, orderFnamePattern.FilenameFor(uint64(order.ID)))
+	assert.NilErr(t, jsonfile.Write(fname, order, s.log))
+	pendingFname := filepath.Join(s.root, pendingInvoicesDir, pendingOrderKey(order))
+	assert.NilErr(t, jsonfile.Write(pendingFname, "", s.log))
+	s.pending[pendingOrderKey(order)] = order
+	return order
+}
+
+// payTestOrder processes the order as paid.
+func payTestOrder(t testing.TB, s *Store, order *Order) (*Order, []*Subscription) {
+	t.Helper()
+	paid, subs, err := s.settleOrder(order)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, paid.Status, StatusPaid)
+	return paid, subs
+}
+
+// TestInventoryReservation tests that units in orders waiting for payment are
+// reserved until the order is paid or canceled.
+func TestInventoryReservation(t *testing.T) {
+	t.Parallel()
+
+	s := newTestStore(t, Config{})
+	alice, bob := clientintf.UserID{0: 1}, clientintf.UserID{0: 2}
+
+	// Alice's pending order reserves the entire stock.
+	cart := testCart(t, s, "mug", 2, nil)
+	assert.NilErr(t, s.checkCartStock(&cart))
+	order := addTestOrder(t, s, alice, cart)
+	bobCart := testCart(t, s, "mug", 1, nil)
+	assert.NonNilErr(t, s.checkCartStock(&bobCart))
+
+	// Canceling the order releases the stock.
+	canceled, err := s.cancelOrder(order)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, canceled.Status, StatusCanceled)
+	assert.NilErr(t, s.checkCartStock(&bobCart))
+
+	// Paying an order decreases the stock.
+	order = addTestOrder(t, s, bob, bobCart)
+	payTestOrder(t, s, order)
+	assert.DeepEqual(t, s.inventory["mug"], int64(1))
+	inv, err := loadInventory(s.root)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, inv["mug"], int64(1))
+	assert.NonNilErr(t, s.checkCartStock(&cart))
+	assert.NilErr(t, s.checkCartStock(&bobCart))
+}
+
+// TestVariantStock tests that the stock of variants of a product is tracked
+// independently.
+func TestVariantStock(t *testing.T) {
+	t.Parallel()
+
+	s := newTestStore(t, Config{})
+	alice := clientintf.UserID{0: 1}
+	prod := s.products["shirt"]
+
+	// Variants not in the inventory have unlimited stock.
+	sizeM := map[string]string{"size": "M"}
+	sizeS := map[string]string{"size": "S"}
+	assert.NonNilErr(t, s.checkStock(prod, sizeM, 2))
+	assert.NilErr(t, s.checkStock(prod, sizeM, 1))
+	assert.NilErr(t, s.checkStock(prod, sizeS, 100))
+	avail, err := s.availableStock(prod, sizeS)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, avail, int64(-1))
+
+	// Reserving the M variant does not affect the S variant.
+	addTestOrder(t, s, alice, testCart(t, s, "shirt", 1, sizeM))
+	assert.NonNilErr(t, s.checkStock(prod, sizeM, 1))
+	assert.NilErr(t, s.checkStock(prod, sizeS, 1))
+}
+
+// TestLicenseKeys tests that license keys are reserved by pending orders and
+// delivered once the order is paid.
+func TestLicenseKeys(t *testing.T) {
+	t.Parallel()
+
+	s := newTestStore(t, Config{})
+	alice, bob := clientintf.UserID{0: 1}, clientintf.UserID{0: 2}
+	prod := s.products["soft"]
+
+	nbKeys, err := s.availableLicenseKeys(prod)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, nbKeys, int64(2))
+	tooMany := testCart(t, s, "soft", 3, nil)
+	assert.NonNilErr(t, s.checkCartStock(&tooMany))
+
+	// A pending order reserves the keys until it is canceled.
+	order := addTestOrder(t, s, alice, testCart(t, s, "soft", 2, nil))
+	nbKeys, err = s.availableLicenseKeys(prod)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, nbKeys, int64(0))
+	_, err = s.cancelOrder(order)
+	assert.NilErr(t, err)
+	nbKeys, err = s.availableLicenseKeys(prod)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, nbKeys, int64(2))
+
+	// Paying an order delivers the keys and removes them from the file.
+	order = addTestOrder(t, s, bob, testCart(t, s, "soft", 1, nil))
+	paid, _ := payTestOrder(t, s, order)
+	assert.DeepEqual(t, paid.Cart.Items[0].LicenseKeys, []string{"key1"})
+	keys, err := s.readLicenseKeys(prod)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, keys, []string{"key2"})
+
+	// The keys are recorded in the order.
+	fname := filepath.Join(s.root, ordersDir, bob.String(),
+		orderFnamePattern.FilenameFor(uint64(order.ID)))
+	var saved Order
+	assert.NilErr(t, jsonfile.Read(fname, &saved))
+	assert.DeepEqual(t, saved.Cart.Items[0].LicenseKeys, []string{"key1"})
+}
+
+// TestCouponMaxUses tests that pending and paid orders count as uses of a
+// coupon.
+func TestCouponMaxUses(t *testing.T) {
+	t.Parallel()
+
+	s := newTestStore(t, Config{})
+	alice, bob := clientintf.UserID{0: 1}, clientintf.UserID{0: 2}
+
+	cart := testCart(t, s, "mug", 1, nil)
+	cp, err := s.findCoupon("ONCE", &cart)
+	assert.NilErr(t, err)
+	cart.Coupon = cp
+	assert.DeepEqual(t, cart.DiscountCents(), int64(50))
+
+	// The coupon cannot be used while an order with it is pending.
+	order := addTestOrder(t, s, alice, cart)
+	bobCart := testCart(t, s, "mug", 1, nil)
+	_, err = s.findCoupon("once", &bobCart)
+	assert.NonNilErr(t, err)
+
+	// Canceling the order releases the use of the coupon.
+	_, err = s.cancelOrder(order)
+	assert.NilErr(t, err)
+	_, err = s.findCoupon("once", &bobCart)
+	assert.NilErr(t, err)
+
+	// Paying an order redeems the coupon.
+	order = addTestOrder(t, s, bob, cart)
+	payTestOrder(t, s, order)
+	uses, err := s.couponUses("ONCE")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, uses, uint32(1))
+	_, err = s.findCoupon("once", &bobCart)
+	assert.NonNilErr(t, err)
+}
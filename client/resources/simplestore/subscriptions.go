@@ -0,0 +1,386 @@
+package simplestore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/jsonfile"
+	"github.com/companyzero/bisonrelay/internal/strescape"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+const (
+	// defaultSubscriptionRenewBefore is how long before a subscription
+	// expires the renewal order is sent to the user.
+	defaultSubscriptionRenewBefore = 3 * 24 * time.Hour
+
+	// subscriptionRenewRetry is the minimum interval between renewal
+	// orders of a subscription (renewal orders expire when their invoice
+	// expires).
+	subscriptionRenewRetry = 24 * time.Hour
+
+	// maxSubscriptionsCheckInterval is the maximum interval between checks
+	// for subscriptions that need to be renewed or that lapsed.
+	maxSubscriptionsCheckInterval = time.Hour
+
+	// subscriptionProvider is the name of the provider used in
+	// client.ProductSubscription notifications.
+	subscriptionProvider = "simplestore"
+)
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionActive SubscriptionStatus = "active"
+	SubscriptionLapsed SubscriptionStatus = "lapsed"
+)
+
+// Subscription is the subscription of an user to a recurring product.
+type Subscription struct {
+	User    clientintf.UserID  `json:"user"`
+	SKU     string             `json:"sku"`
+	Title   string             `json:"title"`
+	Status  SubscriptionStatus `json:"status"`
+	Started time.Time          `json:"started"`
+	Expires time.Time          `json:"expires"`
+
+	// Orders are the paid orders that started or renewed the
+	// subscription.
+	Orders []OrderID `json:"orders"`
+
+	// RenewalOrder is the last order created to renew the subscription.
+	// It is zero after the renewal order is paid.
+	RenewalOrder OrderID   `json:"renewal_order,omitempty"`
+	LastRenewal  time.Time `json:"last_renewal"`
+}
+
+// IsActive returns true if the subscription is active at the given time.
+func (sub *Subscription) IsActive(now time.Time) bool {
+	return sub.Status == SubscriptionActive && now.Before(sub.Expires)
+}
+
+func (s *Store) subscriptionFname(uid clientintf.UserID, sku string) string {
+	return filepath.Join(s.root, subscriptionsDir, uid.String(),
+		strescape.PathElement(sku)+".json")
+}
+
+func (s *Store) readSubscription(uid clientintf.UserID, sku string) (*Subscription, error) {
+	sub := new(Subscription)
+	if err := jsonfile.Read(s.subscriptionFname(uid, sku), sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *Store) writeSubscription(sub *Subscription) error {
+	return jsonfile.Write(s.subscriptionFname(sub.User, sub.SKU), sub, s.log)
+}
+
+// listSubscriptions lists the subscriptions of the given user (or of all users
+// if nil). Must be called with the mutex held.
+func (s *Store) listSubscriptions(uid *clientintf.UserID) ([]*Subscription, error) {
+	dir := "*"
+	if uid != nil {
+		dir = uid.String()
+	}
+	pattern := filepath.Join(s.root, subscriptionsDir, dir, "*.json")
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]*Subscription, 0, len(files))
+	for _, f := range files {
+		sub := new(Subscription)
+		if err := jsonfile.Read(f, sub); err != nil {
+			s.log.Warnf("Unable to read subscription %s: %v", f, err)
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].Expires.Before(subs[j].Expires)
+	})
+	return subs, nil
+}
+
+// Subscription returns the subscription of the user to the product with the
+// given SKU.
+func (s *Store) Subscription(uid clientintf.UserID, sku string) (*Subscription, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.readSubscription(uid, sku)
+}
+
+// ListSubscriptions lists the subscriptions of the given user (or of all users
+// if nil).
+func (s *Store) ListSubscriptions(uid *clientintf.UserID) ([]*Subscription, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.listSubscriptions(uid)
+}
+
+// subscriptionChanged calls the hooks for changes in subscriptions.
+func (s *Store) subscriptionChanged(sub *Subscription) {
+	if s.cfg.SubscriptionChanged != nil {
+		s.cfg.SubscriptionChanged(sub)
+	}
+	if s.c != nil {
+		s.c.NotifyProductSubscriptionChanged(client.ProductSubscription{
+			UID:      sub.User,
+			Provider: subscriptionProvider,
+			Product:  sub.SKU,
+			Title:    sub.Title,
+			Active:   sub.Status == SubscriptionActive,
+			Expires:  sub.Expires,
+		})
+	}
+}
+
+// extendSubscription starts or renews the subscription of the user that paid
+// for the item of the order. Must be called with the mutex held.
+func (s *Store) extendSubscription(order *Order, item *CartItem) (*Subscription, error) {
+	prod := item.Product
+	now := time.Now()
+	sub, err := s.readSubscription(order.User, prod.SKU)
+	if errors.Is(err, jsonfile.ErrNotFound) {
+		sub = &Subscription{User: order.User, SKU: prod.SKU}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Renewals extend the current period.
+	start := now
+	if sub.IsActive(now) {
+		start = sub.Expires
+	} else {
+		sub.Started = now
+	}
+	period := prod.SubscriptionPeriod() * time.Duration(item.Quantity)
+	sub.Expires = start.Add(period)
+	sub.Status = SubscriptionActive
+	sub.Title = prod.Title
+	sub.Orders = append(sub.Orders, order.ID)
+	if sub.RenewalOrder == order.ID {
+		sub.RenewalOrder = 0
+	}
+	if err := s.writeSubscription(sub); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("Subscription of %s to %s extended until %s by order %s",
+		order.User.ShortLogID(), prod.SKU, sub.Expires.Format(time.RFC3339),
+		order.ID)
+	return sub, nil
+}
+
+func (s *Store) renewBefore() time.Duration {
+	if s.cfg.SubscriptionRenewBefore > 0 {
+		return s.cfg.SubscriptionRenewBefore
+	}
+	return defaultSubscriptionRenewBefore
+}
+
+// renewalPending returns true if the subscription has a renewal order that is
+// waiting for payment. Must be called with the mutex held.
+func (s *Store) renewalPending(sub *Subscription, now time.Time) bool {
+	if sub.RenewalOrder == 0 {
+		return false
+	}
+	fname := filepath.Join(s.root, ordersDir, sub.User.String(),
+		orderFnamePattern.FilenameFor(uint64(sub.RenewalOrder)))
+	var order Order
+	if err := jsonfile.Read(fname, &order); err != nil {
+		return false
+	}
+	return order.Status == StatusPlaced && now.Before(order.ExpiresTS)
+}
+
+// renewSubscription creates an order to renew the subscription and sends its
+// invoice to the user. Must be called with the mutex held.
+func (s *Store) renewSubscription(ctx context.Context, sub *Subscription) error {
+	// Attempt at most one renewal per retry interval, even on errors.
+	sub.LastRenewal = time.Now()
+	if err := s.writeSubscription(sub); err != nil {
+		return err
+	}
+
+	prod := s.products[sub.SKU]
+	if prod == nil || !prod.IsSubscription() {
+		return fmt.Errorf("subscription product %s not available", sub.SKU)
+	}
+
+	var exchangeRate float64
+	if s.cfg.ExchangeRateProvider != nil {
+		exchangeRate = s.cfg.ExchangeRateProvider()
+	}
+	if exchangeRate <= 0 {
+		return fmt.Errorf("invalid exchange rate")
+	}
+
+	cart := Cart{
+		Items:   []*CartItem{{Product: prod, Quantity: 1}},
+		Updated: time.Now(),
+	}
+	preamble := fmt.Sprintf("Your subscription to %q expires on %s. Pay "+
+		"for the following order to renew it for another %d days.",
+		prod.Title, sub.Expires.Format("Mon, 02 Jan 2006 15:04 MST"),
+		prod.SubscriptionDays)
+	order, err := s.createOrder(ctx, sub.User, cart, nil, exchangeRate, preamble, true)
+	if err != nil {
+		return err
+	}
+
+	sub.RenewalOrder = order.ID
+	if err := s.writeSubscription(sub); err != nil {
+		return err
+	}
+	s.log.Infof("Created renewal order %s/%s for subscription to %s",
+		sub.User.ShortLogID(), order.ID, sub.SKU)
+	return nil
+}
+
+// checkSubscriptions lapses expired subscriptions and renews the ones that are
+// about to expire. It returns the next time subscriptions need to be checked.
+func (s *Store) checkSubscriptions(ctx context.Context) time.Time {
+	var changed []*Subscription
+	defer func() {
+		for _, sub := range changed {
+			s.subscriptionChanged(sub)
+		}
+	}()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := time.Now()
+	next := now.Add(maxSubscriptionsCheckInterval)
+	subs, err := s.listSubscriptions(nil)
+	if err != nil {
+		s.log.Errorf("Unable to list subscriptions: %v", err)
+		return next
+	}
+
+	for _, sub := range subs {
+		if sub.Status != SubscriptionActive {
+			continue
+		}
+
+		if !now.Before(sub.Expires) {
+			sub.Status = SubscriptionLapsed
+			if err := s.writeSubscription(sub); err != nil {
+				s.log.Errorf("Unable to write subscription: %v", err)
+				continue
+			}
+			s.log.Infof("Subscription of %s to %s lapsed",
+				sub.User.ShortLogID(), sub.SKU)
+			changed = append(changed, sub)
+			continue
+		}
+		if sub.Expires.Before(next) {
+			next = sub.Expires
+		}
+
+		renewAt := sub.Expires.Add(-s.renewBefore())
+		if retryAt := sub.LastRenewal.Add(subscriptionRenewRetry); retryAt.After(renewAt) {
+			renewAt = retryAt
+		}
+		if now.Before(renewAt) {
+			if renewAt.Before(next) {
+				next = renewAt
+			}
+			continue
+		}
+		if s.renewalPending(sub, now) {
+			continue
+		}
+
+		if err := s.renewSubscription(ctx, sub); err != nil {
+			s.log.Warnf("Unable to renew subscription of %s to %s: %v",
+				sub.User.ShortLogID(), sub.SKU, err)
+		}
+	}
+
+	return next
+}
+
+// runSubscriptionsWatcher periodically checks subscriptions for renewal and
+// expiration.
+func (s *Store) runSubscriptionsWatcher(ctx context.Context) error {
+	// Wait until pending invoices are loaded, so that renewal orders may
+	// be tracked.
+	select {
+	case <-s.pendingLoaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for {
+		next := s.checkSubscriptions(ctx)
+		select {
+		case <-time.After(time.Until(next)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *Store) handleSubscriptions(ctx context.Context, uid clientintf.UserID,
+	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	subs, err := s.ListSubscriptions(&uid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	w := &bytes.Buffer{}
+	w.WriteString("# Subscriptions\n\n")
+	if len(subs) == 0 {
+		w.WriteString("No subscriptions.\n\n")
+	}
+	for _, sub := range subs {
+		status := "expires"
+		if !sub.IsActive(now) {
+			status = "expired"
+		}
+		fmt.Fprintf(w, "  - [%s](/product/%s) - %s - %s %s\n",
+			sub.Title, sub.SKU, sub.Status, status,
+			sub.Expires.Format("2006-01-02 15:04:05"))
+	}
+	w.WriteString("\n[Back to Index](/index.md)\n")
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
+
+func (s *Store) handleAdminSubscriptions(ctx context.Context, uid clientintf.UserID,
+	request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	subs, err := s.ListSubscriptions(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &bytes.Buffer{}
+	w.WriteString("# Subscriptions\n\n")
+	for _, sub := range subs {
+		nick, _ := s.c.UserNick(sub.User)
+		fmt.Fprintf(w, "  - %s - %s - %s - %s until %s\n",
+			sub.User.ShortLogID(), strescape.Nick(nick), sub.SKU,
+			sub.Status, sub.Expires.Format("2006-01-02 15:04:05"))
+	}
+	w.WriteString("\n[Back to Admin](/admin)\n")
+	return &rpc.RMFetchResourceReply{
+		Data:   w.Bytes(),
+		Status: rpc.ResourceStatusOk,
+	}, nil
+}
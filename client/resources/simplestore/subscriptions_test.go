@@ -0,0 +1,102 @@
+package simplestore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// TestSubscriptionRenewal tests that paying for a renewal order extends the
+// current period of the subscription.
+func TestSubscriptionRenewal(t *testing.T) {
+	t.Parallel()
+
+	s := newTestStore(t, Config{})
+	alice := clientintf.UserID{0: 1}
+	period := s.products["member"].SubscriptionPeriod()
+
+	// Paying for the product starts the subscription.
+	order := addTestOrder(t, s, alice, testCart(t, s, "member", 1, nil))
+	_, subs := payTestOrder(t, s, order)
+	assert.DeepEqual(t, len(subs), 1)
+	sub, err := s.readSubscription(alice, "member")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, sub.Status, SubscriptionActive)
+	assert.DeepEqual(t, sub.Orders, []OrderID{order.ID})
+	if !sub.IsActive(time.Now()) || sub.Expires.After(time.Now().Add(period)) {
+		t.Fatalf("unexpected subscription expiration %s", sub.Expires)
+	}
+	firstExpires := sub.Expires
+
+	// Simulate a renewal order created for the subscription. The renewal
+	// is pending until the order is paid.
+	renewal := addTestOrder(t, s, alice, testCart(t, s, "member", 1, nil))
+	sub.RenewalOrder = renewal.ID
+	assert.NilErr(t, s.writeSubscription(sub))
+	assert.BoolIs(t, s.renewalPending(sub, time.Now()), true)
+
+	// Paying for the renewal extends the subscription from its previous
+	// expiration.
+	payTestOrder(t, s, renewal)
+	sub, err = s.readSubscription(alice, "member")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, sub.Expires.Equal(firstExpires.Add(period)), true)
+	assert.DeepEqual(t, sub.Orders, []OrderID{order.ID, renewal.ID})
+	assert.DeepEqual(t, sub.RenewalOrder, OrderID(0))
+	assert.BoolIs(t, s.renewalPending(sub, time.Now()), false)
+}
+
+// TestSubscriptionLapse tests that expired subscriptions lapse and that the
+// subscription hook may call back into the store.
+func TestSubscriptionLapse(t *testing.T) {
+	t.Parallel()
+
+	changedChan := make(chan *Subscription, 5)
+	var s *Store
+	s = newTestStore(t, Config{
+		SubscriptionChanged: func(sub *Subscription) {
+			// The hook is called without the mutex held.
+			if _, err := s.ListSubscriptions(nil); err != nil {
+				t.Error(err)
+			}
+			changedChan <- sub
+		},
+	})
+	alice, bob := clientintf.UserID{0: 1}, clientintf.UserID{0: 2}
+	now := time.Now()
+
+	// Alice's subscription expired, Bob's is close to expiring.
+	expired := &Subscription{User: alice, SKU: "member", Status: SubscriptionActive,
+		Started: now.Add(-31 * 24 * time.Hour), Expires: now.Add(-time.Hour)}
+	assert.NilErr(t, s.writeSubscription(expired))
+	expiring := &Subscription{User: bob, SKU: "member", Status: SubscriptionActive,
+		Started: now.Add(-29 * 24 * time.Hour), Expires: now.Add(24 * time.Hour)}
+	assert.NilErr(t, s.writeSubscription(expiring))
+
+	assert.DoesNotBlock(t, func() { s.checkSubscriptions(context.Background()) })
+	changed := assert.ChanWritten(t, changedChan)
+	assert.DeepEqual(t, changed.User, alice)
+	assert.DeepEqual(t, changed.Status, SubscriptionLapsed)
+	assert.ChanNotWritten(t, changedChan, 100*time.Millisecond)
+	sub, err := s.readSubscription(alice, "member")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, sub.Status, SubscriptionLapsed)
+
+	// A renewal of Bob's subscription was attempted (it fails because
+	// there is no exchange rate) and is only retried after the retry
+	// interval.
+	sub, err = s.readSubscription(bob, "member")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, sub.Status, SubscriptionActive)
+	if sub.LastRenewal.Before(now) {
+		t.Fatalf("renewal of subscription was not attempted")
+	}
+	lastRenewal := sub.LastRenewal
+	s.checkSubscriptions(context.Background())
+	sub, err = s.readSubscription(bob, "member")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, sub.LastRenewal.Equal(lastRenewal), true)
+}
@@ -71,8 +71,10 @@ func (fr *FilesystemResource) Fulfill(ctx context.Context, uid clientintf.UserID
 		data = []byte(ProcessEmbeds(string(data), fr.root, fr.log))
 	}
 
-	return &rpc.RMFetchResourceReply{
+	reply := &rpc.RMFetchResourceReply{
 		Data:   data,
 		Status: rpc.ResourceStatusOk,
-	}, nil
+	}
+	ConditionalReply(req, reply)
+	return reply, nil
 }
@@ -1,11 +1,14 @@
 package resources
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"regexp"
 
 	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/decred/slog"
 )
 
@@ -47,3 +50,31 @@ func RemoveEndOfPostMarker(s string) string {
 	}
 	return s[:loc[0]]
 }
+
+// ResourceETag returns a version identifier for the given resource data.
+func ResourceETag(data []byte) string {
+	h := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(h[:16]) + `"`
+}
+
+// ConditionalReply sets the version of the reply's data in its meta. If the
+// request indicates the remote user already has this version of the resource,
+// the reply is changed to a ResourceStatusNotModified reply without data.
+func ConditionalReply(req *rpc.RMFetchResource, reply *rpc.RMFetchResourceReply) {
+	if reply.Status != rpc.ResourceStatusOk {
+		return
+	}
+	etag := ResourceETag(reply.Data)
+	meta := make(map[string]string, len(reply.Meta)+1)
+	for k, v := range reply.Meta {
+		meta[k] = v
+	}
+	meta[rpc.ResourceMetaETag] = etag
+	reply.Meta = meta
+
+	if req.RequestMethod() == rpc.ResourceMethodGet &&
+		req.Meta[rpc.ResourceMetaIfNoneMatch] == etag {
+		reply.Status = rpc.ResourceStatusNotModified
+		reply.Data = nil
+	}
+}
@@ -363,6 +363,6 @@ func (s *Server) InitChatService(cfg ChatServerCfg) error {
 		kxStreams:  kxStreams,
 	}
 	cs.registerOfflineMessageStorageHandlers()
-	s.services.Bind("ChatService", types.ChatServiceDefn(), cs)
+	s.bindService("ChatService", types.ChatServiceDefn(), cs)
 	return nil
 }
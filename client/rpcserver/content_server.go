@@ -2,8 +2,13 @@ package rpcserver
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/decred/slog"
@@ -21,12 +26,31 @@ type ContentServerCfg struct {
 	RootReplayMsgLogs string
 }
 
+// listUserContentTimeout is how long to wait for a remote user to reply to a
+// ListUserContent request.
+const listUserContentTimeout = 2 * time.Minute
+
+// contentListReply is the reply of a remote user to a content list request.
+type contentListReply struct {
+	files []clientdb.RemoteFile
+	err   error
+}
+
 type contentServer struct {
 	cfg ContentServerCfg
 	log slog.Logger
 	c   *client.Client
 
 	completedStreams *serverStreams[*types.DownloadCompletedResponse]
+
+	// listWaiters are the ListUserContent calls waiting for the reply of
+	// each remote user.
+	listMtx     sync.Mutex
+	listWaiters map[clientintf.UserID][]chan contentListReply
+
+	// progressSubs are the running TransfersProgressStream calls.
+	progressMtx  sync.Mutex
+	progressSubs map[chan *types.TransferProgress]struct{}
 }
 
 func (cs *contentServer) DownloadsCompletedStream(ctx context.Context,
@@ -35,33 +59,50 @@ func (cs *contentServer) DownloadsCompletedStream(ctx context.Context,
 	return cs.completedStreams.runStream(ctx, req.UnackedFrom, stream)
 }
 
-func (cs *contentServer) fileDownloadCompletedHandler(ru *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
-	ntfn := &types.DownloadCompletedResponse{
-		Uid:      ru.ID().Bytes(),
-		Nick:     ru.Nick(),
-		DiskPath: diskPath,
-		FileMetadata: &types.FileMetadata{
-			Version:     fm.Version,
-			Cost:        fm.Cost,
-			Size:        fm.Size,
-			Directory:   fm.Directory,
-			Filename:    fm.Filename,
-			Description: fm.Description,
-			Hash:        fm.Hash,
-			Signature:   fm.Signature,
-			Attributes:  fm.Attributes,
-		},
-	}
-
-	ntfn.FileMetadata.Manifest = make([]*types.FileManifest, len(fm.Manifest))
+// marshalFileMetadata converts the file metadata to its clientrpc type.
+func marshalFileMetadata(fm *rpc.FileMetadata) *types.FileMetadata {
+	res := &types.FileMetadata{
+		Version:     fm.Version,
+		Cost:        fm.Cost,
+		Size:        fm.Size,
+		Directory:   fm.Directory,
+		Filename:    fm.Filename,
+		Description: fm.Description,
+		Hash:        fm.Hash,
+		Signature:   fm.Signature,
+		Attributes:  fm.Attributes,
+	}
+
+	res.Manifest = make([]*types.FileManifest, len(fm.Manifest))
 	for i, m := range fm.Manifest {
-		ntfn.FileMetadata.Manifest[i] = &types.FileManifest{
+		res.Manifest[i] = &types.FileManifest{
 			Index: m.Index,
 			Hash:  m.Hash,
 			Size:  m.Size,
 		}
 	}
 
+	if len(fm.Files) > 0 {
+		res.Files = make([]*types.BundleFile, len(fm.Files))
+		for i, f := range fm.Files {
+			res.Files[i] = &types.BundleFile{
+				Path: f.Path,
+				Size: f.Size,
+				Hash: f.Hash,
+			}
+		}
+	}
+	return res
+}
+
+func (cs *contentServer) fileDownloadCompletedHandler(ru *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
+	ntfn := &types.DownloadCompletedResponse{
+		Uid:          ru.ID().Bytes(),
+		Nick:         ru.Nick(),
+		DiskPath:     diskPath,
+		FileMetadata: marshalFileMetadata(&fm),
+	}
+
 	cs.completedStreams.send(ntfn)
 }
 
@@ -69,11 +110,332 @@ func (cs *contentServer) AckDownloadCompleted(ctx context.Context, req *types.Ac
 	return cs.completedStreams.ack(req.SequenceId)
 }
 
+func (cs *contentServer) ShareDir(ctx context.Context, req *types.ShareDirRequest, res *types.ShareDirResponse) error {
+	if req.Dir == "" {
+		return fmt.Errorf("dir cannot be empty")
+	}
+
+	var uid *clientintf.UserID
+	if len(req.Uid) > 0 {
+		uid = new(clientintf.UserID)
+		if err := uid.FromBytes(req.Uid); err != nil {
+			return err
+		}
+	}
+
+	sf, md, err := cs.c.ShareDir(req.Dir, uid, req.Cost, req.Description)
+	if err != nil {
+		return err
+	}
+	res.FileId = sf.FID.Bytes()
+	res.FileMetadata = marshalFileMetadata(&md)
+	return nil
+}
+
+func (cs *contentServer) SearchContent(ctx context.Context, req *types.SearchContentRequest, res *types.SearchContentResponse) error {
+	uids := make([]clientintf.UserID, len(req.Uids))
+	for i := range req.Uids {
+		if err := uids[i].FromBytes(req.Uids[i]); err != nil {
+			return err
+		}
+	}
+	return cs.c.SearchContent(req.Query, uids)
+}
+
+func (cs *contentServer) ContentSearchResults(ctx context.Context, req *types.ContentSearchResultsRequest, res *types.ContentSearchResultsResponse) error {
+	search, err := cs.c.ContentSearchResults(req.Query)
+	if err != nil {
+		return err
+	}
+
+	res.Query = search.Query
+	res.Started = search.Started.Unix()
+	res.Updated = search.Updated.Unix()
+	res.Queried = uint32(search.Queried)
+	res.Replied = uint32(search.Replied)
+	res.Results = make([]*types.ContentSearchResult, len(search.Results))
+	for i, r := range search.Results {
+		sellers := make([]*types.ContentSearchSeller, len(r.Sellers))
+		for j, s := range r.Sellers {
+			sellers[j] = &types.ContentSearchSeller{
+				Uid:      s.UID.Bytes(),
+				Nick:     s.Nick,
+				FileId:   s.FID.Bytes(),
+				Filename: s.Filename,
+				Cost:     s.Cost,
+			}
+		}
+		res.Results[i] = &types.ContentSearchResult{
+			Hash:        r.Hash,
+			Filename:    r.Filename,
+			Description: r.Description,
+			Size:        r.Size,
+			Sellers:     sellers,
+		}
+	}
+	return nil
+}
+
+// parseOptionalUID parses an optional user ID. It returns nil if b is empty.
+func parseOptionalUID(b []byte) (*clientintf.UserID, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	uid := new(clientintf.UserID)
+	if err := uid.FromBytes(b); err != nil {
+		return nil, err
+	}
+	return uid, nil
+}
+
+func (cs *contentServer) ShareFile(_ context.Context, req *types.ShareFileRequest, res *types.ShareFileResponse) error {
+	if req.Filename == "" {
+		return fmt.Errorf("filename cannot be empty")
+	}
+	uid, err := parseOptionalUID(req.Uid)
+	if err != nil {
+		return err
+	}
+
+	sf, md, err := cs.c.ShareFile(req.Filename, uid, req.Cost, req.Description)
+	if err != nil {
+		return err
+	}
+	res.FileId = sf.FID.Bytes()
+	res.FileMetadata = marshalFileMetadata(&md)
+	return nil
+}
+
+func (cs *contentServer) UnshareFile(_ context.Context, req *types.UnshareFileRequest, _ *types.UnshareFileResponse) error {
+	var fid clientdb.FileID
+	if err := fid.FromBytes(req.FileId); err != nil {
+		return err
+	}
+	uid, err := parseOptionalUID(req.Uid)
+	if err != nil {
+		return err
+	}
+	return cs.c.UnshareFile(fid, uid)
+}
+
+func (cs *contentServer) ListLocalSharedFiles(_ context.Context, _ *types.ListLocalSharedFilesRequest, res *types.ListLocalSharedFilesResponse) error {
+	files, err := cs.c.ListLocalSharedFiles()
+	if err != nil {
+		return err
+	}
+	res.Files = make([]*types.SharedFile, len(files))
+	for i, f := range files {
+		shares := make([][]byte, len(f.Shares))
+		for j := range f.Shares {
+			shares[j] = f.Shares[j].Bytes()
+		}
+		res.Files[i] = &types.SharedFile{
+			FileId:   f.SF.FID.Bytes(),
+			FileHash: f.SF.FileHash.Bytes(),
+			Filename: f.SF.Filename,
+			Cost:     f.Cost,
+			Size:     f.Size,
+			Global:   f.Global,
+			Shares:   shares,
+		}
+	}
+	return nil
+}
+
+func (cs *contentServer) ListUserContent(ctx context.Context, req *types.ListUserContentRequest, res *types.ListUserContentResponse) error {
+	var uid clientintf.UserID
+	if err := uid.FromBytes(req.Uid); err != nil {
+		return err
+	}
+	dirs := req.Dirs
+	if len(dirs) == 0 {
+		dirs = []string{"*"}
+	}
+
+	// Register to receive the reply before sending the request.
+	replyChan := make(chan contentListReply, 1)
+	cs.listMtx.Lock()
+	cs.listWaiters[uid] = append(cs.listWaiters[uid], replyChan)
+	cs.listMtx.Unlock()
+	defer func() {
+		cs.listMtx.Lock()
+		waiters := cs.listWaiters[uid]
+		for i := range waiters {
+			if waiters[i] == replyChan {
+				waiters = append(waiters[:i], waiters[i+1:]...)
+				break
+			}
+		}
+		if len(waiters) == 0 {
+			delete(cs.listWaiters, uid)
+		} else {
+			cs.listWaiters[uid] = waiters
+		}
+		cs.listMtx.Unlock()
+	}()
+
+	if err := cs.c.ListUserContent(uid, dirs, req.Filter); err != nil {
+		return err
+	}
+
+	var reply contentListReply
+	select {
+	case reply = <-replyChan:
+	case <-time.After(listUserContentTimeout):
+		return fmt.Errorf("timeout waiting for content list from user %s", uid)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if reply.err != nil {
+		return reply.err
+	}
+
+	res.Files = make([]*types.UserContentFile, len(reply.files))
+	for i := range reply.files {
+		f := &reply.files[i]
+		res.Files[i] = &types.UserContentFile{
+			FileId:       f.FID.Bytes(),
+			FileMetadata: marshalFileMetadata(&f.Metadata),
+			DiskPath:     f.DiskPath,
+		}
+	}
+	return nil
+}
+
+func (cs *contentServer) GetUserContent(_ context.Context, req *types.GetUserContentRequest, _ *types.GetUserContentResponse) error {
+	var uid clientintf.UserID
+	if err := uid.FromBytes(req.Uid); err != nil {
+		return err
+	}
+	var fid clientdb.FileID
+	if err := fid.FromBytes(req.FileId); err != nil {
+		return err
+	}
+	return cs.c.GetUserContent(uid, fid)
+}
+
+func (cs *contentServer) ListDownloads(_ context.Context, _ *types.ListDownloadsRequest, res *types.ListDownloadsResponse) error {
+	fds, err := cs.c.ListDownloads()
+	if err != nil {
+		return err
+	}
+	res.Downloads = make([]*types.FileDownload, len(fds))
+	for i := range fds {
+		fd := &fds[i]
+		dl := &types.FileDownload{
+			Uid:        fd.UID.Bytes(),
+			FileId:     fd.FID.Bytes(),
+			DiskPath:   fd.DiskPath,
+			IsSentFile: fd.IsSentFile,
+		}
+		if fd.Metadata != nil {
+			dl.FileMetadata = marshalFileMetadata(fd.Metadata)
+			dl.NbChunks = uint32(len(fd.Metadata.Manifest))
+		}
+		if fd.DiskPath != "" {
+			dl.NbDownloadedChunks = dl.NbChunks
+		} else {
+			for _, state := range fd.ChunkStates {
+				if state == clientdb.ChunkStateDownloaded {
+					dl.NbDownloadedChunks++
+				}
+			}
+		}
+		res.Downloads[i] = dl
+	}
+	return nil
+}
+
+func (cs *contentServer) CancelDownload(_ context.Context, req *types.CancelDownloadRequest, _ *types.CancelDownloadResponse) error {
+	var fid clientdb.FileID
+	if err := fid.FromBytes(req.FileId); err != nil {
+		return err
+	}
+	return cs.c.CancelDownload(fid)
+}
+
+func (cs *contentServer) TransfersProgressStream(ctx context.Context, _ *types.TransfersProgressStreamRequest, stream types.ContentService_TransfersProgressStreamServer) error {
+	ch := make(chan *types.TransferProgress, 100)
+	cs.progressMtx.Lock()
+	cs.progressSubs[ch] = struct{}{}
+	cs.progressMtx.Unlock()
+	defer func() {
+		cs.progressMtx.Lock()
+		delete(cs.progressSubs, ch)
+		cs.progressMtx.Unlock()
+	}()
+
+	for {
+		select {
+		case ntfn := <-ch:
+			if err := stream.Send(ntfn); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// sendProgress sends the progress event to the running progress streams.
+// Events are dropped for streams that are not keeping up.
+func (cs *contentServer) sendProgress(ntfn *types.TransferProgress) {
+	cs.progressMtx.Lock()
+	for ch := range cs.progressSubs {
+		select {
+		case ch <- ntfn:
+		default:
+			cs.log.Debugf("Dropping transfer progress event of file %x",
+				ntfn.FileId)
+		}
+	}
+	cs.progressMtx.Unlock()
+}
+
+func (cs *contentServer) contentListHandler(ru *client.RemoteUser, files []clientdb.RemoteFile, listErr error) {
+	cs.listMtx.Lock()
+	waiters := cs.listWaiters[ru.ID()]
+	delete(cs.listWaiters, ru.ID())
+	cs.listMtx.Unlock()
+
+	reply := contentListReply{files: files, err: listErr}
+	for _, ch := range waiters {
+		ch <- reply
+	}
+}
+
+func (cs *contentServer) downloadProgressHandler(ru *client.RemoteUser, fm rpc.FileMetadata, nbMissingChunks int) {
+	fid := fm.MetadataHash()
+	cs.sendProgress(&types.TransferProgress{
+		Direction:       types.TransferDirection_TRANSFER_DOWNLOAD,
+		Uid:             ru.ID().Bytes(),
+		Nick:            ru.Nick(),
+		FileId:          fid[:],
+		Filename:        fm.Filename,
+		NbChunks:        uint32(len(fm.Manifest)),
+		NbMissingChunks: uint32(nbMissingChunks),
+	})
+}
+
+func (cs *contentServer) chunkUploadedHandler(ru *client.RemoteUser, sf clientdb.SharedFile, chunkIdx int) {
+	cs.sendProgress(&types.TransferProgress{
+		Direction:  types.TransferDirection_TRANSFER_UPLOAD,
+		Uid:        ru.ID().Bytes(),
+		Nick:       ru.Nick(),
+		FileId:     sf.FID.Bytes(),
+		Filename:   sf.Filename,
+		ChunkIndex: uint32(chunkIdx),
+	})
+}
+
 // registerOfflineMessageStorageHandlers registers the handlers for streams on
 // the client's notification manager.
 func (cs *contentServer) registerOfflineMessageStorageHandlers() {
 	nmgr := cs.c.NotificationManager()
 	nmgr.RegisterSync(client.OnFileDownloadCompleted(cs.fileDownloadCompletedHandler))
+	nmgr.RegisterSync(client.OnContentListReceived(cs.contentListHandler))
+	nmgr.RegisterSync(client.OnFileDownloadProgress(cs.downloadProgressHandler))
+	nmgr.RegisterSync(client.OnFileChunkUploaded(cs.chunkUploadedHandler))
 }
 
 var _ types.ContentServiceServer = (*contentServer)(nil)
@@ -92,8 +454,10 @@ func (s *Server) InitContentService(cfg ContentServerCfg) error {
 		c:   cfg.Client,
 
 		completedStreams: completedStreams,
+		listWaiters:      make(map[clientintf.UserID][]chan contentListReply),
+		progressSubs:     make(map[chan *types.TransferProgress]struct{}),
 	}
 	ps.registerOfflineMessageStorageHandlers()
-	s.services.Bind("ContentService", types.ContentServiceDefn(), ps)
+	s.bindService("ContentService", types.ContentServiceDefn(), ps)
 	return nil
 }
@@ -2,6 +2,7 @@ package rpcserver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientintf"
@@ -93,6 +94,7 @@ func marshalRMGroupList(gl *rpc.RMGroupList, res *types.RMGroupList) *types.RMGr
 		Version:     uint32(gl.Version),
 		Members:     marshalRepeatedIDs(gl.Members, res.Members),
 		ExtraAdmins: marshalRepeatedIDs(gl.ExtraAdmins, res.ExtraAdmins),
+		Channel:     gl.Channel,
 	}
 	return res
 }
@@ -125,6 +127,7 @@ func (g *gcServer) List(_ context.Context, _ *types.ListGCsRequest, res *types.L
 			Version:   uint32(gc.Metadata.Version),
 			Timestamp: gc.Metadata.Timestamp,
 			NbMembers: uint32(len(gc.Metadata.Members)),
+			Channel:   gc.Metadata.Channel,
 		}
 		res.Gcs = append(res.Gcs, gci)
 	}
@@ -242,6 +245,75 @@ func (g *gcServer) AckJoinedGCs(_ context.Context, req *types.AckRequest, _ *typ
 	return g.joinedStreams.ack(req.SequenceId)
 }
 
+func (g *gcServer) CreateChannel(_ context.Context, req *types.CreateChannelRequest, res *types.CreateChannelResponse) error {
+	id, err := g.c.NewChannel(req.Name)
+	if err != nil {
+		return err
+	}
+	res.Id = id[:]
+	return nil
+}
+
+func (g *gcServer) ChannelShareInvite(_ context.Context, req *types.ChannelShareInviteRequest, res *types.ChannelShareInviteResponse) error {
+	gcid, err := g.c.GCIDByName(req.Gc)
+	if err != nil {
+		return err
+	}
+	invite, err := g.c.ChannelShareInvite(gcid, req.Regen)
+	if err != nil {
+		return err
+	}
+	res.Invite = invite.String()
+	return nil
+}
+
+func (g *gcServer) JoinChannel(_ context.Context, req *types.JoinChannelRequest, _ *types.JoinChannelResponse) error {
+	invite, err := client.ParseChannelInvite(req.Invite)
+	if err != nil {
+		return err
+	}
+	return g.c.JoinChannel(invite)
+}
+
+func (g *gcServer) EstimateGCMCost(_ context.Context, req *types.EstimateGCMCostRequest, res *types.EstimateGCMCostResponse) error {
+	gcid, err := g.c.GCIDByName(req.Gc)
+	if err != nil {
+		return err
+	}
+	cost, nbDests, err := g.c.EstimateGCMCost(gcid, req.Msg)
+	if err != nil {
+		return err
+	}
+	res.CostMatoms = cost
+	res.NbDests = uint32(nbDests)
+	return nil
+}
+
+func (g *gcServer) PublishToChannel(_ context.Context, req *types.PublishToChannelRequest, res *types.PublishToChannelResponse) error {
+	gcid, err := g.c.GCIDByName(req.Gc)
+	if err != nil {
+		return err
+	}
+	gc, err := g.c.GetGC(gcid)
+	if err != nil {
+		return err
+	}
+	if !gc.Channel {
+		return fmt.Errorf("GC %s is not a channel", gcid)
+	}
+	cost, nbDests, err := g.c.EstimateGCMCost(gcid, req.Msg)
+	if err != nil {
+		return err
+	}
+	if req.MaxCostMatoms > 0 && cost > req.MaxCostMatoms {
+		return fmt.Errorf("estimated cost %d matoms is higher than "+
+			"max cost %d matoms", cost, req.MaxCostMatoms)
+	}
+	res.CostMatoms = cost
+	res.NbDests = uint32(nbDests)
+	return g.c.GCMessage(gcid, req.Msg, rpc.MessageModeNormal, nil)
+}
+
 func (g *gcServer) registerOfflineMessageStorageHandlers() {
 	nmgr := g.c.NotificationManager()
 	nmgr.RegisterSync(client.OnInvitedToGCNtfn(g.invitedToGCNtfnHandler))
@@ -285,7 +357,7 @@ func (s *Server) InitGCService(cfg GCServerCfg) error {
 		mremovedStreams: mremovedStreams,
 		joinedStreams:   joinedStreams,
 	}
-	s.services.Bind("GCService", types.GCServiceDefn(), gcs)
+	s.bindService("GCService", types.GCServiceDefn(), gcs)
 	gcs.registerOfflineMessageStorageHandlers()
 	return nil
 }
@@ -0,0 +1,488 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/slog"
+)
+
+// errLNNotConfigured is returned by LNService calls when the server was not
+// configured with an LN payment client.
+var errLNNotConfigured = errors.New("LN client not configured")
+
+// LNServerCfg is the configuration for a new [types.LNServiceServer]
+// deployment.
+type LNServerCfg struct {
+	// PayClient should be set to the client's LN payment client. If nil,
+	// every call of the service fails.
+	PayClient *client.DcrlnPaymentClient
+
+	// Log should be set to the app's logger.
+	Log slog.Logger
+}
+
+type lnServer struct {
+	cfg LNServerCfg
+	log slog.Logger
+	pc  *client.DcrlnPaymentClient
+}
+
+// lnRPC returns the LN client of the server.
+func (l *lnServer) lnRPC() (lnrpc.LightningClient, error) {
+	if l.pc == nil {
+		return nil, errLNNotConfigured
+	}
+	return l.pc.LNRPC(), nil
+}
+
+// chanPointFromStr converts a txid:index string to a channel point.
+func chanPointFromStr(s string) (*lnrpc.ChannelPoint, error) {
+	txid, idx, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("channel point does not have output index")
+	}
+	if _, err := chainhash.NewHashFromStr(txid); err != nil {
+		return nil, fmt.Errorf("invalid channel point txid: %v", err)
+	}
+	var outIdx uint32
+	if _, err := fmt.Sscanf(idx, "%d", &outIdx); err != nil {
+		return nil, fmt.Errorf("invalid channel point output index: %v", err)
+	}
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{FundingTxidStr: txid},
+		OutputIndex: outIdx,
+	}, nil
+}
+
+// chanPointToStr converts a channel point to a txid:index string.
+func chanPointToStr(cp *lnrpc.ChannelPoint) string {
+	txid, err := lnrpc.GetChanPointFundingTxid(cp)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", txid, cp.OutputIndex)
+}
+
+func marshalLNChannel(c *lnrpc.Channel) *types.LNChannel {
+	return &types.LNChannel{
+		ChannelPoint:  c.ChannelPoint,
+		RemoteNodeId:  c.RemotePubkey,
+		CapacityAtoms: c.Capacity,
+		LocalAtoms:    c.LocalBalance,
+		RemoteAtoms:   c.RemoteBalance,
+		Active:        c.Active,
+		ChanId:        c.ChanId,
+	}
+}
+
+func marshalLNPendingChannel(c *lnrpc.PendingChannelsResponse_PendingChannel) *types.LNChannel {
+	return &types.LNChannel{
+		ChannelPoint:  c.ChannelPoint,
+		RemoteNodeId:  c.RemoteNodePub,
+		CapacityAtoms: c.Capacity,
+		LocalAtoms:    c.LocalBalance,
+		RemoteAtoms:   c.RemoteBalance,
+		Pending:       true,
+	}
+}
+
+func marshalLNInvoice(inv *lnrpc.Invoice) *types.LNInvoice {
+	return &types.LNInvoice{
+		Invoice:      inv.PaymentRequest,
+		PaymentHash:  inv.RHash,
+		Memo:         inv.Memo,
+		AmountMatoms: inv.ValueMAtoms,
+		PaidMatoms:   inv.AmtPaidMAtoms,
+		Settled:      inv.State == lnrpc.Invoice_SETTLED,
+		CreationDate: inv.CreationDate,
+		SettleDate:   inv.SettleDate,
+		AddIndex:     inv.AddIndex,
+		SettleIndex:  inv.SettleIndex,
+	}
+}
+
+func (l *lnServer) GetInfo(ctx context.Context, _ *types.LNGetInfoRequest, res *types.LNGetInfoResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	info, err := lc.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+	*res = types.LNGetInfoResponse{
+		NodeId:              info.IdentityPubkey,
+		Version:             info.Version,
+		NumActiveChannels:   info.NumActiveChannels,
+		NumInactiveChannels: info.NumInactiveChannels,
+		NumPendingChannels:  info.NumPendingChannels,
+		NumPeers:            info.NumPeers,
+		BlockHeight:         info.BlockHeight,
+		BlockHash:           info.BlockHash,
+		SyncedToChain:       info.SyncedToChain,
+		SyncedToGraph:       info.SyncedToGraph,
+	}
+	return nil
+}
+
+func (l *lnServer) Balances(ctx context.Context, _ *types.LNBalancesRequest, res *types.LNBalancesResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	chanBal, err := lc.ChannelBalance(ctx, &lnrpc.ChannelBalanceRequest{})
+	if err != nil {
+		return err
+	}
+	walletBal, err := lc.WalletBalance(ctx, &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return err
+	}
+	*res = types.LNBalancesResponse{
+		MaxInboundAtoms:        chanBal.MaxInboundAmount,
+		MaxOutboundAtoms:       chanBal.MaxOutboundAmount,
+		WalletConfirmedAtoms:   walletBal.ConfirmedBalance,
+		WalletUnconfirmedAtoms: walletBal.UnconfirmedBalance,
+	}
+	if chanBal.LocalBalance != nil {
+		res.ChannelLocalAtoms = int64(chanBal.LocalBalance.Atoms)
+	}
+	if chanBal.RemoteBalance != nil {
+		res.ChannelRemoteAtoms = int64(chanBal.RemoteBalance.Atoms)
+	}
+	return nil
+}
+
+func (l *lnServer) ListChannels(ctx context.Context, _ *types.LNListChannelsRequest, res *types.LNListChannelsResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	chans, err := lc.ListChannels(ctx, &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return err
+	}
+	pending, err := lc.PendingChannels(ctx, &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		return err
+	}
+
+	res.Channels = make([]*types.LNChannel, len(chans.Channels))
+	for i, c := range chans.Channels {
+		res.Channels[i] = marshalLNChannel(c)
+	}
+	for _, c := range pending.PendingOpenChannels {
+		res.PendingOpen = append(res.PendingOpen, marshalLNPendingChannel(c.Channel))
+	}
+	for _, c := range pending.WaitingCloseChannels {
+		res.PendingClose = append(res.PendingClose, marshalLNPendingChannel(c.Channel))
+	}
+	for _, c := range pending.PendingForceClosingChannels {
+		res.PendingClose = append(res.PendingClose, marshalLNPendingChannel(c.Channel))
+	}
+	return nil
+}
+
+func (l *lnServer) OpenChannel(ctx context.Context, req *types.LNOpenChannelRequest, res *types.LNOpenChannelResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	npk, err := hex.DecodeString(req.NodeId)
+	if err != nil {
+		return fmt.Errorf("unable to decode node id: %w", err)
+	}
+	if req.FundingAtoms <= 0 {
+		return fmt.Errorf("funding amount must be positive")
+	}
+
+	if req.Host != "" {
+		cpr := &lnrpc.ConnectPeerRequest{
+			Addr: &lnrpc.LightningAddress{
+				Pubkey: req.NodeId,
+				Host:   req.Host,
+			},
+		}
+		_, err := lc.ConnectPeer(ctx, cpr)
+		if err != nil && !strings.Contains(err.Error(), "already connected") {
+			return err
+		}
+	}
+
+	ocr := &lnrpc.OpenChannelRequest{
+		NodePubkey:         npk,
+		LocalFundingAmount: req.FundingAtoms,
+		PushAtoms:          req.PushAtoms,
+	}
+	cp, err := lc.OpenChannelSync(ctx, ocr)
+	if err != nil {
+		return err
+	}
+	res.ChannelPoint = chanPointToStr(cp)
+	l.log.Infof("Opening LN channel %s with %s via clientrpc",
+		res.ChannelPoint, req.NodeId)
+	return nil
+}
+
+func (l *lnServer) CloseChannel(ctx context.Context, req *types.LNCloseChannelRequest, res *types.LNCloseChannelResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	cp, err := chanPointFromStr(req.ChannelPoint)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := lc.CloseChannel(ctx, &lnrpc.CloseChannelRequest{
+		ChannelPoint: cp,
+		Force:        req.Force,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		updt, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		var txid []byte
+		switch updt := updt.Update.(type) {
+		case *lnrpc.CloseStatusUpdate_ClosePending:
+			txid = updt.ClosePending.Txid
+		case *lnrpc.CloseStatusUpdate_ChanClose:
+			txid = updt.ChanClose.ClosingTxid
+		default:
+			continue
+		}
+		tx, err := chainhash.NewHash(txid)
+		if err != nil {
+			return err
+		}
+		res.ClosingTxid = tx.String()
+		l.log.Infof("Closing LN channel %s via clientrpc on tx %s",
+			req.ChannelPoint, tx)
+		return nil
+	}
+}
+
+func (l *lnServer) NewAddress(ctx context.Context, req *types.LNNewAddressRequest, res *types.LNNewAddressResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	na, err := lc.NewAddress(ctx, &lnrpc.NewAddressRequest{
+		Type:    lnrpc.AddressType_PUBKEY_HASH,
+		Account: req.Account,
+	})
+	if err != nil {
+		return err
+	}
+	res.Address = na.Address
+	return nil
+}
+
+func (l *lnServer) CreateInvoice(ctx context.Context, req *types.LNCreateInvoiceRequest, res *types.LNCreateInvoiceResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	if req.AmountMatoms < 0 {
+		return fmt.Errorf("invoice amount cannot be negative")
+	}
+	inv, err := lc.AddInvoice(ctx, &lnrpc.Invoice{
+		Memo:        req.Memo,
+		ValueMAtoms: req.AmountMatoms,
+		Expiry:      req.ExpirySeconds,
+	})
+	if err != nil {
+		return err
+	}
+	*res = types.LNCreateInvoiceResponse{
+		Invoice:     inv.PaymentRequest,
+		PaymentHash: inv.RHash,
+		AddIndex:    inv.AddIndex,
+	}
+	return nil
+}
+
+func (l *lnServer) DecodeInvoice(ctx context.Context, req *types.LNDecodeInvoiceRequest, res *types.LNDecodeInvoiceResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	payReq, err := lc.DecodePayReq(ctx, &lnrpc.PayReqString{PayReq: req.Invoice})
+	if err != nil {
+		return err
+	}
+	*res = types.LNDecodeInvoiceResponse{
+		Destination:   payReq.Destination,
+		PaymentHash:   payReq.PaymentHash,
+		AmountMatoms:  payReq.NumMAtoms,
+		Timestamp:     payReq.Timestamp,
+		ExpirySeconds: payReq.Expiry,
+		Memo:          payReq.Description,
+	}
+	return nil
+}
+
+func (l *lnServer) PayInvoice(ctx context.Context, req *types.LNPayInvoiceRequest, res *types.LNPayInvoiceResponse) error {
+	if l.pc == nil {
+		return errLNNotConfigured
+	}
+	var fees int64
+	var err error
+	if req.AmountMatoms > 0 {
+		fees, err = l.pc.PayInvoiceAmount(ctx, req.Invoice, req.AmountMatoms)
+	} else {
+		fees, err = l.pc.PayInvoice(ctx, req.Invoice)
+	}
+	if err != nil {
+		return err
+	}
+	res.FeesMatoms = fees
+	return nil
+}
+
+func (l *lnServer) SendOnChain(ctx context.Context, req *types.LNSendOnChainRequest, res *types.LNSendOnChainResponse) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	if req.AmountAtoms <= 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	sent, err := lc.SendCoins(ctx, &lnrpc.SendCoinsRequest{
+		Addr:    req.Address,
+		Amount:  req.AmountAtoms,
+		Account: req.Account,
+	})
+	if err != nil {
+		return err
+	}
+	res.Txid = sent.Txid
+	l.log.Infof("Sent %d atoms on-chain to %s via clientrpc on tx %s",
+		req.AmountAtoms, req.Address, sent.Txid)
+	return nil
+}
+
+func (l *lnServer) ChannelEventsStream(ctx context.Context, _ *types.LNChannelEventsStreamRequest, stream types.LNService_ChannelEventsStreamServer) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	events, err := lc.SubscribeChannelEvents(ctx, &lnrpc.ChannelEventSubscription{})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := events.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		var ntfn *types.LNChannelEvent
+		switch event := event.Channel.(type) {
+		case *lnrpc.ChannelEventUpdate_PendingOpenChannel:
+			tx, err := chainhash.NewHash(event.PendingOpenChannel.Txid)
+			if err != nil {
+				return err
+			}
+			ntfn = &types.LNChannelEvent{
+				Type: types.LNChannelEventType_LN_CHANNEL_EVENT_PENDING_OPEN,
+				ChannelPoint: fmt.Sprintf("%s:%d", tx,
+					event.PendingOpenChannel.OutputIndex),
+			}
+		case *lnrpc.ChannelEventUpdate_OpenChannel:
+			ntfn = &types.LNChannelEvent{
+				Type:         types.LNChannelEventType_LN_CHANNEL_EVENT_OPEN,
+				ChannelPoint: event.OpenChannel.ChannelPoint,
+				Channel:      marshalLNChannel(event.OpenChannel),
+			}
+		case *lnrpc.ChannelEventUpdate_ClosedChannel:
+			ntfn = &types.LNChannelEvent{
+				Type:         types.LNChannelEventType_LN_CHANNEL_EVENT_CLOSED,
+				ChannelPoint: event.ClosedChannel.ChannelPoint,
+				ClosingTxid:  event.ClosedChannel.ClosingTxHash,
+			}
+		case *lnrpc.ChannelEventUpdate_ActiveChannel:
+			ntfn = &types.LNChannelEvent{
+				Type:         types.LNChannelEventType_LN_CHANNEL_EVENT_ACTIVE,
+				ChannelPoint: chanPointToStr(event.ActiveChannel),
+			}
+		case *lnrpc.ChannelEventUpdate_InactiveChannel:
+			ntfn = &types.LNChannelEvent{
+				Type:         types.LNChannelEventType_LN_CHANNEL_EVENT_INACTIVE,
+				ChannelPoint: chanPointToStr(event.InactiveChannel),
+			}
+		case *lnrpc.ChannelEventUpdate_FullyResolvedChannel:
+			ntfn = &types.LNChannelEvent{
+				Type:         types.LNChannelEventType_LN_CHANNEL_EVENT_FULLY_RESOLVED,
+				ChannelPoint: chanPointToStr(event.FullyResolvedChannel),
+			}
+		default:
+			continue
+		}
+
+		if err := stream.Send(ntfn); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *lnServer) InvoicesStream(ctx context.Context, req *types.LNInvoicesStreamRequest, stream types.LNService_InvoicesStreamServer) error {
+	lc, err := l.lnRPC()
+	if err != nil {
+		return err
+	}
+	invoices, err := lc.SubscribeInvoices(ctx, &lnrpc.InvoiceSubscription{
+		AddIndex:    req.AddIndex,
+		SettleIndex: req.SettleIndex,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		inv, err := invoices.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if err := stream.Send(marshalLNInvoice(inv)); err != nil {
+			return err
+		}
+	}
+}
+
+var _ types.LNServiceServer = (*lnServer)(nil)
+
+// InitLNService initializes and binds an LNService server to the RPC server.
+func (s *Server) InitLNService(cfg LNServerCfg) error {
+	log := slog.Disabled
+	if cfg.Log != nil {
+		log = cfg.Log
+	}
+
+	ls := &lnServer{
+		cfg: cfg,
+		log: log,
+		pc:  cfg.PayClient,
+	}
+	s.bindService("LNService", types.LNServiceDefn(), ls)
+	return nil
+}
@@ -0,0 +1,204 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/internal/mediamsg"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/slog"
+)
+
+// MediaServerCfg is the configuration for a new [types.MediaServiceServer]
+// deployment.
+type MediaServerCfg struct {
+	// Client should be set to the [client.Client] instance.
+	Client *client.Client
+
+	// Log should be set to the app's logger.
+	Log slog.Logger
+
+	// RootReplayMsgLogs is the root dir where replaymsglogs are stored for
+	// supported message types.
+	RootReplayMsgLogs string
+}
+
+type mediaServer struct {
+	cfg MediaServerCfg
+	log slog.Logger
+	c   *client.Client
+
+	mediaStreams *serverStreams[*types.ReceivedMedia]
+}
+
+// marshalMediaInfo converts the media to its clientrpc representation.
+func marshalMediaInfo(m *mediamsg.Media) *types.MediaInfo {
+	info := &types.MediaInfo{
+		Filename:   m.Filename,
+		MimeType:   m.MimeType,
+		Codec:      m.Codec,
+		DurationMs: uint64(m.Duration.Milliseconds()),
+		Size:       m.Size,
+		Thumbnail:  m.Thumbnail,
+		Data:       m.Data,
+		Alt:        m.Alt,
+	}
+	if !m.FileID.IsEmpty() {
+		info.FileId = m.FileID[:]
+	}
+	return info
+}
+
+// SendMedia sends an audio or video message to a user or GC. Media sent to a
+// GC is only sent if its estimated cost is not higher than the max cost of the
+// request.
+func (ms *mediaServer) SendMedia(_ context.Context, req *types.SendMediaRequest, res *types.SendMediaResponse) error {
+	if (req.User == "") == (req.Gc == "") {
+		return fmt.Errorf("exactly one of user or gc must be specified")
+	}
+
+	info := req.Media
+	if info == nil {
+		info = &types.MediaInfo{}
+	}
+
+	var m mediamsg.Media
+	switch {
+	case req.Path != "":
+		var err error
+		if m, err = mediamsg.LoadFile(req.Path); err != nil {
+			return err
+		}
+	case info.Data != nil:
+		m = mediamsg.FromData(info.Filename, info.Data)
+	default:
+		return fmt.Errorf("either path or media data must be specified")
+	}
+
+	if info.Filename != "" {
+		m.Filename = info.Filename
+	}
+	if info.MimeType != "" {
+		m.MimeType = info.MimeType
+	}
+	if info.Codec != "" {
+		m.Codec = info.Codec
+	}
+	if info.DurationMs > 0 {
+		m.Duration = time.Duration(info.DurationMs) * time.Millisecond
+	}
+	m.Thumbnail = info.Thumbnail
+	m.Alt = info.Alt
+
+	var inline bool
+	var err error
+	if req.User != "" {
+		var ru *client.RemoteUser
+		if ru, err = ms.c.UserByNick(req.User); err != nil {
+			return err
+		}
+		inline, err = ms.c.SendMediaPM(ru.ID(), m, req.Caption)
+	} else {
+		var gcID zkidentity.ShortID
+		if gcID, err = ms.c.GCIDByName(req.Gc); err != nil {
+			return err
+		}
+		var cost uint64
+		var nbDests int
+		cost, nbDests, err = ms.c.EstimateMediaGCMCost(gcID, m, req.Caption)
+		if err != nil {
+			return err
+		}
+		if req.MaxCostMatoms > 0 && cost > req.MaxCostMatoms {
+			return fmt.Errorf("estimated cost %d matoms is higher than "+
+				"max cost %d matoms", cost, req.MaxCostMatoms)
+		}
+		res.CostMatoms = cost
+		res.NbDests = uint32(nbDests)
+		inline, err = ms.c.SendMediaGCM(gcID, m, req.Caption)
+	}
+	if err != nil {
+		return err
+	}
+	res.Inline = inline
+	return nil
+}
+
+// MediaStream returns a stream that gets media messages received by the client.
+func (ms *mediaServer) MediaStream(ctx context.Context, req *types.MediaStreamRequest, stream types.MediaService_MediaStreamServer) error {
+	return ms.mediaStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+// AckReceivedMedia acks to the server that media messages up to a sequence ID
+// have been processed.
+func (ms *mediaServer) AckReceivedMedia(_ context.Context, req *types.AckRequest, _ *types.AckResponse) error {
+	return ms.mediaStreams.ack(req.SequenceId)
+}
+
+// sendReceivedMedia sends the media embedded in a received message to the
+// media streams.
+func (ms *mediaServer) sendReceivedMedia(ru *client.RemoteUser, gcID []byte,
+	gcAlias, msg string, ts time.Time) {
+
+	for _, m := range mediamsg.ParseMessage(msg) {
+		ntfn := &types.ReceivedMedia{
+			Uid:         ru.ID().Bytes(),
+			Nick:        ru.Nick(),
+			GcId:        gcID,
+			GcAlias:     gcAlias,
+			TimestampMs: ts.UnixMilli(),
+			Message:     msg,
+			Media:       marshalMediaInfo(&m),
+		}
+		ms.mediaStreams.send(ntfn)
+	}
+}
+
+// pmNtfnHandler is called by the client when a PM arrived from a remote user.
+func (ms *mediaServer) pmNtfnHandler(ru *client.RemoteUser, p rpc.RMPrivateMessage, ts time.Time) {
+	ms.sendReceivedMedia(ru, nil, "", p.Message, ts)
+}
+
+// gcmNtfnHandler is called by the client when a GC message arrives from a
+// remote user.
+func (ms *mediaServer) gcmNtfnHandler(ru *client.RemoteUser, gcm rpc.RMGroupMessage, ts time.Time) {
+	gcalias, err := ms.c.GetGCAlias(gcm.ID)
+	if err != nil {
+		ms.log.Debugf("Skipping received GCM without group %s", gcm.ID)
+	}
+	ms.sendReceivedMedia(ru, gcm.ID[:], gcalias, gcm.Message, ts)
+}
+
+// registerOfflineMessageStorageHandlers registers the handlers for streams on
+// the client's notification manager.
+func (ms *mediaServer) registerOfflineMessageStorageHandlers() {
+	nmgr := ms.c.NotificationManager()
+	nmgr.RegisterSync(client.OnPMNtfn(ms.pmNtfnHandler))
+	nmgr.RegisterSync(client.OnGCMNtfn(ms.gcmNtfnHandler))
+}
+
+var _ types.MediaServiceServer = (*mediaServer)(nil)
+
+// InitMediaService initializes and binds a MediaService server to the RPC
+// server.
+func (s *Server) InitMediaService(cfg MediaServerCfg) error {
+	mediaStreams, err := newServerStreams[*types.ReceivedMedia](cfg.RootReplayMsgLogs, "media", cfg.Log)
+	if err != nil {
+		return err
+	}
+
+	ms := &mediaServer{
+		cfg: cfg,
+		log: cfg.Log,
+		c:   cfg.Client,
+
+		mediaStreams: mediaStreams,
+	}
+	ms.registerOfflineMessageStorageHandlers()
+	s.bindService("MediaService", types.MediaServiceDefn(), ms)
+	return nil
+}
@@ -1,9 +1,12 @@
 package rpcserver
 
 import (
+	"bytes"
 	"context"
+	"time"
 
 	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/decred/slog"
@@ -97,6 +100,76 @@ func (p *paymentsServer) AckTipReceived(_ context.Context, req *types.AckRequest
 	return p.tipStreams.ack(req.SequenceId)
 }
 
+// listPayLedger lists the payment ledger entries selected by the request.
+func (p *paymentsServer) listPayLedger(req *types.ListPayLedgerRequest) ([]clientdb.PayLedgerEntry, error) {
+	var uid *clientintf.UserID
+	if len(req.Uid) > 0 {
+		uid = new(clientintf.UserID)
+		if err := uid.FromBytes(req.Uid); err != nil {
+			return nil, err
+		}
+	}
+
+	var start, end time.Time
+	if req.StartTs > 0 {
+		start = time.Unix(req.StartTs, 0)
+	}
+	if req.EndTs > 0 {
+		end = time.Unix(req.EndTs, 0)
+	}
+	entries, err := p.c.ListPayLedger(start, end)
+	if err != nil {
+		return nil, err
+	}
+	if uid == nil {
+		return entries, nil
+	}
+
+	res := entries[:0]
+	for _, e := range entries {
+		if e.UID == *uid {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+func (p *paymentsServer) ListPayLedger(_ context.Context, req *types.ListPayLedgerRequest, res *types.ListPayLedgerResponse) error {
+	entries, err := p.listPayLedger(req)
+	if err != nil {
+		return err
+	}
+
+	res.Entries = make([]*types.PayLedgerEntry, len(entries))
+	for i, e := range entries {
+		nick, _ := p.c.UserNick(e.UID)
+		res.Entries[i] = &types.PayLedgerEntry{
+			Timestamp:    e.Timestamp.Unix(),
+			Uid:          e.UID.Bytes(),
+			Nick:         nick,
+			Event:        e.Event,
+			Purpose:      e.Purpose,
+			AmountMatoms: e.Amount,
+			PayFeeMatoms: e.PayFee,
+		}
+	}
+	return nil
+}
+
+func (p *paymentsServer) ExportPayLedgerCSV(_ context.Context, req *types.ListPayLedgerRequest, res *types.ExportPayLedgerCSVResponse) error {
+	entries, err := p.listPayLedger(req)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := p.c.WritePayLedgerCSV(&b, entries); err != nil {
+		return err
+	}
+	res.Csv = b.Bytes()
+	return nil
+}
+
 func (p *paymentsServer) registerOfflineMessageStorageHandlers() {
 	nmgr := p.c.NotificationManager()
 	nmgr.RegisterSync(client.OnTipAttemptProgressNtfn(p.tipProgressNtfnHandler))
@@ -125,6 +198,6 @@ func (s *Server) InitPaymentsService(cfg PaymentsServerCfg) error {
 		tipStreams:         tipStreams,
 	}
 	ps.registerOfflineMessageStorageHandlers()
-	s.services.Bind("PaymentsService", types.PaymentsServiceDefn(), ps)
+	s.bindService("PaymentsService", types.PaymentsServiceDefn(), ps)
 	return nil
 }
@@ -2,11 +2,14 @@ package rpcserver
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/decred/slog"
 )
@@ -32,6 +35,33 @@ type postsServer struct {
 	statusStreams *serverStreams[*types.ReceivedPostStatus]
 }
 
+// marshalPostSummary converts the post summary to its clientrpc
+// representation.
+func marshalPostSummary(summ *clientdb.PostSummary) *types.PostSummary {
+	return &types.PostSummary{
+		Id:           summ.ID[:],
+		From:         summ.From[:],
+		AuthorId:     summ.AuthorID[:],
+		AuthorNick:   summ.AuthorNick,
+		Date:         summ.Date.Unix(),
+		LastStatusTs: summ.LastStatusTS.Unix(),
+		Title:        summ.Title,
+	}
+}
+
+// parsePostRef parses the relayer and post IDs that identify a post.
+func parsePostRef(from, postID []byte) (clientintf.UserID, clientintf.PostID, error) {
+	var uid clientintf.UserID
+	var pid clientintf.PostID
+	if err := uid.FromBytes(from); err != nil {
+		return uid, pid, fmt.Errorf("invalid from: %v", err)
+	}
+	if err := pid.FromBytes(postID); err != nil {
+		return uid, pid, fmt.Errorf("invalid post_id: %v", err)
+	}
+	return uid, pid, nil
+}
+
 func (p *postsServer) SubscribeToPosts(_ context.Context, req *types.SubscribeToPostsRequest, _ *types.SubscribeToPostsResponse) error {
 	user, err := p.c.UserByNick(req.User)
 	if err != nil {
@@ -59,15 +89,7 @@ func (p *postsServer) postsNtfnHandler(ru *client.RemoteUser, summ clientdb.Post
 	}
 	ntfn := &types.ReceivedPost{
 		RelayerId: relayerID,
-		Summary: &types.PostSummary{
-			Id:           summ.ID[:],
-			From:         summ.From[:],
-			AuthorId:     summ.AuthorID[:],
-			AuthorNick:   summ.AuthorNick,
-			Date:         summ.Date.Unix(),
-			LastStatusTs: summ.LastStatusTS.Unix(),
-			Title:        summ.Title,
-		},
+		Summary:   marshalPostSummary(&summ),
 		Post: &types.PostMetadata{
 			Version:    pm.Version,
 			Attributes: pm.Attributes,
@@ -126,6 +148,105 @@ func (p *postsServer) AckReceivedPostStatus(_ context.Context, req *types.AckReq
 	return p.statusStreams.ack(req.SequenceId)
 }
 
+func (p *postsServer) CreatePost(_ context.Context, req *types.CreatePostRequest, res *types.CreatePostResponse) error {
+	post := req.Content
+	for _, embed := range req.Embeds {
+		// The free-form fields are escaped by the embed so that they
+		// do not break its syntax.
+		args := mdembeds.EmbeddedArgs{
+			Name: embed.Name,
+			Alt:  embed.Alt,
+			Typ:  embed.Type,
+			Data: embed.Data,
+		}
+		post += "\n" + args.String()
+	}
+	if strings.TrimSpace(post) == "" {
+		return fmt.Errorf("post cannot be empty")
+	}
+
+	summ, err := p.c.CreatePost(post, req.Description)
+	if err != nil {
+		return err
+	}
+	res.Summary = marshalPostSummary(&summ)
+	return nil
+}
+
+func (p *postsServer) ListPosts(_ context.Context, _ *types.ListPostsRequest, res *types.ListPostsResponse) error {
+	posts, err := p.c.ListPosts()
+	if err != nil {
+		return err
+	}
+	res.Posts = make([]*types.PostSummary, len(posts))
+	for i := range posts {
+		res.Posts[i] = marshalPostSummary(&posts[i])
+	}
+	return nil
+}
+
+func (p *postsServer) ReadPost(_ context.Context, req *types.ReadPostRequest, res *types.ReadPostResponse) error {
+	uid, pid, err := parsePostRef(req.From, req.PostId)
+	if err != nil {
+		return err
+	}
+	pm, err := p.c.ReadPost(uid, pid)
+	if err != nil {
+		return err
+	}
+	res.Post = &types.PostMetadata{
+		Version:    pm.Version,
+		Attributes: pm.Attributes,
+	}
+	return nil
+}
+
+func (p *postsServer) CommentPost(_ context.Context, req *types.CommentPostRequest, res *types.CommentPostResponse) error {
+	uid, pid, err := parsePostRef(req.From, req.PostId)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(req.Comment) == "" {
+		return fmt.Errorf("comment cannot be empty")
+	}
+	var parent *clientintf.ID
+	if len(req.Parent) > 0 {
+		parent = new(clientintf.ID)
+		if err := parent.FromBytes(req.Parent); err != nil {
+			return fmt.Errorf("invalid parent: %v", err)
+		}
+	}
+	id, err := p.c.CommentPost(uid, pid, req.Comment, parent)
+	if err != nil {
+		return err
+	}
+	res.CommentId = id[:]
+	return nil
+}
+
+func (p *postsServer) HeartPost(_ context.Context, req *types.HeartPostRequest, _ *types.HeartPostResponse) error {
+	uid, pid, err := parsePostRef(req.From, req.PostId)
+	if err != nil {
+		return err
+	}
+	return p.c.HeartPost(uid, pid, req.Heart)
+}
+
+func (p *postsServer) RelayPost(_ context.Context, req *types.RelayPostRequest, _ *types.RelayPostResponse) error {
+	uid, pid, err := parsePostRef(req.From, req.PostId)
+	if err != nil {
+		return err
+	}
+	if req.ToUser == "" {
+		return p.c.RelayPostToSubscribers(uid, pid)
+	}
+	toUID, err := p.c.UIDByNick(req.ToUser)
+	if err != nil {
+		return err
+	}
+	return p.c.RelayPost(uid, pid, toUID)
+}
+
 // registerOfflineMessageStorageHandlers registers the handlers for streams on
 // the client's notification manager.
 func (p *postsServer) registerOfflineMessageStorageHandlers() {
@@ -158,6 +279,6 @@ func (s *Server) InitPostsService(cfg PostsServerCfg) error {
 		statusStreams: statusStreams,
 	}
 	ps.registerOfflineMessageStorageHandlers()
-	s.services.Bind("PostsService", types.PostsServiceDefn(), ps)
+	s.bindService("PostsService", types.PostsServiceDefn(), ps)
 	return nil
 }
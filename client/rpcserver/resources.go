@@ -2,11 +2,13 @@ package rpcserver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/client/resources"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
@@ -22,6 +24,10 @@ type ResourcesServerCfg struct {
 
 	// Router on which to bind the server, to fulfill requests.
 	Router *resources.Router
+
+	// RootReplayMsgLogs is the root dir where replaymsglogs are stored for
+	// supported message types.
+	RootReplayMsgLogs string
 }
 
 type resourcesServer struct {
@@ -33,6 +39,9 @@ type resourcesServer struct {
 	requests       map[uint64]chan interface{}
 	upstream       types.ResourcesService_RequestsStreamServer
 	upstreamFailed chan error
+
+	subsStreams    *serverStreams[*types.ProductSubscriptionChanged]
+	fetchedStreams *serverStreams[*types.FetchedResource]
 }
 
 // Fulfill attempts to fulfill a request with an upstream clientrpc client.
@@ -65,12 +74,14 @@ func (rs *resourcesServer) Fulfill(ctx context.Context, uid clientintf.UserID,
 		Uid:  uid[:],
 		Nick: user.Nick(),
 		Request: &types.RMFetchResource{
-			Path:  req.Path,
-			Meta:  req.Meta,
-			Tag:   uint64(req.Tag),
-			Data:  req.Data,
-			Index: req.Index,
-			Count: req.Count,
+			Path:        req.Path,
+			Meta:        req.Meta,
+			Tag:         uint64(req.Tag),
+			Data:        req.Data,
+			Index:       req.Index,
+			Count:       req.Count,
+			Method:      req.RequestMethod(),
+			ContentType: req.RequestContentType(),
 		},
 	}
 	err = upstream.Send(evnt)
@@ -147,6 +158,117 @@ func (rs *resourcesServer) FulfillRequest(_ context.Context, res *types.FulfillR
 	return nil
 }
 
+func (rs *resourcesServer) ProductSubscriptionsStream(ctx context.Context,
+	req *types.ProductSubscriptionsStreamRequest, stream types.ResourcesService_ProductSubscriptionsStreamServer) error {
+
+	return rs.subsStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+func (rs *resourcesServer) AckProductSubscriptionChanged(ctx context.Context, req *types.AckRequest, res *types.AckResponse) error {
+	return rs.subsStreams.ack(req.SequenceId)
+}
+
+func (rs *resourcesServer) productSubscriptionChangedHandler(sub client.ProductSubscription) {
+	nick, _ := rs.c.UserNick(sub.UID)
+	ntfn := &types.ProductSubscriptionChanged{
+		Uid:      sub.UID.Bytes(),
+		Nick:     nick,
+		Provider: sub.Provider,
+		Product:  sub.Product,
+		Title:    sub.Title,
+		Active:   sub.Active,
+		Expires:  sub.Expires.Unix(),
+	}
+	rs.subsStreams.send(ntfn)
+}
+
+func (rs *resourcesServer) NewPagesSession(_ context.Context, _ *types.NewPagesSessionRequest, res *types.NewPagesSessionResponse) error {
+	id, err := rs.c.NewPagesSession()
+	if err != nil {
+		return err
+	}
+	res.SessionId = uint64(id)
+	return nil
+}
+
+func (rs *resourcesServer) FetchResource(_ context.Context, req *types.FetchResourceRequest, res *types.FetchResourceResponse) error {
+	var uid clientintf.UserID
+	if err := uid.FromBytes(req.Uid); err != nil {
+		return err
+	}
+	if len(req.Path) == 0 {
+		return fmt.Errorf("path cannot be empty")
+	}
+	if len(req.Data) > 0 && !json.Valid(req.Data) {
+		return fmt.Errorf("data is not valid json")
+	}
+
+	tag, err := rs.c.FetchResource(uid, req.Path, req.Meta,
+		clientintf.PagesSessionID(req.SessionId),
+		clientintf.PagesSessionID(req.ParentPage), req.Data)
+	if err != nil {
+		return err
+	}
+	res.Tag = uint64(tag)
+	return nil
+}
+
+func (rs *resourcesServer) FetchedResourcesStream(ctx context.Context,
+	req *types.FetchedResourcesStreamRequest, stream types.ResourcesService_FetchedResourcesStreamServer) error {
+
+	return rs.fetchedStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+func (rs *resourcesServer) AckFetchedResource(ctx context.Context, req *types.AckRequest, res *types.AckResponse) error {
+	return rs.fetchedStreams.ack(req.SequenceId)
+}
+
+func (rs *resourcesServer) resourceFetchedHandler(ru *client.RemoteUser,
+	fr clientdb.FetchedResource, _ clientdb.PageSessionOverview) {
+
+	// ru is nil for local resources.
+	var nick string
+	if ru != nil {
+		nick = ru.Nick()
+	}
+	ntfn := &types.FetchedResource{
+		Uid:          fr.UID[:],
+		Nick:         nick,
+		SessionId:    uint64(fr.SessionID),
+		ParentPage:   uint64(fr.ParentPage),
+		PageId:       uint64(fr.PageID),
+		RequestTsMs:  fr.RequestTS.UnixMilli(),
+		ResponseTsMs: fr.ResponseTS.UnixMilli(),
+		Request: &types.RMFetchResource{
+			Path:        fr.Request.Path,
+			Meta:        fr.Request.Meta,
+			Tag:         uint64(fr.Request.Tag),
+			Data:        fr.Request.Data,
+			Index:       fr.Request.Index,
+			Count:       fr.Request.Count,
+			Method:      fr.Request.RequestMethod(),
+			ContentType: fr.Request.RequestContentType(),
+		},
+		Response: &types.RMFetchResourceReply{
+			Tag:    uint64(fr.Response.Tag),
+			Status: uint32(fr.Response.Status),
+			Meta:   fr.Response.Meta,
+			Data:   fr.Response.Data,
+			Index:  fr.Response.Index,
+			Count:  fr.Response.Count,
+		},
+	}
+	rs.fetchedStreams.send(ntfn)
+}
+
+// registerOfflineMessageStorageHandlers registers the handlers for streams on
+// the client's notification manager.
+func (rs *resourcesServer) registerOfflineMessageStorageHandlers() {
+	nmgr := rs.c.NotificationManager()
+	nmgr.RegisterSync(client.OnProductSubscriptionChangedNtfn(rs.productSubscriptionChangedHandler))
+	nmgr.RegisterSync(client.OnResourceFetchedNtfn(rs.resourceFetchedHandler))
+}
+
 var _ types.ResourcesServiceServer = (*resourcesServer)(nil)
 
 func (s *Server) InitResourcesService(cfg ResourcesServerCfg) error {
@@ -154,17 +276,30 @@ func (s *Server) InitResourcesService(cfg ResourcesServerCfg) error {
 	if cfg.Log != nil {
 		log = cfg.Log
 	}
+	subsStreams, err := newServerStreams[*types.ProductSubscriptionChanged](cfg.RootReplayMsgLogs, "productsubs", log)
+	if err != nil {
+		return err
+	}
+	fetchedStreams, err := newServerStreams[*types.FetchedResource](cfg.RootReplayMsgLogs, "fetchedresources", log)
+	if err != nil {
+		return err
+	}
+
 	rs := &resourcesServer{
 		c:        cfg.Client,
 		log:      log,
 		requests: make(map[uint64]chan interface{}),
 		nextID:   1,
+
+		subsStreams:    subsStreams,
+		fetchedStreams: fetchedStreams,
 	}
+	rs.registerOfflineMessageStorageHandlers()
 
 	if cfg.Router != nil {
 		cfg.Router.BindPrefixPath([]string{}, rs)
 	}
 
-	s.services.Bind("ResourcesService", types.ResourcesServiceDefn(), rs)
+	s.bindService("ResourcesService", types.ResourcesServiceDefn(), rs)
 	return nil
 }
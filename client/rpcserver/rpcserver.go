@@ -6,6 +6,7 @@ import (
 	"net"
 	"sync"
 
+	"github.com/companyzero/bisonrelay/clientrpc/grpcrpc"
 	"github.com/companyzero/bisonrelay/clientrpc/jsonrpc"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/decred/slog"
@@ -15,10 +16,20 @@ import (
 // Config is the available configuration for a [Server].
 type Config struct {
 	JSONRPCListeners []net.Listener
-	Log              slog.Logger
-	RPCUser          string
-	RPCPass          string
-	AuthMode         string
+
+	// GRPCListeners are the listeners of the gRPC transport. They serve
+	// the same services as the JSON-RPC listeners.
+	GRPCListeners []net.Listener
+
+	Log     slog.Logger
+	RPCUser string
+	RPCPass string
+
+	// AuthMode is the auth mode of the transports. When it is "token",
+	// every call must be made with an API token (see InitTokenAuth).
+	// Otherwise, when it is not empty, calls without an API token must
+	// be made with the RPCUser and RPCPass basic auth credentials.
+	AuthMode string
 }
 
 // Server is an RPC server for a corresponding BR Client instance.
@@ -26,6 +37,8 @@ type Server struct {
 	runOnce    sync.Once
 	services   *types.ServersMap
 	jsonServer *jsonrpc.Server
+	grpcServer *grpcrpc.Server
+	tokenAuth  *tokenAuth
 }
 
 func (s *Server) Run(ctx context.Context) error {
@@ -37,6 +50,9 @@ func (s *Server) Run(ctx context.Context) error {
 
 	g, gctx := errgroup.WithContext(ctx)
 	g.Go(func() error { return s.jsonServer.Run(gctx) })
+	if s.grpcServer != nil {
+		g.Go(func() error { return s.grpcServer.Run(gctx) })
+	}
 
 	return g.Wait()
 }
@@ -52,6 +68,15 @@ func New(cfg Config) *Server {
 	s := &Server{
 		services:   services,
 		jsonServer: jsonServer,
+		tokenAuth:  &tokenAuth{authMode: cfg.AuthMode},
+	}
+	if len(cfg.GRPCListeners) > 0 {
+		s.grpcServer = grpcrpc.NewServer(
+			grpcrpc.WithServices(services),
+			grpcrpc.WithListeners(cfg.GRPCListeners),
+			grpcrpc.WithServerLog(cfg.Log),
+			grpcrpc.WithAuth(cfg.RPCUser, cfg.RPCPass, cfg.AuthMode),
+		)
 	}
 	return s
 }
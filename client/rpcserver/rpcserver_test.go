@@ -0,0 +1,119 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/clientrpc/grpcrpc"
+	"github.com/companyzero/bisonrelay/clientrpc/jsonrpc"
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/internal/tlsconn"
+	"github.com/decred/slog"
+)
+
+// TestTransportsInterop tests that the JSON-RPC and gRPC transports serve the
+// same services of a single server.
+func TestTransportsInterop(t *testing.T) {
+	dir := t.TempDir()
+	tlsCfg := tlsconn.TLSListenersConfig{
+		Addresses:                   []string{"127.0.0.1:0"},
+		CertPath:                    filepath.Join(dir, "rpc.cert"),
+		KeyPath:                     filepath.Join(dir, "rpc.key"),
+		CreateCertPairIfNotExists:   true,
+		ClientCAPath:                filepath.Join(dir, "rpc-ca.cert"),
+		ClientCertPath:              filepath.Join(dir, "rpc-client.cert"),
+		ClientKeyPath:               filepath.Join(dir, "rpc-client.key"),
+		CreateClientCertIfNotExists: true,
+	}
+	jsonListeners, err := tlsconn.TLSListeners(tlsCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsCfg.NextProtos = []string{"h2"}
+	grpcListeners, err := tlsconn.TLSListeners(tlsCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(Config{
+		JSONRPCListeners: jsonListeners,
+		GRPCListeners:    grpcListeners,
+		Log:              slog.Disabled,
+	})
+	s.InitVersionService("testapp", "1.0.0")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() { runErr <- s.Run(ctx) }()
+
+	port := func(l net.Listener) string {
+		_, port, _ := net.SplitHostPort(l.Addr().String())
+		return port
+	}
+	serverCert := filepath.Join(dir, "rpc.cert")
+	clientCert := filepath.Join(dir, "rpc-client.cert")
+	clientKey := filepath.Join(dir, "rpc-client.key")
+
+	jsonClient, err := jsonrpc.NewWSClient(
+		jsonrpc.WithWebsocketURL("wss://localhost:"+port(jsonListeners[0])+"/ws"),
+		jsonrpc.WithServerTLSCertPath(serverCert),
+		jsonrpc.WithClientTLSCert(clientCert, clientKey),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go jsonClient.Run(ctx)
+
+	grpcClient, err := grpcrpc.NewClient(
+		grpcrpc.WithServerAddress("localhost:"+port(grpcListeners[0])),
+		grpcrpc.WithServerTLSCertPath(serverCert),
+		grpcrpc.WithClientTLSCert(clientCert, clientKey),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer grpcClient.Close()
+
+	conns := []struct {
+		name string
+		conn types.ClientConn
+	}{
+		{name: "jsonrpc", conn: jsonClient},
+		{name: "grpc", conn: grpcClient},
+	}
+	for _, tc := range conns {
+		vc := types.NewVersionServiceClient(tc.conn)
+
+		var res types.VersionResponse
+		if err := vc.Version(ctx, &types.VersionRequest{}, &res); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if res.AppName != "testapp" || res.AppVersion != "1.0.0" {
+			t.Fatalf("%s: unexpected version response: %v", tc.name, &res)
+		}
+
+		stream, err := vc.KeepaliveStream(ctx, &types.KeepaliveStreamRequest{Interval: 1000})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		var ev types.KeepaliveEvent
+		if err := stream.Recv(&ev); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if ev.Timestamp == 0 {
+			t.Fatalf("%s: keepalive event without timestamp", tc.name)
+		}
+	}
+
+	cancel()
+	err = <-runErr
+	if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, http.ErrServerClosed) {
+		t.Fatalf("unexpected run error: %v", err)
+	}
+}
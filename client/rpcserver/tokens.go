@@ -0,0 +1,674 @@
+package rpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/internal/jsonfile"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/slog"
+	"google.golang.org/protobuf/proto"
+)
+
+// TokenPermissions is the set of permissions granted to an API token.
+type TokenPermissions struct {
+	// Methods is the list of methods ("Service.Method") the token may
+	// call. Entries may be patterns, as accepted by path.Match (for
+	// example "ChatService.*Stream" or "*").
+	Methods []string `json:"methods"`
+
+	// Users is the list of user IDs the token may target on calls that
+	// send messages, files, posts or payments to users or that share or
+	// fetch content with them. If empty, any user may be targeted. Calls
+	// that may target users not known from the request (such as GC
+	// messages or new posts) are denied when this is set.
+	Users []string `json:"users,omitempty"`
+
+	// MaxAtomsPerDay is the maximum amount (in atoms) the token may spend
+	// per day on calls that send payments. If zero, the token may not
+	// perform such calls. Calls that spend an amount not known in advance
+	// (such as downloading user content) are always denied.
+	MaxAtomsPerDay int64 `json:"max_atoms_per_day,omitempty"`
+
+	// LocalFiles allows calls that read local files specified in the
+	// request (such as sending or sharing files and sending media from a
+	// path). Without it, media may only be sent with its data embedded in
+	// the request.
+	LocalFiles bool `json:"local_files,omitempty"`
+}
+
+// allowsMethod returns true if the permissions allow calling the method.
+func (p *TokenPermissions) allowsMethod(method string) bool {
+	for _, pattern := range p.Methods {
+		if ok, _ := path.Match(pattern, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsUser returns true if the permissions allow targeting the user.
+func (p *TokenPermissions) allowsUser(uid string) bool {
+	if len(p.Users) == 0 {
+		return true
+	}
+	for _, u := range p.Users {
+		if u == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// APIToken is a credential for the clientrpc services, bound to a set of
+// permissions. Only a hash of the secret part of the token is stored.
+type APIToken struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Hash        string           `json:"hash"`
+	Permissions TokenPermissions `json:"permissions"`
+	Created     time.Time        `json:"created"`
+	Expires     *time.Time       `json:"expires,omitempty"`
+	Revoked     *time.Time       `json:"revoked,omitempty"`
+	LastUsed    *time.Time       `json:"last_used,omitempty"`
+
+	// SpentDay and SpentAtoms track the amount spent by the token on
+	// SpentDay (in the format YYYY-MM-DD).
+	SpentDay   string `json:"spent_day,omitempty"`
+	SpentAtoms int64  `json:"spent_atoms,omitempty"`
+}
+
+// IsActive returns true if the token is neither revoked nor expired.
+func (t *APIToken) IsActive(now time.Time) bool {
+	return t.Revoked == nil && (t.Expires == nil || now.Before(*t.Expires))
+}
+
+// SpentToday returns the amount spent by the token on the day of now.
+func (t *APIToken) SpentToday(now time.Time) int64 {
+	if t.SpentDay != now.Format(time.DateOnly) {
+		return 0
+	}
+	return t.SpentAtoms
+}
+
+// hashTokenSecret returns the hash stored for a token secret.
+func hashTokenSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// TokenStore stores the API tokens accepted by the clientrpc services.
+type TokenStore struct {
+	fname string
+	log   slog.Logger
+
+	mtx    sync.Mutex
+	tokens map[string]*APIToken
+}
+
+// NewTokenStore loads the API tokens stored in the passed file.
+func NewTokenStore(fname string, log slog.Logger) (*TokenStore, error) {
+	var tokens []*APIToken
+	err := jsonfile.Read(fname, &tokens)
+	if err != nil && !errors.Is(err, jsonfile.ErrNotFound) {
+		return nil, fmt.Errorf("unable to read tokens file: %w", err)
+	}
+	ts := &TokenStore{
+		fname:  fname,
+		log:    log,
+		tokens: make(map[string]*APIToken, len(tokens)),
+	}
+	for _, t := range tokens {
+		ts.tokens[t.ID] = t
+	}
+	return ts, nil
+}
+
+// save the tokens to disk. Must be called with the mutex held.
+func (ts *TokenStore) save() error {
+	tokens := make([]*APIToken, 0, len(ts.tokens))
+	for _, t := range ts.tokens {
+		tokens = append(tokens, t)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Created.Before(tokens[j].Created)
+	})
+	return jsonfile.Write(ts.fname, tokens, ts.log)
+}
+
+// Mint creates a new API token with the passed permissions. It returns the
+// token data and the full token string, which must be sent by clients and is
+// not recoverable afterwards.
+func (ts *TokenStore) Mint(name string, perms TokenPermissions, expires *time.Time) (APIToken, string, error) {
+	if len(perms.Methods) == 0 {
+		return APIToken{}, "", errors.New("token must allow at least one method")
+	}
+	for _, pattern := range perms.Methods {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return APIToken{}, "", fmt.Errorf("invalid method pattern %q: %v", pattern, err)
+		}
+	}
+	if perms.MaxAtomsPerDay < 0 {
+		return APIToken{}, "", errors.New("max amount per day cannot be negative")
+	}
+
+	var id [8]byte
+	var secret [32]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return APIToken{}, "", err
+	}
+	if _, err := rand.Read(secret[:]); err != nil {
+		return APIToken{}, "", err
+	}
+	hexSecret := hex.EncodeToString(secret[:])
+	t := &APIToken{
+		ID:          hex.EncodeToString(id[:]),
+		Name:        name,
+		Hash:        hashTokenSecret(hexSecret),
+		Permissions: perms,
+		Created:     time.Now(),
+		Expires:     expires,
+	}
+
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+	ts.tokens[t.ID] = t
+	if err := ts.save(); err != nil {
+		delete(ts.tokens, t.ID)
+		return APIToken{}, "", err
+	}
+	return *t, t.ID + "." + hexSecret, nil
+}
+
+// List returns the stored tokens, sorted by creation time.
+func (ts *TokenStore) List() []APIToken {
+	ts.mtx.Lock()
+	res := make([]APIToken, 0, len(ts.tokens))
+	for _, t := range ts.tokens {
+		res = append(res, *t)
+	}
+	ts.mtx.Unlock()
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Created.Before(res[j].Created)
+	})
+	return res
+}
+
+// Revoke the token with the passed ID (or unique ID prefix). Revoked tokens
+// are immediately rejected.
+func (ts *TokenStore) Revoke(id string) (APIToken, error) {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	var t *APIToken
+	for tid, tt := range ts.tokens {
+		if !strings.HasPrefix(tid, id) || id == "" {
+			continue
+		}
+		if t != nil {
+			return APIToken{}, fmt.Errorf("token id prefix %q is ambiguous", id)
+		}
+		t = tt
+	}
+	if t == nil {
+		return APIToken{}, fmt.Errorf("token %q not found", id)
+	}
+	if t.Revoked == nil {
+		now := time.Now()
+		t.Revoked = &now
+		if err := ts.save(); err != nil {
+			t.Revoked = nil
+			return APIToken{}, err
+		}
+	}
+	return *t, nil
+}
+
+// verify returns the active token that corresponds to the passed token
+// string. Must be called with the mutex held.
+func (ts *TokenStore) verify(token string, now time.Time) (*APIToken, error) {
+	id, secret, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, types.ErrUnauthenticated
+	}
+	t, ok := ts.tokens[id]
+	if !ok {
+		return nil, types.ErrUnauthenticated
+	}
+	hash := hashTokenSecret(secret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(t.Hash)) != 1 {
+		return nil, types.ErrUnauthenticated
+	}
+	if !t.IsActive(now) {
+		return nil, types.ErrUnauthenticated
+	}
+	return t, nil
+}
+
+// authorize verifies that the token may call the method with a request that
+// targets the users (identified by their IDs) and spends the amount of the
+// passed call. The amount (in atoms) is reserved from the daily allowance of
+// the token. The returned function must be called with the result of the
+// call, so that the reserved amount is refunded if the call fails.
+func (ts *TokenStore) authorize(token, method string, call callInfo) (func(error), error) {
+	now := time.Now()
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	t, err := ts.verify(token, now)
+	if err != nil {
+		return nil, err
+	}
+	perms := &t.Permissions
+	if !perms.allowsMethod(method) {
+		return nil, fmt.Errorf("%w: token %s cannot call %s",
+			types.ErrPermissionDenied, t.ID, method)
+	}
+	if call.unclassified {
+		return nil, fmt.Errorf("%w: token %s cannot call %s with "+
+			"unknown targets", types.ErrPermissionDenied, t.ID, method)
+	}
+	if call.localFiles && !perms.LocalFiles {
+		return nil, fmt.Errorf("%w: token %s cannot call %s, which "+
+			"reads local files", types.ErrPermissionDenied, t.ID,
+			method)
+	}
+	if call.anyUser && len(perms.Users) > 0 {
+		return nil, fmt.Errorf("%w: token %s cannot call %s "+
+			"targeting any user", types.ErrPermissionDenied, t.ID, method)
+	}
+	for _, uid := range call.users {
+		if !perms.allowsUser(uid) {
+			return nil, fmt.Errorf("%w: token %s cannot target user %s",
+				types.ErrPermissionDenied, t.ID, uid)
+		}
+	}
+	if call.unknownAmount {
+		// The amount cannot be accounted for in the daily allowance.
+		return nil, fmt.Errorf("%w: token %s cannot call %s, which "+
+			"spends an unknown amount", types.ErrPermissionDenied,
+			t.ID, method)
+	}
+	t.LastUsed = &now
+
+	amount := call.amount
+	if amount <= 0 {
+		return func(error) {}, nil
+	}
+
+	spent := t.SpentToday(now)
+	if spent+amount > perms.MaxAtomsPerDay {
+		return nil, fmt.Errorf("%w: token %s daily spending limit "+
+			"(%s) exceeded", types.ErrPermissionDenied, t.ID,
+			dcrutil.Amount(perms.MaxAtomsPerDay))
+	}
+	day := now.Format(time.DateOnly)
+	t.SpentDay, t.SpentAtoms = day, spent+amount
+	if err := ts.save(); err != nil {
+		t.SpentAtoms = spent
+		return nil, err
+	}
+	ts.log.Debugf("Token %s reserved %s (spent today: %s)", t.ID,
+		dcrutil.Amount(amount), dcrutil.Amount(t.SpentAtoms))
+
+	refund := func(err error) {
+		if err == nil {
+			return
+		}
+		ts.mtx.Lock()
+		defer ts.mtx.Unlock()
+		if t.SpentDay != day {
+			return
+		}
+		t.SpentAtoms -= amount
+		if err := ts.save(); err != nil {
+			ts.log.Warnf("Unable to save refund of token %s: %v", t.ID, err)
+		}
+	}
+	return refund, nil
+}
+
+// TokenAuthCfg is the configuration for enforcing API token permissions.
+type TokenAuthCfg struct {
+	// Store is the store of accepted API tokens.
+	Store *TokenStore
+
+	// Client is used to resolve the users targeted by calls.
+	Client *client.Client
+
+	// PayClient is used to decode the invoices paid by calls, in order to
+	// account for their amount.
+	PayClient *client.DcrlnPaymentClient
+}
+
+// tokenAuth enforces the permissions of API tokens on calls to services.
+type tokenAuth struct {
+	// authMode is the auth mode of the server. When it is
+	// types.AuthModeToken, calls without an API token are rejected.
+	authMode string
+
+	mtx sync.Mutex
+	cfg TokenAuthCfg
+}
+
+// callInfo is the set of users targeted and the amount spent by a request.
+type callInfo struct {
+	// users are the nicks or hex-encoded IDs of the users targeted by the
+	// call.
+	users []string
+
+	// anyUser is set when the call may target users that are not known
+	// from the request (for example, members of a GC or subscribers to
+	// posts).
+	anyUser bool
+
+	// amount is the amount (in atoms) spent by the call.
+	amount int64
+
+	// unknownAmount is set when the call spends an amount that is not
+	// known when the call is made (for example, downloads of files, whose
+	// chunks are paid as they are received).
+	unknownAmount bool
+
+	// localFiles is set when the call reads local files specified in the
+	// request.
+	localFiles bool
+
+	// unclassified is set when the request is not known to callTarget. The
+	// users it targets and the amount it spends are unknown.
+	unclassified bool
+}
+
+// userCall returns the callInfo of a request that targets a single user.
+// An empty user means the call does not target any user.
+func userCall(user string) (callInfo, error) {
+	if user == "" {
+		return callInfo{}, nil
+	}
+	return callInfo{users: []string{user}}, nil
+}
+
+// uidCall returns the callInfo of a request that targets the user with the
+// passed raw ID. An empty ID means the call does not target any user.
+func uidCall(uid []byte) (callInfo, error) {
+	if len(uid) == 0 {
+		return callInfo{}, nil
+	}
+	return userCall(hex.EncodeToString(uid))
+}
+
+// callTarget returns the users targeted and the amount (in atoms) spent by a
+// request. Requests that are not listed are returned as unclassified, so that
+// new calls are denied to API tokens until they are classified here.
+func callTarget(req proto.Message) (callInfo, error) {
+	switch req := req.(type) {
+	// Calls that neither target users nor spend funds.
+	case *types.AckRequest,
+		*types.VersionRequest,
+		*types.KeepaliveStreamRequest,
+		*types.UserNickRequest,
+		*types.PublicIdentityReq,
+		*types.PMStreamRequest,
+		*types.GCMStreamRequest,
+		*types.KXStreamRequest,
+		*types.CancelDownloadRequest,
+		*types.ContentSearchResultsRequest,
+		*types.DownloadsCompletedStreamRequest,
+		*types.ListDownloadsRequest,
+		*types.ListLocalSharedFilesRequest,
+		*types.TransfersProgressStreamRequest,
+		*types.UnshareFileRequest,
+		*types.AcceptGCInviteRequest,
+		*types.ChannelShareInviteRequest,
+		*types.CreateChannelRequest,
+		*types.EstimateGCMCostRequest,
+		*types.GetGCRequest,
+		*types.JoinChannelRequest,
+		*types.JoinedGCsRequest,
+		*types.ListGCsRequest,
+		*types.GCMembersAddedRequest,
+		*types.GCMembersRemovedRequest,
+		*types.ReceivedGCInvitesRequest,
+		*types.LNBalancesRequest,
+		*types.LNChannelEventsStreamRequest,
+		*types.LNCloseChannelRequest,
+		*types.LNCreateInvoiceRequest,
+		*types.LNDecodeInvoiceRequest,
+		*types.LNGetInfoRequest,
+		*types.LNInvoicesStreamRequest,
+		*types.LNListChannelsRequest,
+		*types.LNNewAddressRequest,
+		*types.MediaStreamRequest,
+		*types.ListPayLedgerRequest,
+		*types.TipProgressRequest,
+		*types.TipStreamRequest,
+		*types.ListPostsRequest,
+		*types.PostsStatusStreamRequest,
+		*types.PostsStreamRequest,
+		*types.ReadPostRequest,
+		*types.FetchedResourcesStreamRequest,
+		*types.FulfillResourceRequest,
+		*types.NewPagesSessionRequest,
+		*types.ProductSubscriptionsStreamRequest,
+		*types.ResourceRequestsStreamRequest,
+		*types.AddressBookRequest,
+		*types.AddressBookStreamRequest,
+		*types.UsersLastReceivedTimeRequest:
+		return callInfo{}, nil
+
+	// Calls that target users.
+	case *types.PMRequest:
+		return userCall(req.User)
+	case *types.SendFileRequest:
+		call, err := userCall(req.User)
+		call.localFiles = true
+		return call, err
+	case *types.SendMediaRequest:
+		call := callInfo{anyUser: true}
+		var err error
+		if req.Gc == "" {
+			call, err = userCall(req.User)
+		}
+		call.localFiles = req.Path != ""
+		return call, err
+	case *types.MediateKXRequest:
+		return callInfo{users: []string{req.Mediator, req.Target}}, nil
+	case *types.RelayPostRequest:
+		return userCall(req.ToUser)
+	case *types.CommentPostRequest:
+		return uidCall(req.From)
+	case *types.HeartPostRequest:
+		return uidCall(req.From)
+	case *types.SubscribeToPostsRequest:
+		return userCall(req.User)
+	case *types.UnsubscribeToPostsRequest:
+		return userCall(req.User)
+	case *types.InviteToGCRequest:
+		return userCall(req.User)
+	case *types.KickFromGCRequest:
+		return userCall(req.User)
+	case *types.ShareFileRequest:
+		// Files shared without a user are shared with every user.
+		if len(req.Uid) == 0 {
+			return callInfo{anyUser: true, localFiles: true}, nil
+		}
+		call, err := uidCall(req.Uid)
+		call.localFiles = true
+		return call, err
+	case *types.ShareDirRequest:
+		// Dirs shared without a user are shared with every user.
+		if len(req.Uid) == 0 {
+			return callInfo{anyUser: true, localFiles: true}, nil
+		}
+		call, err := uidCall(req.Uid)
+		call.localFiles = true
+		return call, err
+	case *types.ListUserContentRequest:
+		return uidCall(req.Uid)
+	case *types.GetUserContentRequest:
+		call, err := uidCall(req.Uid)
+		call.unknownAmount = true
+		return call, err
+	case *types.SearchContentRequest:
+		if len(req.Uids) == 0 {
+			return callInfo{anyUser: true}, nil
+		}
+		users := make([]string, len(req.Uids))
+		for i := range req.Uids {
+			users[i] = hex.EncodeToString(req.Uids[i])
+		}
+		return callInfo{users: users}, nil
+	case *types.FetchResourceRequest:
+		return uidCall(req.Uid)
+	case *types.HandshakeRequest:
+		return userCall(req.User)
+	case *types.ResetRatchetRequest:
+		return userCall(req.User)
+	case *types.BlockUserRequest:
+		return userCall(req.User)
+	case *types.IgnoreUserRequest:
+		return userCall(req.User)
+	case *types.RenameUserRequest:
+		return userCall(req.User)
+
+	// Calls that send messages to users not known from the request.
+	case *types.AcceptInviteRequest,
+		*types.GCMRequest,
+		*types.PublishToChannelRequest,
+		*types.CreatePostRequest:
+		return callInfo{anyUser: true}, nil
+
+	// Calls that spend funds.
+	case *types.TipUserRequest:
+		amount, err := dcrutil.NewAmount(req.DcrAmount)
+		if err != nil {
+			return callInfo{}, err
+		}
+		return callInfo{users: []string{req.User}, amount: int64(amount)}, nil
+	case *types.WriteNewInviteRequest:
+		return callInfo{amount: int64(req.FundAmount)}, nil
+	case *types.LNOpenChannelRequest:
+		return callInfo{amount: req.FundingAtoms + req.PushAtoms}, nil
+	case *types.LNSendOnChainRequest:
+		return callInfo{amount: req.AmountAtoms}, nil
+	case *types.LNPayInvoiceRequest:
+		return callInfo{amount: matomsToAtoms(req.AmountMatoms)}, nil
+
+	default:
+		return callInfo{unclassified: true}, nil
+	}
+}
+
+// matomsToAtoms converts an amount in milli-atoms to atoms, rounding up.
+func matomsToAtoms(matoms int64) int64 {
+	return (matoms + 999) / 1000
+}
+
+// authorize verifies the API token of a call (if any) to the method. The
+// returned function must be called with the result of the call.
+func (ta *tokenAuth) authorize(ctx context.Context, method string, req proto.Message) (func(error), error) {
+	token, ok := types.AuthTokenFromContext(ctx)
+	if !ok {
+		if ta.authMode == types.AuthModeToken {
+			return nil, types.ErrUnauthenticated
+		}
+		return func(error) {}, nil
+	}
+	ta.mtx.Lock()
+	cfg := ta.cfg
+	ta.mtx.Unlock()
+	if cfg.Store == nil {
+		return nil, types.ErrUnauthenticated
+	}
+
+	call, err := callTarget(req)
+	if err != nil {
+		return nil, err
+	}
+	if payReq, ok := req.(*types.LNPayInvoiceRequest); ok && call.amount == 0 {
+		// The amount paid is the amount of the invoice.
+		if cfg.PayClient == nil {
+			return nil, types.ErrPermissionDenied
+		}
+		inv, err := cfg.PayClient.DecodeInvoice(ctx, payReq.Invoice)
+		if err != nil {
+			return nil, err
+		}
+		call.amount = matomsToAtoms(inv.MAtoms)
+	}
+	uids := make([]string, 0, len(call.users))
+	for _, user := range call.users {
+		if cfg.Client == nil {
+			return nil, types.ErrPermissionDenied
+		}
+		uid, err := cfg.Client.UIDByNick(user)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, uid.String())
+	}
+	call.users = uids
+	return cfg.Store.authorize(token, method, call)
+}
+
+// wrapDefn returns a copy of the service definition where the handlers
+// enforce the permissions of API tokens before being called.
+func (ta *tokenAuth) wrapDefn(name string, defn types.ServiceDefn) types.ServiceDefn {
+	methods := make(map[string]types.MethodDefn, len(defn.Methods))
+	for methodName, md := range defn.Methods {
+		method := name + "." + methodName
+		if md.ServerHandler != nil {
+			handler := md.ServerHandler
+			md.ServerHandler = func(x interface{}, ctx context.Context, req, res proto.Message) error {
+				done, err := ta.authorize(ctx, method, req)
+				if err != nil {
+					return err
+				}
+				err = handler(x, ctx, req, res)
+				done(err)
+				return err
+			}
+		}
+		if md.ServerStreamHandler != nil {
+			handler := md.ServerStreamHandler
+			md.ServerStreamHandler = func(x interface{}, ctx context.Context, req proto.Message, stream types.ServerStream) error {
+				done, err := ta.authorize(ctx, method, req)
+				if err != nil {
+					return err
+				}
+				err = handler(x, ctx, req, stream)
+				done(err)
+				return err
+			}
+		}
+		methods[methodName] = md
+	}
+	return types.ServiceDefn{Name: defn.Name, Methods: methods}
+}
+
+// InitTokenAuth enables API tokens on the server. Calls made with an API token
+// are only performed if allowed by the permissions of the token. Without a
+// token store, calls made with API tokens are rejected.
+func (s *Server) InitTokenAuth(cfg TokenAuthCfg) {
+	s.tokenAuth.mtx.Lock()
+	s.tokenAuth.cfg = cfg
+	s.tokenAuth.mtx.Unlock()
+}
+
+// bindService binds the service to the server, enforcing the permissions of
+// API tokens on its methods.
+func (s *Server) bindService(name string, defn types.ServiceDefn, impl interface{}) {
+	s.services.Bind(name, s.tokenAuth.wrapDefn(name, defn), impl)
+}
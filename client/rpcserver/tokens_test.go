@@ -0,0 +1,330 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/decred/slog"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestTokenStore tests minting, verifying, persisting and revoking tokens.
+func TestTokenStore(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "rpctokens.json")
+	ts, err := NewTokenStore(fname, slog.Disabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := ts.Mint("empty", TokenPermissions{}, nil); err == nil {
+		t.Fatal("expected error minting token without methods")
+	}
+
+	perms := TokenPermissions{
+		Methods: []string{"ChatService.*Stream", "ChatService.PM"},
+		Users:   []string{"uid1"},
+	}
+	tok, secret, err := ts.Mint("bot", perms, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-time.Hour)
+	_, expiredSecret, err := ts.Mint("expired", perms, &past)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		method  string
+		call    callInfo
+		wantErr error
+	}{
+		{"stream", secret, "ChatService.PMStream", callInfo{}, nil},
+		{"allowed user", secret, "ChatService.PM", callInfo{users: []string{"uid1"}}, nil},
+		{"other user", secret, "ChatService.PM", callInfo{users: []string{"uid2"}}, types.ErrPermissionDenied},
+		{"any user", secret, "ChatService.PM", callInfo{anyUser: true}, types.ErrPermissionDenied},
+		{"unclassified", secret, "ChatService.PM", callInfo{unclassified: true}, types.ErrPermissionDenied},
+		{"unknown amount", secret, "ChatService.PM", callInfo{users: []string{"uid1"}, unknownAmount: true}, types.ErrPermissionDenied},
+		{"other method", secret, "GCService.KillGC", callInfo{}, types.ErrPermissionDenied},
+		{"wrong secret", tok.ID + ".00", "ChatService.PM", callInfo{users: []string{"uid1"}}, types.ErrUnauthenticated},
+		{"malformed", "xxx", "ChatService.PM", callInfo{users: []string{"uid1"}}, types.ErrUnauthenticated},
+		{"expired", expiredSecret, "ChatService.PMStream", callInfo{}, types.ErrUnauthenticated},
+	}
+	for _, tc := range tests {
+		_, err := ts.authorize(tc.token, tc.method, tc.call)
+		if !errors.Is(err, tc.wantErr) {
+			t.Fatalf("%s: unexpected error: got %v, want %v", tc.name, err, tc.wantErr)
+		}
+	}
+
+	// Tokens are persisted.
+	ts, err = NewTokenStore(fname, slog.Disabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(ts.List()); got != 2 {
+		t.Fatalf("unexpected nb of tokens: got %d, want 2", got)
+	}
+	if _, err := ts.authorize(secret, "ChatService.PM", callInfo{users: []string{"uid1"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Revoked tokens are rejected.
+	if _, err := ts.Revoke(tok.ID[:6]); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ts.authorize(secret, "ChatService.PM", callInfo{users: []string{"uid1"}})
+	if !errors.Is(err, types.ErrUnauthenticated) {
+		t.Fatalf("unexpected error: got %v, want %v", err, types.ErrUnauthenticated)
+	}
+}
+
+// TestTokenSpendingLimit tests that the daily spending limit of tokens is
+// enforced by the bound services.
+func TestTokenSpendingLimit(t *testing.T) {
+	ts, err := NewTokenStore(filepath.Join(t.TempDir(), "rpctokens.json"), slog.Disabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, secret, err := ts.Mint("inviter", TokenPermissions{
+		Methods:        []string{"GCService.WriteNewInvite"},
+		MaxAtomsPerDay: 1000,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(Config{Log: slog.Disabled, AuthMode: types.AuthModeToken})
+	s.InitTokenAuth(TokenAuthCfg{Store: ts})
+
+	// Calls fail when the handler is called with an odd amount, to test
+	// refunds.
+	handlerErr := errors.New("handler error")
+	defn := s.tokenAuth.wrapDefn("GCService", types.ServiceDefn{
+		Name: "GCService",
+		Methods: map[string]types.MethodDefn{
+			"WriteNewInvite": {
+				ServerHandler: func(_ interface{}, _ context.Context, req, _ proto.Message) error {
+					if req.(*types.WriteNewInviteRequest).FundAmount%2 == 1 {
+						return handlerErr
+					}
+					return nil
+				},
+			},
+		},
+	})
+	handler := defn.Methods["WriteNewInvite"].ServerHandler
+	call := func(ctx context.Context, amount uint64) error {
+		req := &types.WriteNewInviteRequest{FundAmount: amount}
+		return handler(nil, ctx, req, &types.WriteNewInviteResponse{})
+	}
+
+	ctx := context.Background()
+	if err := call(ctx, 0); !errors.Is(err, types.ErrUnauthenticated) {
+		t.Fatalf("unexpected error: got %v, want %v", err, types.ErrUnauthenticated)
+	}
+
+	ctx = types.ContextWithAuthToken(ctx, secret)
+	if err := call(ctx, 600); err != nil {
+		t.Fatal(err)
+	}
+	if err := call(ctx, 399); !errors.Is(err, handlerErr) {
+		t.Fatalf("unexpected error: got %v, want %v", err, handlerErr)
+	}
+	if err := call(ctx, 402); !errors.Is(err, types.ErrPermissionDenied) {
+		t.Fatalf("unexpected error: got %v, want %v", err, types.ErrPermissionDenied)
+	}
+	if err := call(ctx, 400); err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.List()[0].SpentToday(time.Now()); got != 1000 {
+		t.Fatalf("unexpected amount spent: got %d, want 1000", got)
+	}
+}
+
+// TestCallTargetLNAmounts tests the amounts accounted for LNService calls.
+func TestCallTargetLNAmounts(t *testing.T) {
+	tests := []struct {
+		name string
+		req  proto.Message
+		want int64
+	}{
+		{"open channel", &types.LNOpenChannelRequest{FundingAtoms: 1000, PushAtoms: 10}, 1010},
+		{"send on-chain", &types.LNSendOnChainRequest{AmountAtoms: 500}, 500},
+		{"pay invoice", &types.LNPayInvoiceRequest{AmountMatoms: 1001}, 2},
+		{"create invoice", &types.LNCreateInvoiceRequest{AmountMatoms: 1000}, 0},
+	}
+	for _, tc := range tests {
+		call, err := callTarget(tc.req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if got := call.amount; got != tc.want {
+			t.Fatalf("%s: unexpected amount: got %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestCallTargetClassified tests that the requests of every method bound by
+// the services are classified, so that the users targeted and the amount spent
+// by calls made with API tokens are known.
+func TestCallTargetClassified(t *testing.T) {
+	for _, svc := range types.Services() {
+		for methodName, md := range svc.Methods {
+			req := md.NewRequest()
+			call, err := callTarget(req)
+			if err != nil {
+				t.Fatalf("%s.%s: unexpected error: %v", svc.Name,
+					methodName, err)
+			}
+			if call.unclassified {
+				t.Fatalf("%s.%s: request %T is not classified",
+					svc.Name, methodName, req)
+			}
+		}
+	}
+
+	// Requests that are not bound by any service are unclassified.
+	call, err := callTarget(&types.PMResponse{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !call.unclassified {
+		t.Fatal("unexpected classified response")
+	}
+}
+
+// TestCallTargetUsers tests the users targeted by calls.
+func TestCallTargetUsers(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     proto.Message
+		users   []string
+		anyUser bool
+	}{
+		{"media to user", &types.SendMediaRequest{User: "bob"}, []string{"bob"}, false},
+		{"media to gc", &types.SendMediaRequest{Gc: "gc01"}, nil, true},
+		{"mediate kx", &types.MediateKXRequest{Mediator: "bob", Target: "hex01"}, []string{"bob", "hex01"}, false},
+		{"comment post", &types.CommentPostRequest{From: []byte{0x01}}, []string{"01"}, false},
+		{"search all", &types.SearchContentRequest{}, nil, true},
+		{"search users", &types.SearchContentRequest{Uids: [][]byte{{0x01}, {0x02}}}, []string{"01", "02"}, false},
+		{"global share", &types.ShareFileRequest{}, nil, true},
+		{"user share", &types.ShareFileRequest{Uid: []byte{0x01}}, []string{"01"}, false},
+		{"global dir share", &types.ShareDirRequest{}, nil, true},
+		{"user dir share", &types.ShareDirRequest{Uid: []byte{0x01}}, []string{"01"}, false},
+		{"gcm", &types.GCMRequest{Gc: "gc01"}, nil, true},
+		{"block user", &types.BlockUserRequest{User: "bob"}, []string{"bob"}, false},
+	}
+	for _, tc := range tests {
+		call, err := callTarget(tc.req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(call.users, tc.users) || call.anyUser != tc.anyUser {
+			t.Fatalf("%s: unexpected call: %+v", tc.name, call)
+		}
+	}
+}
+
+// TestTokenUserRestrictedShares tests that tokens restricted to some users
+// cannot share content with every user.
+func TestTokenUserRestrictedShares(t *testing.T) {
+	ts, err := NewTokenStore(filepath.Join(t.TempDir(), "rpctokens.json"), slog.Disabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, secret, err := ts.Mint("sharer", TokenPermissions{
+		Methods:    []string{"ContentService.ShareFile", "ContentService.ShareDir"},
+		Users:      []string{"01"},
+		LocalFiles: true,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		req     proto.Message
+		wantErr error
+	}{
+		{"allowed user", "ContentService.ShareFile", &types.ShareFileRequest{Uid: []byte{0x01}}, nil},
+		{"other user", "ContentService.ShareFile", &types.ShareFileRequest{Uid: []byte{0x02}}, types.ErrPermissionDenied},
+		{"global share", "ContentService.ShareFile", &types.ShareFileRequest{}, types.ErrPermissionDenied},
+		{"allowed user dir", "ContentService.ShareDir", &types.ShareDirRequest{Uid: []byte{0x01}}, nil},
+		{"global dir share", "ContentService.ShareDir", &types.ShareDirRequest{}, types.ErrPermissionDenied},
+	}
+	for _, tc := range tests {
+		call, err := callTarget(tc.req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		_, err = ts.authorize(secret, tc.method, call)
+		if !errors.Is(err, tc.wantErr) {
+			t.Fatalf("%s: unexpected error: got %v, want %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+// TestTokenLocalFiles tests that only tokens allowed to access local files may
+// call methods that read local files.
+func TestTokenLocalFiles(t *testing.T) {
+	ts, err := NewTokenStore(filepath.Join(t.TempDir(), "rpctokens.json"), slog.Disabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	methods := []string{"ChatService.SendFile", "MediaService.SendMedia",
+		"ContentService.ShareFile"}
+	_, noFiles, err := ts.Mint("nofiles", TokenPermissions{Methods: methods}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, withFiles, err := ts.Mint("files", TokenPermissions{
+		Methods:    methods,
+		LocalFiles: true,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		req        proto.Message
+		localFiles bool
+	}{
+		{"send file", "ChatService.SendFile", &types.SendFileRequest{User: "bob", Filename: "/etc/passwd"}, true},
+		{"media from path", "MediaService.SendMedia", &types.SendMediaRequest{User: "bob", Path: "/tmp/a.opus"}, true},
+		{"media to gc from path", "MediaService.SendMedia", &types.SendMediaRequest{Gc: "gc01", Path: "/tmp/a.opus"}, true},
+		{"media with data", "MediaService.SendMedia", &types.SendMediaRequest{User: "bob", Media: &types.MediaInfo{Data: []byte{0x01}}}, false},
+		{"share file", "ContentService.ShareFile", &types.ShareFileRequest{Filename: "/etc/passwd"}, true},
+	}
+	for _, tc := range tests {
+		call, err := callTarget(tc.req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if call.localFiles != tc.localFiles {
+			t.Fatalf("%s: unexpected localFiles %v", tc.name, call.localFiles)
+		}
+		var wantErr error
+		if tc.localFiles {
+			wantErr = types.ErrPermissionDenied
+		}
+		_, err = ts.authorize(noFiles, tc.method, call)
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("%s: unexpected error: got %v, want %v", tc.name, err, wantErr)
+		}
+		_, err = ts.authorize(withFiles, tc.method, call)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+	}
+}
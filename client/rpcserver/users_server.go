@@ -0,0 +1,188 @@
+package rpcserver
+
+import (
+	"context"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/decred/slog"
+)
+
+// UsersServerCfg is the configuration for a new [types.UsersServiceServer]
+// deployment.
+type UsersServerCfg struct {
+	// Client should be set to the [client.Client] instance.
+	Client *client.Client
+
+	// Log should be set to the app's logger.
+	Log slog.Logger
+
+	// RootReplayMsgLogs is the root dir where replaymsglogs are stored for
+	// supported message types.
+	RootReplayMsgLogs string
+}
+
+type usersServer struct {
+	cfg UsersServerCfg
+	log slog.Logger
+	c   *client.Client
+
+	abStreams *serverStreams[*types.AddressBookChanged]
+}
+
+func (u *usersServer) AddressBook(_ context.Context, _ *types.AddressBookRequest, res *types.AddressBookResponse) error {
+	ab := u.c.AddressBook()
+	res.Users = make([]*types.AddressBookEntry, len(ab))
+	for i, entry := range ab {
+		res.Users[i] = &types.AddressBookEntry{
+			Uid:                  entry.ID.Identity[:],
+			Nick:                 entry.Nick(),
+			Name:                 entry.ID.Name,
+			Ignored:              entry.Ignored,
+			FirstCreated:         entry.FirstCreated.Unix(),
+			LastCompletedKx:      entry.LastCompletedKX.Unix(),
+			LastHandshakeAttempt: entry.LastHandshakeAttempt.Unix(),
+		}
+	}
+	return nil
+}
+
+func (u *usersServer) RenameUser(_ context.Context, req *types.RenameUserRequest, _ *types.RenameUserResponse) error {
+	uid, err := u.c.UIDByNick(req.User)
+	if err != nil {
+		return err
+	}
+	return u.c.RenameUser(uid, req.NewNick)
+}
+
+func (u *usersServer) IgnoreUser(_ context.Context, req *types.IgnoreUserRequest, _ *types.IgnoreUserResponse) error {
+	uid, err := u.c.UIDByNick(req.User)
+	if err != nil {
+		return err
+	}
+	return u.c.Ignore(uid, req.Ignore)
+}
+
+func (u *usersServer) BlockUser(_ context.Context, req *types.BlockUserRequest, _ *types.BlockUserResponse) error {
+	uid, err := u.c.UIDByNick(req.User)
+	if err != nil {
+		return err
+	}
+	return u.c.Block(uid)
+}
+
+func (u *usersServer) Handshake(_ context.Context, req *types.HandshakeRequest, _ *types.HandshakeResponse) error {
+	uid, err := u.c.UIDByNick(req.User)
+	if err != nil {
+		return err
+	}
+	return u.c.Handshake(uid)
+}
+
+func (u *usersServer) ResetRatchet(_ context.Context, req *types.ResetRatchetRequest, _ *types.ResetRatchetResponse) error {
+	uid, err := u.c.UIDByNick(req.User)
+	if err != nil {
+		return err
+	}
+	return u.c.ResetRatchet(uid)
+}
+
+func (u *usersServer) UsersLastReceivedTime(_ context.Context, _ *types.UsersLastReceivedTimeRequest, res *types.UsersLastReceivedTimeResponse) error {
+	times, err := u.c.ListUsersLastReceivedTime()
+	if err != nil {
+		return err
+	}
+	res.Users = make([]*types.UserLastReceivedTime, len(times))
+	for i, t := range times {
+		nick, _ := u.c.UserNick(t.UID)
+		res.Users[i] = &types.UserLastReceivedTime{
+			Uid:           t.UID.Bytes(),
+			Nick:          nick,
+			LastDecrypted: t.LastDecrypted.Unix(),
+		}
+	}
+	return nil
+}
+
+func (u *usersServer) AddressBookStream(ctx context.Context, req *types.AddressBookStreamRequest, stream types.UsersService_AddressBookStreamServer) error {
+	return u.abStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+func (u *usersServer) AckAddressBookChanged(_ context.Context, req *types.AckRequest, _ *types.AckResponse) error {
+	return u.abStreams.ack(req.SequenceId)
+}
+
+func (u *usersServer) sendChange(ru *client.RemoteUser, kind types.AddressBookChangeKind) {
+	ntfn := &types.AddressBookChanged{
+		Kind: kind,
+		Uid:  ru.ID().Bytes(),
+		Nick: ru.Nick(),
+	}
+	u.abStreams.send(ntfn)
+}
+
+func (u *usersServer) kxNtfnHandler(_ *clientintf.RawRVID, ru *client.RemoteUser, isNew bool) {
+	kind := types.AddressBookChangeKind_ADDRESS_BOOK_CHANGE_RESET
+	if isNew {
+		kind = types.AddressBookChangeKind_ADDRESS_BOOK_CHANGE_ADDED
+	}
+	u.sendChange(ru, kind)
+}
+
+func (u *usersServer) blockNtfnHandler(ru *client.RemoteUser) {
+	u.sendChange(ru, types.AddressBookChangeKind_ADDRESS_BOOK_CHANGE_REMOVED_BY_REMOTE)
+}
+
+func (u *usersServer) abChangedNtfnHandler(ru *client.RemoteUser, change client.AddressBookChange) {
+	var kind types.AddressBookChangeKind
+	switch change {
+	case client.AddressBookChangeRenamed:
+		kind = types.AddressBookChangeKind_ADDRESS_BOOK_CHANGE_RENAMED
+	case client.AddressBookChangeIgnored:
+		kind = types.AddressBookChangeKind_ADDRESS_BOOK_CHANGE_IGNORED
+	case client.AddressBookChangeUnignored:
+		kind = types.AddressBookChangeKind_ADDRESS_BOOK_CHANGE_UNIGNORED
+	case client.AddressBookChangeBlocked:
+		kind = types.AddressBookChangeKind_ADDRESS_BOOK_CHANGE_BLOCKED
+	default:
+		u.log.Warnf("Unknown address book change %d", change)
+		return
+	}
+	u.sendChange(ru, kind)
+}
+
+// registerOfflineMessageStorageHandlers registers the handlers for streams on
+// the client's notification manager.
+func (u *usersServer) registerOfflineMessageStorageHandlers() {
+	nmgr := u.c.NotificationManager()
+	nmgr.RegisterSync(client.OnKXCompleted(u.kxNtfnHandler))
+	nmgr.RegisterSync(client.OnBlockNtfn(u.blockNtfnHandler))
+	nmgr.RegisterSync(client.OnAddressBookChangedNtfn(u.abChangedNtfnHandler))
+}
+
+var _ types.UsersServiceServer = (*usersServer)(nil)
+
+// InitUsersService initializes and binds a UsersService server to the RPC
+// server.
+func (s *Server) InitUsersService(cfg UsersServerCfg) error {
+	log := slog.Disabled
+	if cfg.Log != nil {
+		log = cfg.Log
+	}
+	abStreams, err := newServerStreams[*types.AddressBookChanged](cfg.RootReplayMsgLogs, "addressbook", log)
+	if err != nil {
+		return err
+	}
+
+	us := &usersServer{
+		cfg: cfg,
+		log: log,
+		c:   cfg.Client,
+
+		abStreams: abStreams,
+	}
+	us.registerOfflineMessageStorageHandlers()
+	s.bindService("UsersService", types.UsersServiceDefn(), us)
+	return nil
+}
@@ -51,7 +51,7 @@ func (v *versionServer) KeepaliveStream(ctx context.Context, req *types.Keepaliv
 
 // InitVersionService inits and binds a VersionService server to the RPC server.
 func (s *Server) InitVersionService(appName, appVersion string) {
-	s.services.Bind("VersionService", types.VersionServiceDefn(), &versionServer{
+	s.bindService("VersionService", types.VersionServiceDefn(), &versionServer{
 		AppName:    appName,
 		AppVersion: appVersion,
 	})
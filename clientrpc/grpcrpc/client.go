@@ -0,0 +1,246 @@
+package grpcrpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/decred/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/proto"
+)
+
+// fullMethodFromSvcMethod converts a method name as used in the services map
+// ("Service.Method") to a gRPC full method name ("/Service/Method").
+func fullMethodFromSvcMethod(method string) (string, error) {
+	svc, name, ok := strings.Cut(method, ".")
+	if !ok || svc == "" || name == "" {
+		return "", errors.New("method is not Service.Method")
+	}
+	return "/" + svc + "/" + name, nil
+}
+
+// clientStream is the client side of a stream: it receives the messages sent
+// by the server.
+type clientStream struct {
+	s grpc.ClientStream
+}
+
+// Recv the next message of the stream. It returns io.EOF once the server
+// finishes the stream.
+func (s clientStream) Recv(m proto.Message) error {
+	return s.s.RecvMsg(m)
+}
+
+// authHeaderCreds sends an authorization header (basic auth credentials or an
+// API token) on every request.
+type authHeaderCreds struct {
+	header string
+}
+
+func (c authHeaderCreds) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": c.header}, nil
+}
+
+func (c authHeaderCreds) RequireTransportSecurity() bool {
+	return true
+}
+
+// Client is a gRPC client. It can connect to compatible servers to perform
+// requests and may be used in place of the JSON-RPC clients by the clients of
+// the types package.
+//
+// The underlying connection is established on the first request and is
+// automatically reestablished if it breaks.
+type Client struct {
+	conn *grpc.ClientConn
+	log  slog.Logger
+}
+
+// Close the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Request performs a unary request to the server, filling in the passed
+// response object.
+func (c *Client) Request(ctx context.Context, method string, req, res proto.Message) error {
+	fullMethod, err := fullMethodFromSvcMethod(method)
+	if err != nil {
+		return err
+	}
+	return c.conn.Invoke(ctx, fullMethod, req, res)
+}
+
+// Stream performs a streaming request to the server. It returns a stream from
+// which individual responses can be read.
+//
+// The stream closes when the passed context is closed, when the server sends
+// an error (or io.EOF at the end of the stream) or when the connection to the
+// server on which the request was made is broken.
+func (c *Client) Stream(ctx context.Context, method string, params proto.Message) (types.ClientStream, error) {
+	fullMethod, err := fullMethodFromSvcMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := &grpc.StreamDesc{StreamName: method, ServerStreams: true}
+	s, err := c.conn.NewStream(ctx, desc, fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.SendMsg(params); err != nil {
+		return nil, err
+	}
+	if err := s.CloseSend(); err != nil {
+		return nil, err
+	}
+	return clientStream{s: s}, nil
+}
+
+var _ types.ClientConn = (*Client)(nil)
+
+type clientConfig struct {
+	log            slog.Logger
+	addr           string
+	serverCertPath string
+	clientCertPath string
+	clientKeyPath  string
+	rpcUser        string
+	rpcPass        string
+	authToken      string
+}
+
+// tlsConfig creates the TLS config used to connect to the server.
+func (cfg *clientConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:       tls.VersionTLS12,
+		CurvePreferences: []tls.CurveID{tls.X25519, tls.CurveP256},
+		CipherSuites: []uint16{
+			tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+			tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		},
+	}
+	if cfg.serverCertPath != "" {
+		serverCert, err := os.ReadFile(cfg.serverCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load server cert file: %v", err)
+		}
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(serverCert)
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.clientCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.clientCertPath, cfg.clientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client keypair: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// ClientOption is a configuration option for gRPC clients.
+type ClientOption func(cfg *clientConfig)
+
+// WithServerAddress defines the address (host:port) of the clientrpc gRPC
+// server.
+func WithServerAddress(addr string) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.addr = addr
+	}
+}
+
+// WithClientLog defines the logger to use to log client-related debug messages.
+func WithClientLog(log slog.Logger) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.log = log
+	}
+}
+
+// WithServerTLSCertPath defines the path to the certificate file to use to
+// connect to the server. If this option is not defined, only system
+// certificates will be used to verify the server connection.
+func WithServerTLSCertPath(certPath string) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.serverCertPath = certPath
+	}
+}
+
+// WithClientTLSCert defines the path to the client certificate and key to use
+// to authenticate against the server. If the server requires client
+// authentication, then providing this option is necessary.
+func WithClientTLSCert(certPath, keyPath string) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.clientCertPath = certPath
+		cfg.clientKeyPath = keyPath
+	}
+}
+
+// WithClientBasicAuth defines the basic auth credentials to send on every
+// request.
+func WithClientBasicAuth(rpcuser, rpcpass string) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.rpcUser = rpcuser
+		cfg.rpcPass = rpcpass
+	}
+}
+
+// WithClientAuthToken defines the API token to send on every request. The
+// token is used instead of any basic auth credentials.
+func WithClientAuthToken(token string) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.authToken = token
+	}
+}
+
+// NewClient creates a new gRPC client.
+func NewClient(options ...ClientOption) (*Client, error) {
+	cfg := &clientConfig{
+		log: slog.Disabled,
+	}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	if cfg.addr == "" {
+		return nil, errors.New("server address not specified")
+	}
+
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize)),
+	}
+	switch {
+	case cfg.authToken != "":
+		header := types.BearerAuthHeader(cfg.authToken)
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(authHeaderCreds{header: header}))
+	case cfg.rpcUser != "" || cfg.rpcPass != "":
+		auth := cfg.rpcUser + ":" + cfg.rpcPass
+		header := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(authHeaderCreds{header: header}))
+	}
+
+	conn, err := grpc.Dial(cfg.addr, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	cfg.log.Debugf("Created gRPC client for %s", cfg.addr)
+
+	return &Client{conn: conn, log: cfg.log}, nil
+}
@@ -0,0 +1,268 @@
+package grpcrpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/decred/slog"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxMsgSize is the maximum size of messages received by servers and clients.
+const maxMsgSize = 64 * 1024 * 1024
+
+// serverStream is the server side of a stream: it sends replies via Send().
+type serverStream struct {
+	s grpc.ServerStream
+}
+
+func (s serverStream) Send(m proto.Message) error {
+	return s.s.SendMsg(m)
+}
+
+// svcMethodFromFullMethod converts a gRPC full method name ("/Service/Method")
+// to the name used in the services map ("Service.Method").
+func svcMethodFromFullMethod(fullMethod string) (string, error) {
+	svc, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok || svc == "" || method == "" {
+		return "", errors.New("method is not /Service/Method")
+	}
+	return svc + "." + method, nil
+}
+
+// statusFromError converts an error returned by a service into a gRPC status
+// error.
+func statusFromError(err error) error {
+	if err == nil || errors.Is(err, io.EOF) {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, types.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, types.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+// Server is a gRPC server. It serves the same services as the JSON-RPC server,
+// using the service and method names of the clientrpc.proto file, so clients
+// generated from it may be used to connect to it.
+type Server struct {
+	services  *types.ServersMap
+	listeners []net.Listener
+	log       slog.Logger
+	rpcUser   string
+	rpcPass   string
+	authMode  string
+}
+
+// checkAuth verifies the credentials of the request, when required by the
+// server. It returns the context to use for the request, which carries its
+// API token (if any).
+func (s *Server) checkAuth(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var auth string
+	if v := md.Get("authorization"); len(v) > 0 {
+		auth = v[0]
+	}
+
+	// API tokens are verified by the services before each call, so only
+	// pass them along.
+	if token, ok := types.ParseBearerAuth(auth); ok {
+		return types.ContextWithAuthToken(ctx, token), nil
+	}
+
+	switch {
+	case s.authMode == "":
+		return ctx, nil
+	case s.authMode == types.AuthModeToken:
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	case s.rpcUser == "" || s.rpcPass == "":
+		return nil, status.Error(codes.Unauthenticated, "Forbidden")
+	}
+
+	enc, ok := strings.CutPrefix(auth, "Basic ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+	creds, err := base64.StdEncoding.DecodeString(enc)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+	username, password, _ := strings.Cut(string(creds), ":")
+	userOk := subtle.ConstantTimeCompare([]byte(username), []byte(s.rpcUser)) == 1
+	passOk := subtle.ConstantTimeCompare([]byte(password), []byte(s.rpcPass)) == 1
+	if !userOk || !passOk {
+		return nil, status.Error(codes.PermissionDenied, "Forbidden")
+	}
+	return ctx, nil
+}
+
+// handleStream handles every call made to the server, both unary and
+// streaming ones, by dispatching it to the bound service.
+func (s *Server) handleStream(_ interface{}, stream grpc.ServerStream) error {
+	ctx, err := s.checkAuth(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	fullMethod, _ := grpc.MethodFromServerStream(stream)
+	method, err := svcMethodFromFullMethod(fullMethod)
+	if err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	_, svc, methodDefn, err := s.services.SvcForMethod(method)
+	if err != nil {
+		s.log.Errorf("Error calling SvcForMethod %s: %v", method, err)
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	req := methodDefn.NewRequest()
+	if err := stream.RecvMsg(req); err != nil {
+		return status.Errorf(codes.InvalidArgument, "unable to decode request: %v", err)
+	}
+
+	if methodDefn.IsStreaming {
+		err = methodDefn.ServerStreamHandler(svc, ctx, req, serverStream{s: stream})
+		return statusFromError(err)
+	}
+
+	res := methodDefn.NewResponse()
+	err = methodDefn.ServerHandler(svc, ctx, req, res)
+	if err != nil {
+		s.log.Errorf("Error handling request %s: %v", method, err)
+		return statusFromError(err)
+	}
+	return stream.SendMsg(res)
+}
+
+// Run the server, responding to requests until the context is closed.
+func (s *Server) Run(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+
+	grpcServer := grpc.NewServer(
+		grpc.UnknownServiceHandler(s.handleStream),
+		grpc.MaxRecvMsgSize(maxMsgSize),
+	)
+
+	// Listen on network interfaces.
+	for _, l := range s.listeners {
+		l := l
+		g.Go(func() error {
+			s.log.Infof("Listening for clientrpc gRPC requests on %s", l.Addr())
+			err := grpcServer.Serve(l)
+			if errors.Is(err, grpc.ErrServerStopped) {
+				err = nil
+			}
+			return err
+		})
+	}
+
+	// Wait to shutdown listeners. Streams are long lived, so only wait a
+	// short time for them to finish.
+	g.Go(func() error {
+		<-gctx.Done()
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			grpcServer.Stop()
+		}
+		return nil
+	})
+
+	return g.Wait()
+}
+
+type serverConfig struct {
+	services  *types.ServersMap
+	listeners []net.Listener
+	log       slog.Logger
+	authMode  string
+	rpcUser   string
+	rpcPass   string
+}
+
+// ServerOption defines an option when configuring a gRPC server.
+type ServerOption func(*serverConfig)
+
+// WithServices defines the service map to use on the server. Services may be
+// added or removed from this as needed.
+func WithServices(s *types.ServersMap) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.services = s
+	}
+}
+
+// WithListeners defines which listeners to bind the server to. The listeners
+// must have been configured with TLS (advertising the "h2" protocol),
+// client-side authentication or any other needed configuration.
+func WithListeners(listeners []net.Listener) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.listeners = listeners
+	}
+}
+
+// WithServerLog defines the logger to use to log server debug messages.
+func WithServerLog(log slog.Logger) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.log = log
+	}
+}
+
+// WithAuth defines the credentials required by the server. When authMode is
+// types.AuthModeToken, every request must carry an API token. Otherwise, when
+// it is not empty, requests without an API token must carry the basic auth
+// credentials.
+func WithAuth(username, password, authMode string) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.rpcUser = username
+		cfg.rpcPass = password
+		cfg.authMode = authMode
+	}
+}
+
+// NewServer returns a new gRPC server.
+//
+// This is usually only used inside Bison Relay clients.
+func NewServer(options ...ServerOption) *Server {
+	cfg := &serverConfig{
+		log: slog.Disabled,
+	}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	return &Server{
+		services:  cfg.services,
+		listeners: cfg.listeners,
+		log:       cfg.log,
+		authMode:  cfg.authMode,
+		rpcUser:   cfg.rpcUser,
+		rpcPass:   cfg.rpcPass,
+	}
+}
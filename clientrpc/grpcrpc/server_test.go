@@ -0,0 +1,246 @@
+package grpcrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/internal/tlsconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testServerImpl struct {
+	appName string
+}
+
+func (t *testServerImpl) Version(ctx context.Context, _ *types.VersionRequest, res *types.VersionResponse) error {
+	if t.appName == "" {
+		return errors.New("no app name")
+	}
+	res.AppName = t.appName
+	res.AppVersion, _ = types.AuthTokenFromContext(ctx)
+	return nil
+}
+
+func (t *testServerImpl) KeepaliveStream(ctx context.Context, req *types.KeepaliveStreamRequest, stream types.VersionService_KeepaliveStreamServer) error {
+	// Send a fixed number of events, so that the end of the stream can be
+	// tested.
+	for i := int64(0); i < req.Interval; i++ {
+		event := &types.KeepaliveEvent{Timestamp: i}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newTestServer runs a server with TLS and client cert auth and returns a
+// client connected to it.
+func newTestServer(t *testing.T, services *types.ServersMap, srvOpts []ServerOption,
+	cliOpts []ClientOption) *Client {
+
+	t.Helper()
+	dir := t.TempDir()
+	listeners, err := tlsconn.TLSListeners(tlsconn.TLSListenersConfig{
+		Addresses:                   []string{"127.0.0.1:0"},
+		CertPath:                    filepath.Join(dir, "rpc.cert"),
+		KeyPath:                     filepath.Join(dir, "rpc.key"),
+		CreateCertPairIfNotExists:   true,
+		ClientCAPath:                filepath.Join(dir, "rpc-ca.cert"),
+		ClientCertPath:              filepath.Join(dir, "rpc-client.cert"),
+		ClientKeyPath:               filepath.Join(dir, "rpc-client.key"),
+		CreateClientCertIfNotExists: true,
+		NextProtos:                  []string{"h2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srvOpts = append(srvOpts, WithServices(services), WithListeners(listeners))
+	server := NewServer(srvOpts...)
+	runErr := make(chan error, 1)
+	go func() { runErr <- server.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-runErr; err != nil && !errors.Is(err, context.Canceled) {
+			t.Errorf("unexpected run error: %v", err)
+		}
+	})
+
+	_, port, _ := net.SplitHostPort(listeners[0].Addr().String())
+	cliOpts = append(cliOpts,
+		WithServerAddress("localhost:"+port),
+		WithServerTLSCertPath(filepath.Join(dir, "rpc.cert")),
+		WithClientTLSCert(filepath.Join(dir, "rpc-client.cert"),
+			filepath.Join(dir, "rpc-client.key")))
+	c, err := NewClient(cliOpts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// TestUnaryAndStreamRequests tests unary and streaming requests through the
+// gRPC transport.
+func TestUnaryAndStreamRequests(t *testing.T) {
+	services := &types.ServersMap{}
+	services.Bind("VersionService", types.VersionServiceDefn(),
+		&testServerImpl{appName: "testapp"})
+	c := newTestServer(t, services, nil, nil)
+	vc := types.NewVersionServiceClient(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var res types.VersionResponse
+	if err := vc.Version(ctx, &types.VersionRequest{}, &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.AppName != "testapp" {
+		t.Fatalf("unexpected app name: got %q, want %q", res.AppName, "testapp")
+	}
+
+	const nbEvents = 5
+	stream, err := vc.KeepaliveStream(ctx, &types.KeepaliveStreamRequest{Interval: nbEvents})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int64(0); i < nbEvents; i++ {
+		var ev types.KeepaliveEvent
+		if err := stream.Recv(&ev); err != nil {
+			t.Fatal(err)
+		}
+		if ev.Timestamp != i {
+			t.Fatalf("unexpected event: got %d, want %d", ev.Timestamp, i)
+		}
+	}
+	var ev types.KeepaliveEvent
+	if err := stream.Recv(&ev); !errors.Is(err, io.EOF) {
+		t.Fatalf("unexpected error at end of stream: got %v, want %v", err, io.EOF)
+	}
+}
+
+// TestErrors tests that errors are returned as gRPC statuses.
+func TestErrors(t *testing.T) {
+	services := &types.ServersMap{}
+	services.Bind("VersionService", types.VersionServiceDefn(),
+		&testServerImpl{})
+	c := newTestServer(t, services, nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Handler error.
+	var res types.VersionResponse
+	err := types.NewVersionServiceClient(c).Version(ctx, &types.VersionRequest{}, &res)
+	if status.Code(err) != codes.Unknown || status.Convert(err).Message() != "no app name" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Service not bound.
+	var pmRes types.PMResponse
+	err = types.NewChatServiceClient(c).PM(ctx, &types.PMRequest{}, &pmRes)
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestBasicAuth tests that basic auth credentials are verified when required.
+func TestBasicAuth(t *testing.T) {
+	services := &types.ServersMap{}
+	services.Bind("VersionService", types.VersionServiceDefn(),
+		&testServerImpl{appName: "testapp"})
+	srvOpts := []ServerOption{WithAuth("user", "pass", "basic")}
+
+	tests := []struct {
+		name     string
+		cliOpts  []ClientOption
+		wantCode codes.Code
+	}{{
+		name:     "no credentials",
+		wantCode: codes.Unauthenticated,
+	}, {
+		name:     "wrong credentials",
+		cliOpts:  []ClientOption{WithClientBasicAuth("user", "wrong")},
+		wantCode: codes.PermissionDenied,
+	}, {
+		name:     "correct credentials",
+		cliOpts:  []ClientOption{WithClientBasicAuth("user", "pass")},
+		wantCode: codes.OK,
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestServer(t, services, srvOpts, tc.cliOpts)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			var res types.VersionResponse
+			err := types.NewVersionServiceClient(c).Version(ctx,
+				&types.VersionRequest{}, &res)
+			if status.Code(err) != tc.wantCode {
+				t.Fatalf("unexpected error: got %v, want code %s",
+					err, tc.wantCode)
+			}
+		})
+	}
+}
+
+// TestTokenAuth tests that API tokens are passed along to the services.
+func TestTokenAuth(t *testing.T) {
+	services := &types.ServersMap{}
+	services.Bind("VersionService", types.VersionServiceDefn(),
+		&testServerImpl{appName: "testapp"})
+
+	tests := []struct {
+		name      string
+		authMode  string
+		cliOpts   []ClientOption
+		wantCode  codes.Code
+		wantToken string
+	}{{
+		name:     "token mode without token",
+		authMode: types.AuthModeToken,
+		cliOpts:  []ClientOption{WithClientBasicAuth("user", "pass")},
+		wantCode: codes.Unauthenticated,
+	}, {
+		name:      "token mode with token",
+		authMode:  types.AuthModeToken,
+		cliOpts:   []ClientOption{WithClientAuthToken("id.secret")},
+		wantToken: "id.secret",
+	}, {
+		name:      "basic mode with token",
+		authMode:  "basic",
+		cliOpts:   []ClientOption{WithClientAuthToken("id.secret")},
+		wantToken: "id.secret",
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			srvOpts := []ServerOption{WithAuth("user", "pass", tc.authMode)}
+			c := newTestServer(t, services, srvOpts, tc.cliOpts)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			var res types.VersionResponse
+			err := types.NewVersionServiceClient(c).Version(ctx,
+				&types.VersionRequest{}, &res)
+			if status.Code(err) != tc.wantCode {
+				t.Fatalf("unexpected error: got %v, want code %s",
+					err, tc.wantCode)
+			}
+			if res.AppVersion != tc.wantToken {
+				t.Fatalf("unexpected token: got %q, want %q",
+					res.AppVersion, tc.wantToken)
+			}
+		})
+	}
+}
@@ -17,12 +17,28 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-func (s *Server) wrapWithBasicAuth(handler http.HandlerFunc) http.HandlerFunc {
+func (s *Server) wrapWithAuth(handler http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// API tokens are verified by the services before each call, so
+		// only pass them along.
+		if token, ok := types.ParseBearerAuth(r.Header.Get("Authorization")); ok {
+			r = r.WithContext(types.ContextWithAuthToken(r.Context(), token))
+			handler(w, r)
+			return
+		}
+
 		// Check if provided credentials match the server's credentials
 		if s.authMode == "" {
 			// If authentication is not required, proceed with the request
 			handler(w, r)
+			return
+		}
+
+		// Every request must carry an API token.
+		if s.authMode == types.AuthModeToken {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="Restricted"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
 		}
 
 		// If Basic Auth is required
@@ -100,7 +116,7 @@ func (s *Server) Run(ctx context.Context) error {
 	serveMux := &http.ServeMux{}
 
 	// Handler for POST JSON-RPC requests.
-	serveMux.HandleFunc("/", s.wrapWithBasicAuth(s.handlePostRequest))
+	serveMux.HandleFunc("/", s.wrapWithAuth(s.handlePostRequest))
 
 	// Handler for WebSocket JSON-RPC requests.
 	wsHandler := func(w http.ResponseWriter, r *http.Request) {
@@ -165,7 +181,7 @@ func (s *Server) Run(ctx context.Context) error {
 			}
 		}
 	}
-	serveMux.HandleFunc("/ws", s.wrapWithBasicAuth(wsHandler))
+	serveMux.HandleFunc("/ws", s.wrapWithAuth(wsHandler))
 
 	httpServer := &http.Server{
 		Handler:     serveMux,
@@ -311,6 +311,7 @@ type clientConfig struct {
 	clientKeyPath  string
 	rpcUser        string
 	rpcPass        string
+	authToken      string
 }
 
 // makeDialer creates the per-conn dialer, based on the config.
@@ -355,6 +356,9 @@ func (cfg *clientConfig) makeDialer() (func(context.Context) (*websocket.Conn, e
 		// Add the Authorization header for Basic Auth
 		headers := http.Header{}
 		authHeader := makeBasicAuthHeader(cfg.rpcUser, cfg.rpcPass)
+		if cfg.authToken != "" {
+			authHeader = types.BearerAuthHeader(cfg.authToken)
+		}
 		headers.Set("Authorization", authHeader)
 		conn, resp, err := wsDialer.DialContext(ctx, cfg.url, headers)
 		if resp != nil {
@@ -415,6 +419,14 @@ func WithClientBasicAuth(rpcuser, rpcpass string) ClientOption {
 	}
 }
 
+// WithClientAuthToken defines the API token to send on every request. The
+// token is used instead of any basic auth credentials.
+func WithClientAuthToken(token string) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.authToken = token
+	}
+}
+
 // NewWSClient creates a new Websockets-based JSON-RPC 2.0 client.
 func NewWSClient(options ...ClientOption) (*WSClient, error) {
 	cfg := &clientConfig{
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrUnauthenticated is returned when a request is made without valid
+	// credentials.
+	ErrUnauthenticated = errors.New("invalid or missing API token")
+
+	// ErrPermissionDenied is returned when the credentials of a request do
+	// not allow it to be performed.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// AuthModeToken is the auth mode where every request must carry an API token.
+const AuthModeToken = "token"
+
+type authTokenCtxKey struct{}
+
+// ContextWithAuthToken returns a context that carries the API token of a
+// request.
+func ContextWithAuthToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, authTokenCtxKey{}, token)
+}
+
+// AuthTokenFromContext returns the API token of the request carried by the
+// context, if there is one.
+func AuthTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(authTokenCtxKey{}).(string)
+	return token, ok && token != ""
+}
+
+// ParseBearerAuth returns the token of a bearer authorization header value.
+func ParseBearerAuth(header string) (string, bool) {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	token = strings.TrimSpace(token)
+	return token, ok && token != ""
+}
+
+// BearerAuthHeader returns the value of the authorization header for the API
+// token.
+func BearerAuthHeader(token string) string {
+	return "Bearer " + token
+}
@@ -68,6 +68,193 @@ func (MessageMode) EnumDescriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{0}
 }
 
+// AddressBookChangeKind is the kind of change made to the address book.
+type AddressBookChangeKind int32
+
+const (
+	// ADDRESS_BOOK_CHANGE_ADDED is a user added after a completed KX.
+	AddressBookChangeKind_ADDRESS_BOOK_CHANGE_ADDED AddressBookChangeKind = 0
+	// ADDRESS_BOOK_CHANGE_RESET is a ratchet reset completed with a user.
+	AddressBookChangeKind_ADDRESS_BOOK_CHANGE_RESET AddressBookChangeKind = 1
+	// ADDRESS_BOOK_CHANGE_RENAMED is a user that had its local nick changed.
+	AddressBookChangeKind_ADDRESS_BOOK_CHANGE_RENAMED AddressBookChangeKind = 2
+	// ADDRESS_BOOK_CHANGE_IGNORED is a user that is now ignored.
+	AddressBookChangeKind_ADDRESS_BOOK_CHANGE_IGNORED AddressBookChangeKind = 3
+	// ADDRESS_BOOK_CHANGE_UNIGNORED is a user that is no longer ignored.
+	AddressBookChangeKind_ADDRESS_BOOK_CHANGE_UNIGNORED AddressBookChangeKind = 4
+	// ADDRESS_BOOK_CHANGE_BLOCKED is a user that was blocked by the local
+	// client and removed from the address book.
+	AddressBookChangeKind_ADDRESS_BOOK_CHANGE_BLOCKED AddressBookChangeKind = 5
+	// ADDRESS_BOOK_CHANGE_REMOVED_BY_REMOTE is a user that requested to be
+	// blocked and was removed from the address book.
+	AddressBookChangeKind_ADDRESS_BOOK_CHANGE_REMOVED_BY_REMOTE AddressBookChangeKind = 6
+)
+
+// Enum value maps for AddressBookChangeKind.
+var (
+	AddressBookChangeKind_name = map[int32]string{
+		0: "ADDRESS_BOOK_CHANGE_ADDED",
+		1: "ADDRESS_BOOK_CHANGE_RESET",
+		2: "ADDRESS_BOOK_CHANGE_RENAMED",
+		3: "ADDRESS_BOOK_CHANGE_IGNORED",
+		4: "ADDRESS_BOOK_CHANGE_UNIGNORED",
+		5: "ADDRESS_BOOK_CHANGE_BLOCKED",
+		6: "ADDRESS_BOOK_CHANGE_REMOVED_BY_REMOTE",
+	}
+	AddressBookChangeKind_value = map[string]int32{
+		"ADDRESS_BOOK_CHANGE_ADDED":             0,
+		"ADDRESS_BOOK_CHANGE_RESET":             1,
+		"ADDRESS_BOOK_CHANGE_RENAMED":           2,
+		"ADDRESS_BOOK_CHANGE_IGNORED":           3,
+		"ADDRESS_BOOK_CHANGE_UNIGNORED":         4,
+		"ADDRESS_BOOK_CHANGE_BLOCKED":           5,
+		"ADDRESS_BOOK_CHANGE_REMOVED_BY_REMOTE": 6,
+	}
+)
+
+func (x AddressBookChangeKind) Enum() *AddressBookChangeKind {
+	p := new(AddressBookChangeKind)
+	*p = x
+	return p
+}
+
+func (x AddressBookChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressBookChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_clientrpc_proto_enumTypes[1].Descriptor()
+}
+
+func (AddressBookChangeKind) Type() protoreflect.EnumType {
+	return &file_clientrpc_proto_enumTypes[1]
+}
+
+func (x AddressBookChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressBookChangeKind.Descriptor instead.
+func (AddressBookChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{1}
+}
+
+// LNChannelEventType is the type of a channel event.
+type LNChannelEventType int32
+
+const (
+	// LN_CHANNEL_EVENT_PENDING_OPEN is a channel that is waiting for its
+	// funding transaction to confirm.
+	LNChannelEventType_LN_CHANNEL_EVENT_PENDING_OPEN LNChannelEventType = 0
+	// LN_CHANNEL_EVENT_OPEN is a channel that was opened.
+	LNChannelEventType_LN_CHANNEL_EVENT_OPEN LNChannelEventType = 1
+	// LN_CHANNEL_EVENT_CLOSED is a channel that was closed.
+	LNChannelEventType_LN_CHANNEL_EVENT_CLOSED LNChannelEventType = 2
+	// LN_CHANNEL_EVENT_ACTIVE is a channel that became active.
+	LNChannelEventType_LN_CHANNEL_EVENT_ACTIVE LNChannelEventType = 3
+	// LN_CHANNEL_EVENT_INACTIVE is a channel that became inactive.
+	LNChannelEventType_LN_CHANNEL_EVENT_INACTIVE LNChannelEventType = 4
+	// LN_CHANNEL_EVENT_FULLY_RESOLVED is a closed channel that had all its
+	// outputs resolved.
+	LNChannelEventType_LN_CHANNEL_EVENT_FULLY_RESOLVED LNChannelEventType = 5
+)
+
+// Enum value maps for LNChannelEventType.
+var (
+	LNChannelEventType_name = map[int32]string{
+		0: "LN_CHANNEL_EVENT_PENDING_OPEN",
+		1: "LN_CHANNEL_EVENT_OPEN",
+		2: "LN_CHANNEL_EVENT_CLOSED",
+		3: "LN_CHANNEL_EVENT_ACTIVE",
+		4: "LN_CHANNEL_EVENT_INACTIVE",
+		5: "LN_CHANNEL_EVENT_FULLY_RESOLVED",
+	}
+	LNChannelEventType_value = map[string]int32{
+		"LN_CHANNEL_EVENT_PENDING_OPEN":   0,
+		"LN_CHANNEL_EVENT_OPEN":           1,
+		"LN_CHANNEL_EVENT_CLOSED":         2,
+		"LN_CHANNEL_EVENT_ACTIVE":         3,
+		"LN_CHANNEL_EVENT_INACTIVE":       4,
+		"LN_CHANNEL_EVENT_FULLY_RESOLVED": 5,
+	}
+)
+
+func (x LNChannelEventType) Enum() *LNChannelEventType {
+	p := new(LNChannelEventType)
+	*p = x
+	return p
+}
+
+func (x LNChannelEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LNChannelEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_clientrpc_proto_enumTypes[2].Descriptor()
+}
+
+func (LNChannelEventType) Type() protoreflect.EnumType {
+	return &file_clientrpc_proto_enumTypes[2]
+}
+
+func (x LNChannelEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LNChannelEventType.Descriptor instead.
+func (LNChannelEventType) EnumDescriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{2}
+}
+
+// TransferDirection is the direction of a file transfer.
+type TransferDirection int32
+
+const (
+	// TRANSFER_DOWNLOAD is a file being downloaded from a remote user.
+	TransferDirection_TRANSFER_DOWNLOAD TransferDirection = 0
+	// TRANSFER_UPLOAD is a file being uploaded to a remote user.
+	TransferDirection_TRANSFER_UPLOAD TransferDirection = 1
+)
+
+// Enum value maps for TransferDirection.
+var (
+	TransferDirection_name = map[int32]string{
+		0: "TRANSFER_DOWNLOAD",
+		1: "TRANSFER_UPLOAD",
+	}
+	TransferDirection_value = map[string]int32{
+		"TRANSFER_DOWNLOAD": 0,
+		"TRANSFER_UPLOAD":   1,
+	}
+)
+
+func (x TransferDirection) Enum() *TransferDirection {
+	p := new(TransferDirection)
+	*p = x
+	return p
+}
+
+func (x TransferDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_clientrpc_proto_enumTypes[3].Descriptor()
+}
+
+func (TransferDirection) Type() protoreflect.EnumType {
+	return &file_clientrpc_proto_enumTypes[3]
+}
+
+func (x TransferDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferDirection.Descriptor instead.
+func (TransferDirection) EnumDescriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{3}
+}
+
 type VersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3202,21 +3389,18 @@ func (x *JoinedGCEvent) GetGc() *RMGroupList {
 	return nil
 }
 
-// TipProgressRequest is the request to create a stream that receives events
-// about the progress of TipUser requests.
-type TipProgressRequest struct {
+// CreateChannelRequest is the request to create a new channel.
+type CreateChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unacked_from specifies to the server the sequence_id of the last received
-	// tip progress event. Events received by the server that have a higher
-	// sequence_id will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+	// name is the name of the new channel.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *TipProgressRequest) Reset() {
-	*x = TipProgressRequest{}
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3224,13 +3408,13 @@ func (x *TipProgressRequest) Reset() {
 	}
 }
 
-func (x *TipProgressRequest) String() string {
+func (x *CreateChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipProgressRequest) ProtoMessage() {}
+func (*CreateChannelRequest) ProtoMessage() {}
 
-func (x *TipProgressRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3242,47 +3426,30 @@ func (x *TipProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipProgressRequest.ProtoReflect.Descriptor instead.
-func (*TipProgressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *TipProgressRequest) GetUnackedFrom() uint64 {
+func (x *CreateChannelRequest) GetName() string {
 	if x != nil {
-		return x.UnackedFrom
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// TipProgressEvent details the progress of a TipUser request.
-type TipProgressEvent struct {
+// CreateChannelResponse is the response to a request to create a channel.
+type CreateChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// uid is the User ID of the TipUser target.
-	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the nick of the TipUser target.
-	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
-	// amount_matoms is the amount being tipped in milli-atoms.
-	AmountMatoms int64 `protobuf:"varint,4,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
-	// completed flags whether the TipUser request was successfully completed
-	// (i.e. if the tip was paid).
-	Completed bool `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	// attempt is the attempt number.
-	Attempt int32 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	// attempt_err is filled when the attempt to fetch an invoice or perform
-	// the payment for a received invoice failed.
-	AttemptErr string `protobuf:"bytes,7,opt,name=attempt_err,json=attemptErr,proto3" json:"attempt_err,omitempty"`
-	// will_retry flags whether a new attempt to request an invoice and perform
-	// a payment will be done or if no more attempts will happen.
-	WillRetry bool `protobuf:"varint,8,opt,name=will_retry,json=willRetry,proto3" json:"will_retry,omitempty"`
+	// id is the ID of the new channel.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *TipProgressEvent) Reset() {
-	*x = TipProgressEvent{}
+func (x *CreateChannelResponse) Reset() {
+	*x = CreateChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3290,13 +3457,13 @@ func (x *TipProgressEvent) Reset() {
 	}
 }
 
-func (x *TipProgressEvent) String() string {
+func (x *CreateChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipProgressEvent) ProtoMessage() {}
+func (*CreateChannelResponse) ProtoMessage() {}
 
-func (x *TipProgressEvent) ProtoReflect() protoreflect.Message {
+func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3308,77 +3475,33 @@ func (x *TipProgressEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipProgressEvent.ProtoReflect.Descriptor instead.
-func (*TipProgressEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *TipProgressEvent) GetSequenceId() uint64 {
-	if x != nil {
-		return x.SequenceId
-	}
-	return 0
-}
-
-func (x *TipProgressEvent) GetUid() []byte {
+func (x *CreateChannelResponse) GetId() []byte {
 	if x != nil {
-		return x.Uid
+		return x.Id
 	}
 	return nil
 }
 
-func (x *TipProgressEvent) GetNick() string {
-	if x != nil {
-		return x.Nick
-	}
-	return ""
-}
-
-func (x *TipProgressEvent) GetAmountMatoms() int64 {
-	if x != nil {
-		return x.AmountMatoms
-	}
-	return 0
-}
-
-func (x *TipProgressEvent) GetCompleted() bool {
-	if x != nil {
-		return x.Completed
-	}
-	return false
-}
-
-func (x *TipProgressEvent) GetAttempt() int32 {
-	if x != nil {
-		return x.Attempt
-	}
-	return 0
-}
-
-func (x *TipProgressEvent) GetAttemptErr() string {
-	if x != nil {
-		return x.AttemptErr
-	}
-	return ""
-}
-
-func (x *TipProgressEvent) GetWillRetry() bool {
-	if x != nil {
-		return x.WillRetry
-	}
-	return false
-}
-
-// ResourceRequestsStreamRequest is the request for a stream to receive resource
-// requests.
-type ResourceRequestsStreamRequest struct {
+// ChannelShareInviteRequest is the request to get a shareable channel invite.
+type ChannelShareInviteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// gc is the hex-encoded ID or alias of the channel.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// regen requests a new invite to be generated. This invalidates previously
+	// shared invites.
+	Regen bool `protobuf:"varint,2,opt,name=regen,proto3" json:"regen,omitempty"`
 }
 
-func (x *ResourceRequestsStreamRequest) Reset() {
-	*x = ResourceRequestsStreamRequest{}
+func (x *ChannelShareInviteRequest) Reset() {
+	*x = ChannelShareInviteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3386,13 +3509,13 @@ func (x *ResourceRequestsStreamRequest) Reset() {
 	}
 }
 
-func (x *ResourceRequestsStreamRequest) String() string {
+func (x *ChannelShareInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceRequestsStreamRequest) ProtoMessage() {}
+func (*ChannelShareInviteRequest) ProtoMessage() {}
 
-func (x *ResourceRequestsStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *ChannelShareInviteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3404,31 +3527,38 @@ func (x *ResourceRequestsStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceRequestsStreamRequest.ProtoReflect.Descriptor instead.
-func (*ResourceRequestsStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelShareInviteRequest.ProtoReflect.Descriptor instead.
+func (*ChannelShareInviteRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{58}
 }
 
-// ResourceRequestsStreamResponse is the a request made by a remote client for
-// one of the local client's resources.
-type ResourceRequestsStreamResponse struct {
+func (x *ChannelShareInviteRequest) GetGc() string {
+	if x != nil {
+		return x.Gc
+	}
+	return ""
+}
+
+func (x *ChannelShareInviteRequest) GetRegen() bool {
+	if x != nil {
+		return x.Regen
+	}
+	return false
+}
+
+// ChannelShareInviteResponse is the response to a request for a shareable
+// channel invite.
+type ChannelShareInviteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is an opaque ID to link requests and responses made through the clientrpc
-	// interface.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// uid is the User ID of the client that requested the resource.
-	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the nick of the client that requested the resource.
-	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
-	// request is the actual request made by the remote client.
-	Request *RMFetchResource `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	// invite is the encoded invite that may be shared with other users.
+	Invite string `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
 }
 
-func (x *ResourceRequestsStreamResponse) Reset() {
-	*x = ResourceRequestsStreamResponse{}
+func (x *ChannelShareInviteResponse) Reset() {
+	*x = ChannelShareInviteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3436,13 +3566,13 @@ func (x *ResourceRequestsStreamResponse) Reset() {
 	}
 }
 
-func (x *ResourceRequestsStreamResponse) String() string {
+func (x *ChannelShareInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceRequestsStreamResponse) ProtoMessage() {}
+func (*ChannelShareInviteResponse) ProtoMessage() {}
 
-func (x *ResourceRequestsStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *ChannelShareInviteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3454,55 +3584,30 @@ func (x *ResourceRequestsStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceRequestsStreamResponse.ProtoReflect.Descriptor instead.
-func (*ResourceRequestsStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelShareInviteResponse.ProtoReflect.Descriptor instead.
+func (*ChannelShareInviteResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ResourceRequestsStreamResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ResourceRequestsStreamResponse) GetUid() []byte {
-	if x != nil {
-		return x.Uid
-	}
-	return nil
-}
-
-func (x *ResourceRequestsStreamResponse) GetNick() string {
+func (x *ChannelShareInviteResponse) GetInvite() string {
 	if x != nil {
-		return x.Nick
+		return x.Invite
 	}
 	return ""
 }
 
-func (x *ResourceRequestsStreamResponse) GetRequest() *RMFetchResource {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-// FulfillResourceRequest fulfills a remote request for a local client resource.
-type FulfillResourceRequest struct {
+// JoinChannelRequest is the request to subscribe to a channel.
+type JoinChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id must be the corresponding request received by the clientrpc client.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// error_msg is an optional error message to fail the request with.
-	ErrorMsg string `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	// response is the actual resource that should be sent to the remote client.
-	Response *RMFetchResourceReply `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	// invite is the encoded invite, as returned by ChannelShareInvite.
+	Invite string `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
 }
 
-func (x *FulfillResourceRequest) Reset() {
-	*x = FulfillResourceRequest{}
+func (x *JoinChannelRequest) Reset() {
+	*x = JoinChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3510,13 +3615,13 @@ func (x *FulfillResourceRequest) Reset() {
 	}
 }
 
-func (x *FulfillResourceRequest) String() string {
+func (x *JoinChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FulfillResourceRequest) ProtoMessage() {}
+func (*JoinChannelRequest) ProtoMessage() {}
 
-func (x *FulfillResourceRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3528,42 +3633,27 @@ func (x *FulfillResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FulfillResourceRequest.ProtoReflect.Descriptor instead.
-func (*FulfillResourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinChannelRequest.ProtoReflect.Descriptor instead.
+func (*JoinChannelRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *FulfillResourceRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *FulfillResourceRequest) GetErrorMsg() string {
+func (x *JoinChannelRequest) GetInvite() string {
 	if x != nil {
-		return x.ErrorMsg
+		return x.Invite
 	}
 	return ""
 }
 
-func (x *FulfillResourceRequest) GetResponse() *RMFetchResourceReply {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-// FulfillResourceRequestResponse is the response to a FulfillResourceResquest
-// call.
-type FulfillResourceRequestResponse struct {
+// JoinChannelResponse is the response to a request to subscribe to a channel.
+type JoinChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FulfillResourceRequestResponse) Reset() {
-	*x = FulfillResourceRequestResponse{}
+func (x *JoinChannelResponse) Reset() {
+	*x = JoinChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3571,13 +3661,13 @@ func (x *FulfillResourceRequestResponse) Reset() {
 	}
 }
 
-func (x *FulfillResourceRequestResponse) String() string {
+func (x *JoinChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FulfillResourceRequestResponse) ProtoMessage() {}
+func (*JoinChannelResponse) ProtoMessage() {}
 
-func (x *FulfillResourceRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *JoinChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3589,26 +3679,25 @@ func (x *FulfillResourceRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FulfillResourceRequestResponse.ProtoReflect.Descriptor instead.
-func (*FulfillResourceRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinChannelResponse.ProtoReflect.Descriptor instead.
+func (*JoinChannelResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{61}
 }
 
-// DownloadsCompletedRequest is the request sent when obtaining a stream of
-// completed downloads notifications.
-type DownloadsCompletedStreamRequest struct {
+// EstimateGCMCostRequest is the request to estimate the cost of a GC message.
+type EstimateGCMCostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unacked_from specifies to the server the sequence_id of the last completed
-	// download. Downloads completed by the server that have a higher sequence_id
-	// will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+	// gc is the hex-encoded ID or alias of the GC.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// msg is the message to send.
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *DownloadsCompletedStreamRequest) Reset() {
-	*x = DownloadsCompletedStreamRequest{}
+func (x *EstimateGCMCostRequest) Reset() {
+	*x = EstimateGCMCostRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3616,13 +3705,13 @@ func (x *DownloadsCompletedStreamRequest) Reset() {
 	}
 }
 
-func (x *DownloadsCompletedStreamRequest) String() string {
+func (x *EstimateGCMCostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadsCompletedStreamRequest) ProtoMessage() {}
+func (*EstimateGCMCostRequest) ProtoMessage() {}
 
-func (x *DownloadsCompletedStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *EstimateGCMCostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3634,38 +3723,39 @@ func (x *DownloadsCompletedStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadsCompletedStreamRequest.ProtoReflect.Descriptor instead.
-func (*DownloadsCompletedStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateGCMCostRequest.ProtoReflect.Descriptor instead.
+func (*EstimateGCMCostRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DownloadsCompletedStreamRequest) GetUnackedFrom() uint64 {
+func (x *EstimateGCMCostRequest) GetGc() string {
 	if x != nil {
-		return x.UnackedFrom
+		return x.Gc
 	}
-	return 0
+	return ""
 }
 
-// DownloadCompletedResponse is the data about one completed file download.
-type DownloadCompletedResponse struct {
+func (x *EstimateGCMCostRequest) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// EstimateGCMCostResponse is the response to a GC message cost estimate.
+type EstimateGCMCostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// uid is the User ID of the client that sent the file.
-	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the nick of the client that sent the resource.
-	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
-	// disk_path is the path of the file in the local client's disk.
-	DiskPath string `protobuf:"bytes,4,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
-	// file_metadata is the metadata about the file.
-	FileMetadata *FileMetadata `protobuf:"bytes,5,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
+	// cost_matoms is the estimated total cost (in milliatoms).
+	CostMatoms uint64 `protobuf:"varint,1,opt,name=cost_matoms,json=costMatoms,proto3" json:"cost_matoms,omitempty"`
+	// nb_dests is the number of members the message would be sent to.
+	NbDests uint32 `protobuf:"varint,2,opt,name=nb_dests,json=nbDests,proto3" json:"nb_dests,omitempty"`
 }
 
-func (x *DownloadCompletedResponse) Reset() {
-	*x = DownloadCompletedResponse{}
+func (x *EstimateGCMCostResponse) Reset() {
+	*x = EstimateGCMCostResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3673,13 +3763,13 @@ func (x *DownloadCompletedResponse) Reset() {
 	}
 }
 
-func (x *DownloadCompletedResponse) String() string {
+func (x *EstimateGCMCostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadCompletedResponse) ProtoMessage() {}
+func (*EstimateGCMCostResponse) ProtoMessage() {}
 
-func (x *DownloadCompletedResponse) ProtoReflect() protoreflect.Message {
+func (x *EstimateGCMCostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3691,60 +3781,42 @@ func (x *DownloadCompletedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadCompletedResponse.ProtoReflect.Descriptor instead.
-func (*DownloadCompletedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateGCMCostResponse.ProtoReflect.Descriptor instead.
+func (*EstimateGCMCostResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DownloadCompletedResponse) GetSequenceId() uint64 {
+func (x *EstimateGCMCostResponse) GetCostMatoms() uint64 {
 	if x != nil {
-		return x.SequenceId
+		return x.CostMatoms
 	}
 	return 0
 }
 
-func (x *DownloadCompletedResponse) GetUid() []byte {
-	if x != nil {
-		return x.Uid
-	}
-	return nil
-}
-
-func (x *DownloadCompletedResponse) GetNick() string {
-	if x != nil {
-		return x.Nick
-	}
-	return ""
-}
-
-func (x *DownloadCompletedResponse) GetDiskPath() string {
-	if x != nil {
-		return x.DiskPath
-	}
-	return ""
-}
-
-func (x *DownloadCompletedResponse) GetFileMetadata() *FileMetadata {
+func (x *EstimateGCMCostResponse) GetNbDests() uint32 {
 	if x != nil {
-		return x.FileMetadata
+		return x.NbDests
 	}
-	return nil
+	return 0
 }
 
-// RMPrivateMessage is the network-level routed private message.
-type RMPrivateMessage struct {
+// PublishToChannelRequest is the request to publish a message in a channel.
+type PublishToChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// message is the private message payload.
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	// mode is the message mode.
-	Mode MessageMode `protobuf:"varint,2,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+	// gc is the hex-encoded ID or alias of the channel.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// msg is the message to publish.
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// max_cost_matoms is the maximum estimated cost (in milliatoms) to publish
+	// the message. If zero, the message is published regardless of cost.
+	MaxCostMatoms uint64 `protobuf:"varint,3,opt,name=max_cost_matoms,json=maxCostMatoms,proto3" json:"max_cost_matoms,omitempty"`
 }
 
-func (x *RMPrivateMessage) Reset() {
-	*x = RMPrivateMessage{}
+func (x *PublishToChannelRequest) Reset() {
+	*x = PublishToChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3752,13 +3824,13 @@ func (x *RMPrivateMessage) Reset() {
 	}
 }
 
-func (x *RMPrivateMessage) String() string {
+func (x *PublishToChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMPrivateMessage) ProtoMessage() {}
+func (*PublishToChannelRequest) ProtoMessage() {}
 
-func (x *RMPrivateMessage) ProtoReflect() protoreflect.Message {
+func (x *PublishToChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3770,44 +3842,47 @@ func (x *RMPrivateMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMPrivateMessage.ProtoReflect.Descriptor instead.
-func (*RMPrivateMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishToChannelRequest.ProtoReflect.Descriptor instead.
+func (*PublishToChannelRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *RMPrivateMessage) GetMessage() string {
+func (x *PublishToChannelRequest) GetGc() string {
 	if x != nil {
-		return x.Message
+		return x.Gc
 	}
 	return ""
 }
 
-func (x *RMPrivateMessage) GetMode() MessageMode {
+func (x *PublishToChannelRequest) GetMsg() string {
 	if x != nil {
-		return x.Mode
+		return x.Msg
 	}
-	return MessageMode_MESSAGE_MODE_NORMAL
+	return ""
 }
 
-// RMGroupMessage is the network-level routed group message.
-type RMGroupMessage struct {
+func (x *PublishToChannelRequest) GetMaxCostMatoms() uint64 {
+	if x != nil {
+		return x.MaxCostMatoms
+	}
+	return 0
+}
+
+// PublishToChannelResponse is the response to publishing a message in a
+// channel.
+type PublishToChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the group chat id where the message was sent.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// generation is the internal generation of the group chat metadata when the
-	// sender sent this message.
-	Generation uint64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
-	// message is the textual content.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// mode is the mode of the message.
-	Mode MessageMode `protobuf:"varint,4,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+	// cost_matoms is the estimated total cost (in milliatoms).
+	CostMatoms uint64 `protobuf:"varint,1,opt,name=cost_matoms,json=costMatoms,proto3" json:"cost_matoms,omitempty"`
+	// nb_dests is the number of subscribers the message was sent to.
+	NbDests uint32 `protobuf:"varint,2,opt,name=nb_dests,json=nbDests,proto3" json:"nb_dests,omitempty"`
 }
 
-func (x *RMGroupMessage) Reset() {
-	*x = RMGroupMessage{}
+func (x *PublishToChannelResponse) Reset() {
+	*x = PublishToChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3815,13 +3890,13 @@ func (x *RMGroupMessage) Reset() {
 	}
 }
 
-func (x *RMGroupMessage) String() string {
+func (x *PublishToChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMGroupMessage) ProtoMessage() {}
+func (*PublishToChannelResponse) ProtoMessage() {}
 
-func (x *RMGroupMessage) ProtoReflect() protoreflect.Message {
+func (x *PublishToChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3833,53 +3908,40 @@ func (x *RMGroupMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMGroupMessage.ProtoReflect.Descriptor instead.
-func (*RMGroupMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishToChannelResponse.ProtoReflect.Descriptor instead.
+func (*PublishToChannelResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *RMGroupMessage) GetId() []byte {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-func (x *RMGroupMessage) GetGeneration() uint64 {
+func (x *PublishToChannelResponse) GetCostMatoms() uint64 {
 	if x != nil {
-		return x.Generation
+		return x.CostMatoms
 	}
 	return 0
 }
 
-func (x *RMGroupMessage) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *RMGroupMessage) GetMode() MessageMode {
+func (x *PublishToChannelResponse) GetNbDests() uint32 {
 	if x != nil {
-		return x.Mode
+		return x.NbDests
 	}
-	return MessageMode_MESSAGE_MODE_NORMAL
+	return 0
 }
 
-// PostMetadata is the network-level post data.
-type PostMetadata struct {
+// TipProgressRequest is the request to create a stream that receives events
+// about the progress of TipUser requests.
+type TipProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// version defines the available fields within attributes.
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// attributes defines the available post attributes.
-	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// unacked_from specifies to the server the sequence_id of the last received
+	// tip progress event. Events received by the server that have a higher
+	// sequence_id will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *PostMetadata) Reset() {
-	*x = PostMetadata{}
+func (x *TipProgressRequest) Reset() {
+	*x = TipProgressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3887,13 +3949,13 @@ func (x *PostMetadata) Reset() {
 	}
 }
 
-func (x *PostMetadata) String() string {
+func (x *TipProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostMetadata) ProtoMessage() {}
+func (*TipProgressRequest) ProtoMessage() {}
 
-func (x *PostMetadata) ProtoReflect() protoreflect.Message {
+func (x *TipProgressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3905,43 +3967,47 @@ func (x *PostMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostMetadata.ProtoReflect.Descriptor instead.
-func (*PostMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use TipProgressRequest.ProtoReflect.Descriptor instead.
+func (*TipProgressRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *PostMetadata) GetVersion() uint64 {
+func (x *TipProgressRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.Version
+		return x.UnackedFrom
 	}
 	return 0
 }
 
-func (x *PostMetadata) GetAttributes() map[string]string {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-// PostMetadataStatus is the network-level post status update data.
-type PostMetadataStatus struct {
+// TipProgressEvent details the progress of a TipUser request.
+type TipProgressEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// version defines the available fields within attributes.
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// from is the UID of the original status creator.
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// link is the ID of the post.
-	Link string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
-	// attributes is the list of post update attributes.
-	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the User ID of the TipUser target.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the TipUser target.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// amount_matoms is the amount being tipped in milli-atoms.
+	AmountMatoms int64 `protobuf:"varint,4,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// completed flags whether the TipUser request was successfully completed
+	// (i.e. if the tip was paid).
+	Completed bool `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	// attempt is the attempt number.
+	Attempt int32 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// attempt_err is filled when the attempt to fetch an invoice or perform
+	// the payment for a received invoice failed.
+	AttemptErr string `protobuf:"bytes,7,opt,name=attempt_err,json=attemptErr,proto3" json:"attempt_err,omitempty"`
+	// will_retry flags whether a new attempt to request an invoice and perform
+	// a payment will be done or if no more attempts will happen.
+	WillRetry bool `protobuf:"varint,8,opt,name=will_retry,json=willRetry,proto3" json:"will_retry,omitempty"`
 }
 
-func (x *PostMetadataStatus) Reset() {
-	*x = PostMetadataStatus{}
+func (x *TipProgressEvent) Reset() {
+	*x = TipProgressEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3949,13 +4015,13 @@ func (x *PostMetadataStatus) Reset() {
 	}
 }
 
-func (x *PostMetadataStatus) String() string {
+func (x *TipProgressEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostMetadataStatus) ProtoMessage() {}
+func (*TipProgressEvent) ProtoMessage() {}
 
-func (x *PostMetadataStatus) ProtoReflect() protoreflect.Message {
+func (x *TipProgressEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3967,48 +4033,77 @@ func (x *PostMetadataStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostMetadataStatus.ProtoReflect.Descriptor instead.
-func (*PostMetadataStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use TipProgressEvent.ProtoReflect.Descriptor instead.
+func (*TipProgressEvent) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *PostMetadataStatus) GetVersion() uint64 {
+func (x *TipProgressEvent) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Version
+		return x.SequenceId
 	}
 	return 0
 }
 
-func (x *PostMetadataStatus) GetFrom() string {
+func (x *TipProgressEvent) GetUid() []byte {
 	if x != nil {
-		return x.From
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *TipProgressEvent) GetNick() string {
+	if x != nil {
+		return x.Nick
 	}
 	return ""
 }
 
-func (x *PostMetadataStatus) GetLink() string {
+func (x *TipProgressEvent) GetAmountMatoms() int64 {
 	if x != nil {
-		return x.Link
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *TipProgressEvent) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *TipProgressEvent) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *TipProgressEvent) GetAttemptErr() string {
+	if x != nil {
+		return x.AttemptErr
 	}
 	return ""
 }
 
-func (x *PostMetadataStatus) GetAttributes() map[string]string {
+func (x *TipProgressEvent) GetWillRetry() bool {
 	if x != nil {
-		return x.Attributes
+		return x.WillRetry
 	}
-	return nil
+	return false
 }
 
-// PublicIdentityReq is a request to get PublicIdentity.
-type PublicIdentityReq struct {
+// ResourceRequestsStreamRequest is the request for a stream to receive resource
+// requests.
+type ResourceRequestsStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PublicIdentityReq) Reset() {
-	*x = PublicIdentityReq{}
+func (x *ResourceRequestsStreamRequest) Reset() {
+	*x = ResourceRequestsStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4016,13 +4111,13 @@ func (x *PublicIdentityReq) Reset() {
 	}
 }
 
-func (x *PublicIdentityReq) String() string {
+func (x *ResourceRequestsStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublicIdentityReq) ProtoMessage() {}
+func (*ResourceRequestsStreamRequest) ProtoMessage() {}
 
-func (x *PublicIdentityReq) ProtoReflect() protoreflect.Message {
+func (x *ResourceRequestsStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4034,35 +4129,31 @@ func (x *PublicIdentityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublicIdentityReq.ProtoReflect.Descriptor instead.
-func (*PublicIdentityReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceRequestsStreamRequest.ProtoReflect.Descriptor instead.
+func (*ResourceRequestsStreamRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{68}
 }
 
-// PublicIdentity is the lowlevel public identity.
-type PublicIdentity struct {
+// ResourceRequestsStreamResponse is the a request made by a remote client for
+// one of the local client's resources.
+type ResourceRequestsStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the name of the user.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// nick is the short name/alias of the user.
-	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
-	// sig_key is the signature key used to authenticate messages from the user.
-	SigKey []byte `protobuf:"bytes,3,opt,name=sig_key,json=sigKey,proto3" json:"sig_key,omitempty"`
-	// key is the NTRU public key of the user.
-	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	// identity is the public ID of the user.
-	Identity []byte `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
-	// digest is a hash of the user's public information.
-	Digest []byte `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
-	// signature is a signature of the user's public information.
-	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// id is an opaque ID to link requests and responses made through the clientrpc
+	// interface.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// uid is the User ID of the client that requested the resource.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the client that requested the resource.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// request is the actual request made by the remote client.
+	Request *RMFetchResource `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *PublicIdentity) Reset() {
-	*x = PublicIdentity{}
+func (x *ResourceRequestsStreamResponse) Reset() {
+	*x = ResourceRequestsStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4070,13 +4161,13 @@ func (x *PublicIdentity) Reset() {
 	}
 }
 
-func (x *PublicIdentity) String() string {
+func (x *ResourceRequestsStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublicIdentity) ProtoMessage() {}
+func (*ResourceRequestsStreamResponse) ProtoMessage() {}
 
-func (x *PublicIdentity) ProtoReflect() protoreflect.Message {
+func (x *ResourceRequestsStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4088,83 +4179,55 @@ func (x *PublicIdentity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublicIdentity.ProtoReflect.Descriptor instead.
-func (*PublicIdentity) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceRequestsStreamResponse.ProtoReflect.Descriptor instead.
+func (*ResourceRequestsStreamResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *PublicIdentity) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PublicIdentity) GetNick() string {
-	if x != nil {
-		return x.Nick
-	}
-	return ""
-}
-
-func (x *PublicIdentity) GetSigKey() []byte {
-	if x != nil {
-		return x.SigKey
-	}
-	return nil
-}
-
-func (x *PublicIdentity) GetKey() []byte {
+func (x *ResourceRequestsStreamResponse) GetId() uint64 {
 	if x != nil {
-		return x.Key
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *PublicIdentity) GetIdentity() []byte {
+func (x *ResourceRequestsStreamResponse) GetUid() []byte {
 	if x != nil {
-		return x.Identity
+		return x.Uid
 	}
 	return nil
 }
 
-func (x *PublicIdentity) GetDigest() []byte {
+func (x *ResourceRequestsStreamResponse) GetNick() string {
 	if x != nil {
-		return x.Digest
+		return x.Nick
 	}
-	return nil
+	return ""
 }
 
-func (x *PublicIdentity) GetSignature() []byte {
+func (x *ResourceRequestsStreamResponse) GetRequest() *RMFetchResource {
 	if x != nil {
-		return x.Signature
+		return x.Request
 	}
 	return nil
 }
 
-// InviteFunds is the lowlevel information about funds added to an invite.
-type InviteFunds struct {
+// FulfillResourceRequest fulfills a remote request for a local client resource.
+type FulfillResourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tx is the hash of the transaction that includes the funds.
-	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// index is the output index of the transaction that includes the funds.
-	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	// tree is the tree of the transaction that includes the funds.
-	Tree int32 `protobuf:"varint,3,opt,name=tree,proto3" json:"tree,omitempty"`
-	// private_key is the private key needed to redeem the funds in the transaction.
-	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,proto3" json:"private_key,omitempty"`
-	// height_hint is a height at or before the transaction that includes the funds
-	// is mined in.
-	HeightHint uint32 `protobuf:"varint,5,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
-	// address is the public address that the funds were sent to.
-	Address string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	// id must be the corresponding request received by the clientrpc client.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// error_msg is an optional error message to fail the request with.
+	ErrorMsg string `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	// response is the actual resource that should be sent to the remote client.
+	Response *RMFetchResourceReply `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *InviteFunds) Reset() {
-	*x = InviteFunds{}
+func (x *FulfillResourceRequest) Reset() {
+	*x = FulfillResourceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4172,13 +4235,13 @@ func (x *InviteFunds) Reset() {
 	}
 }
 
-func (x *InviteFunds) String() string {
+func (x *FulfillResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteFunds) ProtoMessage() {}
+func (*FulfillResourceRequest) ProtoMessage() {}
 
-func (x *InviteFunds) ProtoReflect() protoreflect.Message {
+func (x *FulfillResourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4190,72 +4253,42 @@ func (x *InviteFunds) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteFunds.ProtoReflect.Descriptor instead.
-func (*InviteFunds) Descriptor() ([]byte, []int) {
+// Deprecated: Use FulfillResourceRequest.ProtoReflect.Descriptor instead.
+func (*FulfillResourceRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *InviteFunds) GetTx() string {
-	if x != nil {
-		return x.Tx
-	}
-	return ""
-}
-
-func (x *InviteFunds) GetIndex() uint32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *InviteFunds) GetTree() int32 {
+func (x *FulfillResourceRequest) GetId() uint64 {
 	if x != nil {
-		return x.Tree
+		return x.Id
 	}
 	return 0
 }
 
-func (x *InviteFunds) GetPrivateKey() string {
+func (x *FulfillResourceRequest) GetErrorMsg() string {
 	if x != nil {
-		return x.PrivateKey
+		return x.ErrorMsg
 	}
 	return ""
 }
 
-func (x *InviteFunds) GetHeightHint() uint32 {
-	if x != nil {
-		return x.HeightHint
-	}
-	return 0
-}
-
-func (x *InviteFunds) GetAddress() string {
+func (x *FulfillResourceRequest) GetResponse() *RMFetchResourceReply {
 	if x != nil {
-		return x.Address
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-// OOBPublicIdentityInvite is an out-of-band invite to perform a KX.
-type OOBPublicIdentityInvite struct {
+// FulfillResourceRequestResponse is the response to a FulfillResourceResquest
+// call.
+type FulfillResourceRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// public is the public identity of the inviter.
-	Public *PublicIdentity `protobuf:"bytes,1,opt,name=public,proto3" json:"public,omitempty"`
-	// initial_rendezvous is the initial random RV where key exchange will happen.
-	InitialRendezvous []byte `protobuf:"bytes,2,opt,name=initial_rendezvous,json=initialrendezvous,proto3" json:"initial_rendezvous,omitempty"`
-	// reset_rendezvous is the inviter's reset RV in case of ratchet reset actions.
-	ResetRendezvous []byte `protobuf:"bytes,3,opt,name=reset_rendezvous,json=resetrendezvous,proto3" json:"reset_rendezvous,omitempty"`
-	// funds are funds that may be included in the invite and are redeemable by
-	// the invitee.
-	Funds *InviteFunds `protobuf:"bytes,4,opt,name=funds,proto3" json:"funds,omitempty"`
 }
 
-func (x *OOBPublicIdentityInvite) Reset() {
-	*x = OOBPublicIdentityInvite{}
+func (x *FulfillResourceRequestResponse) Reset() {
+	*x = FulfillResourceRequestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4263,13 +4296,13 @@ func (x *OOBPublicIdentityInvite) Reset() {
 	}
 }
 
-func (x *OOBPublicIdentityInvite) String() string {
+func (x *FulfillResourceRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OOBPublicIdentityInvite) ProtoMessage() {}
+func (*FulfillResourceRequestResponse) ProtoMessage() {}
 
-func (x *OOBPublicIdentityInvite) ProtoReflect() protoreflect.Message {
+func (x *FulfillResourceRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4281,76 +4314,106 @@ func (x *OOBPublicIdentityInvite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OOBPublicIdentityInvite.ProtoReflect.Descriptor instead.
-func (*OOBPublicIdentityInvite) Descriptor() ([]byte, []int) {
+// Deprecated: Use FulfillResourceRequestResponse.ProtoReflect.Descriptor instead.
+func (*FulfillResourceRequestResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *OOBPublicIdentityInvite) GetPublic() *PublicIdentity {
-	if x != nil {
-		return x.Public
-	}
-	return nil
+// ProductSubscriptionsStreamRequest is the request for a stream of product
+// subscription changes.
+type ProductSubscriptionsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last
+	// processed event. Events with a higher sequence_id will be streamed back
+	// to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *OOBPublicIdentityInvite) GetInitialRendezvous() []byte {
-	if x != nil {
-		return x.InitialRendezvous
+func (x *ProductSubscriptionsStreamRequest) Reset() {
+	*x = ProductSubscriptionsStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *OOBPublicIdentityInvite) GetResetRendezvous() []byte {
-	if x != nil {
-		return x.ResetRendezvous
+func (x *ProductSubscriptionsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSubscriptionsStreamRequest) ProtoMessage() {}
+
+func (x *ProductSubscriptionsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *OOBPublicIdentityInvite) GetFunds() *InviteFunds {
+// Deprecated: Use ProductSubscriptionsStreamRequest.ProtoReflect.Descriptor instead.
+func (*ProductSubscriptionsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ProductSubscriptionsStreamRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.Funds
+		return x.UnackedFrom
 	}
-	return nil
+	return 0
 }
 
-// RMGroupInvite is the information about an invite to join a GC.
-type RMGroupInvite struct {
+// ProductSubscriptionChanged is a change in the subscription of a remote user
+// to a recurring product.
+type ProductSubscriptionChanged struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the ID of the GC.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the name of the GC.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// token is a random number that must be sent back by the invitee to the inviter.
-	Token uint64 `protobuf:"varint,3,opt,name=token,proto3" json:"token,omitempty"`
-	// description is a description of the GC.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// expires is a timestamp for when the invitation expires.
-	Expires int64 `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
-	// version is the version of the current definition of the GC.
-	Version uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
-}
-
-func (x *RMGroupInvite) Reset() {
-	*x = RMGroupInvite{}
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the ID of the subscriber.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the subscriber.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// provider identifies the kind of provider that sold the product.
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// product is the id of the product (e.g. its SKU).
+	Product string `protobuf:"bytes,5,opt,name=product,proto3" json:"product,omitempty"`
+	// title is the name of the product.
+	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	// active is true while the subscription is paid for.
+	Active bool `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	// expires is the unix timestamp of the end of the current period of the
+	// subscription.
+	Expires int64 `protobuf:"varint,8,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *ProductSubscriptionChanged) Reset() {
+	*x = ProductSubscriptionChanged{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[72]
+		mi := &file_clientrpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RMGroupInvite) String() string {
+func (x *ProductSubscriptionChanged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMGroupInvite) ProtoMessage() {}
+func (*ProductSubscriptionChanged) ProtoMessage() {}
 
-func (x *RMGroupInvite) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[72]
+func (x *ProductSubscriptionChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4361,92 +4424,97 @@ func (x *RMGroupInvite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMGroupInvite.ProtoReflect.Descriptor instead.
-func (*RMGroupInvite) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ProductSubscriptionChanged.ProtoReflect.Descriptor instead.
+func (*ProductSubscriptionChanged) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *RMGroupInvite) GetId() []byte {
+func (x *ProductSubscriptionChanged) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.SequenceId
+	}
+	return 0
+}
+
+func (x *ProductSubscriptionChanged) GetUid() []byte {
+	if x != nil {
+		return x.Uid
 	}
 	return nil
 }
 
-func (x *RMGroupInvite) GetName() string {
+func (x *ProductSubscriptionChanged) GetNick() string {
 	if x != nil {
-		return x.Name
+		return x.Nick
 	}
 	return ""
 }
 
-func (x *RMGroupInvite) GetToken() uint64 {
+func (x *ProductSubscriptionChanged) GetProvider() string {
 	if x != nil {
-		return x.Token
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *RMGroupInvite) GetDescription() string {
+func (x *ProductSubscriptionChanged) GetProduct() string {
 	if x != nil {
-		return x.Description
+		return x.Product
 	}
 	return ""
 }
 
-func (x *RMGroupInvite) GetExpires() int64 {
+func (x *ProductSubscriptionChanged) GetTitle() string {
 	if x != nil {
-		return x.Expires
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *RMGroupInvite) GetVersion() uint32 {
+func (x *ProductSubscriptionChanged) GetActive() bool {
 	if x != nil {
-		return x.Version
+		return x.Active
+	}
+	return false
+}
+
+func (x *ProductSubscriptionChanged) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
 	}
 	return 0
 }
 
-// RMGroupList is the full definition of a GC.
-type RMGroupList struct {
+// DownloadsCompletedRequest is the request sent when obtaining a stream of
+// completed downloads notifications.
+type DownloadsCompletedStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the ID of the GC.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the name of the GC.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// generation is a monotonically increasing count of GC changes.
-	Generation uint64 `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
-	// timestamp is the timestamp for the last modification of the GC defintions.
-	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// version is the GC rules version.
-	Version uint32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	// members is the list of user IDs that are in the GC.
-	Members [][]byte `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
-	// extra_admins is the list of user IDs that are additional admins of the GC.
-	ExtraAdmins [][]byte `protobuf:"bytes,7,rep,name=extra_admins,proto3" json:"extra_admins,omitempty"`
+	// unacked_from specifies to the server the sequence_id of the last completed
+	// download. Downloads completed by the server that have a higher sequence_id
+	// will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *RMGroupList) Reset() {
-	*x = RMGroupList{}
+func (x *DownloadsCompletedStreamRequest) Reset() {
+	*x = DownloadsCompletedStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[73]
+		mi := &file_clientrpc_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RMGroupList) String() string {
+func (x *DownloadsCompletedStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMGroupList) ProtoMessage() {}
+func (*DownloadsCompletedStreamRequest) ProtoMessage() {}
 
-func (x *RMGroupList) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[73]
+func (x *DownloadsCompletedStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4457,97 +4525,137 @@ func (x *RMGroupList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMGroupList.ProtoReflect.Descriptor instead.
-func (*RMGroupList) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use DownloadsCompletedStreamRequest.ProtoReflect.Descriptor instead.
+func (*DownloadsCompletedStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *RMGroupList) GetId() []byte {
+func (x *DownloadsCompletedStreamRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UnackedFrom
 	}
-	return nil
+	return 0
 }
 
-func (x *RMGroupList) GetName() string {
-	if x != nil {
-		return x.Name
+// DownloadCompletedResponse is the data about one completed file download.
+type DownloadCompletedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the User ID of the client that sent the file.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the client that sent the resource.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// disk_path is the path of the file in the local client's disk.
+	DiskPath string `protobuf:"bytes,4,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// file_metadata is the metadata about the file.
+	FileMetadata *FileMetadata `protobuf:"bytes,5,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
+}
+
+func (x *DownloadCompletedResponse) Reset() {
+	*x = DownloadCompletedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RMGroupList) GetGeneration() uint64 {
+func (x *DownloadCompletedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCompletedResponse) ProtoMessage() {}
+
+func (x *DownloadCompletedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCompletedResponse.ProtoReflect.Descriptor instead.
+func (*DownloadCompletedResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DownloadCompletedResponse) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Generation
+		return x.SequenceId
 	}
 	return 0
 }
 
-func (x *RMGroupList) GetTimestamp() int64 {
+func (x *DownloadCompletedResponse) GetUid() []byte {
 	if x != nil {
-		return x.Timestamp
+		return x.Uid
 	}
-	return 0
+	return nil
 }
 
-func (x *RMGroupList) GetVersion() uint32 {
+func (x *DownloadCompletedResponse) GetNick() string {
 	if x != nil {
-		return x.Version
+		return x.Nick
 	}
-	return 0
+	return ""
 }
 
-func (x *RMGroupList) GetMembers() [][]byte {
+func (x *DownloadCompletedResponse) GetDiskPath() string {
 	if x != nil {
-		return x.Members
+		return x.DiskPath
 	}
-	return nil
+	return ""
 }
 
-func (x *RMGroupList) GetExtraAdmins() [][]byte {
+func (x *DownloadCompletedResponse) GetFileMetadata() *FileMetadata {
 	if x != nil {
-		return x.ExtraAdmins
+		return x.FileMetadata
 	}
 	return nil
 }
 
-// RMFetchResource is the lowlevel request to fetch a resource.
-type RMFetchResource struct {
+// ShareDirRequest is the request to share a dir as a bundle.
+type ShareDirRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// path is the resource's path (already split into segments).
-	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
-	// meta is metadata associated with the request.
-	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// tag is a unique tag that should be relayed back on the reply.
-	Tag uint64 `protobuf:"varint,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	// data is raw request data.
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	// index is used in chunked/multipart requests.
-	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
-	// count is the total number of chunks in multipart requests.
-	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// dir is the path to the local dir to share.
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// cost is the cost (in atoms) to download the bundle.
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// description is the description of the bundle.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// uid is the user to share the bundle with. If empty, the bundle is shared
+	// with all users.
+	Uid []byte `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *RMFetchResource) Reset() {
-	*x = RMFetchResource{}
+func (x *ShareDirRequest) Reset() {
+	*x = ShareDirRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[74]
+		mi := &file_clientrpc_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RMFetchResource) String() string {
+func (x *ShareDirRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMFetchResource) ProtoMessage() {}
+func (*ShareDirRequest) ProtoMessage() {}
 
-func (x *RMFetchResource) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[74]
+func (x *ShareDirRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4558,90 +4666,68 @@ func (x *RMFetchResource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMFetchResource.ProtoReflect.Descriptor instead.
-func (*RMFetchResource) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use ShareDirRequest.ProtoReflect.Descriptor instead.
+func (*ShareDirRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *RMFetchResource) GetPath() []string {
+func (x *ShareDirRequest) GetDir() string {
 	if x != nil {
-		return x.Path
+		return x.Dir
 	}
-	return nil
+	return ""
 }
 
-func (x *RMFetchResource) GetMeta() map[string]string {
+func (x *ShareDirRequest) GetCost() uint64 {
 	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *RMFetchResource) GetTag() uint64 {
-	if x != nil {
-		return x.Tag
+		return x.Cost
 	}
 	return 0
 }
 
-func (x *RMFetchResource) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *RMFetchResource) GetIndex() uint32 {
+func (x *ShareDirRequest) GetDescription() string {
 	if x != nil {
-		return x.Index
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *RMFetchResource) GetCount() uint32 {
+func (x *ShareDirRequest) GetUid() []byte {
 	if x != nil {
-		return x.Count
+		return x.Uid
 	}
-	return 0
+	return nil
 }
 
-// RMFetchResourceReply is the lowlevel resource response.
-type RMFetchResourceReply struct {
+// ShareDirResponse is the response to a ShareDir request.
+type ShareDirResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tag is the same as the request tag.
-	Tag uint64 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	// status is the general status of the response.
-	Status uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	// meta is additional response metadata.
-	Meta map[string]string `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// data is the raw response data.
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	// index is used in chunked/multipart responses.
-	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
-	// count is the total number of chunks in multipart responses.
-	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// file_id is the ID of the shared bundle.
+	FileId []byte `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// file_metadata is the metadata of the shared bundle.
+	FileMetadata *FileMetadata `protobuf:"bytes,2,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
 }
 
-func (x *RMFetchResourceReply) Reset() {
-	*x = RMFetchResourceReply{}
+func (x *ShareDirResponse) Reset() {
+	*x = ShareDirResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[75]
+		mi := &file_clientrpc_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RMFetchResourceReply) String() string {
+func (x *ShareDirResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMFetchResourceReply) ProtoMessage() {}
+func (*ShareDirResponse) ProtoMessage() {}
 
-func (x *RMFetchResourceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[75]
+func (x *ShareDirResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4652,84 +4738,55 @@ func (x *RMFetchResourceReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMFetchResourceReply.ProtoReflect.Descriptor instead.
-func (*RMFetchResourceReply) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *RMFetchResourceReply) GetTag() uint64 {
-	if x != nil {
-		return x.Tag
-	}
-	return 0
-}
-
-func (x *RMFetchResourceReply) GetStatus() uint32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+// Deprecated: Use ShareDirResponse.ProtoReflect.Descriptor instead.
+func (*ShareDirResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *RMFetchResourceReply) GetMeta() map[string]string {
+func (x *ShareDirResponse) GetFileId() []byte {
 	if x != nil {
-		return x.Meta
+		return x.FileId
 	}
 	return nil
 }
 
-func (x *RMFetchResourceReply) GetData() []byte {
+func (x *ShareDirResponse) GetFileMetadata() *FileMetadata {
 	if x != nil {
-		return x.Data
+		return x.FileMetadata
 	}
 	return nil
 }
 
-func (x *RMFetchResourceReply) GetIndex() uint32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *RMFetchResourceReply) GetCount() uint32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-// FileManifest is metadata about a chunk of the file.
-type FileManifest struct {
+// SearchContentRequest is the request to start a content search.
+type SearchContentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// index is the position of this manifest item on the file metadata manifest.
-	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	// size is the size of this chunk.
-	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	// hash is the hash of the chunk data.
-	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// query is the (case insensitive) regexp to match against filenames and
+	// descriptions.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// uids are the users to search. If empty, all users are searched.
+	Uids [][]byte `protobuf:"bytes,2,rep,name=uids,proto3" json:"uids,omitempty"`
 }
 
-func (x *FileManifest) Reset() {
-	*x = FileManifest{}
+func (x *SearchContentRequest) Reset() {
+	*x = SearchContentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[76]
+		mi := &file_clientrpc_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileManifest) String() string {
+func (x *SearchContentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileManifest) ProtoMessage() {}
+func (*SearchContentRequest) ProtoMessage() {}
 
-func (x *FileManifest) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[76]
+func (x *SearchContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4740,77 +4797,92 @@ func (x *FileManifest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileManifest.ProtoReflect.Descriptor instead.
-func (*FileManifest) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use SearchContentRequest.ProtoReflect.Descriptor instead.
+func (*SearchContentRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *FileManifest) GetIndex() uint64 {
+func (x *SearchContentRequest) GetQuery() string {
 	if x != nil {
-		return x.Index
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *FileManifest) GetSize() uint64 {
+func (x *SearchContentRequest) GetUids() [][]byte {
 	if x != nil {
-		return x.Size
+		return x.Uids
 	}
-	return 0
+	return nil
 }
 
-func (x *FileManifest) GetHash() []byte {
-	if x != nil {
-		return x.Hash
+// SearchContentResponse is the response to a SearchContent request.
+type SearchContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SearchContentResponse) Reset() {
+	*x = SearchContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-// FileMetadata is metadata about a file in the file transfer subsystem.
-type FileMetadata struct {
+func (x *SearchContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentResponse) ProtoMessage() {}
+
+func (x *SearchContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentResponse.ProtoReflect.Descriptor instead.
+func (*SearchContentResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{79}
+}
+
+// ContentSearchResultsRequest is the request for the results of a content
+// search.
+type ContentSearchResultsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// version of the metadata.
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// cost (in milliatoms) to download the file.
-	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	// size (in bytes) of the file.
-	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	// directory where the file is stored in the source.
-	Directory string `protobuf:"bytes,4,opt,name=directory,proto3" json:"directory,omitempty"`
-	// filename of the file.
-	Filename string `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
-	// description of the file.
-	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	// hash of the file contents.
-	Hash string `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
-	// manifest of the chunks that compose the file.
-	Manifest []*FileManifest `protobuf:"bytes,8,rep,name=manifest,proto3" json:"manifest,omitempty"`
-	// signature of the file by the host.
-	Signature string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
-	// attributes of the file.
-	Attributes map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// query is the query of the search.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *FileMetadata) Reset() {
-	*x = FileMetadata{}
+func (x *ContentSearchResultsRequest) Reset() {
+	*x = ContentSearchResultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[77]
+		mi := &file_clientrpc_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileMetadata) String() string {
+func (x *ContentSearchResultsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileMetadata) ProtoMessage() {}
+func (*ContentSearchResultsRequest) ProtoMessage() {}
 
-func (x *FileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[77]
+func (x *ContentSearchResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4821,110 +4893,139 @@ func (x *FileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
-func (*FileMetadata) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use ContentSearchResultsRequest.ProtoReflect.Descriptor instead.
+func (*ContentSearchResultsRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *FileMetadata) GetVersion() uint64 {
+func (x *ContentSearchResultsRequest) GetQuery() string {
 	if x != nil {
-		return x.Version
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *FileMetadata) GetCost() uint64 {
-	if x != nil {
-		return x.Cost
-	}
-	return 0
+// ContentSearchSeller is a user that shares a file found in a search.
+type ContentSearchSeller struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the ID of the user.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the user.
+	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
+	// file_id is the ID of the file shared by the user.
+	FileId []byte `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// filename is the name of the file shared by the user.
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// cost (in atoms) to download the file from the user.
+	Cost uint64 `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
 }
 
-func (x *FileMetadata) GetSize() uint64 {
-	if x != nil {
-		return x.Size
+func (x *ContentSearchSeller) Reset() {
+	*x = ContentSearchSeller{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *FileMetadata) GetDirectory() string {
-	if x != nil {
-		return x.Directory
-	}
-	return ""
+func (x *ContentSearchSeller) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FileMetadata) GetFilename() string {
-	if x != nil {
-		return x.Filename
+func (*ContentSearchSeller) ProtoMessage() {}
+
+func (x *ContentSearchSeller) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FileMetadata) GetDescription() string {
+// Deprecated: Use ContentSearchSeller.ProtoReflect.Descriptor instead.
+func (*ContentSearchSeller) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ContentSearchSeller) GetUid() []byte {
 	if x != nil {
-		return x.Description
+		return x.Uid
 	}
-	return ""
+	return nil
 }
 
-func (x *FileMetadata) GetHash() string {
+func (x *ContentSearchSeller) GetNick() string {
 	if x != nil {
-		return x.Hash
+		return x.Nick
 	}
 	return ""
 }
 
-func (x *FileMetadata) GetManifest() []*FileManifest {
+func (x *ContentSearchSeller) GetFileId() []byte {
 	if x != nil {
-		return x.Manifest
+		return x.FileId
 	}
 	return nil
 }
 
-func (x *FileMetadata) GetSignature() string {
+func (x *ContentSearchSeller) GetFilename() string {
 	if x != nil {
-		return x.Signature
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *FileMetadata) GetAttributes() map[string]string {
+func (x *ContentSearchSeller) GetCost() uint64 {
 	if x != nil {
-		return x.Attributes
+		return x.Cost
 	}
-	return nil
+	return 0
 }
 
-// TipStreamRequest is the request for a new tip reception stream.
-type TipStreamRequest struct {
+// ContentSearchResult is a file found in a search. Files with the same content
+// shared by multiple users are aggregated in a single result.
+type ContentSearchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unacked_from specifies to the server the sequence_id of the last processed
-	// PM. PMs received by the server that have a higher sequence_id will be
-	// streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+	// hash of the file contents.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// filename of the file.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// description of the file.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// size (in bytes) of the file.
+	Size uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// sellers are the users that share the file, ordered by cost.
+	Sellers []*ContentSearchSeller `protobuf:"bytes,5,rep,name=sellers,proto3" json:"sellers,omitempty"`
 }
 
-func (x *TipStreamRequest) Reset() {
-	*x = TipStreamRequest{}
+func (x *ContentSearchResult) Reset() {
+	*x = ContentSearchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[78]
+		mi := &file_clientrpc_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TipStreamRequest) String() string {
+func (x *ContentSearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipStreamRequest) ProtoMessage() {}
+func (*ContentSearchResult) ProtoMessage() {}
 
-func (x *TipStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[78]
+func (x *ContentSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4935,49 +5036,84 @@ func (x *TipStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipStreamRequest.ProtoReflect.Descriptor instead.
-func (*TipStreamRequest) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ContentSearchResult.ProtoReflect.Descriptor instead.
+func (*ContentSearchResult) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *TipStreamRequest) GetUnackedFrom() uint64 {
+func (x *ContentSearchResult) GetHash() string {
 	if x != nil {
-		return x.UnackedFrom
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ContentSearchResult) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ContentSearchResult) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ContentSearchResult) GetSize() uint64 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-// ReceivedPM is a private message received by the client.
-type ReceivedTip struct {
+func (x *ContentSearchResult) GetSellers() []*ContentSearchSeller {
+	if x != nil {
+		return x.Sellers
+	}
+	return nil
+}
+
+// ContentSearchResultsResponse is the response to a ContentSearchResults
+// request.
+type ContentSearchResultsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uid is the source user ID in raw format.
-	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	// amount_matoms is the amount being tipped in milli-atoms.
-	AmountMatoms int64 `protobuf:"varint,2,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,3,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-}
-
-func (x *ReceivedTip) Reset() {
-	*x = ReceivedTip{}
+	// query is the query of the search.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// started is the unix timestamp of when the search was started.
+	Started int64 `protobuf:"varint,2,opt,name=started,proto3" json:"started,omitempty"`
+	// updated is the unix timestamp of the last received reply.
+	Updated int64 `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	// queried is the number of users searched.
+	Queried uint32 `protobuf:"varint,4,opt,name=queried,proto3" json:"queried,omitempty"`
+	// replied is the number of users that replied.
+	Replied uint32 `protobuf:"varint,5,opt,name=replied,proto3" json:"replied,omitempty"`
+	// results are the files found.
+	Results []*ContentSearchResult `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ContentSearchResultsResponse) Reset() {
+	*x = ContentSearchResultsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[79]
+		mi := &file_clientrpc_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceivedTip) String() string {
+func (x *ContentSearchResultsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceivedTip) ProtoMessage() {}
+func (*ContentSearchResultsResponse) ProtoMessage() {}
 
-func (x *ReceivedTip) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[79]
+func (x *ContentSearchResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4988,67 +5124,221 @@ func (x *ReceivedTip) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceivedTip.ProtoReflect.Descriptor instead.
-func (*ReceivedTip) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ContentSearchResultsResponse.ProtoReflect.Descriptor instead.
+func (*ContentSearchResultsResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ReceivedTip) GetUid() []byte {
+func (x *ContentSearchResultsResponse) GetQuery() string {
 	if x != nil {
-		return x.Uid
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-func (x *ReceivedTip) GetAmountMatoms() int64 {
+func (x *ContentSearchResultsResponse) GetStarted() int64 {
 	if x != nil {
-		return x.AmountMatoms
+		return x.Started
 	}
 	return 0
 }
 
-func (x *ReceivedTip) GetSequenceId() uint64 {
+func (x *ContentSearchResultsResponse) GetUpdated() int64 {
 	if x != nil {
-		return x.SequenceId
+		return x.Updated
 	}
 	return 0
 }
 
-// GCInfo is the summary info for a GC.
-type ListGCsResponse_GCInfo struct {
+func (x *ContentSearchResultsResponse) GetQueried() uint32 {
+	if x != nil {
+		return x.Queried
+	}
+	return 0
+}
+
+func (x *ContentSearchResultsResponse) GetReplied() uint32 {
+	if x != nil {
+		return x.Replied
+	}
+	return 0
+}
+
+func (x *ContentSearchResultsResponse) GetResults() []*ContentSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// MediaInfo is the metadata (and optionally, the data) of an audio or video
+// message.
+type MediaInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the unique GC ID.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the local name/alias for the GC.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// version is the current version the GC definition is on.
-	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	// timestamp is the timestamp of the last modification to the GC definition.
-	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// nb_members is the total number of members in the GC.
-	NbMembers uint32 `protobuf:"varint,5,opt,name=nb_members,json=nbMembers,proto3" json:"nb_members,omitempty"`
+	// filename is the name of the media file.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// mime_type is the mime type of the media (for example, audio/ogg).
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// codec is the codec used to encode the media (for example, opus).
+	Codec string `protobuf:"bytes,3,opt,name=codec,proto3" json:"codec,omitempty"`
+	// duration_ms is the duration of the media in milliseconds.
+	DurationMs uint64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// size is the size of the media file.
+	Size uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// thumbnail is an optional small image that represents the media.
+	Thumbnail []byte `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// data is the content of the media file, when it was embedded in the
+	// message.
+	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// alt is a short description of the media.
+	Alt string `protobuf:"bytes,8,opt,name=alt,proto3" json:"alt,omitempty"`
+	// file_id is the ID of the file transfer that sends the media, when it was
+	// too large to be embedded in the message. It matches the metadata hash of
+	// the file reported in ContentService.DownloadsCompletedStream.
+	FileId []byte `protobuf:"bytes,9,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *MediaInfo) Reset() {
+	*x = MediaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *ListGCsResponse_GCInfo) Reset() {
-	*x = ListGCsResponse_GCInfo{}
+func (x *MediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaInfo) ProtoMessage() {}
+
+func (x *MediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaInfo.ProtoReflect.Descriptor instead.
+func (*MediaInfo) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MediaInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *MediaInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MediaInfo) GetThumbnail() []byte {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return nil
+}
+
+func (x *MediaInfo) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MediaInfo) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+// SendMediaRequest is a request to send an audio or video message.
+type SendMediaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user is the nick or hex-encoded ID of the destination user. Either user
+	// or gc must be specified.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// gc is the alias or hex-encoded ID of the destination GC.
+	Gc string `protobuf:"bytes,2,opt,name=gc,proto3" json:"gc,omitempty"`
+	// path is the local path to the media file. If empty, media.data must be
+	// specified. Calls made with an API token may only specify a path if the
+	// token is allowed to access local files.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// media is the metadata of the media. Unspecified fields are determined
+	// from the media file when possible.
+	Media *MediaInfo `protobuf:"bytes,4,opt,name=media,proto3" json:"media,omitempty"`
+	// caption is an optional text sent along with the media.
+	Caption string `protobuf:"bytes,5,opt,name=caption,proto3" json:"caption,omitempty"`
+	// max_cost_matoms is the maximum estimated cost (in milliatoms) to send the
+	// media to a GC, including sending the media file to every member. If
+	// zero, the media is sent regardless of cost.
+	MaxCostMatoms uint64 `protobuf:"varint,6,opt,name=max_cost_matoms,json=maxCostMatoms,proto3" json:"max_cost_matoms,omitempty"`
+}
+
+func (x *SendMediaRequest) Reset() {
+	*x = SendMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[80]
+		mi := &file_clientrpc_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListGCsResponse_GCInfo) String() string {
+func (x *SendMediaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGCsResponse_GCInfo) ProtoMessage() {}
+func (*SendMediaRequest) ProtoMessage() {}
 
-func (x *ListGCsResponse_GCInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[80]
+func (x *SendMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5059,72 +5349,6961 @@ func (x *ListGCsResponse_GCInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGCsResponse_GCInfo.ProtoReflect.Descriptor instead.
-func (*ListGCsResponse_GCInfo) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use SendMediaRequest.ProtoReflect.Descriptor instead.
+func (*SendMediaRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ListGCsResponse_GCInfo) GetId() []byte {
+func (x *SendMediaRequest) GetUser() string {
 	if x != nil {
-		return x.Id
+		return x.User
+	}
+	return ""
+}
+
+func (x *SendMediaRequest) GetGc() string {
+	if x != nil {
+		return x.Gc
+	}
+	return ""
+}
+
+func (x *SendMediaRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SendMediaRequest) GetMedia() *MediaInfo {
+	if x != nil {
+		return x.Media
 	}
 	return nil
 }
 
-func (x *ListGCsResponse_GCInfo) GetName() string {
+func (x *SendMediaRequest) GetCaption() string {
 	if x != nil {
-		return x.Name
+		return x.Caption
 	}
 	return ""
 }
 
-func (x *ListGCsResponse_GCInfo) GetVersion() uint32 {
+func (x *SendMediaRequest) GetMaxCostMatoms() uint64 {
 	if x != nil {
-		return x.Version
+		return x.MaxCostMatoms
 	}
 	return 0
 }
 
-func (x *ListGCsResponse_GCInfo) GetTimestamp() int64 {
+// SendMediaResponse is the response to a SendMedia request.
+type SendMediaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inline is true if the media was embedded in the message, as opposed to
+	// sent as a file transfer.
+	Inline bool `protobuf:"varint,1,opt,name=inline,proto3" json:"inline,omitempty"`
+	// cost_matoms is the estimated total cost (in milliatoms) to send the media
+	// to a GC.
+	CostMatoms uint64 `protobuf:"varint,2,opt,name=cost_matoms,json=costMatoms,proto3" json:"cost_matoms,omitempty"`
+	// nb_dests is the number of GC members the media was sent to.
+	NbDests uint32 `protobuf:"varint,3,opt,name=nb_dests,json=nbDests,proto3" json:"nb_dests,omitempty"`
+}
+
+func (x *SendMediaResponse) Reset() {
+	*x = SendMediaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMediaResponse) ProtoMessage() {}
+
+func (x *SendMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMediaResponse.ProtoReflect.Descriptor instead.
+func (*SendMediaResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SendMediaResponse) GetInline() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.Inline
+	}
+	return false
+}
+
+func (x *SendMediaResponse) GetCostMatoms() uint64 {
+	if x != nil {
+		return x.CostMatoms
 	}
 	return 0
 }
 
-func (x *ListGCsResponse_GCInfo) GetNbMembers() uint32 {
+func (x *SendMediaResponse) GetNbDests() uint32 {
 	if x != nil {
-		return x.NbMembers
+		return x.NbDests
 	}
 	return 0
 }
 
-var File_clientrpc_proto protoreflect.FileDescriptor
+// MediaStreamRequest is the request for a new media message reception
+// stream.
+type MediaStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_clientrpc_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x52,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x34, 0x0a, 0x16, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x2e, 0x0a, 0x0e, 0x4b, 0x65, 0x65, 0x70, 0x61,
-	0x6c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2d, 0x0a, 0x0a, 0x41, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x09, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
+	// unacked_from specifies to the server the sequence_id of the last processed
+	// media message. Media received by the server that has a higher sequence_id
+	// will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *MediaStreamRequest) Reset() {
+	*x = MediaStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaStreamRequest) ProtoMessage() {}
+
+func (x *MediaStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaStreamRequest.ProtoReflect.Descriptor instead.
+func (*MediaStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MediaStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// ReceivedMedia is an audio or video message received by the client.
+type ReceivedMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the source user ID in raw format.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the source's nick or alias.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// gc_id is the raw ID of the GC where the media was sent. Empty when the
+	// media was received as a private message.
+	GcId []byte `protobuf:"bytes,4,opt,name=gc_id,json=gcId,proto3" json:"gc_id,omitempty"`
+	// gc_alias is the local alias of the GC where the media was sent.
+	GcAlias string `protobuf:"bytes,5,opt,name=gc_alias,json=gcAlias,proto3" json:"gc_alias,omitempty"`
+	// timestamp_ms is the timestamp from unix epoch with millisecond
+	// precision.
+	TimestampMs int64 `protobuf:"varint,6,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// message is the full message that included the media.
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// media is the received media.
+	Media *MediaInfo `protobuf:"bytes,8,opt,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *ReceivedMedia) Reset() {
+	*x = ReceivedMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceivedMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivedMedia) ProtoMessage() {}
+
+func (x *ReceivedMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivedMedia.ProtoReflect.Descriptor instead.
+func (*ReceivedMedia) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ReceivedMedia) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+func (x *ReceivedMedia) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *ReceivedMedia) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *ReceivedMedia) GetGcId() []byte {
+	if x != nil {
+		return x.GcId
+	}
+	return nil
+}
+
+func (x *ReceivedMedia) GetGcAlias() string {
+	if x != nil {
+		return x.GcAlias
+	}
+	return ""
+}
+
+func (x *ReceivedMedia) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *ReceivedMedia) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReceivedMedia) GetMedia() *MediaInfo {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// RMPrivateMessage is the network-level routed private message.
+type RMPrivateMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message is the private message payload.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// mode is the message mode.
+	Mode MessageMode `protobuf:"varint,2,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+}
+
+func (x *RMPrivateMessage) Reset() {
+	*x = RMPrivateMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMPrivateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMPrivateMessage) ProtoMessage() {}
+
+func (x *RMPrivateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMPrivateMessage.ProtoReflect.Descriptor instead.
+func (*RMPrivateMessage) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RMPrivateMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RMPrivateMessage) GetMode() MessageMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MessageMode_MESSAGE_MODE_NORMAL
+}
+
+// RMGroupMessage is the network-level routed group message.
+type RMGroupMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the group chat id where the message was sent.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// generation is the internal generation of the group chat metadata when the
+	// sender sent this message.
+	Generation uint64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	// message is the textual content.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// mode is the mode of the message.
+	Mode MessageMode `protobuf:"varint,4,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+}
+
+func (x *RMGroupMessage) Reset() {
+	*x = RMGroupMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMGroupMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMGroupMessage) ProtoMessage() {}
+
+func (x *RMGroupMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMGroupMessage.ProtoReflect.Descriptor instead.
+func (*RMGroupMessage) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RMGroupMessage) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RMGroupMessage) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *RMGroupMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RMGroupMessage) GetMode() MessageMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MessageMode_MESSAGE_MODE_NORMAL
+}
+
+// PostMetadata is the network-level post data.
+type PostMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version defines the available fields within attributes.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// attributes defines the available post attributes.
+	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PostMetadata) Reset() {
+	*x = PostMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMetadata) ProtoMessage() {}
+
+func (x *PostMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMetadata.ProtoReflect.Descriptor instead.
+func (*PostMetadata) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PostMetadata) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostMetadata) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// PostMetadataStatus is the network-level post status update data.
+type PostMetadataStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version defines the available fields within attributes.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// from is the UID of the original status creator.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// link is the ID of the post.
+	Link string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	// attributes is the list of post update attributes.
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PostMetadataStatus) Reset() {
+	*x = PostMetadataStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMetadataStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMetadataStatus) ProtoMessage() {}
+
+func (x *PostMetadataStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMetadataStatus.ProtoReflect.Descriptor instead.
+func (*PostMetadataStatus) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PostMetadataStatus) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostMetadataStatus) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PostMetadataStatus) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *PostMetadataStatus) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// PublicIdentityReq is a request to get PublicIdentity.
+type PublicIdentityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublicIdentityReq) Reset() {
+	*x = PublicIdentityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicIdentityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicIdentityReq) ProtoMessage() {}
+
+func (x *PublicIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicIdentityReq.ProtoReflect.Descriptor instead.
+func (*PublicIdentityReq) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{93}
+}
+
+// PublicIdentity is the lowlevel public identity.
+type PublicIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the user.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// nick is the short name/alias of the user.
+	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
+	// sig_key is the signature key used to authenticate messages from the user.
+	SigKey []byte `protobuf:"bytes,3,opt,name=sig_key,json=sigKey,proto3" json:"sig_key,omitempty"`
+	// key is the NTRU public key of the user.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// identity is the public ID of the user.
+	Identity []byte `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	// digest is a hash of the user's public information.
+	Digest []byte `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+	// signature is a signature of the user's public information.
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PublicIdentity) Reset() {
+	*x = PublicIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicIdentity) ProtoMessage() {}
+
+func (x *PublicIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicIdentity.ProtoReflect.Descriptor instead.
+func (*PublicIdentity) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PublicIdentity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicIdentity) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *PublicIdentity) GetSigKey() []byte {
+	if x != nil {
+		return x.SigKey
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetIdentity() []byte {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// InviteFunds is the lowlevel information about funds added to an invite.
+type InviteFunds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx is the hash of the transaction that includes the funds.
+	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// index is the output index of the transaction that includes the funds.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// tree is the tree of the transaction that includes the funds.
+	Tree int32 `protobuf:"varint,3,opt,name=tree,proto3" json:"tree,omitempty"`
+	// private_key is the private key needed to redeem the funds in the transaction.
+	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,proto3" json:"private_key,omitempty"`
+	// height_hint is a height at or before the transaction that includes the funds
+	// is mined in.
+	HeightHint uint32 `protobuf:"varint,5,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
+	// address is the public address that the funds were sent to.
+	Address string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *InviteFunds) Reset() {
+	*x = InviteFunds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteFunds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteFunds) ProtoMessage() {}
+
+func (x *InviteFunds) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteFunds.ProtoReflect.Descriptor instead.
+func (*InviteFunds) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *InviteFunds) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+func (x *InviteFunds) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *InviteFunds) GetTree() int32 {
+	if x != nil {
+		return x.Tree
+	}
+	return 0
+}
+
+func (x *InviteFunds) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *InviteFunds) GetHeightHint() uint32 {
+	if x != nil {
+		return x.HeightHint
+	}
+	return 0
+}
+
+func (x *InviteFunds) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// OOBPublicIdentityInvite is an out-of-band invite to perform a KX.
+type OOBPublicIdentityInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public is the public identity of the inviter.
+	Public *PublicIdentity `protobuf:"bytes,1,opt,name=public,proto3" json:"public,omitempty"`
+	// initial_rendezvous is the initial random RV where key exchange will happen.
+	InitialRendezvous []byte `protobuf:"bytes,2,opt,name=initial_rendezvous,json=initialrendezvous,proto3" json:"initial_rendezvous,omitempty"`
+	// reset_rendezvous is the inviter's reset RV in case of ratchet reset actions.
+	ResetRendezvous []byte `protobuf:"bytes,3,opt,name=reset_rendezvous,json=resetrendezvous,proto3" json:"reset_rendezvous,omitempty"`
+	// funds are funds that may be included in the invite and are redeemable by
+	// the invitee.
+	Funds *InviteFunds `protobuf:"bytes,4,opt,name=funds,proto3" json:"funds,omitempty"`
+}
+
+func (x *OOBPublicIdentityInvite) Reset() {
+	*x = OOBPublicIdentityInvite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OOBPublicIdentityInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OOBPublicIdentityInvite) ProtoMessage() {}
+
+func (x *OOBPublicIdentityInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OOBPublicIdentityInvite.ProtoReflect.Descriptor instead.
+func (*OOBPublicIdentityInvite) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *OOBPublicIdentityInvite) GetPublic() *PublicIdentity {
+	if x != nil {
+		return x.Public
+	}
+	return nil
+}
+
+func (x *OOBPublicIdentityInvite) GetInitialRendezvous() []byte {
+	if x != nil {
+		return x.InitialRendezvous
+	}
+	return nil
+}
+
+func (x *OOBPublicIdentityInvite) GetResetRendezvous() []byte {
+	if x != nil {
+		return x.ResetRendezvous
+	}
+	return nil
+}
+
+func (x *OOBPublicIdentityInvite) GetFunds() *InviteFunds {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+// RMGroupInvite is the information about an invite to join a GC.
+type RMGroupInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the ID of the GC.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the GC.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// token is a random number that must be sent back by the invitee to the inviter.
+	Token uint64 `protobuf:"varint,3,opt,name=token,proto3" json:"token,omitempty"`
+	// description is a description of the GC.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// expires is a timestamp for when the invitation expires.
+	Expires int64 `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	// version is the version of the current definition of the GC.
+	Version uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RMGroupInvite) Reset() {
+	*x = RMGroupInvite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMGroupInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMGroupInvite) ProtoMessage() {}
+
+func (x *RMGroupInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMGroupInvite.ProtoReflect.Descriptor instead.
+func (*RMGroupInvite) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RMGroupInvite) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RMGroupInvite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RMGroupInvite) GetToken() uint64 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+func (x *RMGroupInvite) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RMGroupInvite) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *RMGroupInvite) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RMGroupList is the full definition of a GC.
+type RMGroupList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the ID of the GC.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the GC.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// generation is a monotonically increasing count of GC changes.
+	Generation uint64 `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
+	// timestamp is the timestamp for the last modification of the GC defintions.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// version is the GC rules version.
+	Version uint32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// members is the list of user IDs that are in the GC.
+	Members [][]byte `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	// extra_admins is the list of user IDs that are additional admins of the GC.
+	ExtraAdmins [][]byte `protobuf:"bytes,7,rep,name=extra_admins,proto3" json:"extra_admins,omitempty"`
+	// channel is true if the GC is an announcement-only channel.
+	Channel bool `protobuf:"varint,8,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *RMGroupList) Reset() {
+	*x = RMGroupList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMGroupList) ProtoMessage() {}
+
+func (x *RMGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMGroupList.ProtoReflect.Descriptor instead.
+func (*RMGroupList) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RMGroupList) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RMGroupList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RMGroupList) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *RMGroupList) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RMGroupList) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RMGroupList) GetMembers() [][]byte {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RMGroupList) GetExtraAdmins() [][]byte {
+	if x != nil {
+		return x.ExtraAdmins
+	}
+	return nil
+}
+
+func (x *RMGroupList) GetChannel() bool {
+	if x != nil {
+		return x.Channel
+	}
+	return false
+}
+
+// RMFetchResource is the lowlevel request to fetch a resource.
+type RMFetchResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path is the resource's path (already split into segments).
+	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// meta is metadata associated with the request.
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// tag is a unique tag that should be relayed back on the reply.
+	Tag uint64 `protobuf:"varint,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// data is raw request data.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// index is used in chunked/multipart requests.
+	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// count is the total number of chunks in multipart requests.
+	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// method is the request method (GET or POST). Empty for requests sent by
+	// older clients.
+	Method string `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	// content_type is the content type of the request data. Empty for requests
+	// sent by older clients (which always send json data).
+	ContentType string `protobuf:"bytes,8,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *RMFetchResource) Reset() {
+	*x = RMFetchResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMFetchResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMFetchResource) ProtoMessage() {}
+
+func (x *RMFetchResource) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMFetchResource.ProtoReflect.Descriptor instead.
+func (*RMFetchResource) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RMFetchResource) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *RMFetchResource) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RMFetchResource) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *RMFetchResource) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RMFetchResource) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RMFetchResource) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RMFetchResource) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RMFetchResource) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// RMFetchResourceReply is the lowlevel resource response.
+type RMFetchResourceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tag is the same as the request tag.
+	Tag uint64 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// status is the general status of the response.
+	Status uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// meta is additional response metadata.
+	Meta map[string]string `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// data is the raw response data.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// index is used in chunked/multipart responses.
+	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// count is the total number of chunks in multipart responses.
+	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RMFetchResourceReply) Reset() {
+	*x = RMFetchResourceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMFetchResourceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMFetchResourceReply) ProtoMessage() {}
+
+func (x *RMFetchResourceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMFetchResourceReply.ProtoReflect.Descriptor instead.
+func (*RMFetchResourceReply) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RMFetchResourceReply) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *RMFetchResourceReply) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RMFetchResourceReply) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RMFetchResourceReply) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RMFetchResourceReply) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RMFetchResourceReply) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// FileManifest is metadata about a chunk of the file.
+type FileManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the position of this manifest item on the file metadata manifest.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// size is the size of this chunk.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hash is the hash of the chunk data.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *FileManifest) Reset() {
+	*x = FileManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileManifest) ProtoMessage() {}
+
+func (x *FileManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileManifest.ProtoReflect.Descriptor instead.
+func (*FileManifest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *FileManifest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FileManifest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileManifest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// FileMetadata is metadata about a file in the file transfer subsystem.
+type FileMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version of the metadata.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// cost (in milliatoms) to download the file.
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// size (in bytes) of the file.
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// directory where the file is stored in the source.
+	Directory string `protobuf:"bytes,4,opt,name=directory,proto3" json:"directory,omitempty"`
+	// filename of the file.
+	Filename string `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
+	// description of the file.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// hash of the file contents.
+	Hash string `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	// manifest of the chunks that compose the file.
+	Manifest []*FileManifest `protobuf:"bytes,8,rep,name=manifest,proto3" json:"manifest,omitempty"`
+	// signature of the file by the host.
+	Signature string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	// attributes of the file.
+	Attributes map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// files of a multi-file bundle.
+	Files []*BundleFile `protobuf:"bytes,11,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *FileMetadata) Reset() {
+	*x = FileMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMetadata) ProtoMessage() {}
+
+func (x *FileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
+func (*FileMetadata) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *FileMetadata) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetCost() uint64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetManifest() []*FileManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *FileMetadata) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *FileMetadata) GetFiles() []*BundleFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// BundleFile is one of the files of a multi-file bundle.
+type BundleFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path of the file, relative to the root of the bundle.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// size (in bytes) of the file.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hash of the file contents.
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *BundleFile) Reset() {
+	*x = BundleFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleFile) ProtoMessage() {}
+
+func (x *BundleFile) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleFile.ProtoReflect.Descriptor instead.
+func (*BundleFile) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *BundleFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *BundleFile) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BundleFile) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// TipStreamRequest is the request for a new tip reception stream.
+type TipStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last processed
+	// PM. PMs received by the server that have a higher sequence_id will be
+	// streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *TipStreamRequest) Reset() {
+	*x = TipStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TipStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipStreamRequest) ProtoMessage() {}
+
+func (x *TipStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipStreamRequest.ProtoReflect.Descriptor instead.
+func (*TipStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *TipStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// ReceivedPM is a private message received by the client.
+type ReceivedTip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the source user ID in raw format.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// amount_matoms is the amount being tipped in milli-atoms.
+	AmountMatoms int64 `protobuf:"varint,2,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,3,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+}
+
+func (x *ReceivedTip) Reset() {
+	*x = ReceivedTip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceivedTip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivedTip) ProtoMessage() {}
+
+func (x *ReceivedTip) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivedTip.ProtoReflect.Descriptor instead.
+func (*ReceivedTip) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ReceivedTip) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *ReceivedTip) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *ReceivedTip) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+// AddressBookRequest is the request to list the address book.
+type AddressBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddressBookRequest) Reset() {
+	*x = AddressBookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBookRequest) ProtoMessage() {}
+
+func (x *AddressBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBookRequest.ProtoReflect.Descriptor instead.
+func (*AddressBookRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{106}
+}
+
+// AddressBookEntry is a user in the address book.
+type AddressBookEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the raw ID of the user.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the local nick of the user.
+	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
+	// name is the name of the user, as defined by them.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// ignored is true if messages from the user are ignored.
+	Ignored bool `protobuf:"varint,4,opt,name=ignored,proto3" json:"ignored,omitempty"`
+	// first_created is the unix timestamp of the first KX with the user.
+	FirstCreated int64 `protobuf:"varint,5,opt,name=first_created,json=firstCreated,proto3" json:"first_created,omitempty"`
+	// last_completed_kx is the unix timestamp of the last completed KX with
+	// the user (either the initial one or a ratchet reset).
+	LastCompletedKx int64 `protobuf:"varint,6,opt,name=last_completed_kx,json=lastCompletedKx,proto3" json:"last_completed_kx,omitempty"`
+	// last_handshake_attempt is the unix timestamp of the last handshake
+	// started by the local client with the user.
+	LastHandshakeAttempt int64 `protobuf:"varint,7,opt,name=last_handshake_attempt,json=lastHandshakeAttempt,proto3" json:"last_handshake_attempt,omitempty"`
+}
+
+func (x *AddressBookEntry) Reset() {
+	*x = AddressBookEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressBookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBookEntry) ProtoMessage() {}
+
+func (x *AddressBookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBookEntry.ProtoReflect.Descriptor instead.
+func (*AddressBookEntry) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AddressBookEntry) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *AddressBookEntry) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *AddressBookEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddressBookEntry) GetIgnored() bool {
+	if x != nil {
+		return x.Ignored
+	}
+	return false
+}
+
+func (x *AddressBookEntry) GetFirstCreated() int64 {
+	if x != nil {
+		return x.FirstCreated
+	}
+	return 0
+}
+
+func (x *AddressBookEntry) GetLastCompletedKx() int64 {
+	if x != nil {
+		return x.LastCompletedKx
+	}
+	return 0
+}
+
+func (x *AddressBookEntry) GetLastHandshakeAttempt() int64 {
+	if x != nil {
+		return x.LastHandshakeAttempt
+	}
+	return 0
+}
+
+// AddressBookResponse is the list of users in the address book.
+type AddressBookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users are the users in the address book.
+	Users []*AddressBookEntry `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AddressBookResponse) Reset() {
+	*x = AddressBookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBookResponse) ProtoMessage() {}
+
+func (x *AddressBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBookResponse.ProtoReflect.Descriptor instead.
+func (*AddressBookResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AddressBookResponse) GetUsers() []*AddressBookEntry {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// RenameUserRequest is the request to change the local nick of a user.
+type RenameUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user is the nick or hex-encoded ID of the user.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// new_nick is the new local nick of the user.
+	NewNick string `protobuf:"bytes,2,opt,name=new_nick,json=newNick,proto3" json:"new_nick,omitempty"`
+}
+
+func (x *RenameUserRequest) Reset() {
+	*x = RenameUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameUserRequest) ProtoMessage() {}
+
+func (x *RenameUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameUserRequest.ProtoReflect.Descriptor instead.
+func (*RenameUserRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RenameUserRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RenameUserRequest) GetNewNick() string {
+	if x != nil {
+		return x.NewNick
+	}
+	return ""
+}
+
+// RenameUserResponse is the response to RenameUser.
+type RenameUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RenameUserResponse) Reset() {
+	*x = RenameUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameUserResponse) ProtoMessage() {}
+
+func (x *RenameUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameUserResponse.ProtoReflect.Descriptor instead.
+func (*RenameUserResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{110}
+}
+
+// IgnoreUserRequest is the request to set or clear the ignored flag of a
+// user.
+type IgnoreUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user is the nick or hex-encoded ID of the user.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// ignore is whether to ignore (true) or unignore (false) the user.
+	Ignore bool `protobuf:"varint,2,opt,name=ignore,proto3" json:"ignore,omitempty"`
+}
+
+func (x *IgnoreUserRequest) Reset() {
+	*x = IgnoreUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IgnoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreUserRequest) ProtoMessage() {}
+
+func (x *IgnoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreUserRequest.ProtoReflect.Descriptor instead.
+func (*IgnoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *IgnoreUserRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *IgnoreUserRequest) GetIgnore() bool {
+	if x != nil {
+		return x.Ignore
+	}
+	return false
+}
+
+// IgnoreUserResponse is the response to IgnoreUser.
+type IgnoreUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IgnoreUserResponse) Reset() {
+	*x = IgnoreUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IgnoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreUserResponse) ProtoMessage() {}
+
+func (x *IgnoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreUserResponse.ProtoReflect.Descriptor instead.
+func (*IgnoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{112}
+}
+
+// BlockUserRequest is the request to block a user.
+type BlockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user is the nick or hex-encoded ID of the user.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *BlockUserRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+// BlockUserResponse is the response to BlockUser.
+type BlockUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{114}
+}
+
+// HandshakeRequest is the request to start a handshake with a user.
+type HandshakeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user is the nick or hex-encoded ID of the user.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *HandshakeRequest) Reset() {
+	*x = HandshakeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandshakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeRequest) ProtoMessage() {}
+
+func (x *HandshakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeRequest.ProtoReflect.Descriptor instead.
+func (*HandshakeRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *HandshakeRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+// HandshakeResponse is the response to Handshake.
+type HandshakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HandshakeResponse) Reset() {
+	*x = HandshakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandshakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeResponse) ProtoMessage() {}
+
+func (x *HandshakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeResponse.ProtoReflect.Descriptor instead.
+func (*HandshakeResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{116}
+}
+
+// ResetRatchetRequest is the request to reset the ratchet with a user.
+type ResetRatchetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user is the nick or hex-encoded ID of the user.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ResetRatchetRequest) Reset() {
+	*x = ResetRatchetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetRatchetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRatchetRequest) ProtoMessage() {}
+
+func (x *ResetRatchetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRatchetRequest.ProtoReflect.Descriptor instead.
+func (*ResetRatchetRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ResetRatchetRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+// ResetRatchetResponse is the response to ResetRatchet.
+type ResetRatchetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetRatchetResponse) Reset() {
+	*x = ResetRatchetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetRatchetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRatchetResponse) ProtoMessage() {}
+
+func (x *ResetRatchetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRatchetResponse.ProtoReflect.Descriptor instead.
+func (*ResetRatchetResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{118}
+}
+
+// UsersLastReceivedTimeRequest is the request to list the time of the last
+// message received from each user.
+type UsersLastReceivedTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UsersLastReceivedTimeRequest) Reset() {
+	*x = UsersLastReceivedTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersLastReceivedTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersLastReceivedTimeRequest) ProtoMessage() {}
+
+func (x *UsersLastReceivedTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersLastReceivedTimeRequest.ProtoReflect.Descriptor instead.
+func (*UsersLastReceivedTimeRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{119}
+}
+
+// UserLastReceivedTime is the time of the last message received from a
+// user.
+type UserLastReceivedTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the raw ID of the user.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the local nick of the user.
+	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
+	// last_decrypted is the unix timestamp of the last message decrypted
+	// from the user.
+	LastDecrypted int64 `protobuf:"varint,3,opt,name=last_decrypted,json=lastDecrypted,proto3" json:"last_decrypted,omitempty"`
+}
+
+func (x *UserLastReceivedTime) Reset() {
+	*x = UserLastReceivedTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserLastReceivedTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLastReceivedTime) ProtoMessage() {}
+
+func (x *UserLastReceivedTime) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLastReceivedTime.ProtoReflect.Descriptor instead.
+func (*UserLastReceivedTime) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UserLastReceivedTime) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *UserLastReceivedTime) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *UserLastReceivedTime) GetLastDecrypted() int64 {
+	if x != nil {
+		return x.LastDecrypted
+	}
+	return 0
+}
+
+// UsersLastReceivedTimeResponse is the response to UsersLastReceivedTime.
+type UsersLastReceivedTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users are the users, ordered by the most recent received message.
+	Users []*UserLastReceivedTime `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *UsersLastReceivedTimeResponse) Reset() {
+	*x = UsersLastReceivedTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersLastReceivedTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersLastReceivedTimeResponse) ProtoMessage() {}
+
+func (x *UsersLastReceivedTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersLastReceivedTimeResponse.ProtoReflect.Descriptor instead.
+func (*UsersLastReceivedTimeResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UsersLastReceivedTimeResponse) GetUsers() []*UserLastReceivedTime {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// AddressBookStreamRequest is the request for a stream of address book
+// changes.
+type AddressBookStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last
+	// processed event. Events with a higher sequence_id will be streamed back
+	// to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *AddressBookStreamRequest) Reset() {
+	*x = AddressBookStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressBookStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBookStreamRequest) ProtoMessage() {}
+
+func (x *AddressBookStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBookStreamRequest.ProtoReflect.Descriptor instead.
+func (*AddressBookStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *AddressBookStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// AddressBookChanged is a change to the address book.
+type AddressBookChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// kind is the kind of change.
+	Kind AddressBookChangeKind `protobuf:"varint,2,opt,name=kind,proto3,enum=AddressBookChangeKind" json:"kind,omitempty"`
+	// uid is the raw ID of the user.
+	Uid []byte `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the local nick of the user, after the change.
+	Nick string `protobuf:"bytes,4,opt,name=nick,proto3" json:"nick,omitempty"`
+}
+
+func (x *AddressBookChanged) Reset() {
+	*x = AddressBookChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressBookChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBookChanged) ProtoMessage() {}
+
+func (x *AddressBookChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBookChanged.ProtoReflect.Descriptor instead.
+func (*AddressBookChanged) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AddressBookChanged) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+func (x *AddressBookChanged) GetKind() AddressBookChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AddressBookChangeKind_ADDRESS_BOOK_CHANGE_ADDED
+}
+
+func (x *AddressBookChanged) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *AddressBookChanged) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+// PostEmbed is a file embedded in a post.
+type PostEmbed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the embedded file.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// alt is the alternative text of the embed.
+	Alt string `protobuf:"bytes,2,opt,name=alt,proto3" json:"alt,omitempty"`
+	// type is the MIME type of the embedded file (e.g. image/jpeg).
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// data is the content of the embedded file.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PostEmbed) Reset() {
+	*x = PostEmbed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostEmbed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEmbed) ProtoMessage() {}
+
+func (x *PostEmbed) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEmbed.ProtoReflect.Descriptor instead.
+func (*PostEmbed) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PostEmbed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostEmbed) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+func (x *PostEmbed) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PostEmbed) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreatePostRequest is the request to create a new post.
+type CreatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// content is the markdown content of the post.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// description is an optional description of the post.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// embeds are files embedded in the post. They are added, in order, after
+	// the content.
+	Embeds []*PostEmbed `protobuf:"bytes,3,rep,name=embeds,proto3" json:"embeds,omitempty"`
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetEmbeds() []*PostEmbed {
+	if x != nil {
+		return x.Embeds
+	}
+	return nil
+}
+
+// CreatePostResponse is the response to CreatePost.
+type CreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// summary is the summary of the created post.
+	Summary *PostSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CreatePostResponse) GetSummary() *PostSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// ListPostsRequest is the request to list the posts.
+type ListPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPostsRequest) Reset() {
+	*x = ListPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsRequest) ProtoMessage() {}
+
+func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{127}
+}
+
+// ListPostsResponse is the list of posts.
+type ListPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// posts are the summaries of the posts.
+	Posts []*PostSummary `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *ListPostsResponse) Reset() {
+	*x = ListPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsResponse) ProtoMessage() {}
+
+func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostsResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListPostsResponse) GetPosts() []*PostSummary {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// ReadPostRequest is the request to read a post.
+type ReadPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the raw ID of the relayer of the post (the local client ID for
+	// local posts).
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// post_id is the raw ID of the post.
+	PostId []byte `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *ReadPostRequest) Reset() {
+	*x = ReadPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPostRequest) ProtoMessage() {}
+
+func (x *ReadPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPostRequest.ProtoReflect.Descriptor instead.
+func (*ReadPostRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ReadPostRequest) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReadPostRequest) GetPostId() []byte {
+	if x != nil {
+		return x.PostId
+	}
+	return nil
+}
+
+// ReadPostResponse is the response to ReadPost.
+type ReadPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// post is the full post data.
+	Post *PostMetadata `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *ReadPostResponse) Reset() {
+	*x = ReadPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPostResponse) ProtoMessage() {}
+
+func (x *ReadPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPostResponse.ProtoReflect.Descriptor instead.
+func (*ReadPostResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ReadPostResponse) GetPost() *PostMetadata {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// CommentPostRequest is the request to comment on a post.
+type CommentPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the raw ID of the relayer of the post (the local client ID for
+	// local posts).
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// post_id is the raw ID of the post.
+	PostId []byte `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// comment is the text of the comment.
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// parent is the optional raw ID of the comment being replied to.
+	Parent []byte `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *CommentPostRequest) Reset() {
+	*x = CommentPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostRequest) ProtoMessage() {}
+
+func (x *CommentPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostRequest.ProtoReflect.Descriptor instead.
+func (*CommentPostRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CommentPostRequest) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CommentPostRequest) GetPostId() []byte {
+	if x != nil {
+		return x.PostId
+	}
+	return nil
+}
+
+func (x *CommentPostRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CommentPostRequest) GetParent() []byte {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+// CommentPostResponse is the response to CommentPost.
+type CommentPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// comment_id is the raw ID of the new comment.
+	CommentId []byte `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *CommentPostResponse) Reset() {
+	*x = CommentPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostResponse) ProtoMessage() {}
+
+func (x *CommentPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostResponse.ProtoReflect.Descriptor instead.
+func (*CommentPostResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *CommentPostResponse) GetCommentId() []byte {
+	if x != nil {
+		return x.CommentId
+	}
+	return nil
+}
+
+// HeartPostRequest is the request to heart or unheart a post.
+type HeartPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the raw ID of the relayer of the post (the local client ID for
+	// local posts).
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// post_id is the raw ID of the post.
+	PostId []byte `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// heart is whether to add (true) or remove (false) the heart.
+	Heart bool `protobuf:"varint,3,opt,name=heart,proto3" json:"heart,omitempty"`
+}
+
+func (x *HeartPostRequest) Reset() {
+	*x = HeartPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartPostRequest) ProtoMessage() {}
+
+func (x *HeartPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartPostRequest.ProtoReflect.Descriptor instead.
+func (*HeartPostRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *HeartPostRequest) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *HeartPostRequest) GetPostId() []byte {
+	if x != nil {
+		return x.PostId
+	}
+	return nil
+}
+
+func (x *HeartPostRequest) GetHeart() bool {
+	if x != nil {
+		return x.Heart
+	}
+	return false
+}
+
+// HeartPostResponse is the response to HeartPost.
+type HeartPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartPostResponse) Reset() {
+	*x = HeartPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartPostResponse) ProtoMessage() {}
+
+func (x *HeartPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartPostResponse.ProtoReflect.Descriptor instead.
+func (*HeartPostResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{134}
+}
+
+// RelayPostRequest is the request to relay a post.
+type RelayPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the raw ID of the relayer of the post (the local client ID for
+	// local posts).
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// post_id is the raw ID of the post.
+	PostId []byte `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// to_user is the nick or hex-encoded ID of the user to relay the post to.
+	// If empty, the post is relayed to all subscribers of the local client.
+	ToUser string `protobuf:"bytes,3,opt,name=to_user,json=toUser,proto3" json:"to_user,omitempty"`
+}
+
+func (x *RelayPostRequest) Reset() {
+	*x = RelayPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayPostRequest) ProtoMessage() {}
+
+func (x *RelayPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayPostRequest.ProtoReflect.Descriptor instead.
+func (*RelayPostRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *RelayPostRequest) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RelayPostRequest) GetPostId() []byte {
+	if x != nil {
+		return x.PostId
+	}
+	return nil
+}
+
+func (x *RelayPostRequest) GetToUser() string {
+	if x != nil {
+		return x.ToUser
+	}
+	return ""
+}
+
+// RelayPostResponse is the response to RelayPost.
+type RelayPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RelayPostResponse) Reset() {
+	*x = RelayPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayPostResponse) ProtoMessage() {}
+
+func (x *RelayPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayPostResponse.ProtoReflect.Descriptor instead.
+func (*RelayPostResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{136}
+}
+
+// LNGetInfoRequest is the request for information about the LN node.
+type LNGetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LNGetInfoRequest) Reset() {
+	*x = LNGetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNGetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNGetInfoRequest) ProtoMessage() {}
+
+func (x *LNGetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNGetInfoRequest.ProtoReflect.Descriptor instead.
+func (*LNGetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{137}
+}
+
+// LNGetInfoResponse is information about the LN node.
+type LNGetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node_id is the hex-encoded public key of the node.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// version is the version of the node.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// num_active_channels is the number of active channels.
+	NumActiveChannels uint32 `protobuf:"varint,3,opt,name=num_active_channels,json=numActiveChannels,proto3" json:"num_active_channels,omitempty"`
+	// num_inactive_channels is the number of inactive channels.
+	NumInactiveChannels uint32 `protobuf:"varint,4,opt,name=num_inactive_channels,json=numInactiveChannels,proto3" json:"num_inactive_channels,omitempty"`
+	// num_pending_channels is the number of pending channels.
+	NumPendingChannels uint32 `protobuf:"varint,5,opt,name=num_pending_channels,json=numPendingChannels,proto3" json:"num_pending_channels,omitempty"`
+	// num_peers is the number of connected peers.
+	NumPeers uint32 `protobuf:"varint,6,opt,name=num_peers,json=numPeers,proto3" json:"num_peers,omitempty"`
+	// block_height is the height of the best block known to the node.
+	BlockHeight uint32 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_hash is the hash of the best block known to the node.
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// synced_to_chain is true if the node is synced to the chain.
+	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain,json=syncedToChain,proto3" json:"synced_to_chain,omitempty"`
+	// synced_to_graph is true if the node is synced to the network graph.
+	SyncedToGraph bool `protobuf:"varint,10,opt,name=synced_to_graph,json=syncedToGraph,proto3" json:"synced_to_graph,omitempty"`
+}
+
+func (x *LNGetInfoResponse) Reset() {
+	*x = LNGetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNGetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNGetInfoResponse) ProtoMessage() {}
+
+func (x *LNGetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNGetInfoResponse.ProtoReflect.Descriptor instead.
+func (*LNGetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *LNGetInfoResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *LNGetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LNGetInfoResponse) GetNumActiveChannels() uint32 {
+	if x != nil {
+		return x.NumActiveChannels
+	}
+	return 0
+}
+
+func (x *LNGetInfoResponse) GetNumInactiveChannels() uint32 {
+	if x != nil {
+		return x.NumInactiveChannels
+	}
+	return 0
+}
+
+func (x *LNGetInfoResponse) GetNumPendingChannels() uint32 {
+	if x != nil {
+		return x.NumPendingChannels
+	}
+	return 0
+}
+
+func (x *LNGetInfoResponse) GetNumPeers() uint32 {
+	if x != nil {
+		return x.NumPeers
+	}
+	return 0
+}
+
+func (x *LNGetInfoResponse) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *LNGetInfoResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *LNGetInfoResponse) GetSyncedToChain() bool {
+	if x != nil {
+		return x.SyncedToChain
+	}
+	return false
+}
+
+func (x *LNGetInfoResponse) GetSyncedToGraph() bool {
+	if x != nil {
+		return x.SyncedToGraph
+	}
+	return false
+}
+
+// LNBalancesRequest is the request for the balances of the node.
+type LNBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LNBalancesRequest) Reset() {
+	*x = LNBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNBalancesRequest) ProtoMessage() {}
+
+func (x *LNBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNBalancesRequest.ProtoReflect.Descriptor instead.
+func (*LNBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{139}
+}
+
+// LNBalancesResponse is the balances of the node.
+type LNBalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_local_atoms is the sum of the local balances of the channels.
+	ChannelLocalAtoms int64 `protobuf:"varint,1,opt,name=channel_local_atoms,json=channelLocalAtoms,proto3" json:"channel_local_atoms,omitempty"`
+	// channel_remote_atoms is the sum of the remote balances of the
+	// channels.
+	ChannelRemoteAtoms int64 `protobuf:"varint,2,opt,name=channel_remote_atoms,json=channelRemoteAtoms,proto3" json:"channel_remote_atoms,omitempty"`
+	// max_inbound_atoms is the maximum amount that can be received in a
+	// single payment.
+	MaxInboundAtoms int64 `protobuf:"varint,3,opt,name=max_inbound_atoms,json=maxInboundAtoms,proto3" json:"max_inbound_atoms,omitempty"`
+	// max_outbound_atoms is the maximum amount that can be sent in a single
+	// payment.
+	MaxOutboundAtoms int64 `protobuf:"varint,4,opt,name=max_outbound_atoms,json=maxOutboundAtoms,proto3" json:"max_outbound_atoms,omitempty"`
+	// wallet_confirmed_atoms is the confirmed on-chain balance.
+	WalletConfirmedAtoms int64 `protobuf:"varint,5,opt,name=wallet_confirmed_atoms,json=walletConfirmedAtoms,proto3" json:"wallet_confirmed_atoms,omitempty"`
+	// wallet_unconfirmed_atoms is the unconfirmed on-chain balance.
+	WalletUnconfirmedAtoms int64 `protobuf:"varint,6,opt,name=wallet_unconfirmed_atoms,json=walletUnconfirmedAtoms,proto3" json:"wallet_unconfirmed_atoms,omitempty"`
+}
+
+func (x *LNBalancesResponse) Reset() {
+	*x = LNBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNBalancesResponse) ProtoMessage() {}
+
+func (x *LNBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNBalancesResponse.ProtoReflect.Descriptor instead.
+func (*LNBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *LNBalancesResponse) GetChannelLocalAtoms() int64 {
+	if x != nil {
+		return x.ChannelLocalAtoms
+	}
+	return 0
+}
+
+func (x *LNBalancesResponse) GetChannelRemoteAtoms() int64 {
+	if x != nil {
+		return x.ChannelRemoteAtoms
+	}
+	return 0
+}
+
+func (x *LNBalancesResponse) GetMaxInboundAtoms() int64 {
+	if x != nil {
+		return x.MaxInboundAtoms
+	}
+	return 0
+}
+
+func (x *LNBalancesResponse) GetMaxOutboundAtoms() int64 {
+	if x != nil {
+		return x.MaxOutboundAtoms
+	}
+	return 0
+}
+
+func (x *LNBalancesResponse) GetWalletConfirmedAtoms() int64 {
+	if x != nil {
+		return x.WalletConfirmedAtoms
+	}
+	return 0
+}
+
+func (x *LNBalancesResponse) GetWalletUnconfirmedAtoms() int64 {
+	if x != nil {
+		return x.WalletUnconfirmedAtoms
+	}
+	return 0
+}
+
+// LNListChannelsRequest is the request to list channels.
+type LNListChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LNListChannelsRequest) Reset() {
+	*x = LNListChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNListChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNListChannelsRequest) ProtoMessage() {}
+
+func (x *LNListChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*LNListChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{141}
+}
+
+// LNChannel is a channel of the node.
+type LNChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_point is the funding outpoint of the channel (txid:index).
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// remote_node_id is the hex-encoded public key of the remote node.
+	RemoteNodeId string `protobuf:"bytes,2,opt,name=remote_node_id,json=remoteNodeId,proto3" json:"remote_node_id,omitempty"`
+	// capacity_atoms is the total capacity of the channel.
+	CapacityAtoms int64 `protobuf:"varint,3,opt,name=capacity_atoms,json=capacityAtoms,proto3" json:"capacity_atoms,omitempty"`
+	// local_atoms is the local balance of the channel.
+	LocalAtoms int64 `protobuf:"varint,4,opt,name=local_atoms,json=localAtoms,proto3" json:"local_atoms,omitempty"`
+	// remote_atoms is the remote balance of the channel.
+	RemoteAtoms int64 `protobuf:"varint,5,opt,name=remote_atoms,json=remoteAtoms,proto3" json:"remote_atoms,omitempty"`
+	// active is true if the channel can be used for payments.
+	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	// pending is true if the channel is not yet open or not yet closed.
+	Pending bool `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`
+	// chan_id is the short channel ID (zero for pending channels).
+	ChanId uint64 `protobuf:"varint,8,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+}
+
+func (x *LNChannel) Reset() {
+	*x = LNChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNChannel) ProtoMessage() {}
+
+func (x *LNChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNChannel.ProtoReflect.Descriptor instead.
+func (*LNChannel) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *LNChannel) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *LNChannel) GetRemoteNodeId() string {
+	if x != nil {
+		return x.RemoteNodeId
+	}
+	return ""
+}
+
+func (x *LNChannel) GetCapacityAtoms() int64 {
+	if x != nil {
+		return x.CapacityAtoms
+	}
+	return 0
+}
+
+func (x *LNChannel) GetLocalAtoms() int64 {
+	if x != nil {
+		return x.LocalAtoms
+	}
+	return 0
+}
+
+func (x *LNChannel) GetRemoteAtoms() int64 {
+	if x != nil {
+		return x.RemoteAtoms
+	}
+	return 0
+}
+
+func (x *LNChannel) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *LNChannel) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *LNChannel) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+// LNListChannelsResponse is the list of channels.
+type LNListChannelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channels are the open channels.
+	Channels []*LNChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// pending_open are channels waiting for the funding transaction to
+	// confirm.
+	PendingOpen []*LNChannel `protobuf:"bytes,2,rep,name=pending_open,json=pendingOpen,proto3" json:"pending_open,omitempty"`
+	// pending_close are channels waiting for the closing transaction to
+	// confirm or for funds to be recovered.
+	PendingClose []*LNChannel `protobuf:"bytes,3,rep,name=pending_close,json=pendingClose,proto3" json:"pending_close,omitempty"`
+}
+
+func (x *LNListChannelsResponse) Reset() {
+	*x = LNListChannelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNListChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNListChannelsResponse) ProtoMessage() {}
+
+func (x *LNListChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*LNListChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *LNListChannelsResponse) GetChannels() []*LNChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *LNListChannelsResponse) GetPendingOpen() []*LNChannel {
+	if x != nil {
+		return x.PendingOpen
+	}
+	return nil
+}
+
+func (x *LNListChannelsResponse) GetPendingClose() []*LNChannel {
+	if x != nil {
+		return x.PendingClose
+	}
+	return nil
+}
+
+// LNOpenChannelRequest is the request to open a channel.
+type LNOpenChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node_id is the hex-encoded public key of the remote node.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// host is the optional address (host:port) of the remote node, used to
+	// connect to it if not yet connected.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// funding_atoms is the amount to commit to the channel.
+	FundingAtoms int64 `protobuf:"varint,3,opt,name=funding_atoms,json=fundingAtoms,proto3" json:"funding_atoms,omitempty"`
+	// push_atoms is the amount to send to the remote node when opening the
+	// channel.
+	PushAtoms int64 `protobuf:"varint,4,opt,name=push_atoms,json=pushAtoms,proto3" json:"push_atoms,omitempty"`
+}
+
+func (x *LNOpenChannelRequest) Reset() {
+	*x = LNOpenChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNOpenChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNOpenChannelRequest) ProtoMessage() {}
+
+func (x *LNOpenChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNOpenChannelRequest.ProtoReflect.Descriptor instead.
+func (*LNOpenChannelRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *LNOpenChannelRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *LNOpenChannelRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *LNOpenChannelRequest) GetFundingAtoms() int64 {
+	if x != nil {
+		return x.FundingAtoms
+	}
+	return 0
+}
+
+func (x *LNOpenChannelRequest) GetPushAtoms() int64 {
+	if x != nil {
+		return x.PushAtoms
+	}
+	return 0
+}
+
+// LNOpenChannelResponse is the response to OpenChannel.
+type LNOpenChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_point is the funding outpoint of the channel (txid:index).
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+}
+
+func (x *LNOpenChannelResponse) Reset() {
+	*x = LNOpenChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNOpenChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNOpenChannelResponse) ProtoMessage() {}
+
+func (x *LNOpenChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNOpenChannelResponse.ProtoReflect.Descriptor instead.
+func (*LNOpenChannelResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *LNOpenChannelResponse) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+// LNCloseChannelRequest is the request to close a channel.
+type LNCloseChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_point is the funding outpoint of the channel (txid:index).
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// force closes the channel unilaterally, for example when the remote node
+	// is offline.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *LNCloseChannelRequest) Reset() {
+	*x = LNCloseChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNCloseChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNCloseChannelRequest) ProtoMessage() {}
+
+func (x *LNCloseChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNCloseChannelRequest.ProtoReflect.Descriptor instead.
+func (*LNCloseChannelRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *LNCloseChannelRequest) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *LNCloseChannelRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// LNCloseChannelResponse is the response to CloseChannel.
+type LNCloseChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// closing_txid is the id of the closing transaction.
+	ClosingTxid string `protobuf:"bytes,1,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+}
+
+func (x *LNCloseChannelResponse) Reset() {
+	*x = LNCloseChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNCloseChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNCloseChannelResponse) ProtoMessage() {}
+
+func (x *LNCloseChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNCloseChannelResponse.ProtoReflect.Descriptor instead.
+func (*LNCloseChannelResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *LNCloseChannelResponse) GetClosingTxid() string {
+	if x != nil {
+		return x.ClosingTxid
+	}
+	return ""
+}
+
+// LNNewAddressRequest is the request for a new on-chain address.
+type LNNewAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account is the wallet account of the address. If empty, the default
+	// account is used.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *LNNewAddressRequest) Reset() {
+	*x = LNNewAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNNewAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNNewAddressRequest) ProtoMessage() {}
+
+func (x *LNNewAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNNewAddressRequest.ProtoReflect.Descriptor instead.
+func (*LNNewAddressRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *LNNewAddressRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// LNNewAddressResponse is the response to NewAddress.
+type LNNewAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the new address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *LNNewAddressResponse) Reset() {
+	*x = LNNewAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNNewAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNNewAddressResponse) ProtoMessage() {}
+
+func (x *LNNewAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNNewAddressResponse.ProtoReflect.Descriptor instead.
+func (*LNNewAddressResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *LNNewAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// LNCreateInvoiceRequest is the request to create an invoice.
+type LNCreateInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount_matoms is the amount of the invoice. If zero, the payer defines
+	// the amount.
+	AmountMatoms int64 `protobuf:"varint,1,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// memo is a description of the invoice.
+	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	// expiry_seconds is the time until the invoice expires. If zero, the
+	// default expiry is used.
+	ExpirySeconds int64 `protobuf:"varint,3,opt,name=expiry_seconds,json=expirySeconds,proto3" json:"expiry_seconds,omitempty"`
+}
+
+func (x *LNCreateInvoiceRequest) Reset() {
+	*x = LNCreateInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNCreateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNCreateInvoiceRequest) ProtoMessage() {}
+
+func (x *LNCreateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNCreateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*LNCreateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *LNCreateInvoiceRequest) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *LNCreateInvoiceRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *LNCreateInvoiceRequest) GetExpirySeconds() int64 {
+	if x != nil {
+		return x.ExpirySeconds
+	}
+	return 0
+}
+
+// LNCreateInvoiceResponse is the response to CreateInvoice.
+type LNCreateInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice is the encoded invoice (payment request).
+	Invoice string `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// payment_hash is the hash of the invoice.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// add_index is the index of the invoice, as used in InvoicesStream.
+	AddIndex uint64 `protobuf:"varint,3,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
+}
+
+func (x *LNCreateInvoiceResponse) Reset() {
+	*x = LNCreateInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNCreateInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNCreateInvoiceResponse) ProtoMessage() {}
+
+func (x *LNCreateInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNCreateInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*LNCreateInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *LNCreateInvoiceResponse) GetInvoice() string {
+	if x != nil {
+		return x.Invoice
+	}
+	return ""
+}
+
+func (x *LNCreateInvoiceResponse) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *LNCreateInvoiceResponse) GetAddIndex() uint64 {
+	if x != nil {
+		return x.AddIndex
+	}
+	return 0
+}
+
+// LNDecodeInvoiceRequest is the request to decode an invoice.
+type LNDecodeInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice is the encoded invoice.
+	Invoice string `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *LNDecodeInvoiceRequest) Reset() {
+	*x = LNDecodeInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNDecodeInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNDecodeInvoiceRequest) ProtoMessage() {}
+
+func (x *LNDecodeInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNDecodeInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*LNDecodeInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *LNDecodeInvoiceRequest) GetInvoice() string {
+	if x != nil {
+		return x.Invoice
+	}
+	return ""
+}
+
+// LNDecodeInvoiceResponse is a decoded invoice.
+type LNDecodeInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// destination is the hex-encoded public key of the receiving node.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// payment_hash is the hex-encoded hash of the invoice.
+	PaymentHash string `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// amount_matoms is the amount of the invoice.
+	AmountMatoms int64 `protobuf:"varint,3,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// timestamp is the unix timestamp of the creation of the invoice.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// expiry_seconds is the time after timestamp when the invoice expires.
+	ExpirySeconds int64 `protobuf:"varint,5,opt,name=expiry_seconds,json=expirySeconds,proto3" json:"expiry_seconds,omitempty"`
+	// memo is the description of the invoice.
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *LNDecodeInvoiceResponse) Reset() {
+	*x = LNDecodeInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNDecodeInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNDecodeInvoiceResponse) ProtoMessage() {}
+
+func (x *LNDecodeInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNDecodeInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*LNDecodeInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *LNDecodeInvoiceResponse) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *LNDecodeInvoiceResponse) GetPaymentHash() string {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return ""
+}
+
+func (x *LNDecodeInvoiceResponse) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *LNDecodeInvoiceResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LNDecodeInvoiceResponse) GetExpirySeconds() int64 {
+	if x != nil {
+		return x.ExpirySeconds
+	}
+	return 0
+}
+
+func (x *LNDecodeInvoiceResponse) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// LNPayInvoiceRequest is the request to pay an invoice.
+type LNPayInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice is the encoded invoice.
+	Invoice string `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// amount_matoms is the amount to pay. It must only be set for invoices
+	// without an amount.
+	AmountMatoms int64 `protobuf:"varint,2,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+}
+
+func (x *LNPayInvoiceRequest) Reset() {
+	*x = LNPayInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNPayInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNPayInvoiceRequest) ProtoMessage() {}
+
+func (x *LNPayInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNPayInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*LNPayInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *LNPayInvoiceRequest) GetInvoice() string {
+	if x != nil {
+		return x.Invoice
+	}
+	return ""
+}
+
+func (x *LNPayInvoiceRequest) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+// LNPayInvoiceResponse is the response to PayInvoice.
+type LNPayInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fees_matoms is the amount paid in routing fees.
+	FeesMatoms int64 `protobuf:"varint,1,opt,name=fees_matoms,json=feesMatoms,proto3" json:"fees_matoms,omitempty"`
+}
+
+func (x *LNPayInvoiceResponse) Reset() {
+	*x = LNPayInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNPayInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNPayInvoiceResponse) ProtoMessage() {}
+
+func (x *LNPayInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNPayInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*LNPayInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *LNPayInvoiceResponse) GetFeesMatoms() int64 {
+	if x != nil {
+		return x.FeesMatoms
+	}
+	return 0
+}
+
+// LNSendOnChainRequest is the request to send on-chain funds.
+type LNSendOnChainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the destination address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount_atoms is the amount to send.
+	AmountAtoms int64 `protobuf:"varint,2,opt,name=amount_atoms,json=amountAtoms,proto3" json:"amount_atoms,omitempty"`
+	// account is the source wallet account. If empty, the default account is
+	// used.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *LNSendOnChainRequest) Reset() {
+	*x = LNSendOnChainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNSendOnChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNSendOnChainRequest) ProtoMessage() {}
+
+func (x *LNSendOnChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNSendOnChainRequest.ProtoReflect.Descriptor instead.
+func (*LNSendOnChainRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *LNSendOnChainRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LNSendOnChainRequest) GetAmountAtoms() int64 {
+	if x != nil {
+		return x.AmountAtoms
+	}
+	return 0
+}
+
+func (x *LNSendOnChainRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// LNSendOnChainResponse is the response to SendOnChain.
+type LNSendOnChainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// txid is the id of the sent transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *LNSendOnChainResponse) Reset() {
+	*x = LNSendOnChainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNSendOnChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNSendOnChainResponse) ProtoMessage() {}
+
+func (x *LNSendOnChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNSendOnChainResponse.ProtoReflect.Descriptor instead.
+func (*LNSendOnChainResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *LNSendOnChainResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+// LNChannelEventsStreamRequest is the request for a stream of channel
+// events.
+type LNChannelEventsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LNChannelEventsStreamRequest) Reset() {
+	*x = LNChannelEventsStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNChannelEventsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNChannelEventsStreamRequest) ProtoMessage() {}
+
+func (x *LNChannelEventsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNChannelEventsStreamRequest.ProtoReflect.Descriptor instead.
+func (*LNChannelEventsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{158}
+}
+
+// LNChannelEvent is a change to a channel of the node.
+type LNChannelEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the type of the event.
+	Type LNChannelEventType `protobuf:"varint,1,opt,name=type,proto3,enum=LNChannelEventType" json:"type,omitempty"`
+	// channel_point is the funding outpoint of the channel (txid:index).
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// channel is the channel data, for open channel events.
+	Channel *LNChannel `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// closing_txid is the id of the closing transaction, for closed channel
+	// events.
+	ClosingTxid string `protobuf:"bytes,4,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+}
+
+func (x *LNChannelEvent) Reset() {
+	*x = LNChannelEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNChannelEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNChannelEvent) ProtoMessage() {}
+
+func (x *LNChannelEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNChannelEvent.ProtoReflect.Descriptor instead.
+func (*LNChannelEvent) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *LNChannelEvent) GetType() LNChannelEventType {
+	if x != nil {
+		return x.Type
+	}
+	return LNChannelEventType_LN_CHANNEL_EVENT_PENDING_OPEN
+}
+
+func (x *LNChannelEvent) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *LNChannelEvent) GetChannel() *LNChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *LNChannelEvent) GetClosingTxid() string {
+	if x != nil {
+		return x.ClosingTxid
+	}
+	return ""
+}
+
+// LNInvoicesStreamRequest is the request for a stream of invoices.
+type LNInvoicesStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// add_index is the add_index of the last created invoice processed by
+	// the client. Invoices created after it are sent.
+	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
+	// settle_index is the settle_index of the last settled invoice processed
+	// by the client. Invoices settled after it are sent.
+	SettleIndex uint64 `protobuf:"varint,2,opt,name=settle_index,json=settleIndex,proto3" json:"settle_index,omitempty"`
+}
+
+func (x *LNInvoicesStreamRequest) Reset() {
+	*x = LNInvoicesStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNInvoicesStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNInvoicesStreamRequest) ProtoMessage() {}
+
+func (x *LNInvoicesStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNInvoicesStreamRequest.ProtoReflect.Descriptor instead.
+func (*LNInvoicesStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *LNInvoicesStreamRequest) GetAddIndex() uint64 {
+	if x != nil {
+		return x.AddIndex
+	}
+	return 0
+}
+
+func (x *LNInvoicesStreamRequest) GetSettleIndex() uint64 {
+	if x != nil {
+		return x.SettleIndex
+	}
+	return 0
+}
+
+// LNInvoice is an invoice created by the node.
+type LNInvoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice is the encoded invoice.
+	Invoice string `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// payment_hash is the hash of the invoice.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// memo is the description of the invoice.
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// amount_matoms is the amount of the invoice.
+	AmountMatoms int64 `protobuf:"varint,4,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// paid_matoms is the amount paid to the invoice.
+	PaidMatoms int64 `protobuf:"varint,5,opt,name=paid_matoms,json=paidMatoms,proto3" json:"paid_matoms,omitempty"`
+	// settled is true if the invoice was paid.
+	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"`
+	// creation_date is the unix timestamp of the creation of the invoice.
+	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// settle_date is the unix timestamp of the payment of the invoice.
+	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date,json=settleDate,proto3" json:"settle_date,omitempty"`
+	// add_index is the index of the creation of the invoice.
+	AddIndex uint64 `protobuf:"varint,9,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
+	// settle_index is the index of the payment of the invoice.
+	SettleIndex uint64 `protobuf:"varint,10,opt,name=settle_index,json=settleIndex,proto3" json:"settle_index,omitempty"`
+}
+
+func (x *LNInvoice) Reset() {
+	*x = LNInvoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LNInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LNInvoice) ProtoMessage() {}
+
+func (x *LNInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LNInvoice.ProtoReflect.Descriptor instead.
+func (*LNInvoice) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *LNInvoice) GetInvoice() string {
+	if x != nil {
+		return x.Invoice
+	}
+	return ""
+}
+
+func (x *LNInvoice) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *LNInvoice) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *LNInvoice) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *LNInvoice) GetPaidMatoms() int64 {
+	if x != nil {
+		return x.PaidMatoms
+	}
+	return 0
+}
+
+func (x *LNInvoice) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *LNInvoice) GetCreationDate() int64 {
+	if x != nil {
+		return x.CreationDate
+	}
+	return 0
+}
+
+func (x *LNInvoice) GetSettleDate() int64 {
+	if x != nil {
+		return x.SettleDate
+	}
+	return 0
+}
+
+func (x *LNInvoice) GetAddIndex() uint64 {
+	if x != nil {
+		return x.AddIndex
+	}
+	return 0
+}
+
+func (x *LNInvoice) GetSettleIndex() uint64 {
+	if x != nil {
+		return x.SettleIndex
+	}
+	return 0
+}
+
+// ShareFileRequest is the request to share a local file.
+type ShareFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// filename is the path to the local file to share.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// cost is the cost (in atoms) to download the file.
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// description is the description of the file.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// uid is the user to share the file with. If empty, the file is shared
+	// with all users.
+	Uid []byte `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *ShareFileRequest) Reset() {
+	*x = ShareFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareFileRequest) ProtoMessage() {}
+
+func (x *ShareFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareFileRequest.ProtoReflect.Descriptor instead.
+func (*ShareFileRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ShareFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ShareFileRequest) GetCost() uint64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *ShareFileRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShareFileRequest) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+// ShareFileResponse is the response to a ShareFile request.
+type ShareFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// file_id is the ID of the shared file.
+	FileId []byte `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// file_metadata is the metadata of the shared file.
+	FileMetadata *FileMetadata `protobuf:"bytes,2,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
+}
+
+func (x *ShareFileResponse) Reset() {
+	*x = ShareFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareFileResponse) ProtoMessage() {}
+
+func (x *ShareFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareFileResponse.ProtoReflect.Descriptor instead.
+func (*ShareFileResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ShareFileResponse) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+func (x *ShareFileResponse) GetFileMetadata() *FileMetadata {
+	if x != nil {
+		return x.FileMetadata
+	}
+	return nil
+}
+
+// UnshareFileRequest is the request to stop sharing a file.
+type UnshareFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// file_id is the ID of the shared file.
+	FileId []byte `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// uid is the user to stop sharing the file with. If empty, the file is
+	// not shared with any user anymore.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *UnshareFileRequest) Reset() {
+	*x = UnshareFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnshareFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareFileRequest) ProtoMessage() {}
+
+func (x *UnshareFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareFileRequest.ProtoReflect.Descriptor instead.
+func (*UnshareFileRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *UnshareFileRequest) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+func (x *UnshareFileRequest) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+// UnshareFileResponse is the response to an UnshareFile request.
+type UnshareFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnshareFileResponse) Reset() {
+	*x = UnshareFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnshareFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareFileResponse) ProtoMessage() {}
+
+func (x *UnshareFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareFileResponse.ProtoReflect.Descriptor instead.
+func (*UnshareFileResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{165}
+}
+
+// ListLocalSharedFilesRequest is the request to list the local shared files.
+type ListLocalSharedFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLocalSharedFilesRequest) Reset() {
+	*x = ListLocalSharedFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLocalSharedFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalSharedFilesRequest) ProtoMessage() {}
+
+func (x *ListLocalSharedFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalSharedFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListLocalSharedFilesRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{166}
+}
+
+// SharedFile is a file shared by the local client.
+type SharedFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// file_id is the ID of the shared file.
+	FileId []byte `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// file_hash is the hash of the contents of the file.
+	FileHash []byte `protobuf:"bytes,2,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+	// filename is the name of the file.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// cost is the cost (in atoms) to download the file.
+	Cost uint64 `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	// size is the size (in bytes) of the file.
+	Size uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// global is true if the file is shared with all users.
+	Global bool `protobuf:"varint,6,opt,name=global,proto3" json:"global,omitempty"`
+	// shares are the users the file is shared with.
+	Shares [][]byte `protobuf:"bytes,7,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *SharedFile) Reset() {
+	*x = SharedFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedFile) ProtoMessage() {}
+
+func (x *SharedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedFile.ProtoReflect.Descriptor instead.
+func (*SharedFile) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *SharedFile) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+func (x *SharedFile) GetFileHash() []byte {
+	if x != nil {
+		return x.FileHash
+	}
+	return nil
+}
+
+func (x *SharedFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *SharedFile) GetCost() uint64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *SharedFile) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SharedFile) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+func (x *SharedFile) GetShares() [][]byte {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// ListLocalSharedFilesResponse is the list of local shared files.
+type ListLocalSharedFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// files are the shared files.
+	Files []*SharedFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *ListLocalSharedFilesResponse) Reset() {
+	*x = ListLocalSharedFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLocalSharedFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalSharedFilesResponse) ProtoMessage() {}
+
+func (x *ListLocalSharedFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalSharedFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListLocalSharedFilesResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ListLocalSharedFilesResponse) GetFiles() []*SharedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// ListUserContentRequest is the request to list the files of a remote user.
+type ListUserContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the remote user.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// dirs are the dirs to list (global, shared or * for all). If empty, all
+	// dirs are listed.
+	Dirs []string `protobuf:"bytes,2,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	// filter is an optional regexp to match against the filenames and
+	// descriptions of the files.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListUserContentRequest) Reset() {
+	*x = ListUserContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserContentRequest) ProtoMessage() {}
+
+func (x *ListUserContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserContentRequest.ProtoReflect.Descriptor instead.
+func (*ListUserContentRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ListUserContentRequest) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *ListUserContentRequest) GetDirs() []string {
+	if x != nil {
+		return x.Dirs
+	}
+	return nil
+}
+
+func (x *ListUserContentRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// UserContentFile is a file shared by a remote user.
+type UserContentFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// file_id is the ID of the file.
+	FileId []byte `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// file_metadata is the metadata of the file.
+	FileMetadata *FileMetadata `protobuf:"bytes,2,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
+	// disk_path is the path of the file, if it was already downloaded.
+	DiskPath string `protobuf:"bytes,3,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+}
+
+func (x *UserContentFile) Reset() {
+	*x = UserContentFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserContentFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserContentFile) ProtoMessage() {}
+
+func (x *UserContentFile) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserContentFile.ProtoReflect.Descriptor instead.
+func (*UserContentFile) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *UserContentFile) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+func (x *UserContentFile) GetFileMetadata() *FileMetadata {
+	if x != nil {
+		return x.FileMetadata
+	}
+	return nil
+}
+
+func (x *UserContentFile) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+// ListUserContentResponse is the list of files of a remote user.
+type ListUserContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// files are the files shared by the user.
+	Files []*UserContentFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *ListUserContentResponse) Reset() {
+	*x = ListUserContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserContentResponse) ProtoMessage() {}
+
+func (x *ListUserContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserContentResponse.ProtoReflect.Descriptor instead.
+func (*ListUserContentResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ListUserContentResponse) GetFiles() []*UserContentFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// GetUserContentRequest is the request to download a file of a remote user.
+type GetUserContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the remote user.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// file_id is the ID of the file.
+	FileId []byte `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *GetUserContentRequest) Reset() {
+	*x = GetUserContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContentRequest) ProtoMessage() {}
+
+func (x *GetUserContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContentRequest.ProtoReflect.Descriptor instead.
+func (*GetUserContentRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *GetUserContentRequest) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *GetUserContentRequest) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+// GetUserContentResponse is the response to a GetUserContent request.
+type GetUserContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUserContentResponse) Reset() {
+	*x = GetUserContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContentResponse) ProtoMessage() {}
+
+func (x *GetUserContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContentResponse.ProtoReflect.Descriptor instead.
+func (*GetUserContentResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{173}
+}
+
+// ListDownloadsRequest is the request to list downloads.
+type ListDownloadsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDownloadsRequest) Reset() {
+	*x = ListDownloadsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDownloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadsRequest) ProtoMessage() {}
+
+func (x *ListDownloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDownloadsRequest.ProtoReflect.Descriptor instead.
+func (*ListDownloadsRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{174}
+}
+
+// FileDownload is a download of the local client.
+type FileDownload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the user the file is downloaded from.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// file_id is the ID of the file.
+	FileId []byte `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// file_metadata is the metadata of the file, if already known.
+	FileMetadata *FileMetadata `protobuf:"bytes,3,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
+	// disk_path is the path of the file, set once the download completes.
+	DiskPath string `protobuf:"bytes,4,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// nb_chunks is the number of chunks of the file.
+	NbChunks uint32 `protobuf:"varint,5,opt,name=nb_chunks,json=nbChunks,proto3" json:"nb_chunks,omitempty"`
+	// nb_downloaded_chunks is the number of chunks already downloaded.
+	NbDownloadedChunks uint32 `protobuf:"varint,6,opt,name=nb_downloaded_chunks,json=nbDownloadedChunks,proto3" json:"nb_downloaded_chunks,omitempty"`
+	// is_sent_file is true if the file was pushed by the remote user.
+	IsSentFile bool `protobuf:"varint,7,opt,name=is_sent_file,json=isSentFile,proto3" json:"is_sent_file,omitempty"`
+}
+
+func (x *FileDownload) Reset() {
+	*x = FileDownload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileDownload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDownload) ProtoMessage() {}
+
+func (x *FileDownload) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDownload.ProtoReflect.Descriptor instead.
+func (*FileDownload) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *FileDownload) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *FileDownload) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+func (x *FileDownload) GetFileMetadata() *FileMetadata {
+	if x != nil {
+		return x.FileMetadata
+	}
+	return nil
+}
+
+func (x *FileDownload) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *FileDownload) GetNbChunks() uint32 {
+	if x != nil {
+		return x.NbChunks
+	}
+	return 0
+}
+
+func (x *FileDownload) GetNbDownloadedChunks() uint32 {
+	if x != nil {
+		return x.NbDownloadedChunks
+	}
+	return 0
+}
+
+func (x *FileDownload) GetIsSentFile() bool {
+	if x != nil {
+		return x.IsSentFile
+	}
+	return false
+}
+
+// ListDownloadsResponse is the list of downloads.
+type ListDownloadsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// downloads are the downloads of the local client.
+	Downloads []*FileDownload `protobuf:"bytes,1,rep,name=downloads,proto3" json:"downloads,omitempty"`
+}
+
+func (x *ListDownloadsResponse) Reset() {
+	*x = ListDownloadsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDownloadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadsResponse) ProtoMessage() {}
+
+func (x *ListDownloadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDownloadsResponse.ProtoReflect.Descriptor instead.
+func (*ListDownloadsResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ListDownloadsResponse) GetDownloads() []*FileDownload {
+	if x != nil {
+		return x.Downloads
+	}
+	return nil
+}
+
+// CancelDownloadRequest is the request to cancel a download.
+type CancelDownloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// file_id is the ID of the file being downloaded.
+	FileId []byte `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *CancelDownloadRequest) Reset() {
+	*x = CancelDownloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDownloadRequest) ProtoMessage() {}
+
+func (x *CancelDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDownloadRequest.ProtoReflect.Descriptor instead.
+func (*CancelDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *CancelDownloadRequest) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+// CancelDownloadResponse is the response to a CancelDownload request.
+type CancelDownloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelDownloadResponse) Reset() {
+	*x = CancelDownloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDownloadResponse) ProtoMessage() {}
+
+func (x *CancelDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDownloadResponse.ProtoReflect.Descriptor instead.
+func (*CancelDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{178}
+}
+
+// TransfersProgressStreamRequest is the request for a stream of transfer
+// progress events.
+type TransfersProgressStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TransfersProgressStreamRequest) Reset() {
+	*x = TransfersProgressStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransfersProgressStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransfersProgressStreamRequest) ProtoMessage() {}
+
+func (x *TransfersProgressStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransfersProgressStreamRequest.ProtoReflect.Descriptor instead.
+func (*TransfersProgressStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{179}
+}
+
+// TransferProgress is a progress event of a file transfer.
+type TransferProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// direction is the direction of the transfer.
+	Direction TransferDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=TransferDirection" json:"direction,omitempty"`
+	// uid is the remote user.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the remote user.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// file_id is the ID of the file.
+	FileId []byte `protobuf:"bytes,4,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// filename is the name of the file.
+	Filename string `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
+	// nb_chunks is the number of chunks of the file, if known.
+	NbChunks uint32 `protobuf:"varint,6,opt,name=nb_chunks,json=nbChunks,proto3" json:"nb_chunks,omitempty"`
+	// nb_missing_chunks is the number of chunks not yet downloaded, for
+	// downloads.
+	NbMissingChunks uint32 `protobuf:"varint,7,opt,name=nb_missing_chunks,json=nbMissingChunks,proto3" json:"nb_missing_chunks,omitempty"`
+	// chunk_index is the index of the chunk that was sent, for uploads.
+	ChunkIndex uint32 `protobuf:"varint,8,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+}
+
+func (x *TransferProgress) Reset() {
+	*x = TransferProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferProgress) ProtoMessage() {}
+
+func (x *TransferProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferProgress.ProtoReflect.Descriptor instead.
+func (*TransferProgress) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *TransferProgress) GetDirection() TransferDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransferDirection_TRANSFER_DOWNLOAD
+}
+
+func (x *TransferProgress) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *TransferProgress) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *TransferProgress) GetFileId() []byte {
+	if x != nil {
+		return x.FileId
+	}
+	return nil
+}
+
+func (x *TransferProgress) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *TransferProgress) GetNbChunks() uint32 {
+	if x != nil {
+		return x.NbChunks
+	}
+	return 0
+}
+
+func (x *TransferProgress) GetNbMissingChunks() uint32 {
+	if x != nil {
+		return x.NbMissingChunks
+	}
+	return 0
+}
+
+func (x *TransferProgress) GetChunkIndex() uint32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+// NewPagesSessionRequest is the request to start a new pages session.
+type NewPagesSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NewPagesSessionRequest) Reset() {
+	*x = NewPagesSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPagesSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPagesSessionRequest) ProtoMessage() {}
+
+func (x *NewPagesSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPagesSessionRequest.ProtoReflect.Descriptor instead.
+func (*NewPagesSessionRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{181}
+}
+
+// NewPagesSessionResponse is the response to a NewPagesSession call.
+type NewPagesSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// session_id is the ID of the new pages session.
+	SessionId uint64 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *NewPagesSessionResponse) Reset() {
+	*x = NewPagesSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPagesSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPagesSessionResponse) ProtoMessage() {}
+
+func (x *NewPagesSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPagesSessionResponse.ProtoReflect.Descriptor instead.
+func (*NewPagesSessionResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *NewPagesSessionResponse) GetSessionId() uint64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+// FetchResourceRequest is the request to fetch a resource from a remote user.
+type FetchResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the ID of the user from which to fetch the resource.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// path is the resource's path (already split into segments).
+	Path []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	// meta is metadata sent with the request.
+	Meta map[string]string `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// session_id is the pages session the request is made on. May be zero to
+	// not associate the resource with a session.
+	SessionId uint64 `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// parent_page is the page (within the session) that originated the request.
+	ParentPage uint64 `protobuf:"varint,5,opt,name=parent_page,json=parentPage,proto3" json:"parent_page,omitempty"`
+	// data is optional raw (json) request data. If specified, it must be valid
+	// json.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FetchResourceRequest) Reset() {
+	*x = FetchResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchResourceRequest) ProtoMessage() {}
+
+func (x *FetchResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchResourceRequest.ProtoReflect.Descriptor instead.
+func (*FetchResourceRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *FetchResourceRequest) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *FetchResourceRequest) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *FetchResourceRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FetchResourceRequest) GetSessionId() uint64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *FetchResourceRequest) GetParentPage() uint64 {
+	if x != nil {
+		return x.ParentPage
+	}
+	return 0
+}
+
+func (x *FetchResourceRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// FetchResourceResponse is the response to a FetchResource call.
+type FetchResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tag is the tag of the request, which is returned in the corresponding
+	// FetchedResource event.
+	Tag uint64 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *FetchResourceResponse) Reset() {
+	*x = FetchResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchResourceResponse) ProtoMessage() {}
+
+func (x *FetchResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchResourceResponse.ProtoReflect.Descriptor instead.
+func (*FetchResourceResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *FetchResourceResponse) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+// FetchedResourcesStreamRequest is the request for a stream of fetched
+// resources.
+type FetchedResourcesStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last
+	// processed event. Events with a higher sequence_id will be streamed back
+	// to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *FetchedResourcesStreamRequest) Reset() {
+	*x = FetchedResourcesStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchedResourcesStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchedResourcesStreamRequest) ProtoMessage() {}
+
+func (x *FetchedResourcesStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchedResourcesStreamRequest.ProtoReflect.Descriptor instead.
+func (*FetchedResourcesStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *FetchedResourcesStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// FetchedResource is a resource fetched by the local client.
+type FetchedResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the ID of the user that sent the resource.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the user that sent the resource.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// session_id is the pages session of the request.
+	SessionId uint64 `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// parent_page is the page that originated the request.
+	ParentPage uint64 `protobuf:"varint,5,opt,name=parent_page,json=parentPage,proto3" json:"parent_page,omitempty"`
+	// page_id is the ID of the fetched page within its session.
+	PageId uint64 `protobuf:"varint,6,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	// request_ts_ms is the unix timestamp (in milliseconds) of the request.
+	RequestTsMs int64 `protobuf:"varint,7,opt,name=request_ts_ms,json=requestTsMs,proto3" json:"request_ts_ms,omitempty"`
+	// response_ts_ms is the unix timestamp (in milliseconds) of the response.
+	ResponseTsMs int64 `protobuf:"varint,8,opt,name=response_ts_ms,json=responseTsMs,proto3" json:"response_ts_ms,omitempty"`
+	// request is the request sent to the remote user.
+	Request *RMFetchResource `protobuf:"bytes,9,opt,name=request,proto3" json:"request,omitempty"`
+	// response is the response sent by the remote user.
+	Response *RMFetchResourceReply `protobuf:"bytes,10,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *FetchedResource) Reset() {
+	*x = FetchedResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchedResource) ProtoMessage() {}
+
+func (x *FetchedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchedResource.ProtoReflect.Descriptor instead.
+func (*FetchedResource) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *FetchedResource) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+func (x *FetchedResource) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *FetchedResource) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *FetchedResource) GetSessionId() uint64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *FetchedResource) GetParentPage() uint64 {
+	if x != nil {
+		return x.ParentPage
+	}
+	return 0
+}
+
+func (x *FetchedResource) GetPageId() uint64 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *FetchedResource) GetRequestTsMs() int64 {
+	if x != nil {
+		return x.RequestTsMs
+	}
+	return 0
+}
+
+func (x *FetchedResource) GetResponseTsMs() int64 {
+	if x != nil {
+		return x.ResponseTsMs
+	}
+	return 0
+}
+
+func (x *FetchedResource) GetRequest() *RMFetchResource {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *FetchedResource) GetResponse() *RMFetchResourceReply {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// ListPayLedgerRequest is the request to list the entries of the payment
+// ledger.
+type ListPayLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_ts is the unix timestamp (in seconds) of the start of the interval
+	// to list. Zero means unbounded.
+	StartTs int64 `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	// end_ts is the unix timestamp (in seconds) of the end (exclusive) of the
+	// interval to list. Zero means unbounded.
+	EndTs int64 `protobuf:"varint,2,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	// uid optionally restricts the entries to the ones related to the given
+	// user.
+	Uid []byte `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *ListPayLedgerRequest) Reset() {
+	*x = ListPayLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPayLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayLedgerRequest) ProtoMessage() {}
+
+func (x *ListPayLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ListPayLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ListPayLedgerRequest) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *ListPayLedgerRequest) GetEndTs() int64 {
+	if x != nil {
+		return x.EndTs
+	}
+	return 0
+}
+
+func (x *ListPayLedgerRequest) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+// PayLedgerEntry is an entry of the payment ledger.
+type PayLedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timestamp is the unix timestamp (in seconds) of the payment.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// uid is the ID of the counterparty of the payment.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the counterparty of the payment.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// event is the specific event that triggered the payment.
+	Event string `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	// purpose is the general purpose of the payment (tip, file_chunk, paywall,
+	// store_order, sub_fee or push_fee).
+	Purpose string `protobuf:"bytes,5,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// amount_matoms is the amount of the payment, in milli-atoms. It is negative
+	// for outbound payments and positive for inbound ones.
+	AmountMatoms int64 `protobuf:"varint,6,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// pay_fee_matoms is the (negative) amount of fees paid, in milli-atoms.
+	PayFeeMatoms int64 `protobuf:"varint,7,opt,name=pay_fee_matoms,json=payFeeMatoms,proto3" json:"pay_fee_matoms,omitempty"`
+}
+
+func (x *PayLedgerEntry) Reset() {
+	*x = PayLedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayLedgerEntry) ProtoMessage() {}
+
+func (x *PayLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayLedgerEntry.ProtoReflect.Descriptor instead.
+func (*PayLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *PayLedgerEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PayLedgerEntry) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *PayLedgerEntry) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *PayLedgerEntry) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PayLedgerEntry) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *PayLedgerEntry) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *PayLedgerEntry) GetPayFeeMatoms() int64 {
+	if x != nil {
+		return x.PayFeeMatoms
+	}
+	return 0
+}
+
+// ListPayLedgerResponse is the response to a ListPayLedger call.
+type ListPayLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*PayLedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListPayLedgerResponse) Reset() {
+	*x = ListPayLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPayLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayLedgerResponse) ProtoMessage() {}
+
+func (x *ListPayLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ListPayLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *ListPayLedgerResponse) GetEntries() []*PayLedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// ExportPayLedgerCSVResponse is the response to an ExportPayLedgerCSV call.
+type ExportPayLedgerCSVResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// csv is the CSV-formatted ledger.
+	Csv []byte `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+}
+
+func (x *ExportPayLedgerCSVResponse) Reset() {
+	*x = ExportPayLedgerCSVResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPayLedgerCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayLedgerCSVResponse) ProtoMessage() {}
+
+func (x *ExportPayLedgerCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayLedgerCSVResponse.ProtoReflect.Descriptor instead.
+func (*ExportPayLedgerCSVResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *ExportPayLedgerCSVResponse) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+// GCInfo is the summary info for a GC.
+type ListGCsResponse_GCInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique GC ID.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the local name/alias for the GC.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the current version the GC definition is on.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// timestamp is the timestamp of the last modification to the GC definition.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// nb_members is the total number of members in the GC.
+	NbMembers uint32 `protobuf:"varint,5,opt,name=nb_members,json=nbMembers,proto3" json:"nb_members,omitempty"`
+	// channel is true if the GC is an announcement-only channel.
+	Channel bool `protobuf:"varint,6,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *ListGCsResponse_GCInfo) Reset() {
+	*x = ListGCsResponse_GCInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGCsResponse_GCInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGCsResponse_GCInfo) ProtoMessage() {}
+
+func (x *ListGCsResponse_GCInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGCsResponse_GCInfo.ProtoReflect.Descriptor instead.
+func (*ListGCsResponse_GCInfo) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *ListGCsResponse_GCInfo) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListGCsResponse_GCInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListGCsResponse_GCInfo) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ListGCsResponse_GCInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ListGCsResponse_GCInfo) GetNbMembers() uint32 {
+	if x != nil {
+		return x.NbMembers
+	}
+	return 0
+}
+
+func (x *ListGCsResponse_GCInfo) GetChannel() bool {
+	if x != nil {
+		return x.Channel
+	}
+	return false
+}
+
+var File_clientrpc_proto protoreflect.FileDescriptor
+
+var file_clientrpc_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x34, 0x0a, 0x16, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x2e, 0x0a, 0x0e, 0x4b, 0x65, 0x65, 0x70, 0x61,
+	0x6c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2d, 0x0a, 0x0a, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x09, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x0c, 0x0a, 0x0a, 0x50,
 	0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x0f, 0x50, 0x4d, 0x53,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
@@ -5293,11 +12472,11 @@ var file_clientrpc_proto_rawDesc = []byte{
 	0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x02, 0x67, 0x63,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70,
 	0x4c, 0x69, 0x73, 0x74, 0x52, 0x02, 0x67, 0x63, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
-	0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x4c,
+	0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x0f, 0x4c,
 	0x69, 0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
 	0x0a, 0x03, 0x67, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4c, 0x69,
 	0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x43,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x67, 0x63, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x06, 0x47, 0x43,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x67, 0x63, 0x73, 0x1a, 0x9d, 0x01, 0x0a, 0x06, 0x47, 0x43,
 	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
@@ -5305,284 +12484,991 @@ var file_clientrpc_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x62, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
-	0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x4e, 0x69,
+	0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41,
+	0x64, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75,
 	0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xbc,
-	0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x49, 0x0a,
-	0x0b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69,
-	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x43, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x46, 0x72, 0x6f, 0x6d, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x83,
+	0x01, 0x0a, 0x13, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x67, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x63, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x63, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
 	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a,
 	0x02, 0x67, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x67, 0x63, 0x12, 0x17, 0x0a,
 	0x07, 0x67, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
 	0x67, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
 	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e,
-	0x69, 0x63, 0x6b, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x47, 0x43,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61,
-	0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x47, 0x43, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x69, 0x63, 0x6b, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x10, 0x4a, 0x6f,
+	0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x22, 0x4e, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x45, 0x76, 0x65,
 	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x02, 0x67, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x35, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63,
-	0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x4e, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
-	0x64, 0x47, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x02, 0x67, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x02, 0x67, 0x63, 0x22, 0x37, 0x0a, 0x12, 0x54, 0x69, 0x70, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x02, 0x67,
+	0x63, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x27, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x67, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x22, 0x34, 0x0a, 0x1a, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22,
+	0x2c, 0x0a, 0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x15, 0x0a,
+	0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x47, 0x43, 0x4d, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x22, 0x55, 0x0a, 0x17, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x43, 0x4d, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x62, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6e, 0x62, 0x44, 0x65, 0x73, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x17, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x67, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x22, 0x56, 0x0a, 0x18,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63,
+	0x6f, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f,
+	0x64, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x44,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x12, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xf6, 0x01,
+	0x0a, 0x10, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x45, 0x72, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6c, 0x6c, 0x5f,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x6c,
+	0x6c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b,
+	0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x16,
+	0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x73, 0x67, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
 	0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d,
-	0x22, 0xf6, 0x01, 0x0a, 0x10, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x45, 0x72, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69,
-	0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x77, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x1e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x69, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x78, 0x0a, 0x16, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x46, 0x75, 0x6c,
-	0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x1f, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x22, 0xb3, 0x01, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0xe1, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
 	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
 	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75,
 	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x10, 0x52, 0x4d, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x52, 0x4d, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a,
-	0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda,
-	0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x50, 0x6f,
-	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x73,
-	0x69, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x69,
-	0x67, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x72,
-	0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68,
-	0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0xc0, 0x01, 0x0a, 0x17, 0x4f, 0x4f, 0x42, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
-	0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76,
-	0x6f, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6e,
-	0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x12, 0x22,
-	0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x05, 0x66, 0x75, 0x6e,
-	0x64, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0xe0,
-	0x01, 0x0a, 0x0f, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xee, 0x01, 0x0a, 0x14, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x22, 0x87, 0x03, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x1f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75,
+	0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xb3, 0x01, 0x0a, 0x19, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x0d,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x6b, 0x0a, 0x0f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x5f, 0x0a,
+	0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0d, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40,
+	0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x75, 0x69, 0x64, 0x73,
+	0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x1b, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x84,
+	0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x10, 0x54, 0x69,
-	0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x22, 0x65, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x70,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74,
-	0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x2a, 0x3b, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x53, 0x53, 0x41,
-	0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00,
-	0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x4d, 0x45, 0x10, 0x01, 0x32, 0x7d, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0xae, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20,
+	0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x6f,
+	0x6d, 0x73, 0x22, 0x67, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x44, 0x65, 0x73, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x12, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x22, 0xe5, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x13, 0x0a,
+	0x05, 0x67, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x67, 0x63,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x22, 0x4e, 0x0a, 0x10,
+	0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x7c, 0x0a, 0x0e,
+	0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x50,
+	0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x43, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa4, 0x01,
+	0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x4f, 0x4f, 0x42, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76,
+	0x6f, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x52, 0x4d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe1, 0x01, 0x0a, 0x0b, 0x52, 0x4d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x9b, 0x02,
+	0x0a, 0x0f, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xee, 0x01, 0x0a, 0x14,
+	0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x52,
+	0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0c,
+	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xaa, 0x03, 0x0a, 0x0c, 0x46,
+	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a,
+	0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x0a, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x35, 0x0a, 0x10, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x65, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4b, 0x78, 0x12,
+	0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b,
+	0x65, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x14, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x3e, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x42, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x69, 0x63, 0x6b, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3f, 0x0a, 0x11, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x22, 0x14, 0x0a, 0x12, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x13,
+	0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63,
+	0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x44,
+	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x1d, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x87, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x22,
+	0x59, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
+	0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x73, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x06, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x52, 0x06, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x73, 0x22,
+	0x3c, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x12, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x37, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x0f, 0x52, 0x65,
+	0x61, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x52, 0x65,
+	0x61, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x22, 0x73, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x10,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x65, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x68, 0x65,
+	0x61, 0x72, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x55, 0x73,
+	0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x4e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x03, 0x0a, 0x11,
+	0x4c, 0x4e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x75, 0x6d, 0x5f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75,
+	0x6d, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e,
+	0x75, 0x6d, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x79, 0x6e,
+	0x63, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x54, 0x6f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x4e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0,
+	0x02, 0x0a, 0x12, 0x4c, 0x4e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74,
+	0x6f, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74, 0x6f, 0x6d,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x6f, 0x6d,
+	0x73, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x4e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8c, 0x02, 0x0a, 0x09, 0x4c,
+	0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f,
+	0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x4c, 0x4e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x0c,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0b,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0c,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a,
+	0x14, 0x4c, 0x4e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74,
+	0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x75, 0x73,
+	0x68, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x22, 0x3c, 0x0a, 0x15, 0x4c, 0x4e, 0x4f, 0x70, 0x65, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x15, 0x4c, 0x4e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x16, 0x4c, 0x4e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e,
+	0x67, 0x54, 0x78, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x4c, 0x4e, 0x4e, 0x65, 0x77, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x14, 0x4c, 0x4e, 0x4e, 0x65, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x78, 0x0a, 0x16, 0x4c, 0x4e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74,
+	0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x73, 0x0a, 0x17, 0x4c, 0x4e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
+	0x64, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x32, 0x0a, 0x16, 0x4c, 0x4e, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x17,
+	0x4c, 0x4e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x54, 0x0a, 0x13, 0x4c, 0x4e,
+	0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73,
+	0x22, 0x37, 0x0a, 0x14, 0x4c, 0x4e, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x73,
+	0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x65, 0x65, 0x73, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x22, 0x6d, 0x0a, 0x14, 0x4c, 0x4e, 0x53,
+	0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x4c, 0x4e, 0x53, 0x65,
+	0x6e, 0x64, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x22,
+	0x59, 0x0a, 0x17, 0x4c, 0x4e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
+	0x64, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc2, 0x02, 0x0a, 0x09, 0x4c,
+	0x4e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x69, 0x64, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x61, 0x64, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x76, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x11, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x69, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x12, 0x55, 0x6e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x7b, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74,
+	0x68, 0x22, 0x41, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x69,
+	0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x62, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x62, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x62, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x6e, 0x62, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x6e,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x53, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x30,
+	0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x18, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x02, 0x0a,
+	0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x62, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6e, 0x62, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6e,
+	0x62, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x62, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x18, 0x0a, 0x16, 0x4e, 0x65, 0x77, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x38, 0x0a, 0x17, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x67, 0x65, 0x73, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xfe, 0x01, 0x0a,
+	0x14, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a,
+	0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x42, 0x0a, 0x1d, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xda, 0x02, 0x0a,
+	0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x73, 0x5f, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x73, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x74, 0x73, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x73, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x4d,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x73, 0x12, 0x15, 0x0a, 0x06,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e,
+	0x64, 0x54, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x61, 0x74,
+	0x6f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x46, 0x65,
+	0x65, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x22, 0x42, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x1a, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x53,
+	0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x2a, 0x3b, 0x0a, 0x0b, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41,
+	0x4c, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x10, 0x01, 0x2a, 0x86, 0x02, 0x0a, 0x15, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x42, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x42, 0x4f, 0x4f,
+	0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x42, 0x4f, 0x4f,
+	0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x42, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x47, 0x4e, 0x4f,
+	0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
+	0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x45, 0x44, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
+	0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10,
+	0x06, 0x2a, 0xd0, 0x01, 0x0a, 0x12, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x4e, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4c,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x4e, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x04, 0x12,
+	0x23, 0x0a, 0x1f, 0x4c, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x44, 0x10, 0x05, 0x2a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x4c,
+	0x4f, 0x41, 0x44, 0x10, 0x01, 0x32, 0x7d, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
@@ -5633,7 +13519,7 @@ var file_clientrpc_proto_rawDesc = []byte{
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
 	0x4e, 0x69, 0x63, 0x6b, 0x12, 0x10, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc8, 0x05, 0x0a, 0x09, 0x47, 0x43,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa0, 0x08, 0x0a, 0x09, 0x47, 0x43,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74,
 	0x65, 0x54, 0x6f, 0x47, 0x43, 0x12, 0x12, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f,
 	0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x49, 0x6e, 0x76, 0x69,
@@ -5678,48 +13564,98 @@ var file_clientrpc_proto_rawDesc = []byte{
 	0x47, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x29, 0x0a, 0x0c, 0x41, 0x63, 0x6b,
 	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x84, 0x03, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
-	0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x0b, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
-	0x30, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x45, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x15, 0x41, 0x63, 0x6b, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
-	0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x82, 0x02, 0x0a, 0x0f,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x2c, 0x0a, 0x07, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x54, 0x69, 0x70,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x54, 0x69,
-	0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-	0x0b, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x13, 0x2e, 0x54,
-	0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x54, 0x69, 0x70,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x13, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0f, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x43, 0x4d, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x17, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x43, 0x4d, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x47, 0x43, 0x4d, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc2, 0x05, 0x0a,
+	0x0c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a,
+	0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x18, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x41, 0x63,
+	0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0b, 0x2e,
+	0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x12,
+	0x32, 0x0a, 0x15, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x12, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69,
-	0x70, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x54, 0x69, 0x70, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xb3, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x53, 0x65,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x09, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x6c,
+	0x61, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x8c, 0x03, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x0f, 0x2e, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x13, 0x2e, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e,
+	0x41, 0x63, 0x6b, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0b,
+	0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x54, 0x69, 0x70,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x70, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b,
+	0x54, 0x69, 0x70, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x53, 0x56, 0x12, 0x15, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x53, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xd5, 0x04, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
@@ -5730,21 +13666,186 @@ var file_clientrpc_proto_rawDesc = []byte{
 	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9f, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x14, 0x41, 0x63, 0x6b, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0b, 0x2e,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x1d, 0x41, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x67, 0x65, 0x73, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x16, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x12, 0x41, 0x63, 0x6b, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0b, 0x2e,
 	0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x7a, 0x65,
-	0x72, 0x6f, 0x2f, 0x62, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x2f, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x85, 0x07, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x14, 0x41, 0x63, 0x6b, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x44, 0x69, 0x72, 0x12, 0x10, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x69,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x11, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x15, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01,
+	0x32, 0xa7, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x32, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x11,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x30, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12,
+	0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xae, 0x04, 0x0a, 0x0c, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x13, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a,
+	0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x49, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x11, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x48, 0x61, 0x6e, 0x64, 0x73,
+	0x68, 0x61, 0x6b, 0x65, 0x12, 0x11, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x15, 0x41, 0x63, 0x6b, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf1, 0x05, 0x0a, 0x09,
+	0x4c, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x4c, 0x4e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x4e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x4e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x4e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x12, 0x16, 0x2e, 0x4c, 0x4e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x4e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x15, 0x2e, 0x4c, 0x4e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x4e, 0x4f, 0x70, 0x65, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x16, 0x2e, 0x4c, 0x4e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x4e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x2e, 0x4c, 0x4e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x4e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x4c,
+	0x4e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x4e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x12, 0x17, 0x2e, 0x4c, 0x4e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x4e, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x12, 0x14, 0x2e, 0x4c, 0x4e, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x4e, 0x50, 0x61, 0x79, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x15, 0x2e,
+	0x4c, 0x4e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x4e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x13,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4c, 0x4e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x2e, 0x4c, 0x4e, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0a, 0x2e, 0x4c, 0x4e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x30, 0x01, 0x42,
+	0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x7a, 0x65, 0x72, 0x6f, 0x2f, 0x62, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x72, 0x65, 0x6c, 0x61, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5759,218 +13860,470 @@ func file_clientrpc_proto_rawDescGZIP() []byte {
 	return file_clientrpc_proto_rawDescData
 }
 
-var file_clientrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_clientrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_clientrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_clientrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 198)
 var file_clientrpc_proto_goTypes = []interface{}{
-	(MessageMode)(0),                        // 0: MessageMode
-	(*VersionRequest)(nil),                  // 1: VersionRequest
-	(*VersionResponse)(nil),                 // 2: VersionResponse
-	(*KeepaliveStreamRequest)(nil),          // 3: KeepaliveStreamRequest
-	(*KeepaliveEvent)(nil),                  // 4: KeepaliveEvent
-	(*AckRequest)(nil),                      // 5: AckRequest
-	(*AckResponse)(nil),                     // 6: AckResponse
-	(*PMRequest)(nil),                       // 7: PMRequest
-	(*PMResponse)(nil),                      // 8: PMResponse
-	(*PMStreamRequest)(nil),                 // 9: PMStreamRequest
-	(*ReceivedPM)(nil),                      // 10: ReceivedPM
-	(*GCMRequest)(nil),                      // 11: GCMRequest
-	(*GCMResponse)(nil),                     // 12: GCMResponse
-	(*GCMStreamRequest)(nil),                // 13: GCMStreamRequest
-	(*GCReceivedMsg)(nil),                   // 14: GCReceivedMsg
-	(*SubscribeToPostsRequest)(nil),         // 15: SubscribeToPostsRequest
-	(*SubscribeToPostsResponse)(nil),        // 16: SubscribeToPostsResponse
-	(*UnsubscribeToPostsRequest)(nil),       // 17: UnsubscribeToPostsRequest
-	(*UnsubscribeToPostsResponse)(nil),      // 18: UnsubscribeToPostsResponse
-	(*PostSummary)(nil),                     // 19: PostSummary
-	(*PostsStreamRequest)(nil),              // 20: PostsStreamRequest
-	(*ReceivedPost)(nil),                    // 21: ReceivedPost
-	(*PostsStatusStreamRequest)(nil),        // 22: PostsStatusStreamRequest
-	(*ReceivedPostStatus)(nil),              // 23: ReceivedPostStatus
-	(*TipUserRequest)(nil),                  // 24: TipUserRequest
-	(*TipUserResponse)(nil),                 // 25: TipUserResponse
-	(*MediateKXRequest)(nil),                // 26: MediateKXRequest
-	(*MediateKXResponse)(nil),               // 27: MediateKXResponse
-	(*KXStreamRequest)(nil),                 // 28: KXStreamRequest
-	(*KXCompleted)(nil),                     // 29: KXCompleted
-	(*WriteNewInviteRequest)(nil),           // 30: WriteNewInviteRequest
-	(*WriteNewInviteResponse)(nil),          // 31: WriteNewInviteResponse
-	(*AcceptInviteRequest)(nil),             // 32: AcceptInviteRequest
-	(*AcceptInviteResponse)(nil),            // 33: AcceptInviteResponse
-	(*InviteToGCRequest)(nil),               // 34: InviteToGCRequest
-	(*InviteToGCResponse)(nil),              // 35: InviteToGCResponse
-	(*AcceptGCInviteRequest)(nil),           // 36: AcceptGCInviteRequest
-	(*AcceptGCInviteResponse)(nil),          // 37: AcceptGCInviteResponse
-	(*SendFileRequest)(nil),                 // 38: SendFileRequest
-	(*SendFileResponse)(nil),                // 39: SendFileResponse
-	(*UserNickRequest)(nil),                 // 40: UserNickRequest
-	(*UserNickResponse)(nil),                // 41: UserNickResponse
-	(*KickFromGCRequest)(nil),               // 42: KickFromGCRequest
-	(*KickFromGCResponse)(nil),              // 43: KickFromGCResponse
-	(*GetGCRequest)(nil),                    // 44: GetGCRequest
-	(*GetGCResponse)(nil),                   // 45: GetGCResponse
-	(*ListGCsRequest)(nil),                  // 46: ListGCsRequest
-	(*ListGCsResponse)(nil),                 // 47: ListGCsResponse
-	(*ReceivedGCInvitesRequest)(nil),        // 48: ReceivedGCInvitesRequest
-	(*ReceivedGCInvite)(nil),                // 49: ReceivedGCInvite
-	(*UserAndNick)(nil),                     // 50: UserAndNick
-	(*GCMembersAddedRequest)(nil),           // 51: GCMembersAddedRequest
-	(*GCMembersAddedEvent)(nil),             // 52: GCMembersAddedEvent
-	(*GCMembersRemovedRequest)(nil),         // 53: GCMembersRemovedRequest
-	(*GCMembersRemovedEvent)(nil),           // 54: GCMembersRemovedEvent
-	(*JoinedGCsRequest)(nil),                // 55: JoinedGCsRequest
-	(*JoinedGCEvent)(nil),                   // 56: JoinedGCEvent
-	(*TipProgressRequest)(nil),              // 57: TipProgressRequest
-	(*TipProgressEvent)(nil),                // 58: TipProgressEvent
-	(*ResourceRequestsStreamRequest)(nil),   // 59: ResourceRequestsStreamRequest
-	(*ResourceRequestsStreamResponse)(nil),  // 60: ResourceRequestsStreamResponse
-	(*FulfillResourceRequest)(nil),          // 61: FulfillResourceRequest
-	(*FulfillResourceRequestResponse)(nil),  // 62: FulfillResourceRequestResponse
-	(*DownloadsCompletedStreamRequest)(nil), // 63: DownloadsCompletedStreamRequest
-	(*DownloadCompletedResponse)(nil),       // 64: DownloadCompletedResponse
-	(*RMPrivateMessage)(nil),                // 65: RMPrivateMessage
-	(*RMGroupMessage)(nil),                  // 66: RMGroupMessage
-	(*PostMetadata)(nil),                    // 67: PostMetadata
-	(*PostMetadataStatus)(nil),              // 68: PostMetadataStatus
-	(*PublicIdentityReq)(nil),               // 69: PublicIdentityReq
-	(*PublicIdentity)(nil),                  // 70: PublicIdentity
-	(*InviteFunds)(nil),                     // 71: InviteFunds
-	(*OOBPublicIdentityInvite)(nil),         // 72: OOBPublicIdentityInvite
-	(*RMGroupInvite)(nil),                   // 73: RMGroupInvite
-	(*RMGroupList)(nil),                     // 74: RMGroupList
-	(*RMFetchResource)(nil),                 // 75: RMFetchResource
-	(*RMFetchResourceReply)(nil),            // 76: RMFetchResourceReply
-	(*FileManifest)(nil),                    // 77: FileManifest
-	(*FileMetadata)(nil),                    // 78: FileMetadata
-	(*TipStreamRequest)(nil),                // 79: TipStreamRequest
-	(*ReceivedTip)(nil),                     // 80: ReceivedTip
-	(*ListGCsResponse_GCInfo)(nil),          // 81: ListGCsResponse.GCInfo
-	nil,                                     // 82: PostMetadata.AttributesEntry
-	nil,                                     // 83: PostMetadataStatus.AttributesEntry
-	nil,                                     // 84: RMFetchResource.MetaEntry
-	nil,                                     // 85: RMFetchResourceReply.MetaEntry
-	nil,                                     // 86: FileMetadata.AttributesEntry
+	(MessageMode)(0),                          // 0: MessageMode
+	(AddressBookChangeKind)(0),                // 1: AddressBookChangeKind
+	(LNChannelEventType)(0),                   // 2: LNChannelEventType
+	(TransferDirection)(0),                    // 3: TransferDirection
+	(*VersionRequest)(nil),                    // 4: VersionRequest
+	(*VersionResponse)(nil),                   // 5: VersionResponse
+	(*KeepaliveStreamRequest)(nil),            // 6: KeepaliveStreamRequest
+	(*KeepaliveEvent)(nil),                    // 7: KeepaliveEvent
+	(*AckRequest)(nil),                        // 8: AckRequest
+	(*AckResponse)(nil),                       // 9: AckResponse
+	(*PMRequest)(nil),                         // 10: PMRequest
+	(*PMResponse)(nil),                        // 11: PMResponse
+	(*PMStreamRequest)(nil),                   // 12: PMStreamRequest
+	(*ReceivedPM)(nil),                        // 13: ReceivedPM
+	(*GCMRequest)(nil),                        // 14: GCMRequest
+	(*GCMResponse)(nil),                       // 15: GCMResponse
+	(*GCMStreamRequest)(nil),                  // 16: GCMStreamRequest
+	(*GCReceivedMsg)(nil),                     // 17: GCReceivedMsg
+	(*SubscribeToPostsRequest)(nil),           // 18: SubscribeToPostsRequest
+	(*SubscribeToPostsResponse)(nil),          // 19: SubscribeToPostsResponse
+	(*UnsubscribeToPostsRequest)(nil),         // 20: UnsubscribeToPostsRequest
+	(*UnsubscribeToPostsResponse)(nil),        // 21: UnsubscribeToPostsResponse
+	(*PostSummary)(nil),                       // 22: PostSummary
+	(*PostsStreamRequest)(nil),                // 23: PostsStreamRequest
+	(*ReceivedPost)(nil),                      // 24: ReceivedPost
+	(*PostsStatusStreamRequest)(nil),          // 25: PostsStatusStreamRequest
+	(*ReceivedPostStatus)(nil),                // 26: ReceivedPostStatus
+	(*TipUserRequest)(nil),                    // 27: TipUserRequest
+	(*TipUserResponse)(nil),                   // 28: TipUserResponse
+	(*MediateKXRequest)(nil),                  // 29: MediateKXRequest
+	(*MediateKXResponse)(nil),                 // 30: MediateKXResponse
+	(*KXStreamRequest)(nil),                   // 31: KXStreamRequest
+	(*KXCompleted)(nil),                       // 32: KXCompleted
+	(*WriteNewInviteRequest)(nil),             // 33: WriteNewInviteRequest
+	(*WriteNewInviteResponse)(nil),            // 34: WriteNewInviteResponse
+	(*AcceptInviteRequest)(nil),               // 35: AcceptInviteRequest
+	(*AcceptInviteResponse)(nil),              // 36: AcceptInviteResponse
+	(*InviteToGCRequest)(nil),                 // 37: InviteToGCRequest
+	(*InviteToGCResponse)(nil),                // 38: InviteToGCResponse
+	(*AcceptGCInviteRequest)(nil),             // 39: AcceptGCInviteRequest
+	(*AcceptGCInviteResponse)(nil),            // 40: AcceptGCInviteResponse
+	(*SendFileRequest)(nil),                   // 41: SendFileRequest
+	(*SendFileResponse)(nil),                  // 42: SendFileResponse
+	(*UserNickRequest)(nil),                   // 43: UserNickRequest
+	(*UserNickResponse)(nil),                  // 44: UserNickResponse
+	(*KickFromGCRequest)(nil),                 // 45: KickFromGCRequest
+	(*KickFromGCResponse)(nil),                // 46: KickFromGCResponse
+	(*GetGCRequest)(nil),                      // 47: GetGCRequest
+	(*GetGCResponse)(nil),                     // 48: GetGCResponse
+	(*ListGCsRequest)(nil),                    // 49: ListGCsRequest
+	(*ListGCsResponse)(nil),                   // 50: ListGCsResponse
+	(*ReceivedGCInvitesRequest)(nil),          // 51: ReceivedGCInvitesRequest
+	(*ReceivedGCInvite)(nil),                  // 52: ReceivedGCInvite
+	(*UserAndNick)(nil),                       // 53: UserAndNick
+	(*GCMembersAddedRequest)(nil),             // 54: GCMembersAddedRequest
+	(*GCMembersAddedEvent)(nil),               // 55: GCMembersAddedEvent
+	(*GCMembersRemovedRequest)(nil),           // 56: GCMembersRemovedRequest
+	(*GCMembersRemovedEvent)(nil),             // 57: GCMembersRemovedEvent
+	(*JoinedGCsRequest)(nil),                  // 58: JoinedGCsRequest
+	(*JoinedGCEvent)(nil),                     // 59: JoinedGCEvent
+	(*CreateChannelRequest)(nil),              // 60: CreateChannelRequest
+	(*CreateChannelResponse)(nil),             // 61: CreateChannelResponse
+	(*ChannelShareInviteRequest)(nil),         // 62: ChannelShareInviteRequest
+	(*ChannelShareInviteResponse)(nil),        // 63: ChannelShareInviteResponse
+	(*JoinChannelRequest)(nil),                // 64: JoinChannelRequest
+	(*JoinChannelResponse)(nil),               // 65: JoinChannelResponse
+	(*EstimateGCMCostRequest)(nil),            // 66: EstimateGCMCostRequest
+	(*EstimateGCMCostResponse)(nil),           // 67: EstimateGCMCostResponse
+	(*PublishToChannelRequest)(nil),           // 68: PublishToChannelRequest
+	(*PublishToChannelResponse)(nil),          // 69: PublishToChannelResponse
+	(*TipProgressRequest)(nil),                // 70: TipProgressRequest
+	(*TipProgressEvent)(nil),                  // 71: TipProgressEvent
+	(*ResourceRequestsStreamRequest)(nil),     // 72: ResourceRequestsStreamRequest
+	(*ResourceRequestsStreamResponse)(nil),    // 73: ResourceRequestsStreamResponse
+	(*FulfillResourceRequest)(nil),            // 74: FulfillResourceRequest
+	(*FulfillResourceRequestResponse)(nil),    // 75: FulfillResourceRequestResponse
+	(*ProductSubscriptionsStreamRequest)(nil), // 76: ProductSubscriptionsStreamRequest
+	(*ProductSubscriptionChanged)(nil),        // 77: ProductSubscriptionChanged
+	(*DownloadsCompletedStreamRequest)(nil),   // 78: DownloadsCompletedStreamRequest
+	(*DownloadCompletedResponse)(nil),         // 79: DownloadCompletedResponse
+	(*ShareDirRequest)(nil),                   // 80: ShareDirRequest
+	(*ShareDirResponse)(nil),                  // 81: ShareDirResponse
+	(*SearchContentRequest)(nil),              // 82: SearchContentRequest
+	(*SearchContentResponse)(nil),             // 83: SearchContentResponse
+	(*ContentSearchResultsRequest)(nil),       // 84: ContentSearchResultsRequest
+	(*ContentSearchSeller)(nil),               // 85: ContentSearchSeller
+	(*ContentSearchResult)(nil),               // 86: ContentSearchResult
+	(*ContentSearchResultsResponse)(nil),      // 87: ContentSearchResultsResponse
+	(*MediaInfo)(nil),                         // 88: MediaInfo
+	(*SendMediaRequest)(nil),                  // 89: SendMediaRequest
+	(*SendMediaResponse)(nil),                 // 90: SendMediaResponse
+	(*MediaStreamRequest)(nil),                // 91: MediaStreamRequest
+	(*ReceivedMedia)(nil),                     // 92: ReceivedMedia
+	(*RMPrivateMessage)(nil),                  // 93: RMPrivateMessage
+	(*RMGroupMessage)(nil),                    // 94: RMGroupMessage
+	(*PostMetadata)(nil),                      // 95: PostMetadata
+	(*PostMetadataStatus)(nil),                // 96: PostMetadataStatus
+	(*PublicIdentityReq)(nil),                 // 97: PublicIdentityReq
+	(*PublicIdentity)(nil),                    // 98: PublicIdentity
+	(*InviteFunds)(nil),                       // 99: InviteFunds
+	(*OOBPublicIdentityInvite)(nil),           // 100: OOBPublicIdentityInvite
+	(*RMGroupInvite)(nil),                     // 101: RMGroupInvite
+	(*RMGroupList)(nil),                       // 102: RMGroupList
+	(*RMFetchResource)(nil),                   // 103: RMFetchResource
+	(*RMFetchResourceReply)(nil),              // 104: RMFetchResourceReply
+	(*FileManifest)(nil),                      // 105: FileManifest
+	(*FileMetadata)(nil),                      // 106: FileMetadata
+	(*BundleFile)(nil),                        // 107: BundleFile
+	(*TipStreamRequest)(nil),                  // 108: TipStreamRequest
+	(*ReceivedTip)(nil),                       // 109: ReceivedTip
+	(*AddressBookRequest)(nil),                // 110: AddressBookRequest
+	(*AddressBookEntry)(nil),                  // 111: AddressBookEntry
+	(*AddressBookResponse)(nil),               // 112: AddressBookResponse
+	(*RenameUserRequest)(nil),                 // 113: RenameUserRequest
+	(*RenameUserResponse)(nil),                // 114: RenameUserResponse
+	(*IgnoreUserRequest)(nil),                 // 115: IgnoreUserRequest
+	(*IgnoreUserResponse)(nil),                // 116: IgnoreUserResponse
+	(*BlockUserRequest)(nil),                  // 117: BlockUserRequest
+	(*BlockUserResponse)(nil),                 // 118: BlockUserResponse
+	(*HandshakeRequest)(nil),                  // 119: HandshakeRequest
+	(*HandshakeResponse)(nil),                 // 120: HandshakeResponse
+	(*ResetRatchetRequest)(nil),               // 121: ResetRatchetRequest
+	(*ResetRatchetResponse)(nil),              // 122: ResetRatchetResponse
+	(*UsersLastReceivedTimeRequest)(nil),      // 123: UsersLastReceivedTimeRequest
+	(*UserLastReceivedTime)(nil),              // 124: UserLastReceivedTime
+	(*UsersLastReceivedTimeResponse)(nil),     // 125: UsersLastReceivedTimeResponse
+	(*AddressBookStreamRequest)(nil),          // 126: AddressBookStreamRequest
+	(*AddressBookChanged)(nil),                // 127: AddressBookChanged
+	(*PostEmbed)(nil),                         // 128: PostEmbed
+	(*CreatePostRequest)(nil),                 // 129: CreatePostRequest
+	(*CreatePostResponse)(nil),                // 130: CreatePostResponse
+	(*ListPostsRequest)(nil),                  // 131: ListPostsRequest
+	(*ListPostsResponse)(nil),                 // 132: ListPostsResponse
+	(*ReadPostRequest)(nil),                   // 133: ReadPostRequest
+	(*ReadPostResponse)(nil),                  // 134: ReadPostResponse
+	(*CommentPostRequest)(nil),                // 135: CommentPostRequest
+	(*CommentPostResponse)(nil),               // 136: CommentPostResponse
+	(*HeartPostRequest)(nil),                  // 137: HeartPostRequest
+	(*HeartPostResponse)(nil),                 // 138: HeartPostResponse
+	(*RelayPostRequest)(nil),                  // 139: RelayPostRequest
+	(*RelayPostResponse)(nil),                 // 140: RelayPostResponse
+	(*LNGetInfoRequest)(nil),                  // 141: LNGetInfoRequest
+	(*LNGetInfoResponse)(nil),                 // 142: LNGetInfoResponse
+	(*LNBalancesRequest)(nil),                 // 143: LNBalancesRequest
+	(*LNBalancesResponse)(nil),                // 144: LNBalancesResponse
+	(*LNListChannelsRequest)(nil),             // 145: LNListChannelsRequest
+	(*LNChannel)(nil),                         // 146: LNChannel
+	(*LNListChannelsResponse)(nil),            // 147: LNListChannelsResponse
+	(*LNOpenChannelRequest)(nil),              // 148: LNOpenChannelRequest
+	(*LNOpenChannelResponse)(nil),             // 149: LNOpenChannelResponse
+	(*LNCloseChannelRequest)(nil),             // 150: LNCloseChannelRequest
+	(*LNCloseChannelResponse)(nil),            // 151: LNCloseChannelResponse
+	(*LNNewAddressRequest)(nil),               // 152: LNNewAddressRequest
+	(*LNNewAddressResponse)(nil),              // 153: LNNewAddressResponse
+	(*LNCreateInvoiceRequest)(nil),            // 154: LNCreateInvoiceRequest
+	(*LNCreateInvoiceResponse)(nil),           // 155: LNCreateInvoiceResponse
+	(*LNDecodeInvoiceRequest)(nil),            // 156: LNDecodeInvoiceRequest
+	(*LNDecodeInvoiceResponse)(nil),           // 157: LNDecodeInvoiceResponse
+	(*LNPayInvoiceRequest)(nil),               // 158: LNPayInvoiceRequest
+	(*LNPayInvoiceResponse)(nil),              // 159: LNPayInvoiceResponse
+	(*LNSendOnChainRequest)(nil),              // 160: LNSendOnChainRequest
+	(*LNSendOnChainResponse)(nil),             // 161: LNSendOnChainResponse
+	(*LNChannelEventsStreamRequest)(nil),      // 162: LNChannelEventsStreamRequest
+	(*LNChannelEvent)(nil),                    // 163: LNChannelEvent
+	(*LNInvoicesStreamRequest)(nil),           // 164: LNInvoicesStreamRequest
+	(*LNInvoice)(nil),                         // 165: LNInvoice
+	(*ShareFileRequest)(nil),                  // 166: ShareFileRequest
+	(*ShareFileResponse)(nil),                 // 167: ShareFileResponse
+	(*UnshareFileRequest)(nil),                // 168: UnshareFileRequest
+	(*UnshareFileResponse)(nil),               // 169: UnshareFileResponse
+	(*ListLocalSharedFilesRequest)(nil),       // 170: ListLocalSharedFilesRequest
+	(*SharedFile)(nil),                        // 171: SharedFile
+	(*ListLocalSharedFilesResponse)(nil),      // 172: ListLocalSharedFilesResponse
+	(*ListUserContentRequest)(nil),            // 173: ListUserContentRequest
+	(*UserContentFile)(nil),                   // 174: UserContentFile
+	(*ListUserContentResponse)(nil),           // 175: ListUserContentResponse
+	(*GetUserContentRequest)(nil),             // 176: GetUserContentRequest
+	(*GetUserContentResponse)(nil),            // 177: GetUserContentResponse
+	(*ListDownloadsRequest)(nil),              // 178: ListDownloadsRequest
+	(*FileDownload)(nil),                      // 179: FileDownload
+	(*ListDownloadsResponse)(nil),             // 180: ListDownloadsResponse
+	(*CancelDownloadRequest)(nil),             // 181: CancelDownloadRequest
+	(*CancelDownloadResponse)(nil),            // 182: CancelDownloadResponse
+	(*TransfersProgressStreamRequest)(nil),    // 183: TransfersProgressStreamRequest
+	(*TransferProgress)(nil),                  // 184: TransferProgress
+	(*NewPagesSessionRequest)(nil),            // 185: NewPagesSessionRequest
+	(*NewPagesSessionResponse)(nil),           // 186: NewPagesSessionResponse
+	(*FetchResourceRequest)(nil),              // 187: FetchResourceRequest
+	(*FetchResourceResponse)(nil),             // 188: FetchResourceResponse
+	(*FetchedResourcesStreamRequest)(nil),     // 189: FetchedResourcesStreamRequest
+	(*FetchedResource)(nil),                   // 190: FetchedResource
+	(*ListPayLedgerRequest)(nil),              // 191: ListPayLedgerRequest
+	(*PayLedgerEntry)(nil),                    // 192: PayLedgerEntry
+	(*ListPayLedgerResponse)(nil),             // 193: ListPayLedgerResponse
+	(*ExportPayLedgerCSVResponse)(nil),        // 194: ExportPayLedgerCSVResponse
+	(*ListGCsResponse_GCInfo)(nil),            // 195: ListGCsResponse.GCInfo
+	nil,                                       // 196: PostMetadata.AttributesEntry
+	nil,                                       // 197: PostMetadataStatus.AttributesEntry
+	nil,                                       // 198: RMFetchResource.MetaEntry
+	nil,                                       // 199: RMFetchResourceReply.MetaEntry
+	nil,                                       // 200: FileMetadata.AttributesEntry
+	nil,                                       // 201: FetchResourceRequest.MetaEntry
 }
 var file_clientrpc_proto_depIdxs = []int32{
-	65, // 0: PMRequest.msg:type_name -> RMPrivateMessage
-	65, // 1: ReceivedPM.msg:type_name -> RMPrivateMessage
-	66, // 2: GCReceivedMsg.msg:type_name -> RMGroupMessage
-	19, // 3: ReceivedPost.summary:type_name -> PostSummary
-	67, // 4: ReceivedPost.post:type_name -> PostMetadata
-	68, // 5: ReceivedPostStatus.status:type_name -> PostMetadataStatus
-	72, // 6: WriteNewInviteResponse.invite:type_name -> OOBPublicIdentityInvite
-	72, // 7: AcceptInviteResponse.invite:type_name -> OOBPublicIdentityInvite
-	74, // 8: GetGCResponse.gc:type_name -> RMGroupList
-	81, // 9: ListGCsResponse.gcs:type_name -> ListGCsResponse.GCInfo
-	73, // 10: ReceivedGCInvite.invite:type_name -> RMGroupInvite
-	50, // 11: GCMembersAddedEvent.users:type_name -> UserAndNick
-	50, // 12: GCMembersRemovedEvent.users:type_name -> UserAndNick
-	74, // 13: JoinedGCEvent.gc:type_name -> RMGroupList
-	75, // 14: ResourceRequestsStreamResponse.request:type_name -> RMFetchResource
-	76, // 15: FulfillResourceRequest.response:type_name -> RMFetchResourceReply
-	78, // 16: DownloadCompletedResponse.file_metadata:type_name -> FileMetadata
-	0,  // 17: RMPrivateMessage.mode:type_name -> MessageMode
-	0,  // 18: RMGroupMessage.mode:type_name -> MessageMode
-	82, // 19: PostMetadata.attributes:type_name -> PostMetadata.AttributesEntry
-	83, // 20: PostMetadataStatus.attributes:type_name -> PostMetadataStatus.AttributesEntry
-	70, // 21: OOBPublicIdentityInvite.public:type_name -> PublicIdentity
-	71, // 22: OOBPublicIdentityInvite.funds:type_name -> InviteFunds
-	84, // 23: RMFetchResource.meta:type_name -> RMFetchResource.MetaEntry
-	85, // 24: RMFetchResourceReply.meta:type_name -> RMFetchResourceReply.MetaEntry
-	77, // 25: FileMetadata.manifest:type_name -> FileManifest
-	86, // 26: FileMetadata.attributes:type_name -> FileMetadata.AttributesEntry
-	1,  // 27: VersionService.Version:input_type -> VersionRequest
-	3,  // 28: VersionService.KeepaliveStream:input_type -> KeepaliveStreamRequest
-	69, // 29: ChatService.UserPublicIdentity:input_type -> PublicIdentityReq
-	7,  // 30: ChatService.PM:input_type -> PMRequest
-	9,  // 31: ChatService.PMStream:input_type -> PMStreamRequest
-	5,  // 32: ChatService.AckReceivedPM:input_type -> AckRequest
-	11, // 33: ChatService.GCM:input_type -> GCMRequest
-	13, // 34: ChatService.GCMStream:input_type -> GCMStreamRequest
-	5,  // 35: ChatService.AckReceivedGCM:input_type -> AckRequest
-	26, // 36: ChatService.MediateKX:input_type -> MediateKXRequest
-	28, // 37: ChatService.KXStream:input_type -> KXStreamRequest
-	5,  // 38: ChatService.AckKXCompleted:input_type -> AckRequest
-	30, // 39: ChatService.WriteNewInvite:input_type -> WriteNewInviteRequest
-	32, // 40: ChatService.AcceptInvite:input_type -> AcceptInviteRequest
-	38, // 41: ChatService.SendFile:input_type -> SendFileRequest
-	40, // 42: ChatService.UserNick:input_type -> UserNickRequest
-	34, // 43: GCService.InviteToGC:input_type -> InviteToGCRequest
-	36, // 44: GCService.AcceptGCInvite:input_type -> AcceptGCInviteRequest
-	42, // 45: GCService.KickFromGC:input_type -> KickFromGCRequest
-	44, // 46: GCService.GetGC:input_type -> GetGCRequest
-	46, // 47: GCService.List:input_type -> ListGCsRequest
-	48, // 48: GCService.ReceivedGCInvites:input_type -> ReceivedGCInvitesRequest
-	5,  // 49: GCService.AckReceivedGCInvites:input_type -> AckRequest
-	51, // 50: GCService.MembersAdded:input_type -> GCMembersAddedRequest
-	5,  // 51: GCService.AckMembersAdded:input_type -> AckRequest
-	53, // 52: GCService.MembersRemoved:input_type -> GCMembersRemovedRequest
-	5,  // 53: GCService.AckMembersRemoved:input_type -> AckRequest
-	55, // 54: GCService.JoinedGCs:input_type -> JoinedGCsRequest
-	5,  // 55: GCService.AckJoinedGCs:input_type -> AckRequest
-	15, // 56: PostsService.SubscribeToPosts:input_type -> SubscribeToPostsRequest
-	17, // 57: PostsService.UnsubscribeToPosts:input_type -> UnsubscribeToPostsRequest
-	20, // 58: PostsService.PostsStream:input_type -> PostsStreamRequest
-	5,  // 59: PostsService.AckReceivedPost:input_type -> AckRequest
-	22, // 60: PostsService.PostsStatusStream:input_type -> PostsStatusStreamRequest
-	5,  // 61: PostsService.AckReceivedPostStatus:input_type -> AckRequest
-	24, // 62: PaymentsService.TipUser:input_type -> TipUserRequest
-	57, // 63: PaymentsService.TipProgress:input_type -> TipProgressRequest
-	5,  // 64: PaymentsService.AckTipProgress:input_type -> AckRequest
-	79, // 65: PaymentsService.TipStream:input_type -> TipStreamRequest
-	5,  // 66: PaymentsService.AckTipReceived:input_type -> AckRequest
-	59, // 67: ResourcesService.RequestsStream:input_type -> ResourceRequestsStreamRequest
-	61, // 68: ResourcesService.FulfillRequest:input_type -> FulfillResourceRequest
-	63, // 69: ContentService.DownloadsCompletedStream:input_type -> DownloadsCompletedStreamRequest
-	5,  // 70: ContentService.AckDownloadCompleted:input_type -> AckRequest
-	2,  // 71: VersionService.Version:output_type -> VersionResponse
-	4,  // 72: VersionService.KeepaliveStream:output_type -> KeepaliveEvent
-	70, // 73: ChatService.UserPublicIdentity:output_type -> PublicIdentity
-	8,  // 74: ChatService.PM:output_type -> PMResponse
-	10, // 75: ChatService.PMStream:output_type -> ReceivedPM
-	6,  // 76: ChatService.AckReceivedPM:output_type -> AckResponse
-	12, // 77: ChatService.GCM:output_type -> GCMResponse
-	14, // 78: ChatService.GCMStream:output_type -> GCReceivedMsg
-	6,  // 79: ChatService.AckReceivedGCM:output_type -> AckResponse
-	27, // 80: ChatService.MediateKX:output_type -> MediateKXResponse
-	29, // 81: ChatService.KXStream:output_type -> KXCompleted
-	6,  // 82: ChatService.AckKXCompleted:output_type -> AckResponse
-	31, // 83: ChatService.WriteNewInvite:output_type -> WriteNewInviteResponse
-	33, // 84: ChatService.AcceptInvite:output_type -> AcceptInviteResponse
-	39, // 85: ChatService.SendFile:output_type -> SendFileResponse
-	41, // 86: ChatService.UserNick:output_type -> UserNickResponse
-	35, // 87: GCService.InviteToGC:output_type -> InviteToGCResponse
-	37, // 88: GCService.AcceptGCInvite:output_type -> AcceptGCInviteResponse
-	43, // 89: GCService.KickFromGC:output_type -> KickFromGCResponse
-	45, // 90: GCService.GetGC:output_type -> GetGCResponse
-	47, // 91: GCService.List:output_type -> ListGCsResponse
-	49, // 92: GCService.ReceivedGCInvites:output_type -> ReceivedGCInvite
-	6,  // 93: GCService.AckReceivedGCInvites:output_type -> AckResponse
-	52, // 94: GCService.MembersAdded:output_type -> GCMembersAddedEvent
-	6,  // 95: GCService.AckMembersAdded:output_type -> AckResponse
-	54, // 96: GCService.MembersRemoved:output_type -> GCMembersRemovedEvent
-	6,  // 97: GCService.AckMembersRemoved:output_type -> AckResponse
-	56, // 98: GCService.JoinedGCs:output_type -> JoinedGCEvent
-	6,  // 99: GCService.AckJoinedGCs:output_type -> AckResponse
-	16, // 100: PostsService.SubscribeToPosts:output_type -> SubscribeToPostsResponse
-	18, // 101: PostsService.UnsubscribeToPosts:output_type -> UnsubscribeToPostsResponse
-	21, // 102: PostsService.PostsStream:output_type -> ReceivedPost
-	6,  // 103: PostsService.AckReceivedPost:output_type -> AckResponse
-	23, // 104: PostsService.PostsStatusStream:output_type -> ReceivedPostStatus
-	6,  // 105: PostsService.AckReceivedPostStatus:output_type -> AckResponse
-	25, // 106: PaymentsService.TipUser:output_type -> TipUserResponse
-	58, // 107: PaymentsService.TipProgress:output_type -> TipProgressEvent
-	6,  // 108: PaymentsService.AckTipProgress:output_type -> AckResponse
-	80, // 109: PaymentsService.TipStream:output_type -> ReceivedTip
-	6,  // 110: PaymentsService.AckTipReceived:output_type -> AckResponse
-	60, // 111: ResourcesService.RequestsStream:output_type -> ResourceRequestsStreamResponse
-	62, // 112: ResourcesService.FulfillRequest:output_type -> FulfillResourceRequestResponse
-	64, // 113: ContentService.DownloadsCompletedStream:output_type -> DownloadCompletedResponse
-	6,  // 114: ContentService.AckDownloadCompleted:output_type -> AckResponse
-	71, // [71:115] is the sub-list for method output_type
-	27, // [27:71] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	93,  // 0: PMRequest.msg:type_name -> RMPrivateMessage
+	93,  // 1: ReceivedPM.msg:type_name -> RMPrivateMessage
+	94,  // 2: GCReceivedMsg.msg:type_name -> RMGroupMessage
+	22,  // 3: ReceivedPost.summary:type_name -> PostSummary
+	95,  // 4: ReceivedPost.post:type_name -> PostMetadata
+	96,  // 5: ReceivedPostStatus.status:type_name -> PostMetadataStatus
+	100, // 6: WriteNewInviteResponse.invite:type_name -> OOBPublicIdentityInvite
+	100, // 7: AcceptInviteResponse.invite:type_name -> OOBPublicIdentityInvite
+	102, // 8: GetGCResponse.gc:type_name -> RMGroupList
+	195, // 9: ListGCsResponse.gcs:type_name -> ListGCsResponse.GCInfo
+	101, // 10: ReceivedGCInvite.invite:type_name -> RMGroupInvite
+	53,  // 11: GCMembersAddedEvent.users:type_name -> UserAndNick
+	53,  // 12: GCMembersRemovedEvent.users:type_name -> UserAndNick
+	102, // 13: JoinedGCEvent.gc:type_name -> RMGroupList
+	103, // 14: ResourceRequestsStreamResponse.request:type_name -> RMFetchResource
+	104, // 15: FulfillResourceRequest.response:type_name -> RMFetchResourceReply
+	106, // 16: DownloadCompletedResponse.file_metadata:type_name -> FileMetadata
+	106, // 17: ShareDirResponse.file_metadata:type_name -> FileMetadata
+	85,  // 18: ContentSearchResult.sellers:type_name -> ContentSearchSeller
+	86,  // 19: ContentSearchResultsResponse.results:type_name -> ContentSearchResult
+	88,  // 20: SendMediaRequest.media:type_name -> MediaInfo
+	88,  // 21: ReceivedMedia.media:type_name -> MediaInfo
+	0,   // 22: RMPrivateMessage.mode:type_name -> MessageMode
+	0,   // 23: RMGroupMessage.mode:type_name -> MessageMode
+	196, // 24: PostMetadata.attributes:type_name -> PostMetadata.AttributesEntry
+	197, // 25: PostMetadataStatus.attributes:type_name -> PostMetadataStatus.AttributesEntry
+	98,  // 26: OOBPublicIdentityInvite.public:type_name -> PublicIdentity
+	99,  // 27: OOBPublicIdentityInvite.funds:type_name -> InviteFunds
+	198, // 28: RMFetchResource.meta:type_name -> RMFetchResource.MetaEntry
+	199, // 29: RMFetchResourceReply.meta:type_name -> RMFetchResourceReply.MetaEntry
+	105, // 30: FileMetadata.manifest:type_name -> FileManifest
+	200, // 31: FileMetadata.attributes:type_name -> FileMetadata.AttributesEntry
+	107, // 32: FileMetadata.files:type_name -> BundleFile
+	111, // 33: AddressBookResponse.users:type_name -> AddressBookEntry
+	124, // 34: UsersLastReceivedTimeResponse.users:type_name -> UserLastReceivedTime
+	1,   // 35: AddressBookChanged.kind:type_name -> AddressBookChangeKind
+	128, // 36: CreatePostRequest.embeds:type_name -> PostEmbed
+	22,  // 37: CreatePostResponse.summary:type_name -> PostSummary
+	22,  // 38: ListPostsResponse.posts:type_name -> PostSummary
+	95,  // 39: ReadPostResponse.post:type_name -> PostMetadata
+	146, // 40: LNListChannelsResponse.channels:type_name -> LNChannel
+	146, // 41: LNListChannelsResponse.pending_open:type_name -> LNChannel
+	146, // 42: LNListChannelsResponse.pending_close:type_name -> LNChannel
+	2,   // 43: LNChannelEvent.type:type_name -> LNChannelEventType
+	146, // 44: LNChannelEvent.channel:type_name -> LNChannel
+	106, // 45: ShareFileResponse.file_metadata:type_name -> FileMetadata
+	171, // 46: ListLocalSharedFilesResponse.files:type_name -> SharedFile
+	106, // 47: UserContentFile.file_metadata:type_name -> FileMetadata
+	174, // 48: ListUserContentResponse.files:type_name -> UserContentFile
+	106, // 49: FileDownload.file_metadata:type_name -> FileMetadata
+	179, // 50: ListDownloadsResponse.downloads:type_name -> FileDownload
+	3,   // 51: TransferProgress.direction:type_name -> TransferDirection
+	201, // 52: FetchResourceRequest.meta:type_name -> FetchResourceRequest.MetaEntry
+	103, // 53: FetchedResource.request:type_name -> RMFetchResource
+	104, // 54: FetchedResource.response:type_name -> RMFetchResourceReply
+	192, // 55: ListPayLedgerResponse.entries:type_name -> PayLedgerEntry
+	4,   // 56: VersionService.Version:input_type -> VersionRequest
+	6,   // 57: VersionService.KeepaliveStream:input_type -> KeepaliveStreamRequest
+	97,  // 58: ChatService.UserPublicIdentity:input_type -> PublicIdentityReq
+	10,  // 59: ChatService.PM:input_type -> PMRequest
+	12,  // 60: ChatService.PMStream:input_type -> PMStreamRequest
+	8,   // 61: ChatService.AckReceivedPM:input_type -> AckRequest
+	14,  // 62: ChatService.GCM:input_type -> GCMRequest
+	16,  // 63: ChatService.GCMStream:input_type -> GCMStreamRequest
+	8,   // 64: ChatService.AckReceivedGCM:input_type -> AckRequest
+	29,  // 65: ChatService.MediateKX:input_type -> MediateKXRequest
+	31,  // 66: ChatService.KXStream:input_type -> KXStreamRequest
+	8,   // 67: ChatService.AckKXCompleted:input_type -> AckRequest
+	33,  // 68: ChatService.WriteNewInvite:input_type -> WriteNewInviteRequest
+	35,  // 69: ChatService.AcceptInvite:input_type -> AcceptInviteRequest
+	41,  // 70: ChatService.SendFile:input_type -> SendFileRequest
+	43,  // 71: ChatService.UserNick:input_type -> UserNickRequest
+	37,  // 72: GCService.InviteToGC:input_type -> InviteToGCRequest
+	39,  // 73: GCService.AcceptGCInvite:input_type -> AcceptGCInviteRequest
+	45,  // 74: GCService.KickFromGC:input_type -> KickFromGCRequest
+	47,  // 75: GCService.GetGC:input_type -> GetGCRequest
+	49,  // 76: GCService.List:input_type -> ListGCsRequest
+	51,  // 77: GCService.ReceivedGCInvites:input_type -> ReceivedGCInvitesRequest
+	8,   // 78: GCService.AckReceivedGCInvites:input_type -> AckRequest
+	54,  // 79: GCService.MembersAdded:input_type -> GCMembersAddedRequest
+	8,   // 80: GCService.AckMembersAdded:input_type -> AckRequest
+	56,  // 81: GCService.MembersRemoved:input_type -> GCMembersRemovedRequest
+	8,   // 82: GCService.AckMembersRemoved:input_type -> AckRequest
+	58,  // 83: GCService.JoinedGCs:input_type -> JoinedGCsRequest
+	8,   // 84: GCService.AckJoinedGCs:input_type -> AckRequest
+	60,  // 85: GCService.CreateChannel:input_type -> CreateChannelRequest
+	62,  // 86: GCService.ChannelShareInvite:input_type -> ChannelShareInviteRequest
+	64,  // 87: GCService.JoinChannel:input_type -> JoinChannelRequest
+	66,  // 88: GCService.EstimateGCMCost:input_type -> EstimateGCMCostRequest
+	68,  // 89: GCService.PublishToChannel:input_type -> PublishToChannelRequest
+	18,  // 90: PostsService.SubscribeToPosts:input_type -> SubscribeToPostsRequest
+	20,  // 91: PostsService.UnsubscribeToPosts:input_type -> UnsubscribeToPostsRequest
+	23,  // 92: PostsService.PostsStream:input_type -> PostsStreamRequest
+	8,   // 93: PostsService.AckReceivedPost:input_type -> AckRequest
+	25,  // 94: PostsService.PostsStatusStream:input_type -> PostsStatusStreamRequest
+	8,   // 95: PostsService.AckReceivedPostStatus:input_type -> AckRequest
+	129, // 96: PostsService.CreatePost:input_type -> CreatePostRequest
+	131, // 97: PostsService.ListPosts:input_type -> ListPostsRequest
+	133, // 98: PostsService.ReadPost:input_type -> ReadPostRequest
+	135, // 99: PostsService.CommentPost:input_type -> CommentPostRequest
+	137, // 100: PostsService.HeartPost:input_type -> HeartPostRequest
+	139, // 101: PostsService.RelayPost:input_type -> RelayPostRequest
+	27,  // 102: PaymentsService.TipUser:input_type -> TipUserRequest
+	70,  // 103: PaymentsService.TipProgress:input_type -> TipProgressRequest
+	8,   // 104: PaymentsService.AckTipProgress:input_type -> AckRequest
+	108, // 105: PaymentsService.TipStream:input_type -> TipStreamRequest
+	8,   // 106: PaymentsService.AckTipReceived:input_type -> AckRequest
+	191, // 107: PaymentsService.ListPayLedger:input_type -> ListPayLedgerRequest
+	191, // 108: PaymentsService.ExportPayLedgerCSV:input_type -> ListPayLedgerRequest
+	72,  // 109: ResourcesService.RequestsStream:input_type -> ResourceRequestsStreamRequest
+	74,  // 110: ResourcesService.FulfillRequest:input_type -> FulfillResourceRequest
+	76,  // 111: ResourcesService.ProductSubscriptionsStream:input_type -> ProductSubscriptionsStreamRequest
+	8,   // 112: ResourcesService.AckProductSubscriptionChanged:input_type -> AckRequest
+	185, // 113: ResourcesService.NewPagesSession:input_type -> NewPagesSessionRequest
+	187, // 114: ResourcesService.FetchResource:input_type -> FetchResourceRequest
+	189, // 115: ResourcesService.FetchedResourcesStream:input_type -> FetchedResourcesStreamRequest
+	8,   // 116: ResourcesService.AckFetchedResource:input_type -> AckRequest
+	78,  // 117: ContentService.DownloadsCompletedStream:input_type -> DownloadsCompletedStreamRequest
+	8,   // 118: ContentService.AckDownloadCompleted:input_type -> AckRequest
+	80,  // 119: ContentService.ShareDir:input_type -> ShareDirRequest
+	82,  // 120: ContentService.SearchContent:input_type -> SearchContentRequest
+	84,  // 121: ContentService.ContentSearchResults:input_type -> ContentSearchResultsRequest
+	166, // 122: ContentService.ShareFile:input_type -> ShareFileRequest
+	168, // 123: ContentService.UnshareFile:input_type -> UnshareFileRequest
+	170, // 124: ContentService.ListLocalSharedFiles:input_type -> ListLocalSharedFilesRequest
+	173, // 125: ContentService.ListUserContent:input_type -> ListUserContentRequest
+	176, // 126: ContentService.GetUserContent:input_type -> GetUserContentRequest
+	178, // 127: ContentService.ListDownloads:input_type -> ListDownloadsRequest
+	181, // 128: ContentService.CancelDownload:input_type -> CancelDownloadRequest
+	183, // 129: ContentService.TransfersProgressStream:input_type -> TransfersProgressStreamRequest
+	89,  // 130: MediaService.SendMedia:input_type -> SendMediaRequest
+	91,  // 131: MediaService.MediaStream:input_type -> MediaStreamRequest
+	8,   // 132: MediaService.AckReceivedMedia:input_type -> AckRequest
+	110, // 133: UsersService.AddressBook:input_type -> AddressBookRequest
+	113, // 134: UsersService.RenameUser:input_type -> RenameUserRequest
+	115, // 135: UsersService.IgnoreUser:input_type -> IgnoreUserRequest
+	117, // 136: UsersService.BlockUser:input_type -> BlockUserRequest
+	119, // 137: UsersService.Handshake:input_type -> HandshakeRequest
+	121, // 138: UsersService.ResetRatchet:input_type -> ResetRatchetRequest
+	123, // 139: UsersService.UsersLastReceivedTime:input_type -> UsersLastReceivedTimeRequest
+	126, // 140: UsersService.AddressBookStream:input_type -> AddressBookStreamRequest
+	8,   // 141: UsersService.AckAddressBookChanged:input_type -> AckRequest
+	141, // 142: LNService.GetInfo:input_type -> LNGetInfoRequest
+	143, // 143: LNService.Balances:input_type -> LNBalancesRequest
+	145, // 144: LNService.ListChannels:input_type -> LNListChannelsRequest
+	148, // 145: LNService.OpenChannel:input_type -> LNOpenChannelRequest
+	150, // 146: LNService.CloseChannel:input_type -> LNCloseChannelRequest
+	152, // 147: LNService.NewAddress:input_type -> LNNewAddressRequest
+	154, // 148: LNService.CreateInvoice:input_type -> LNCreateInvoiceRequest
+	156, // 149: LNService.DecodeInvoice:input_type -> LNDecodeInvoiceRequest
+	158, // 150: LNService.PayInvoice:input_type -> LNPayInvoiceRequest
+	160, // 151: LNService.SendOnChain:input_type -> LNSendOnChainRequest
+	162, // 152: LNService.ChannelEventsStream:input_type -> LNChannelEventsStreamRequest
+	164, // 153: LNService.InvoicesStream:input_type -> LNInvoicesStreamRequest
+	5,   // 154: VersionService.Version:output_type -> VersionResponse
+	7,   // 155: VersionService.KeepaliveStream:output_type -> KeepaliveEvent
+	98,  // 156: ChatService.UserPublicIdentity:output_type -> PublicIdentity
+	11,  // 157: ChatService.PM:output_type -> PMResponse
+	13,  // 158: ChatService.PMStream:output_type -> ReceivedPM
+	9,   // 159: ChatService.AckReceivedPM:output_type -> AckResponse
+	15,  // 160: ChatService.GCM:output_type -> GCMResponse
+	17,  // 161: ChatService.GCMStream:output_type -> GCReceivedMsg
+	9,   // 162: ChatService.AckReceivedGCM:output_type -> AckResponse
+	30,  // 163: ChatService.MediateKX:output_type -> MediateKXResponse
+	32,  // 164: ChatService.KXStream:output_type -> KXCompleted
+	9,   // 165: ChatService.AckKXCompleted:output_type -> AckResponse
+	34,  // 166: ChatService.WriteNewInvite:output_type -> WriteNewInviteResponse
+	36,  // 167: ChatService.AcceptInvite:output_type -> AcceptInviteResponse
+	42,  // 168: ChatService.SendFile:output_type -> SendFileResponse
+	44,  // 169: ChatService.UserNick:output_type -> UserNickResponse
+	38,  // 170: GCService.InviteToGC:output_type -> InviteToGCResponse
+	40,  // 171: GCService.AcceptGCInvite:output_type -> AcceptGCInviteResponse
+	46,  // 172: GCService.KickFromGC:output_type -> KickFromGCResponse
+	48,  // 173: GCService.GetGC:output_type -> GetGCResponse
+	50,  // 174: GCService.List:output_type -> ListGCsResponse
+	52,  // 175: GCService.ReceivedGCInvites:output_type -> ReceivedGCInvite
+	9,   // 176: GCService.AckReceivedGCInvites:output_type -> AckResponse
+	55,  // 177: GCService.MembersAdded:output_type -> GCMembersAddedEvent
+	9,   // 178: GCService.AckMembersAdded:output_type -> AckResponse
+	57,  // 179: GCService.MembersRemoved:output_type -> GCMembersRemovedEvent
+	9,   // 180: GCService.AckMembersRemoved:output_type -> AckResponse
+	59,  // 181: GCService.JoinedGCs:output_type -> JoinedGCEvent
+	9,   // 182: GCService.AckJoinedGCs:output_type -> AckResponse
+	61,  // 183: GCService.CreateChannel:output_type -> CreateChannelResponse
+	63,  // 184: GCService.ChannelShareInvite:output_type -> ChannelShareInviteResponse
+	65,  // 185: GCService.JoinChannel:output_type -> JoinChannelResponse
+	67,  // 186: GCService.EstimateGCMCost:output_type -> EstimateGCMCostResponse
+	69,  // 187: GCService.PublishToChannel:output_type -> PublishToChannelResponse
+	19,  // 188: PostsService.SubscribeToPosts:output_type -> SubscribeToPostsResponse
+	21,  // 189: PostsService.UnsubscribeToPosts:output_type -> UnsubscribeToPostsResponse
+	24,  // 190: PostsService.PostsStream:output_type -> ReceivedPost
+	9,   // 191: PostsService.AckReceivedPost:output_type -> AckResponse
+	26,  // 192: PostsService.PostsStatusStream:output_type -> ReceivedPostStatus
+	9,   // 193: PostsService.AckReceivedPostStatus:output_type -> AckResponse
+	130, // 194: PostsService.CreatePost:output_type -> CreatePostResponse
+	132, // 195: PostsService.ListPosts:output_type -> ListPostsResponse
+	134, // 196: PostsService.ReadPost:output_type -> ReadPostResponse
+	136, // 197: PostsService.CommentPost:output_type -> CommentPostResponse
+	138, // 198: PostsService.HeartPost:output_type -> HeartPostResponse
+	140, // 199: PostsService.RelayPost:output_type -> RelayPostResponse
+	28,  // 200: PaymentsService.TipUser:output_type -> TipUserResponse
+	71,  // 201: PaymentsService.TipProgress:output_type -> TipProgressEvent
+	9,   // 202: PaymentsService.AckTipProgress:output_type -> AckResponse
+	109, // 203: PaymentsService.TipStream:output_type -> ReceivedTip
+	9,   // 204: PaymentsService.AckTipReceived:output_type -> AckResponse
+	193, // 205: PaymentsService.ListPayLedger:output_type -> ListPayLedgerResponse
+	194, // 206: PaymentsService.ExportPayLedgerCSV:output_type -> ExportPayLedgerCSVResponse
+	73,  // 207: ResourcesService.RequestsStream:output_type -> ResourceRequestsStreamResponse
+	75,  // 208: ResourcesService.FulfillRequest:output_type -> FulfillResourceRequestResponse
+	77,  // 209: ResourcesService.ProductSubscriptionsStream:output_type -> ProductSubscriptionChanged
+	9,   // 210: ResourcesService.AckProductSubscriptionChanged:output_type -> AckResponse
+	186, // 211: ResourcesService.NewPagesSession:output_type -> NewPagesSessionResponse
+	188, // 212: ResourcesService.FetchResource:output_type -> FetchResourceResponse
+	190, // 213: ResourcesService.FetchedResourcesStream:output_type -> FetchedResource
+	9,   // 214: ResourcesService.AckFetchedResource:output_type -> AckResponse
+	79,  // 215: ContentService.DownloadsCompletedStream:output_type -> DownloadCompletedResponse
+	9,   // 216: ContentService.AckDownloadCompleted:output_type -> AckResponse
+	81,  // 217: ContentService.ShareDir:output_type -> ShareDirResponse
+	83,  // 218: ContentService.SearchContent:output_type -> SearchContentResponse
+	87,  // 219: ContentService.ContentSearchResults:output_type -> ContentSearchResultsResponse
+	167, // 220: ContentService.ShareFile:output_type -> ShareFileResponse
+	169, // 221: ContentService.UnshareFile:output_type -> UnshareFileResponse
+	172, // 222: ContentService.ListLocalSharedFiles:output_type -> ListLocalSharedFilesResponse
+	175, // 223: ContentService.ListUserContent:output_type -> ListUserContentResponse
+	177, // 224: ContentService.GetUserContent:output_type -> GetUserContentResponse
+	180, // 225: ContentService.ListDownloads:output_type -> ListDownloadsResponse
+	182, // 226: ContentService.CancelDownload:output_type -> CancelDownloadResponse
+	184, // 227: ContentService.TransfersProgressStream:output_type -> TransferProgress
+	90,  // 228: MediaService.SendMedia:output_type -> SendMediaResponse
+	92,  // 229: MediaService.MediaStream:output_type -> ReceivedMedia
+	9,   // 230: MediaService.AckReceivedMedia:output_type -> AckResponse
+	112, // 231: UsersService.AddressBook:output_type -> AddressBookResponse
+	114, // 232: UsersService.RenameUser:output_type -> RenameUserResponse
+	116, // 233: UsersService.IgnoreUser:output_type -> IgnoreUserResponse
+	118, // 234: UsersService.BlockUser:output_type -> BlockUserResponse
+	120, // 235: UsersService.Handshake:output_type -> HandshakeResponse
+	122, // 236: UsersService.ResetRatchet:output_type -> ResetRatchetResponse
+	125, // 237: UsersService.UsersLastReceivedTime:output_type -> UsersLastReceivedTimeResponse
+	127, // 238: UsersService.AddressBookStream:output_type -> AddressBookChanged
+	9,   // 239: UsersService.AckAddressBookChanged:output_type -> AckResponse
+	142, // 240: LNService.GetInfo:output_type -> LNGetInfoResponse
+	144, // 241: LNService.Balances:output_type -> LNBalancesResponse
+	147, // 242: LNService.ListChannels:output_type -> LNListChannelsResponse
+	149, // 243: LNService.OpenChannel:output_type -> LNOpenChannelResponse
+	151, // 244: LNService.CloseChannel:output_type -> LNCloseChannelResponse
+	153, // 245: LNService.NewAddress:output_type -> LNNewAddressResponse
+	155, // 246: LNService.CreateInvoice:output_type -> LNCreateInvoiceResponse
+	157, // 247: LNService.DecodeInvoice:output_type -> LNDecodeInvoiceResponse
+	159, // 248: LNService.PayInvoice:output_type -> LNPayInvoiceResponse
+	161, // 249: LNService.SendOnChain:output_type -> LNSendOnChainResponse
+	163, // 250: LNService.ChannelEventsStream:output_type -> LNChannelEvent
+	165, // 251: LNService.InvoicesStream:output_type -> LNInvoice
+	154, // [154:252] is the sub-list for method output_type
+	56,  // [56:154] is the sub-list for method input_type
+	56,  // [56:56] is the sub-list for extension type_name
+	56,  // [56:56] is the sub-list for extension extendee
+	0,   // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_clientrpc_proto_init() }
@@ -5991,8 +14344,1232 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResponse); i {
+		file_clientrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepaliveStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepaliveEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PMStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedPM); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCReceivedMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeToPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeToPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostsStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostsStatusStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedPostStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediateKXRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediateKXResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KXStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KXCompleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteNewInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteNewInviteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInviteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToGCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToGCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGCInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGCInviteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserNickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserNickResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickFromGCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickFromGCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGCsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGCsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedGCInvitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedGCInvite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAndNick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersAddedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersAddedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersRemovedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersRemovedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinedGCsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinedGCEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelShareInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelShareInviteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGCMCostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGCMCostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishToChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishToChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipProgressEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceRequestsStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceRequestsStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FulfillResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FulfillResourceRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductSubscriptionsStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductSubscriptionChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadsCompletedStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadCompletedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareDirRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareDirResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchContentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentSearchResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentSearchSeller); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentSearchResultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMediaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMediaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RMPrivateMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RMGroupMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostMetadataStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicIdentityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteFunds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OOBPublicIdentityInvite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RMGroupInvite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RMGroupList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RMFetchResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RMFetchResourceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6003,8 +15580,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeepaliveStreamRequest); i {
+		file_clientrpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6015,8 +15592,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeepaliveEvent); i {
+		file_clientrpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedTip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6027,8 +15604,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckRequest); i {
+		file_clientrpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressBookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6039,8 +15616,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckResponse); i {
+		file_clientrpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressBookEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6051,8 +15628,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PMRequest); i {
+		file_clientrpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressBookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6063,8 +15640,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PMResponse); i {
+		file_clientrpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6075,8 +15652,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PMStreamRequest); i {
+		file_clientrpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6087,8 +15664,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedPM); i {
+		file_clientrpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IgnoreUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6099,8 +15676,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMRequest); i {
+		file_clientrpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IgnoreUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6111,8 +15688,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMResponse); i {
+		file_clientrpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6123,8 +15700,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMStreamRequest); i {
+		file_clientrpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6135,8 +15712,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCReceivedMsg); i {
+		file_clientrpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandshakeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6147,8 +15724,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToPostsRequest); i {
+		file_clientrpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandshakeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6159,8 +15736,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToPostsResponse); i {
+		file_clientrpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetRatchetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6171,8 +15748,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeToPostsRequest); i {
+		file_clientrpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetRatchetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6183,8 +15760,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeToPostsResponse); i {
+		file_clientrpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersLastReceivedTimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6195,8 +15772,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostSummary); i {
+		file_clientrpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserLastReceivedTime); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6207,8 +15784,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostsStreamRequest); i {
+		file_clientrpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersLastReceivedTimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6219,8 +15796,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedPost); i {
+		file_clientrpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressBookStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6231,8 +15808,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostsStatusStreamRequest); i {
+		file_clientrpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressBookChanged); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6243,8 +15820,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedPostStatus); i {
+		file_clientrpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostEmbed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6255,8 +15832,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipUserRequest); i {
+		file_clientrpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6267,8 +15844,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipUserResponse); i {
+		file_clientrpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6279,8 +15856,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediateKXRequest); i {
+		file_clientrpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6291,8 +15868,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediateKXResponse); i {
+		file_clientrpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6303,8 +15880,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KXStreamRequest); i {
+		file_clientrpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6315,8 +15892,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KXCompleted); i {
+		file_clientrpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6327,8 +15904,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteNewInviteRequest); i {
+		file_clientrpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6339,8 +15916,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteNewInviteResponse); i {
+		file_clientrpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6351,8 +15928,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptInviteRequest); i {
+		file_clientrpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6363,8 +15940,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptInviteResponse); i {
+		file_clientrpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6375,8 +15952,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteToGCRequest); i {
+		file_clientrpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6387,8 +15964,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteToGCResponse); i {
+		file_clientrpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6399,8 +15976,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptGCInviteRequest); i {
+		file_clientrpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNGetInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6411,8 +15988,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptGCInviteResponse); i {
+		file_clientrpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNGetInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6423,8 +16000,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFileRequest); i {
+		file_clientrpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNBalancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6435,8 +16012,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFileResponse); i {
+		file_clientrpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNBalancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6447,8 +16024,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserNickRequest); i {
+		file_clientrpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNListChannelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6459,8 +16036,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserNickResponse); i {
+		file_clientrpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6471,8 +16048,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KickFromGCRequest); i {
+		file_clientrpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNListChannelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6483,8 +16060,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KickFromGCResponse); i {
+		file_clientrpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNOpenChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6495,8 +16072,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGCRequest); i {
+		file_clientrpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNOpenChannelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6507,8 +16084,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGCResponse); i {
+		file_clientrpc_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNCloseChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6519,8 +16096,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGCsRequest); i {
+		file_clientrpc_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNCloseChannelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6531,8 +16108,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGCsResponse); i {
+		file_clientrpc_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNNewAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6543,8 +16120,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedGCInvitesRequest); i {
+		file_clientrpc_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNNewAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6555,8 +16132,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedGCInvite); i {
+		file_clientrpc_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNCreateInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6567,8 +16144,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAndNick); i {
+		file_clientrpc_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNCreateInvoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6579,8 +16156,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersAddedRequest); i {
+		file_clientrpc_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNDecodeInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6591,8 +16168,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersAddedEvent); i {
+		file_clientrpc_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNDecodeInvoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6603,8 +16180,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersRemovedRequest); i {
+		file_clientrpc_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNPayInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6615,8 +16192,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersRemovedEvent); i {
+		file_clientrpc_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNPayInvoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6627,8 +16204,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinedGCsRequest); i {
+		file_clientrpc_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNSendOnChainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6639,8 +16216,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinedGCEvent); i {
+		file_clientrpc_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNSendOnChainResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6651,8 +16228,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipProgressRequest); i {
+		file_clientrpc_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNChannelEventsStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6663,8 +16240,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipProgressEvent); i {
+		file_clientrpc_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNChannelEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6675,8 +16252,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceRequestsStreamRequest); i {
+		file_clientrpc_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNInvoicesStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6687,8 +16264,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceRequestsStreamResponse); i {
+		file_clientrpc_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LNInvoice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6699,8 +16276,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FulfillResourceRequest); i {
+		file_clientrpc_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6711,8 +16288,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FulfillResourceRequestResponse); i {
+		file_clientrpc_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6723,8 +16300,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadsCompletedStreamRequest); i {
+		file_clientrpc_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6735,8 +16312,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadCompletedResponse); i {
+		file_clientrpc_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6747,8 +16324,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RMPrivateMessage); i {
+		file_clientrpc_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLocalSharedFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6759,8 +16336,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RMGroupMessage); i {
+		file_clientrpc_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6771,8 +16348,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostMetadata); i {
+		file_clientrpc_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLocalSharedFilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6783,8 +16360,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostMetadataStatus); i {
+		file_clientrpc_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserContentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6795,8 +16372,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublicIdentityReq); i {
+		file_clientrpc_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserContentFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6807,8 +16384,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublicIdentity); i {
+		file_clientrpc_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserContentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6819,8 +16396,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteFunds); i {
+		file_clientrpc_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserContentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6831,8 +16408,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OOBPublicIdentityInvite); i {
+		file_clientrpc_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserContentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6843,8 +16420,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RMGroupInvite); i {
+		file_clientrpc_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDownloadsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6855,8 +16432,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RMGroupList); i {
+		file_clientrpc_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDownload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6867,8 +16444,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RMFetchResource); i {
+		file_clientrpc_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDownloadsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6879,8 +16456,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RMFetchResourceReply); i {
+		file_clientrpc_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDownloadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6891,8 +16468,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileManifest); i {
+		file_clientrpc_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDownloadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6903,8 +16480,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileMetadata); i {
+		file_clientrpc_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransfersProgressStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6915,8 +16492,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipStreamRequest); i {
+		file_clientrpc_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6927,8 +16504,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedTip); i {
+		file_clientrpc_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPagesSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6939,7 +16516,115 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPagesSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchResourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchedResourcesStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchedResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPayLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayLedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPayLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPayLedgerCSVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListGCsResponse_GCInfo); i {
 			case 0:
 				return &v.state
@@ -6957,10 +16642,10 @@ func file_clientrpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_clientrpc_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   86,
+			NumEnums:      4,
+			NumMessages:   198,
 			NumExtensions: 0,
-			NumServices:   7,
+			NumServices:   10,
 		},
 		GoTypes:           file_clientrpc_proto_goTypes,
 		DependencyIndexes: file_clientrpc_proto_depIdxs,
@@ -550,6 +550,20 @@ type GCServiceClient interface {
 	JoinedGCs(ctx context.Context, in *JoinedGCsRequest) (GCService_JoinedGCsClient, error)
 	// AckJoinedGCs acks received joined gc events.
 	AckJoinedGCs(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// CreateChannel creates a new announcement-only channel, where only the
+	// local client (as the channel owner) may publish messages.
+	CreateChannel(ctx context.Context, in *CreateChannelRequest, out *CreateChannelResponse) error
+	// ChannelShareInvite returns a shareable invite that any user KX'd with the
+	// local client may use to subscribe to one of its channels.
+	ChannelShareInvite(ctx context.Context, in *ChannelShareInviteRequest, out *ChannelShareInviteResponse) error
+	// JoinChannel subscribes to a channel, given a shareable invite.
+	JoinChannel(ctx context.Context, in *JoinChannelRequest, out *JoinChannelResponse) error
+	// EstimateGCMCost estimates the cost to send a message to all members of a
+	// GC or subscribers of a channel.
+	EstimateGCMCost(ctx context.Context, in *EstimateGCMCostRequest, out *EstimateGCMCostResponse) error
+	// PublishToChannel publishes a message to all subscribers of a channel, as
+	// long as the estimated cost is not higher than the specified max cost.
+	PublishToChannel(ctx context.Context, in *PublishToChannelRequest, out *PublishToChannelResponse) error
 }
 
 type client_GCService struct {
@@ -654,6 +668,31 @@ func (c *client_GCService) AckJoinedGCs(ctx context.Context, in *AckRequest, out
 	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
+func (c *client_GCService) CreateChannel(ctx context.Context, in *CreateChannelRequest, out *CreateChannelResponse) error {
+	const method = "CreateChannel"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_GCService) ChannelShareInvite(ctx context.Context, in *ChannelShareInviteRequest, out *ChannelShareInviteResponse) error {
+	const method = "ChannelShareInvite"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_GCService) JoinChannel(ctx context.Context, in *JoinChannelRequest, out *JoinChannelResponse) error {
+	const method = "JoinChannel"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_GCService) EstimateGCMCost(ctx context.Context, in *EstimateGCMCostRequest, out *EstimateGCMCostResponse) error {
+	const method = "EstimateGCMCost"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_GCService) PublishToChannel(ctx context.Context, in *PublishToChannelRequest, out *PublishToChannelResponse) error {
+	const method = "PublishToChannel"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
 func NewGCServiceClient(c ClientConn) GCServiceClient {
 	return &client_GCService{c: c, defn: GCServiceDefn()}
 }
@@ -692,6 +731,20 @@ type GCServiceServer interface {
 	JoinedGCs(context.Context, *JoinedGCsRequest, GCService_JoinedGCsServer) error
 	// AckJoinedGCs acks received joined gc events.
 	AckJoinedGCs(context.Context, *AckRequest, *AckResponse) error
+	// CreateChannel creates a new announcement-only channel, where only the
+	// local client (as the channel owner) may publish messages.
+	CreateChannel(context.Context, *CreateChannelRequest, *CreateChannelResponse) error
+	// ChannelShareInvite returns a shareable invite that any user KX'd with the
+	// local client may use to subscribe to one of its channels.
+	ChannelShareInvite(context.Context, *ChannelShareInviteRequest, *ChannelShareInviteResponse) error
+	// JoinChannel subscribes to a channel, given a shareable invite.
+	JoinChannel(context.Context, *JoinChannelRequest, *JoinChannelResponse) error
+	// EstimateGCMCost estimates the cost to send a message to all members of a
+	// GC or subscribers of a channel.
+	EstimateGCMCost(context.Context, *EstimateGCMCostRequest, *EstimateGCMCostResponse) error
+	// PublishToChannel publishes a message to all subscribers of a channel, as
+	// long as the estimated cost is not higher than the specified max cost.
+	PublishToChannel(context.Context, *PublishToChannelRequest, *PublishToChannelResponse) error
 }
 
 type GCService_ReceivedGCInvitesServer interface {
@@ -911,6 +964,87 @@ func GCServiceDefn() ServiceDefn {
 					return conn.Request(ctx, method, request, response)
 				},
 			},
+			"CreateChannel": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(CreateChannelRequest) },
+				NewResponse:  func() proto.Message { return new(CreateChannelResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(CreateChannelRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(CreateChannelResponse).ProtoReflect().Descriptor() },
+				Help:         "CreateChannel creates a new announcement-only channel, where only the local client (as the channel owner) may publish messages.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(GCServiceServer).CreateChannel(ctx, request.(*CreateChannelRequest), response.(*CreateChannelResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "GCService.CreateChannel"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ChannelShareInvite": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(ChannelShareInviteRequest) },
+				NewResponse: func() proto.Message { return new(ChannelShareInviteResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(ChannelShareInviteRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ChannelShareInviteResponse).ProtoReflect().Descriptor()
+				},
+				Help: "ChannelShareInvite returns a shareable invite that any user KX'd with the local client may use to subscribe to one of its channels.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(GCServiceServer).ChannelShareInvite(ctx, request.(*ChannelShareInviteRequest), response.(*ChannelShareInviteResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "GCService.ChannelShareInvite"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"JoinChannel": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(JoinChannelRequest) },
+				NewResponse:  func() proto.Message { return new(JoinChannelResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(JoinChannelRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(JoinChannelResponse).ProtoReflect().Descriptor() },
+				Help:         "JoinChannel subscribes to a channel, given a shareable invite.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(GCServiceServer).JoinChannel(ctx, request.(*JoinChannelRequest), response.(*JoinChannelResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "GCService.JoinChannel"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"EstimateGCMCost": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(EstimateGCMCostRequest) },
+				NewResponse:  func() proto.Message { return new(EstimateGCMCostResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(EstimateGCMCostRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(EstimateGCMCostResponse).ProtoReflect().Descriptor() },
+				Help:         "EstimateGCMCost estimates the cost to send a message to all members of a GC or subscribers of a channel.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(GCServiceServer).EstimateGCMCost(ctx, request.(*EstimateGCMCostRequest), response.(*EstimateGCMCostResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "GCService.EstimateGCMCost"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"PublishToChannel": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(PublishToChannelRequest) },
+				NewResponse: func() proto.Message { return new(PublishToChannelResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor { return new(PublishToChannelRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(PublishToChannelResponse).ProtoReflect().Descriptor()
+				},
+				Help: "PublishToChannel publishes a message to all subscribers of a channel, as long as the estimated cost is not higher than the specified max cost.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(GCServiceServer).PublishToChannel(ctx, request.(*PublishToChannelRequest), response.(*PublishToChannelResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "GCService.PublishToChannel"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
 		},
 	}
 }
@@ -933,6 +1067,22 @@ type PostsServiceClient interface {
 	// AckReceivedPostStatus acknowledges post status received up to a given
 	// sequence_id have been processed.
 	AckReceivedPostStatus(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// CreatePost creates a new post and shares it with the current
+	// subscribers of the local client.
+	CreatePost(ctx context.Context, in *CreatePostRequest, out *CreatePostResponse) error
+	// ListPosts lists the posts created by the local client or received from
+	// remote users.
+	ListPosts(ctx context.Context, in *ListPostsRequest, out *ListPostsResponse) error
+	// ReadPost returns the full data of a post.
+	ReadPost(ctx context.Context, in *ReadPostRequest, out *ReadPostResponse) error
+	// CommentPost comments on a post. The comment is sent to the relayer of
+	// the post, who in turn sends it to its other subscribers.
+	CommentPost(ctx context.Context, in *CommentPostRequest, out *CommentPostResponse) error
+	// HeartPost adds or removes the heart of the local client on a post.
+	HeartPost(ctx context.Context, in *HeartPostRequest, out *HeartPostResponse) error
+	// RelayPost relays a post to a user or to the subscribers of the local
+	// client.
+	RelayPost(ctx context.Context, in *RelayPostRequest, out *RelayPostResponse) error
 }
 
 type client_PostsService struct {
@@ -986,6 +1136,36 @@ func (c *client_PostsService) AckReceivedPostStatus(ctx context.Context, in *Ack
 	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
+func (c *client_PostsService) CreatePost(ctx context.Context, in *CreatePostRequest, out *CreatePostResponse) error {
+	const method = "CreatePost"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) ListPosts(ctx context.Context, in *ListPostsRequest, out *ListPostsResponse) error {
+	const method = "ListPosts"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) ReadPost(ctx context.Context, in *ReadPostRequest, out *ReadPostResponse) error {
+	const method = "ReadPost"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) CommentPost(ctx context.Context, in *CommentPostRequest, out *CommentPostResponse) error {
+	const method = "CommentPost"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) HeartPost(ctx context.Context, in *HeartPostRequest, out *HeartPostResponse) error {
+	const method = "HeartPost"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) RelayPost(ctx context.Context, in *RelayPostRequest, out *RelayPostResponse) error {
+	const method = "RelayPost"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
 func NewPostsServiceClient(c ClientConn) PostsServiceClient {
 	return &client_PostsService{c: c, defn: PostsServiceDefn()}
 }
@@ -1008,6 +1188,22 @@ type PostsServiceServer interface {
 	// AckReceivedPostStatus acknowledges post status received up to a given
 	// sequence_id have been processed.
 	AckReceivedPostStatus(context.Context, *AckRequest, *AckResponse) error
+	// CreatePost creates a new post and shares it with the current
+	// subscribers of the local client.
+	CreatePost(context.Context, *CreatePostRequest, *CreatePostResponse) error
+	// ListPosts lists the posts created by the local client or received from
+	// remote users.
+	ListPosts(context.Context, *ListPostsRequest, *ListPostsResponse) error
+	// ReadPost returns the full data of a post.
+	ReadPost(context.Context, *ReadPostRequest, *ReadPostResponse) error
+	// CommentPost comments on a post. The comment is sent to the relayer of
+	// the post, who in turn sends it to its other subscribers.
+	CommentPost(context.Context, *CommentPostRequest, *CommentPostResponse) error
+	// HeartPost adds or removes the heart of the local client on a post.
+	HeartPost(context.Context, *HeartPostRequest, *HeartPostResponse) error
+	// RelayPost relays a post to a user or to the subscribers of the local
+	// client.
+	RelayPost(context.Context, *RelayPostRequest, *RelayPostResponse) error
 }
 
 type PostsService_PostsStreamServer interface {
@@ -1120,6 +1316,96 @@ func PostsServiceDefn() ServiceDefn {
 					return conn.Request(ctx, method, request, response)
 				},
 			},
+			"CreatePost": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(CreatePostRequest) },
+				NewResponse:  func() proto.Message { return new(CreatePostResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(CreatePostRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(CreatePostResponse).ProtoReflect().Descriptor() },
+				Help:         "CreatePost creates a new post and shares it with the current subscribers of the local client.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).CreatePost(ctx, request.(*CreatePostRequest), response.(*CreatePostResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.CreatePost"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListPosts": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ListPostsRequest) },
+				NewResponse:  func() proto.Message { return new(ListPostsResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ListPostsRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ListPostsResponse).ProtoReflect().Descriptor() },
+				Help:         "ListPosts lists the posts created by the local client or received from remote users.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).ListPosts(ctx, request.(*ListPostsRequest), response.(*ListPostsResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.ListPosts"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ReadPost": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ReadPostRequest) },
+				NewResponse:  func() proto.Message { return new(ReadPostResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ReadPostRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ReadPostResponse).ProtoReflect().Descriptor() },
+				Help:         "ReadPost returns the full data of a post.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).ReadPost(ctx, request.(*ReadPostRequest), response.(*ReadPostResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.ReadPost"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"CommentPost": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(CommentPostRequest) },
+				NewResponse:  func() proto.Message { return new(CommentPostResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(CommentPostRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(CommentPostResponse).ProtoReflect().Descriptor() },
+				Help:         "CommentPost comments on a post. The comment is sent to the relayer of the post, who in turn sends it to its other subscribers.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).CommentPost(ctx, request.(*CommentPostRequest), response.(*CommentPostResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.CommentPost"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"HeartPost": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(HeartPostRequest) },
+				NewResponse:  func() proto.Message { return new(HeartPostResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(HeartPostRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(HeartPostResponse).ProtoReflect().Descriptor() },
+				Help:         "HeartPost adds or removes the heart of the local client on a post.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).HeartPost(ctx, request.(*HeartPostRequest), response.(*HeartPostResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.HeartPost"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"RelayPost": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(RelayPostRequest) },
+				NewResponse:  func() proto.Message { return new(RelayPostResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(RelayPostRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(RelayPostResponse).ProtoReflect().Descriptor() },
+				Help:         "RelayPost relays a post to a user or to the subscribers of the local client.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).RelayPost(ctx, request.(*RelayPostRequest), response.(*RelayPostResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.RelayPost"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
 		},
 	}
 }
@@ -1141,6 +1427,13 @@ type PaymentsServiceClient interface {
 	// AckTipReceived acknowledges events received up to a given
 	// sequence_id have been processed.
 	AckTipReceived(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// ListPayLedger lists the entries of the payment ledger: every payment made
+	// or received by the client (server fees, tips, file chunks, paywalls and
+	// store orders), in the order they were recorded.
+	ListPayLedger(ctx context.Context, in *ListPayLedgerRequest, out *ListPayLedgerResponse) error
+	// ExportPayLedgerCSV returns the entries of the payment ledger formatted as
+	// CSV.
+	ExportPayLedgerCSV(ctx context.Context, in *ListPayLedgerRequest, out *ExportPayLedgerCSVResponse) error
 }
 
 type client_PaymentsService struct {
@@ -1189,6 +1482,16 @@ func (c *client_PaymentsService) AckTipReceived(ctx context.Context, in *AckRequ
 	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
+func (c *client_PaymentsService) ListPayLedger(ctx context.Context, in *ListPayLedgerRequest, out *ListPayLedgerResponse) error {
+	const method = "ListPayLedger"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) ExportPayLedgerCSV(ctx context.Context, in *ListPayLedgerRequest, out *ExportPayLedgerCSVResponse) error {
+	const method = "ExportPayLedgerCSV"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
 func NewPaymentsServiceClient(c ClientConn) PaymentsServiceClient {
 	return &client_PaymentsService{c: c, defn: PaymentsServiceDefn()}
 }
@@ -1210,6 +1513,13 @@ type PaymentsServiceServer interface {
 	// AckTipReceived acknowledges events received up to a given
 	// sequence_id have been processed.
 	AckTipReceived(context.Context, *AckRequest, *AckResponse) error
+	// ListPayLedger lists the entries of the payment ledger: every payment made
+	// or received by the client (server fees, tips, file chunks, paywalls and
+	// store orders), in the order they were recorded.
+	ListPayLedger(context.Context, *ListPayLedgerRequest, *ListPayLedgerResponse) error
+	// ExportPayLedgerCSV returns the entries of the payment ledger formatted as
+	// CSV.
+	ExportPayLedgerCSV(context.Context, *ListPayLedgerRequest, *ExportPayLedgerCSVResponse) error
 }
 
 type PaymentsService_TipProgressServer interface {
@@ -1299,6 +1609,38 @@ func PaymentsServiceDefn() ServiceDefn {
 					return conn.Request(ctx, method, request, response)
 				},
 			},
+			"ListPayLedger": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ListPayLedgerRequest) },
+				NewResponse:  func() proto.Message { return new(ListPayLedgerResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ListPayLedgerRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ListPayLedgerResponse).ProtoReflect().Descriptor() },
+				Help:         "ListPayLedger lists the entries of the payment ledger: every payment made or received by the client (server fees, tips, file chunks, paywalls and store orders), in the order they were recorded.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).ListPayLedger(ctx, request.(*ListPayLedgerRequest), response.(*ListPayLedgerResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.ListPayLedger"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ExportPayLedgerCSV": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(ListPayLedgerRequest) },
+				NewResponse: func() proto.Message { return new(ExportPayLedgerCSVResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor { return new(ListPayLedgerRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ExportPayLedgerCSVResponse).ProtoReflect().Descriptor()
+				},
+				Help: "ExportPayLedgerCSV returns the entries of the payment ledger formatted as CSV.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).ExportPayLedgerCSV(ctx, request.(*ListPayLedgerRequest), response.(*ExportPayLedgerCSVResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.ExportPayLedgerCSV"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
 		},
 	}
 }
@@ -1312,6 +1654,27 @@ type ResourcesServiceClient interface {
 	// FulfillRequest fulfills a previous request received from an user for a
 	// local client resource.
 	FulfillRequest(ctx context.Context, in *FulfillResourceRequest, out *FulfillResourceRequestResponse) error
+	// ProductSubscriptionsStream streams changes to the subscriptions of remote
+	// users to recurring products sold by the local client (for example, by a
+	// simplestore). Clients may use these events to grant or revoke access to
+	// other resources.
+	ProductSubscriptionsStream(ctx context.Context, in *ProductSubscriptionsStreamRequest) (ResourcesService_ProductSubscriptionsStreamClient, error)
+	// AckProductSubscriptionChanged acks to the server that subscription
+	// change events up to a given sequence_id have been processed.
+	AckProductSubscriptionChanged(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// NewPagesSession starts a new pages session. Sessions group the pages
+	// fetched from remote users while navigating their sites.
+	NewPagesSession(ctx context.Context, in *NewPagesSessionRequest, out *NewPagesSessionResponse) error
+	// FetchResource requests a resource from a remote user. The call returns as
+	// soon as the request is sent; the response is delivered through the
+	// FetchedResourcesStream.
+	FetchResource(ctx context.Context, in *FetchResourceRequest, out *FetchResourceResponse) error
+	// FetchedResourcesStream streams the resources fetched by the local client
+	// (including through calls to FetchResource).
+	FetchedResourcesStream(ctx context.Context, in *FetchedResourcesStreamRequest) (ResourcesService_FetchedResourcesStreamClient, error)
+	// AckFetchedResource acks to the server that fetched resource events up to
+	// a given sequence_id have been processed.
+	AckFetchedResource(ctx context.Context, in *AckRequest, out *AckResponse) error
 }
 
 type client_ResourcesService struct {
@@ -1336,6 +1699,52 @@ func (c *client_ResourcesService) FulfillRequest(ctx context.Context, in *Fulfil
 	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
+type ResourcesService_ProductSubscriptionsStreamClient interface {
+	Recv(*ProductSubscriptionChanged) error
+}
+
+func (c *client_ResourcesService) ProductSubscriptionsStream(ctx context.Context, in *ProductSubscriptionsStreamRequest) (ResourcesService_ProductSubscriptionsStreamClient, error) {
+	const method = "ProductSubscriptionsStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*ProductSubscriptionChanged]{c: inner}, nil
+}
+
+func (c *client_ResourcesService) AckProductSubscriptionChanged(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckProductSubscriptionChanged"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ResourcesService) NewPagesSession(ctx context.Context, in *NewPagesSessionRequest, out *NewPagesSessionResponse) error {
+	const method = "NewPagesSession"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ResourcesService) FetchResource(ctx context.Context, in *FetchResourceRequest, out *FetchResourceResponse) error {
+	const method = "FetchResource"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type ResourcesService_FetchedResourcesStreamClient interface {
+	Recv(*FetchedResource) error
+}
+
+func (c *client_ResourcesService) FetchedResourcesStream(ctx context.Context, in *FetchedResourcesStreamRequest) (ResourcesService_FetchedResourcesStreamClient, error) {
+	const method = "FetchedResourcesStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*FetchedResource]{c: inner}, nil
+}
+
+func (c *client_ResourcesService) AckFetchedResource(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckFetchedResource"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
 func NewResourcesServiceClient(c ClientConn) ResourcesServiceClient {
 	return &client_ResourcesService{c: c, defn: ResourcesServiceDefn()}
 }
@@ -1349,12 +1758,41 @@ type ResourcesServiceServer interface {
 	// FulfillRequest fulfills a previous request received from an user for a
 	// local client resource.
 	FulfillRequest(context.Context, *FulfillResourceRequest, *FulfillResourceRequestResponse) error
+	// ProductSubscriptionsStream streams changes to the subscriptions of remote
+	// users to recurring products sold by the local client (for example, by a
+	// simplestore). Clients may use these events to grant or revoke access to
+	// other resources.
+	ProductSubscriptionsStream(context.Context, *ProductSubscriptionsStreamRequest, ResourcesService_ProductSubscriptionsStreamServer) error
+	// AckProductSubscriptionChanged acks to the server that subscription
+	// change events up to a given sequence_id have been processed.
+	AckProductSubscriptionChanged(context.Context, *AckRequest, *AckResponse) error
+	// NewPagesSession starts a new pages session. Sessions group the pages
+	// fetched from remote users while navigating their sites.
+	NewPagesSession(context.Context, *NewPagesSessionRequest, *NewPagesSessionResponse) error
+	// FetchResource requests a resource from a remote user. The call returns as
+	// soon as the request is sent; the response is delivered through the
+	// FetchedResourcesStream.
+	FetchResource(context.Context, *FetchResourceRequest, *FetchResourceResponse) error
+	// FetchedResourcesStream streams the resources fetched by the local client
+	// (including through calls to FetchResource).
+	FetchedResourcesStream(context.Context, *FetchedResourcesStreamRequest, ResourcesService_FetchedResourcesStreamServer) error
+	// AckFetchedResource acks to the server that fetched resource events up to
+	// a given sequence_id have been processed.
+	AckFetchedResource(context.Context, *AckRequest, *AckResponse) error
 }
 
 type ResourcesService_RequestsStreamServer interface {
 	Send(m *ResourceRequestsStreamResponse) error
 }
 
+type ResourcesService_ProductSubscriptionsStreamServer interface {
+	Send(m *ProductSubscriptionChanged) error
+}
+
+type ResourcesService_FetchedResourcesStreamServer interface {
+	Send(m *FetchedResource) error
+}
+
 func ResourcesServiceDefn() ServiceDefn {
 	return ServiceDefn{
 		Name: "ResourcesService",
@@ -1395,6 +1833,102 @@ func ResourcesServiceDefn() ServiceDefn {
 					return conn.Request(ctx, method, request, response)
 				},
 			},
+			"ProductSubscriptionsStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(ProductSubscriptionsStreamRequest) },
+				NewResponse: func() proto.Message { return new(ProductSubscriptionChanged) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(ProductSubscriptionsStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ProductSubscriptionChanged).ProtoReflect().Descriptor()
+				},
+				Help: "ProductSubscriptionsStream streams changes to the subscriptions of remote users to recurring products sold by the local client (for example, by a simplestore). Clients may use these events to grant or revoke access to other resources.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(ResourcesServiceServer).ProductSubscriptionsStream(ctx, request.(*ProductSubscriptionsStreamRequest), streamerImpl[*ProductSubscriptionChanged]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "ResourcesService.ProductSubscriptionsStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckProductSubscriptionChanged": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckProductSubscriptionChanged acks to the server that subscription change events up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ResourcesServiceServer).AckProductSubscriptionChanged(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ResourcesService.AckProductSubscriptionChanged"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"NewPagesSession": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(NewPagesSessionRequest) },
+				NewResponse:  func() proto.Message { return new(NewPagesSessionResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(NewPagesSessionRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(NewPagesSessionResponse).ProtoReflect().Descriptor() },
+				Help:         "NewPagesSession starts a new pages session. Sessions group the pages fetched from remote users while navigating their sites.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ResourcesServiceServer).NewPagesSession(ctx, request.(*NewPagesSessionRequest), response.(*NewPagesSessionResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ResourcesService.NewPagesSession"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"FetchResource": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(FetchResourceRequest) },
+				NewResponse:  func() proto.Message { return new(FetchResourceResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(FetchResourceRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(FetchResourceResponse).ProtoReflect().Descriptor() },
+				Help:         "FetchResource requests a resource from a remote user. The call returns as soon as the request is sent; the response is delivered through the FetchedResourcesStream.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ResourcesServiceServer).FetchResource(ctx, request.(*FetchResourceRequest), response.(*FetchResourceResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ResourcesService.FetchResource"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"FetchedResourcesStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(FetchedResourcesStreamRequest) },
+				NewResponse: func() proto.Message { return new(FetchedResource) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(FetchedResourcesStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(FetchedResource).ProtoReflect().Descriptor() },
+				Help:         "FetchedResourcesStream streams the resources fetched by the local client (including through calls to FetchResource).",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(ResourcesServiceServer).FetchedResourcesStream(ctx, request.(*FetchedResourcesStreamRequest), streamerImpl[*FetchedResource]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "ResourcesService.FetchedResourcesStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckFetchedResource": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckFetchedResource acks to the server that fetched resource events up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ResourcesServiceServer).AckFetchedResource(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ResourcesService.AckFetchedResource"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
 		},
 	}
 }
@@ -1407,6 +1941,39 @@ type ContentServiceClient interface {
 	DownloadsCompletedStream(ctx context.Context, in *DownloadsCompletedStreamRequest) (ContentService_DownloadsCompletedStreamClient, error)
 	// AckDownloadCompleted acks download completed events.
 	AckDownloadCompleted(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// ShareDir shares all files in a local dir (recursively) as a single
+	// bundle. The bundle is bought and downloaded as a single file and the
+	// downloader recreates the dir tree. Symlinks are not followed and, like
+	// other non-regular files, are not included in the bundle.
+	ShareDir(ctx context.Context, in *ShareDirRequest, out *ShareDirResponse) error
+	// SearchContent starts a search for files shared by remote users with a
+	// filename or description that matches a regexp. Results are aggregated
+	// and may be fetched with ContentSearchResults as replies are received.
+	SearchContent(ctx context.Context, in *SearchContentRequest, out *SearchContentResponse) error
+	// ContentSearchResults returns the cached results of a content search.
+	ContentSearchResults(ctx context.Context, in *ContentSearchResultsRequest, out *ContentSearchResultsResponse) error
+	// ShareFile shares a local file with a user or with all users.
+	ShareFile(ctx context.Context, in *ShareFileRequest, out *ShareFileResponse) error
+	// UnshareFile stops sharing a file with a user or with all users.
+	UnshareFile(ctx context.Context, in *UnshareFileRequest, out *UnshareFileResponse) error
+	// ListLocalSharedFiles lists the files shared by the local client.
+	ListLocalSharedFiles(ctx context.Context, in *ListLocalSharedFilesRequest, out *ListLocalSharedFilesResponse) error
+	// ListUserContent lists the files shared by a remote user. It returns once
+	// the user replies with the list.
+	ListUserContent(ctx context.Context, in *ListUserContentRequest, out *ListUserContentResponse) error
+	// GetUserContent starts downloading a file shared by a remote user. The
+	// progress of the download is sent on TransfersProgressStream and its
+	// completion on DownloadsCompletedStream.
+	GetUserContent(ctx context.Context, in *GetUserContentRequest, out *GetUserContentResponse) error
+	// ListDownloads lists the downloads (both in-flight and completed) of the
+	// local client.
+	ListDownloads(ctx context.Context, in *ListDownloadsRequest, out *ListDownloadsResponse) error
+	// CancelDownload cancels an in-flight download.
+	CancelDownload(ctx context.Context, in *CancelDownloadRequest, out *CancelDownloadResponse) error
+	// TransfersProgressStream returns a stream of progress events of in-flight
+	// downloads and uploads. Only events that happen while the stream is open
+	// are sent.
+	TransfersProgressStream(ctx context.Context, in *TransfersProgressStreamRequest) (ContentService_TransfersProgressStreamClient, error)
 }
 
 type client_ContentService struct {
@@ -1431,62 +1998,1082 @@ func (c *client_ContentService) AckDownloadCompleted(ctx context.Context, in *Ac
 	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
-func NewContentServiceClient(c ClientConn) ContentServiceClient {
-	return &client_ContentService{c: c, defn: ContentServiceDefn()}
+func (c *client_ContentService) ShareDir(ctx context.Context, in *ShareDirRequest, out *ShareDirResponse) error {
+	const method = "ShareDir"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
-// ContentServiceServer is the server API for ContentService service.
-type ContentServiceServer interface {
-	// DownloadsCompletedStream streams completed downloads (file transfers) made
-	// by the local client. This includes both downloads initiated by the local
-	// client and pushed by remote clients.
-	DownloadsCompletedStream(context.Context, *DownloadsCompletedStreamRequest, ContentService_DownloadsCompletedStreamServer) error
-	// AckDownloadCompleted acks download completed events.
-	AckDownloadCompleted(context.Context, *AckRequest, *AckResponse) error
+func (c *client_ContentService) SearchContent(ctx context.Context, in *SearchContentRequest, out *SearchContentResponse) error {
+	const method = "SearchContent"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
-type ContentService_DownloadsCompletedStreamServer interface {
-	Send(m *DownloadCompletedResponse) error
+func (c *client_ContentService) ContentSearchResults(ctx context.Context, in *ContentSearchResultsRequest, out *ContentSearchResultsResponse) error {
+	const method = "ContentSearchResults"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
-func ContentServiceDefn() ServiceDefn {
-	return ServiceDefn{
-		Name: "ContentService",
-		Methods: map[string]MethodDefn{
-			"DownloadsCompletedStream": {
-				IsStreaming: true,
-				NewRequest:  func() proto.Message { return new(DownloadsCompletedStreamRequest) },
-				NewResponse: func() proto.Message { return new(DownloadCompletedResponse) },
-				RequestDefn: func() protoreflect.MessageDescriptor {
-					return new(DownloadsCompletedStreamRequest).ProtoReflect().Descriptor()
-				},
-				ResponseDefn: func() protoreflect.MessageDescriptor {
-					return new(DownloadCompletedResponse).ProtoReflect().Descriptor()
-				},
-				Help: "DownloadsCompletedStream streams completed downloads (file transfers) made by the local client. This includes both downloads initiated by the local client and pushed by remote clients.",
+func (c *client_ContentService) ShareFile(ctx context.Context, in *ShareFileRequest, out *ShareFileResponse) error {
+	const method = "ShareFile"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ContentService) UnshareFile(ctx context.Context, in *UnshareFileRequest, out *UnshareFileResponse) error {
+	const method = "UnshareFile"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ContentService) ListLocalSharedFiles(ctx context.Context, in *ListLocalSharedFilesRequest, out *ListLocalSharedFilesResponse) error {
+	const method = "ListLocalSharedFiles"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ContentService) ListUserContent(ctx context.Context, in *ListUserContentRequest, out *ListUserContentResponse) error {
+	const method = "ListUserContent"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ContentService) GetUserContent(ctx context.Context, in *GetUserContentRequest, out *GetUserContentResponse) error {
+	const method = "GetUserContent"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ContentService) ListDownloads(ctx context.Context, in *ListDownloadsRequest, out *ListDownloadsResponse) error {
+	const method = "ListDownloads"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_ContentService) CancelDownload(ctx context.Context, in *CancelDownloadRequest, out *CancelDownloadResponse) error {
+	const method = "CancelDownload"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type ContentService_TransfersProgressStreamClient interface {
+	Recv(*TransferProgress) error
+}
+
+func (c *client_ContentService) TransfersProgressStream(ctx context.Context, in *TransfersProgressStreamRequest) (ContentService_TransfersProgressStreamClient, error) {
+	const method = "TransfersProgressStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*TransferProgress]{c: inner}, nil
+}
+
+func NewContentServiceClient(c ClientConn) ContentServiceClient {
+	return &client_ContentService{c: c, defn: ContentServiceDefn()}
+}
+
+// ContentServiceServer is the server API for ContentService service.
+type ContentServiceServer interface {
+	// DownloadsCompletedStream streams completed downloads (file transfers) made
+	// by the local client. This includes both downloads initiated by the local
+	// client and pushed by remote clients.
+	DownloadsCompletedStream(context.Context, *DownloadsCompletedStreamRequest, ContentService_DownloadsCompletedStreamServer) error
+	// AckDownloadCompleted acks download completed events.
+	AckDownloadCompleted(context.Context, *AckRequest, *AckResponse) error
+	// ShareDir shares all files in a local dir (recursively) as a single
+	// bundle. The bundle is bought and downloaded as a single file and the
+	// downloader recreates the dir tree. Symlinks are not followed and, like
+	// other non-regular files, are not included in the bundle.
+	ShareDir(context.Context, *ShareDirRequest, *ShareDirResponse) error
+	// SearchContent starts a search for files shared by remote users with a
+	// filename or description that matches a regexp. Results are aggregated
+	// and may be fetched with ContentSearchResults as replies are received.
+	SearchContent(context.Context, *SearchContentRequest, *SearchContentResponse) error
+	// ContentSearchResults returns the cached results of a content search.
+	ContentSearchResults(context.Context, *ContentSearchResultsRequest, *ContentSearchResultsResponse) error
+	// ShareFile shares a local file with a user or with all users.
+	ShareFile(context.Context, *ShareFileRequest, *ShareFileResponse) error
+	// UnshareFile stops sharing a file with a user or with all users.
+	UnshareFile(context.Context, *UnshareFileRequest, *UnshareFileResponse) error
+	// ListLocalSharedFiles lists the files shared by the local client.
+	ListLocalSharedFiles(context.Context, *ListLocalSharedFilesRequest, *ListLocalSharedFilesResponse) error
+	// ListUserContent lists the files shared by a remote user. It returns once
+	// the user replies with the list.
+	ListUserContent(context.Context, *ListUserContentRequest, *ListUserContentResponse) error
+	// GetUserContent starts downloading a file shared by a remote user. The
+	// progress of the download is sent on TransfersProgressStream and its
+	// completion on DownloadsCompletedStream.
+	GetUserContent(context.Context, *GetUserContentRequest, *GetUserContentResponse) error
+	// ListDownloads lists the downloads (both in-flight and completed) of the
+	// local client.
+	ListDownloads(context.Context, *ListDownloadsRequest, *ListDownloadsResponse) error
+	// CancelDownload cancels an in-flight download.
+	CancelDownload(context.Context, *CancelDownloadRequest, *CancelDownloadResponse) error
+	// TransfersProgressStream returns a stream of progress events of in-flight
+	// downloads and uploads. Only events that happen while the stream is open
+	// are sent.
+	TransfersProgressStream(context.Context, *TransfersProgressStreamRequest, ContentService_TransfersProgressStreamServer) error
+}
+
+type ContentService_DownloadsCompletedStreamServer interface {
+	Send(m *DownloadCompletedResponse) error
+}
+
+type ContentService_TransfersProgressStreamServer interface {
+	Send(m *TransferProgress) error
+}
+
+func ContentServiceDefn() ServiceDefn {
+	return ServiceDefn{
+		Name: "ContentService",
+		Methods: map[string]MethodDefn{
+			"DownloadsCompletedStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(DownloadsCompletedStreamRequest) },
+				NewResponse: func() proto.Message { return new(DownloadCompletedResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(DownloadsCompletedStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(DownloadCompletedResponse).ProtoReflect().Descriptor()
+				},
+				Help: "DownloadsCompletedStream streams completed downloads (file transfers) made by the local client. This includes both downloads initiated by the local client and pushed by remote clients.",
 				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
 					return x.(ContentServiceServer).DownloadsCompletedStream(ctx, request.(*DownloadsCompletedStreamRequest), streamerImpl[*DownloadCompletedResponse]{s: stream})
 				},
-				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
-					method := "ContentService.DownloadsCompletedStream"
-					return conn.Stream(ctx, method, request)
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "ContentService.DownloadsCompletedStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckDownloadCompleted": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckDownloadCompleted acks download completed events.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).AckDownloadCompleted(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.AckDownloadCompleted"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ShareDir": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ShareDirRequest) },
+				NewResponse:  func() proto.Message { return new(ShareDirResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ShareDirRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ShareDirResponse).ProtoReflect().Descriptor() },
+				Help:         "ShareDir shares all files in a local dir (recursively) as a single bundle. The bundle is bought and downloaded as a single file and the downloader recreates the dir tree. Symlinks are not followed and, like other non-regular files, are not included in the bundle.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).ShareDir(ctx, request.(*ShareDirRequest), response.(*ShareDirResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.ShareDir"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"SearchContent": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(SearchContentRequest) },
+				NewResponse:  func() proto.Message { return new(SearchContentResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(SearchContentRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(SearchContentResponse).ProtoReflect().Descriptor() },
+				Help:         "SearchContent starts a search for files shared by remote users with a filename or description that matches a regexp. Results are aggregated and may be fetched with ContentSearchResults as replies are received.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).SearchContent(ctx, request.(*SearchContentRequest), response.(*SearchContentResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.SearchContent"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ContentSearchResults": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(ContentSearchResultsRequest) },
+				NewResponse: func() proto.Message { return new(ContentSearchResultsResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(ContentSearchResultsRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ContentSearchResultsResponse).ProtoReflect().Descriptor()
+				},
+				Help: "ContentSearchResults returns the cached results of a content search.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).ContentSearchResults(ctx, request.(*ContentSearchResultsRequest), response.(*ContentSearchResultsResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.ContentSearchResults"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ShareFile": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ShareFileRequest) },
+				NewResponse:  func() proto.Message { return new(ShareFileResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ShareFileRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ShareFileResponse).ProtoReflect().Descriptor() },
+				Help:         "ShareFile shares a local file with a user or with all users.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).ShareFile(ctx, request.(*ShareFileRequest), response.(*ShareFileResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.ShareFile"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"UnshareFile": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(UnshareFileRequest) },
+				NewResponse:  func() proto.Message { return new(UnshareFileResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(UnshareFileRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(UnshareFileResponse).ProtoReflect().Descriptor() },
+				Help:         "UnshareFile stops sharing a file with a user or with all users.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).UnshareFile(ctx, request.(*UnshareFileRequest), response.(*UnshareFileResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.UnshareFile"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListLocalSharedFiles": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(ListLocalSharedFilesRequest) },
+				NewResponse: func() proto.Message { return new(ListLocalSharedFilesResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(ListLocalSharedFilesRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ListLocalSharedFilesResponse).ProtoReflect().Descriptor()
+				},
+				Help: "ListLocalSharedFiles lists the files shared by the local client.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).ListLocalSharedFiles(ctx, request.(*ListLocalSharedFilesRequest), response.(*ListLocalSharedFilesResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.ListLocalSharedFiles"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListUserContent": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ListUserContentRequest) },
+				NewResponse:  func() proto.Message { return new(ListUserContentResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ListUserContentRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ListUserContentResponse).ProtoReflect().Descriptor() },
+				Help:         "ListUserContent lists the files shared by a remote user. It returns once the user replies with the list.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).ListUserContent(ctx, request.(*ListUserContentRequest), response.(*ListUserContentResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.ListUserContent"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"GetUserContent": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(GetUserContentRequest) },
+				NewResponse:  func() proto.Message { return new(GetUserContentResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(GetUserContentRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(GetUserContentResponse).ProtoReflect().Descriptor() },
+				Help:         "GetUserContent starts downloading a file shared by a remote user. The progress of the download is sent on TransfersProgressStream and its completion on DownloadsCompletedStream.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).GetUserContent(ctx, request.(*GetUserContentRequest), response.(*GetUserContentResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.GetUserContent"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListDownloads": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ListDownloadsRequest) },
+				NewResponse:  func() proto.Message { return new(ListDownloadsResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ListDownloadsRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ListDownloadsResponse).ProtoReflect().Descriptor() },
+				Help:         "ListDownloads lists the downloads (both in-flight and completed) of the local client.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).ListDownloads(ctx, request.(*ListDownloadsRequest), response.(*ListDownloadsResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.ListDownloads"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"CancelDownload": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(CancelDownloadRequest) },
+				NewResponse:  func() proto.Message { return new(CancelDownloadResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(CancelDownloadRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(CancelDownloadResponse).ProtoReflect().Descriptor() },
+				Help:         "CancelDownload cancels an in-flight download.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(ContentServiceServer).CancelDownload(ctx, request.(*CancelDownloadRequest), response.(*CancelDownloadResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "ContentService.CancelDownload"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"TransfersProgressStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(TransfersProgressStreamRequest) },
+				NewResponse: func() proto.Message { return new(TransferProgress) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(TransfersProgressStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(TransferProgress).ProtoReflect().Descriptor() },
+				Help:         "TransfersProgressStream returns a stream of progress events of in-flight downloads and uploads. Only events that happen while the stream is open are sent.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(ContentServiceServer).TransfersProgressStream(ctx, request.(*TransfersProgressStreamRequest), streamerImpl[*TransferProgress]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "ContentService.TransfersProgressStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+		},
+	}
+}
+
+// MediaServiceClient is the client API for MediaService service.
+type MediaServiceClient interface {
+	// SendMedia sends an audio or video message to a user or GC. Small media is
+	// embedded in the message, while larger media is sent as a file transfer
+	// and the message only includes its metadata.
+	SendMedia(ctx context.Context, in *SendMediaRequest, out *SendMediaResponse) error
+	// MediaStream returns a stream that gets audio and video messages received
+	// by the client. Media that was sent as a file transfer does not include
+	// its data: the file is reported in ContentService.DownloadsCompletedStream
+	// once it has been received.
+	MediaStream(ctx context.Context, in *MediaStreamRequest) (MediaService_MediaStreamClient, error)
+	// AckReceivedMedia acks to the server that media messages up to a given
+	// sequence_id have been processed.
+	AckReceivedMedia(ctx context.Context, in *AckRequest, out *AckResponse) error
+}
+
+type client_MediaService struct {
+	c    ClientConn
+	defn ServiceDefn
+}
+
+func (c *client_MediaService) SendMedia(ctx context.Context, in *SendMediaRequest, out *SendMediaResponse) error {
+	const method = "SendMedia"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type MediaService_MediaStreamClient interface {
+	Recv(*ReceivedMedia) error
+}
+
+func (c *client_MediaService) MediaStream(ctx context.Context, in *MediaStreamRequest) (MediaService_MediaStreamClient, error) {
+	const method = "MediaStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*ReceivedMedia]{c: inner}, nil
+}
+
+func (c *client_MediaService) AckReceivedMedia(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckReceivedMedia"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func NewMediaServiceClient(c ClientConn) MediaServiceClient {
+	return &client_MediaService{c: c, defn: MediaServiceDefn()}
+}
+
+// MediaServiceServer is the server API for MediaService service.
+type MediaServiceServer interface {
+	// SendMedia sends an audio or video message to a user or GC. Small media is
+	// embedded in the message, while larger media is sent as a file transfer
+	// and the message only includes its metadata.
+	SendMedia(context.Context, *SendMediaRequest, *SendMediaResponse) error
+	// MediaStream returns a stream that gets audio and video messages received
+	// by the client. Media that was sent as a file transfer does not include
+	// its data: the file is reported in ContentService.DownloadsCompletedStream
+	// once it has been received.
+	MediaStream(context.Context, *MediaStreamRequest, MediaService_MediaStreamServer) error
+	// AckReceivedMedia acks to the server that media messages up to a given
+	// sequence_id have been processed.
+	AckReceivedMedia(context.Context, *AckRequest, *AckResponse) error
+}
+
+type MediaService_MediaStreamServer interface {
+	Send(m *ReceivedMedia) error
+}
+
+func MediaServiceDefn() ServiceDefn {
+	return ServiceDefn{
+		Name: "MediaService",
+		Methods: map[string]MethodDefn{
+			"SendMedia": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(SendMediaRequest) },
+				NewResponse:  func() proto.Message { return new(SendMediaResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(SendMediaRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(SendMediaResponse).ProtoReflect().Descriptor() },
+				Help:         "SendMedia sends an audio or video message to a user or GC. Small media is embedded in the message, while larger media is sent as a file transfer and the message only includes its metadata.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(MediaServiceServer).SendMedia(ctx, request.(*SendMediaRequest), response.(*SendMediaResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "MediaService.SendMedia"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"MediaStream": {
+				IsStreaming:  true,
+				NewRequest:   func() proto.Message { return new(MediaStreamRequest) },
+				NewResponse:  func() proto.Message { return new(ReceivedMedia) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(MediaStreamRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ReceivedMedia).ProtoReflect().Descriptor() },
+				Help:         "MediaStream returns a stream that gets audio and video messages received by the client. Media that was sent as a file transfer does not include its data: the file is reported in ContentService.DownloadsCompletedStream once it has been received.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(MediaServiceServer).MediaStream(ctx, request.(*MediaStreamRequest), streamerImpl[*ReceivedMedia]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "MediaService.MediaStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckReceivedMedia": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckReceivedMedia acks to the server that media messages up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(MediaServiceServer).AckReceivedMedia(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "MediaService.AckReceivedMedia"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+		},
+	}
+}
+
+// UsersServiceClient is the client API for UsersService service.
+type UsersServiceClient interface {
+	// AddressBook lists the users in the address book of the client.
+	AddressBook(ctx context.Context, in *AddressBookRequest, out *AddressBookResponse) error
+	// RenameUser changes the local nick of a user.
+	RenameUser(ctx context.Context, in *RenameUserRequest, out *RenameUserResponse) error
+	// IgnoreUser sets or clears the ignored flag of a user. Messages from
+	// ignored users are discarded.
+	IgnoreUser(ctx context.Context, in *IgnoreUserRequest, out *IgnoreUserResponse) error
+	// BlockUser blocks a user. The user is asked to stop sending messages to the
+	// local client and is removed from the address book.
+	BlockUser(ctx context.Context, in *BlockUserRequest, out *BlockUserResponse) error
+	// Handshake starts a handshake with a user, to verify the ratchet with the
+	// user is working.
+	Handshake(ctx context.Context, in *HandshakeRequest, out *HandshakeResponse) error
+	// ResetRatchet starts a ratchet reset procedure with a user.
+	ResetRatchet(ctx context.Context, in *ResetRatchetRequest, out *ResetRatchetResponse) error
+	// UsersLastReceivedTime lists the time of the last message received from
+	// each user, most recent first.
+	UsersLastReceivedTime(ctx context.Context, in *UsersLastReceivedTimeRequest, out *UsersLastReceivedTimeResponse) error
+	// AddressBookStream returns a stream of changes to the address book:
+	// users that were added (or had their ratchet reset), renamed, ignored,
+	// unignored or blocked.
+	AddressBookStream(ctx context.Context, in *AddressBookStreamRequest) (UsersService_AddressBookStreamClient, error)
+	// AckAddressBookChanged acks to the server that address book changes up
+	// to a given sequence_id have been processed.
+	AckAddressBookChanged(ctx context.Context, in *AckRequest, out *AckResponse) error
+}
+
+type client_UsersService struct {
+	c    ClientConn
+	defn ServiceDefn
+}
+
+func (c *client_UsersService) AddressBook(ctx context.Context, in *AddressBookRequest, out *AddressBookResponse) error {
+	const method = "AddressBook"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_UsersService) RenameUser(ctx context.Context, in *RenameUserRequest, out *RenameUserResponse) error {
+	const method = "RenameUser"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_UsersService) IgnoreUser(ctx context.Context, in *IgnoreUserRequest, out *IgnoreUserResponse) error {
+	const method = "IgnoreUser"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_UsersService) BlockUser(ctx context.Context, in *BlockUserRequest, out *BlockUserResponse) error {
+	const method = "BlockUser"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_UsersService) Handshake(ctx context.Context, in *HandshakeRequest, out *HandshakeResponse) error {
+	const method = "Handshake"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_UsersService) ResetRatchet(ctx context.Context, in *ResetRatchetRequest, out *ResetRatchetResponse) error {
+	const method = "ResetRatchet"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_UsersService) UsersLastReceivedTime(ctx context.Context, in *UsersLastReceivedTimeRequest, out *UsersLastReceivedTimeResponse) error {
+	const method = "UsersLastReceivedTime"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type UsersService_AddressBookStreamClient interface {
+	Recv(*AddressBookChanged) error
+}
+
+func (c *client_UsersService) AddressBookStream(ctx context.Context, in *AddressBookStreamRequest) (UsersService_AddressBookStreamClient, error) {
+	const method = "AddressBookStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*AddressBookChanged]{c: inner}, nil
+}
+
+func (c *client_UsersService) AckAddressBookChanged(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckAddressBookChanged"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func NewUsersServiceClient(c ClientConn) UsersServiceClient {
+	return &client_UsersService{c: c, defn: UsersServiceDefn()}
+}
+
+// UsersServiceServer is the server API for UsersService service.
+type UsersServiceServer interface {
+	// AddressBook lists the users in the address book of the client.
+	AddressBook(context.Context, *AddressBookRequest, *AddressBookResponse) error
+	// RenameUser changes the local nick of a user.
+	RenameUser(context.Context, *RenameUserRequest, *RenameUserResponse) error
+	// IgnoreUser sets or clears the ignored flag of a user. Messages from
+	// ignored users are discarded.
+	IgnoreUser(context.Context, *IgnoreUserRequest, *IgnoreUserResponse) error
+	// BlockUser blocks a user. The user is asked to stop sending messages to the
+	// local client and is removed from the address book.
+	BlockUser(context.Context, *BlockUserRequest, *BlockUserResponse) error
+	// Handshake starts a handshake with a user, to verify the ratchet with the
+	// user is working.
+	Handshake(context.Context, *HandshakeRequest, *HandshakeResponse) error
+	// ResetRatchet starts a ratchet reset procedure with a user.
+	ResetRatchet(context.Context, *ResetRatchetRequest, *ResetRatchetResponse) error
+	// UsersLastReceivedTime lists the time of the last message received from
+	// each user, most recent first.
+	UsersLastReceivedTime(context.Context, *UsersLastReceivedTimeRequest, *UsersLastReceivedTimeResponse) error
+	// AddressBookStream returns a stream of changes to the address book:
+	// users that were added (or had their ratchet reset), renamed, ignored,
+	// unignored or blocked.
+	AddressBookStream(context.Context, *AddressBookStreamRequest, UsersService_AddressBookStreamServer) error
+	// AckAddressBookChanged acks to the server that address book changes up
+	// to a given sequence_id have been processed.
+	AckAddressBookChanged(context.Context, *AckRequest, *AckResponse) error
+}
+
+type UsersService_AddressBookStreamServer interface {
+	Send(m *AddressBookChanged) error
+}
+
+func UsersServiceDefn() ServiceDefn {
+	return ServiceDefn{
+		Name: "UsersService",
+		Methods: map[string]MethodDefn{
+			"AddressBook": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AddressBookRequest) },
+				NewResponse:  func() proto.Message { return new(AddressBookResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AddressBookRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AddressBookResponse).ProtoReflect().Descriptor() },
+				Help:         "AddressBook lists the users in the address book of the client.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).AddressBook(ctx, request.(*AddressBookRequest), response.(*AddressBookResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.AddressBook"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"RenameUser": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(RenameUserRequest) },
+				NewResponse:  func() proto.Message { return new(RenameUserResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(RenameUserRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(RenameUserResponse).ProtoReflect().Descriptor() },
+				Help:         "RenameUser changes the local nick of a user.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).RenameUser(ctx, request.(*RenameUserRequest), response.(*RenameUserResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.RenameUser"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"IgnoreUser": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(IgnoreUserRequest) },
+				NewResponse:  func() proto.Message { return new(IgnoreUserResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(IgnoreUserRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(IgnoreUserResponse).ProtoReflect().Descriptor() },
+				Help:         "IgnoreUser sets or clears the ignored flag of a user. Messages from ignored users are discarded.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).IgnoreUser(ctx, request.(*IgnoreUserRequest), response.(*IgnoreUserResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.IgnoreUser"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"BlockUser": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(BlockUserRequest) },
+				NewResponse:  func() proto.Message { return new(BlockUserResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(BlockUserRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(BlockUserResponse).ProtoReflect().Descriptor() },
+				Help:         "BlockUser blocks a user. The user is asked to stop sending messages to the local client and is removed from the address book.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).BlockUser(ctx, request.(*BlockUserRequest), response.(*BlockUserResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.BlockUser"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"Handshake": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(HandshakeRequest) },
+				NewResponse:  func() proto.Message { return new(HandshakeResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(HandshakeRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(HandshakeResponse).ProtoReflect().Descriptor() },
+				Help:         "Handshake starts a handshake with a user, to verify the ratchet with the user is working.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).Handshake(ctx, request.(*HandshakeRequest), response.(*HandshakeResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.Handshake"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ResetRatchet": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ResetRatchetRequest) },
+				NewResponse:  func() proto.Message { return new(ResetRatchetResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ResetRatchetRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ResetRatchetResponse).ProtoReflect().Descriptor() },
+				Help:         "ResetRatchet starts a ratchet reset procedure with a user.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).ResetRatchet(ctx, request.(*ResetRatchetRequest), response.(*ResetRatchetResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.ResetRatchet"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"UsersLastReceivedTime": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(UsersLastReceivedTimeRequest) },
+				NewResponse: func() proto.Message { return new(UsersLastReceivedTimeResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(UsersLastReceivedTimeRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(UsersLastReceivedTimeResponse).ProtoReflect().Descriptor()
+				},
+				Help: "UsersLastReceivedTime lists the time of the last message received from each user, most recent first.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).UsersLastReceivedTime(ctx, request.(*UsersLastReceivedTimeRequest), response.(*UsersLastReceivedTimeResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.UsersLastReceivedTime"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"AddressBookStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(AddressBookStreamRequest) },
+				NewResponse: func() proto.Message { return new(AddressBookChanged) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(AddressBookStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AddressBookChanged).ProtoReflect().Descriptor() },
+				Help:         "AddressBookStream returns a stream of changes to the address book: users that were added (or had their ratchet reset), renamed, ignored, unignored or blocked.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(UsersServiceServer).AddressBookStream(ctx, request.(*AddressBookStreamRequest), streamerImpl[*AddressBookChanged]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "UsersService.AddressBookStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckAddressBookChanged": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckAddressBookChanged acks to the server that address book changes up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(UsersServiceServer).AckAddressBookChanged(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "UsersService.AckAddressBookChanged"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+		},
+	}
+}
+
+// LNServiceClient is the client API for LNService service.
+type LNServiceClient interface {
+	// GetInfo returns information about the LN node.
+	GetInfo(ctx context.Context, in *LNGetInfoRequest, out *LNGetInfoResponse) error
+	// Balances returns the channel and on-chain wallet balances.
+	Balances(ctx context.Context, in *LNBalancesRequest, out *LNBalancesResponse) error
+	// ListChannels lists the open and pending channels of the node.
+	ListChannels(ctx context.Context, in *LNListChannelsRequest, out *LNListChannelsResponse) error
+	// OpenChannel opens a channel funded by the on-chain wallet. It returns
+	// once the funding transaction has been published.
+	OpenChannel(ctx context.Context, in *LNOpenChannelRequest, out *LNOpenChannelResponse) error
+	// CloseChannel closes a channel. It returns once the closing transaction
+	// has been published.
+	CloseChannel(ctx context.Context, in *LNCloseChannelRequest, out *LNCloseChannelResponse) error
+	// NewAddress returns a new on-chain address of the wallet.
+	NewAddress(ctx context.Context, in *LNNewAddressRequest, out *LNNewAddressResponse) error
+	// CreateInvoice creates a new invoice to receive funds.
+	CreateInvoice(ctx context.Context, in *LNCreateInvoiceRequest, out *LNCreateInvoiceResponse) error
+	// DecodeInvoice decodes an invoice.
+	DecodeInvoice(ctx context.Context, in *LNDecodeInvoiceRequest, out *LNDecodeInvoiceResponse) error
+	// PayInvoice pays an invoice. It returns once the payment completes.
+	PayInvoice(ctx context.Context, in *LNPayInvoiceRequest, out *LNPayInvoiceResponse) error
+	// SendOnChain sends funds from the on-chain wallet to an address.
+	SendOnChain(ctx context.Context, in *LNSendOnChainRequest, out *LNSendOnChainResponse) error
+	// ChannelEventsStream returns a stream of changes to the channels of the
+	// node (channels being opened, becoming active or inactive and closed).
+	// Only events that happen while the stream is open are sent.
+	ChannelEventsStream(ctx context.Context, in *LNChannelEventsStreamRequest) (LNService_ChannelEventsStreamClient, error)
+	// InvoicesStream returns a stream of invoices that were created or
+	// settled. Invoices created or settled after the add_index and
+	// settle_index of the request are sent first.
+	InvoicesStream(ctx context.Context, in *LNInvoicesStreamRequest) (LNService_InvoicesStreamClient, error)
+}
+
+type client_LNService struct {
+	c    ClientConn
+	defn ServiceDefn
+}
+
+func (c *client_LNService) GetInfo(ctx context.Context, in *LNGetInfoRequest, out *LNGetInfoResponse) error {
+	const method = "GetInfo"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) Balances(ctx context.Context, in *LNBalancesRequest, out *LNBalancesResponse) error {
+	const method = "Balances"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) ListChannels(ctx context.Context, in *LNListChannelsRequest, out *LNListChannelsResponse) error {
+	const method = "ListChannels"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) OpenChannel(ctx context.Context, in *LNOpenChannelRequest, out *LNOpenChannelResponse) error {
+	const method = "OpenChannel"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) CloseChannel(ctx context.Context, in *LNCloseChannelRequest, out *LNCloseChannelResponse) error {
+	const method = "CloseChannel"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) NewAddress(ctx context.Context, in *LNNewAddressRequest, out *LNNewAddressResponse) error {
+	const method = "NewAddress"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) CreateInvoice(ctx context.Context, in *LNCreateInvoiceRequest, out *LNCreateInvoiceResponse) error {
+	const method = "CreateInvoice"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) DecodeInvoice(ctx context.Context, in *LNDecodeInvoiceRequest, out *LNDecodeInvoiceResponse) error {
+	const method = "DecodeInvoice"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) PayInvoice(ctx context.Context, in *LNPayInvoiceRequest, out *LNPayInvoiceResponse) error {
+	const method = "PayInvoice"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_LNService) SendOnChain(ctx context.Context, in *LNSendOnChainRequest, out *LNSendOnChainResponse) error {
+	const method = "SendOnChain"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type LNService_ChannelEventsStreamClient interface {
+	Recv(*LNChannelEvent) error
+}
+
+func (c *client_LNService) ChannelEventsStream(ctx context.Context, in *LNChannelEventsStreamRequest) (LNService_ChannelEventsStreamClient, error) {
+	const method = "ChannelEventsStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*LNChannelEvent]{c: inner}, nil
+}
+
+type LNService_InvoicesStreamClient interface {
+	Recv(*LNInvoice) error
+}
+
+func (c *client_LNService) InvoicesStream(ctx context.Context, in *LNInvoicesStreamRequest) (LNService_InvoicesStreamClient, error) {
+	const method = "InvoicesStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*LNInvoice]{c: inner}, nil
+}
+
+func NewLNServiceClient(c ClientConn) LNServiceClient {
+	return &client_LNService{c: c, defn: LNServiceDefn()}
+}
+
+// LNServiceServer is the server API for LNService service.
+type LNServiceServer interface {
+	// GetInfo returns information about the LN node.
+	GetInfo(context.Context, *LNGetInfoRequest, *LNGetInfoResponse) error
+	// Balances returns the channel and on-chain wallet balances.
+	Balances(context.Context, *LNBalancesRequest, *LNBalancesResponse) error
+	// ListChannels lists the open and pending channels of the node.
+	ListChannels(context.Context, *LNListChannelsRequest, *LNListChannelsResponse) error
+	// OpenChannel opens a channel funded by the on-chain wallet. It returns
+	// once the funding transaction has been published.
+	OpenChannel(context.Context, *LNOpenChannelRequest, *LNOpenChannelResponse) error
+	// CloseChannel closes a channel. It returns once the closing transaction
+	// has been published.
+	CloseChannel(context.Context, *LNCloseChannelRequest, *LNCloseChannelResponse) error
+	// NewAddress returns a new on-chain address of the wallet.
+	NewAddress(context.Context, *LNNewAddressRequest, *LNNewAddressResponse) error
+	// CreateInvoice creates a new invoice to receive funds.
+	CreateInvoice(context.Context, *LNCreateInvoiceRequest, *LNCreateInvoiceResponse) error
+	// DecodeInvoice decodes an invoice.
+	DecodeInvoice(context.Context, *LNDecodeInvoiceRequest, *LNDecodeInvoiceResponse) error
+	// PayInvoice pays an invoice. It returns once the payment completes.
+	PayInvoice(context.Context, *LNPayInvoiceRequest, *LNPayInvoiceResponse) error
+	// SendOnChain sends funds from the on-chain wallet to an address.
+	SendOnChain(context.Context, *LNSendOnChainRequest, *LNSendOnChainResponse) error
+	// ChannelEventsStream returns a stream of changes to the channels of the
+	// node (channels being opened, becoming active or inactive and closed).
+	// Only events that happen while the stream is open are sent.
+	ChannelEventsStream(context.Context, *LNChannelEventsStreamRequest, LNService_ChannelEventsStreamServer) error
+	// InvoicesStream returns a stream of invoices that were created or
+	// settled. Invoices created or settled after the add_index and
+	// settle_index of the request are sent first.
+	InvoicesStream(context.Context, *LNInvoicesStreamRequest, LNService_InvoicesStreamServer) error
+}
+
+type LNService_ChannelEventsStreamServer interface {
+	Send(m *LNChannelEvent) error
+}
+
+type LNService_InvoicesStreamServer interface {
+	Send(m *LNInvoice) error
+}
+
+func LNServiceDefn() ServiceDefn {
+	return ServiceDefn{
+		Name: "LNService",
+		Methods: map[string]MethodDefn{
+			"GetInfo": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNGetInfoRequest) },
+				NewResponse:  func() proto.Message { return new(LNGetInfoResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNGetInfoRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNGetInfoResponse).ProtoReflect().Descriptor() },
+				Help:         "GetInfo returns information about the LN node.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).GetInfo(ctx, request.(*LNGetInfoRequest), response.(*LNGetInfoResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.GetInfo"
+					return conn.Request(ctx, method, request, response)
 				},
 			},
-			"AckDownloadCompleted": {
+			"Balances": {
 				IsStreaming:  false,
-				NewRequest:   func() proto.Message { return new(AckRequest) },
-				NewResponse:  func() proto.Message { return new(AckResponse) },
-				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
-				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
-				Help:         "AckDownloadCompleted acks download completed events.",
+				NewRequest:   func() proto.Message { return new(LNBalancesRequest) },
+				NewResponse:  func() proto.Message { return new(LNBalancesResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNBalancesRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNBalancesResponse).ProtoReflect().Descriptor() },
+				Help:         "Balances returns the channel and on-chain wallet balances.",
 				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
-					return x.(ContentServiceServer).AckDownloadCompleted(ctx, request.(*AckRequest), response.(*AckResponse))
+					return x.(LNServiceServer).Balances(ctx, request.(*LNBalancesRequest), response.(*LNBalancesResponse))
 				},
 				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
-					method := "ContentService.AckDownloadCompleted"
+					method := "LNService.Balances"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListChannels": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNListChannelsRequest) },
+				NewResponse:  func() proto.Message { return new(LNListChannelsResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNListChannelsRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNListChannelsResponse).ProtoReflect().Descriptor() },
+				Help:         "ListChannels lists the open and pending channels of the node.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).ListChannels(ctx, request.(*LNListChannelsRequest), response.(*LNListChannelsResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.ListChannels"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"OpenChannel": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNOpenChannelRequest) },
+				NewResponse:  func() proto.Message { return new(LNOpenChannelResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNOpenChannelRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNOpenChannelResponse).ProtoReflect().Descriptor() },
+				Help:         "OpenChannel opens a channel funded by the on-chain wallet. It returns once the funding transaction has been published.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).OpenChannel(ctx, request.(*LNOpenChannelRequest), response.(*LNOpenChannelResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.OpenChannel"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"CloseChannel": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNCloseChannelRequest) },
+				NewResponse:  func() proto.Message { return new(LNCloseChannelResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNCloseChannelRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNCloseChannelResponse).ProtoReflect().Descriptor() },
+				Help:         "CloseChannel closes a channel. It returns once the closing transaction has been published.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).CloseChannel(ctx, request.(*LNCloseChannelRequest), response.(*LNCloseChannelResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.CloseChannel"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"NewAddress": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNNewAddressRequest) },
+				NewResponse:  func() proto.Message { return new(LNNewAddressResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNNewAddressRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNNewAddressResponse).ProtoReflect().Descriptor() },
+				Help:         "NewAddress returns a new on-chain address of the wallet.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).NewAddress(ctx, request.(*LNNewAddressRequest), response.(*LNNewAddressResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.NewAddress"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"CreateInvoice": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNCreateInvoiceRequest) },
+				NewResponse:  func() proto.Message { return new(LNCreateInvoiceResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNCreateInvoiceRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNCreateInvoiceResponse).ProtoReflect().Descriptor() },
+				Help:         "CreateInvoice creates a new invoice to receive funds.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).CreateInvoice(ctx, request.(*LNCreateInvoiceRequest), response.(*LNCreateInvoiceResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.CreateInvoice"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"DecodeInvoice": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNDecodeInvoiceRequest) },
+				NewResponse:  func() proto.Message { return new(LNDecodeInvoiceResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNDecodeInvoiceRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNDecodeInvoiceResponse).ProtoReflect().Descriptor() },
+				Help:         "DecodeInvoice decodes an invoice.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).DecodeInvoice(ctx, request.(*LNDecodeInvoiceRequest), response.(*LNDecodeInvoiceResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.DecodeInvoice"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"PayInvoice": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNPayInvoiceRequest) },
+				NewResponse:  func() proto.Message { return new(LNPayInvoiceResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNPayInvoiceRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNPayInvoiceResponse).ProtoReflect().Descriptor() },
+				Help:         "PayInvoice pays an invoice. It returns once the payment completes.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).PayInvoice(ctx, request.(*LNPayInvoiceRequest), response.(*LNPayInvoiceResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.PayInvoice"
 					return conn.Request(ctx, method, request, response)
 				},
 			},
+			"SendOnChain": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(LNSendOnChainRequest) },
+				NewResponse:  func() proto.Message { return new(LNSendOnChainResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNSendOnChainRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNSendOnChainResponse).ProtoReflect().Descriptor() },
+				Help:         "SendOnChain sends funds from the on-chain wallet to an address.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(LNServiceServer).SendOnChain(ctx, request.(*LNSendOnChainRequest), response.(*LNSendOnChainResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "LNService.SendOnChain"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ChannelEventsStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(LNChannelEventsStreamRequest) },
+				NewResponse: func() proto.Message { return new(LNChannelEvent) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(LNChannelEventsStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNChannelEvent).ProtoReflect().Descriptor() },
+				Help:         "ChannelEventsStream returns a stream of changes to the channels of the node (channels being opened, becoming active or inactive and closed). Only events that happen while the stream is open are sent.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(LNServiceServer).ChannelEventsStream(ctx, request.(*LNChannelEventsStreamRequest), streamerImpl[*LNChannelEvent]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "LNService.ChannelEventsStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"InvoicesStream": {
+				IsStreaming:  true,
+				NewRequest:   func() proto.Message { return new(LNInvoicesStreamRequest) },
+				NewResponse:  func() proto.Message { return new(LNInvoice) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LNInvoicesStreamRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LNInvoice).ProtoReflect().Descriptor() },
+				Help:         "InvoicesStream returns a stream of invoices that were created or settled. Invoices created or settled after the add_index and settle_index of the request are sent first.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(LNServiceServer).InvoicesStream(ctx, request.(*LNInvoicesStreamRequest), streamerImpl[*LNInvoice]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "LNService.InvoicesStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
 		},
 	}
 }
@@ -1758,6 +3345,51 @@ var help_messages = map[string]map[string]string{
 		"sequence_id": "sequence_id is an opaque sequential ID.",
 		"gc":          "gc is the GC definition.",
 	},
+	"CreateChannelRequest": {
+		"@":    "CreateChannelRequest is the request to create a new channel.",
+		"name": "name is the name of the new channel.",
+	},
+	"CreateChannelResponse": {
+		"@":  "CreateChannelResponse is the response to a request to create a channel.",
+		"id": "id is the ID of the new channel.",
+	},
+	"ChannelShareInviteRequest": {
+		"@":     "ChannelShareInviteRequest is the request to get a shareable channel invite.",
+		"gc":    "gc is the hex-encoded ID or alias of the channel.",
+		"regen": "regen requests a new invite to be generated. This invalidates previously shared invites.",
+	},
+	"ChannelShareInviteResponse": {
+		"@":      "ChannelShareInviteResponse is the response to a request for a shareable channel invite.",
+		"invite": "invite is the encoded invite that may be shared with other users.",
+	},
+	"JoinChannelRequest": {
+		"@":      "JoinChannelRequest is the request to subscribe to a channel.",
+		"invite": "invite is the encoded invite, as returned by ChannelShareInvite.",
+	},
+	"JoinChannelResponse": {
+		"@": "JoinChannelResponse is the response to a request to subscribe to a channel.",
+	},
+	"EstimateGCMCostRequest": {
+		"@":   "EstimateGCMCostRequest is the request to estimate the cost of a GC message.",
+		"gc":  "gc is the hex-encoded ID or alias of the GC.",
+		"msg": "msg is the message to send.",
+	},
+	"EstimateGCMCostResponse": {
+		"@":           "EstimateGCMCostResponse is the response to a GC message cost estimate.",
+		"cost_matoms": "cost_matoms is the estimated total cost (in milliatoms).",
+		"nb_dests":    "nb_dests is the number of members the message would be sent to.",
+	},
+	"PublishToChannelRequest": {
+		"@":               "PublishToChannelRequest is the request to publish a message in a channel.",
+		"gc":              "gc is the hex-encoded ID or alias of the channel.",
+		"msg":             "msg is the message to publish.",
+		"max_cost_matoms": "max_cost_matoms is the maximum estimated cost (in milliatoms) to publish the message. If zero, the message is published regardless of cost.",
+	},
+	"PublishToChannelResponse": {
+		"@":           "PublishToChannelResponse is the response to publishing a message in a channel.",
+		"cost_matoms": "cost_matoms is the estimated total cost (in milliatoms).",
+		"nb_dests":    "nb_dests is the number of subscribers the message was sent to.",
+	},
 	"TipProgressRequest": {
 		"@":            "TipProgressRequest is the request to create a stream that receives events about the progress of TipUser requests.",
 		"unacked_from": "unacked_from specifies to the server the sequence_id of the last received tip progress event. Events received by the server that have a higher sequence_id will be streamed back to the client.",
@@ -1792,6 +3424,21 @@ var help_messages = map[string]map[string]string{
 	"FulfillResourceRequestResponse": {
 		"@": "FulfillResourceRequestResponse is the response to a FulfillResourceResquest call.",
 	},
+	"ProductSubscriptionsStreamRequest": {
+		"@":            "ProductSubscriptionsStreamRequest is the request for a stream of product subscription changes.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed event. Events with a higher sequence_id will be streamed back to the client.",
+	},
+	"ProductSubscriptionChanged": {
+		"@":           "ProductSubscriptionChanged is a change in the subscription of a remote user to a recurring product.",
+		"sequence_id": "sequence_id is an opaque sequential ID.",
+		"uid":         "uid is the ID of the subscriber.",
+		"nick":        "nick is the nick of the subscriber.",
+		"provider":    "provider identifies the kind of provider that sold the product.",
+		"product":     "product is the id of the product (e.g. its SKU).",
+		"title":       "title is the name of the product.",
+		"active":      "active is true while the subscription is paid for.",
+		"expires":     "expires is the unix timestamp of the end of the current period of the subscription.",
+	},
 	"DownloadsCompletedStreamRequest": {
 		"@":            "DownloadsCompletedRequest is the request sent when obtaining a stream of completed downloads notifications.",
 		"unacked_from": "unacked_from specifies to the server the sequence_id of the last completed download. Downloads completed by the server that have a higher sequence_id will be streamed back to the client.",
@@ -1804,6 +3451,97 @@ var help_messages = map[string]map[string]string{
 		"disk_path":     "disk_path is the path of the file in the local client's disk.",
 		"file_metadata": "file_metadata is the metadata about the file.",
 	},
+	"ShareDirRequest": {
+		"@":           "ShareDirRequest is the request to share a dir as a bundle.",
+		"dir":         "dir is the path to the local dir to share.",
+		"cost":        "cost is the cost (in atoms) to download the bundle.",
+		"description": "description is the description of the bundle.",
+		"uid":         "uid is the user to share the bundle with. If empty, the bundle is shared with all users.",
+	},
+	"ShareDirResponse": {
+		"@":             "ShareDirResponse is the response to a ShareDir request.",
+		"file_id":       "file_id is the ID of the shared bundle.",
+		"file_metadata": "file_metadata is the metadata of the shared bundle.",
+	},
+	"SearchContentRequest": {
+		"@":     "SearchContentRequest is the request to start a content search.",
+		"query": "query is the (case insensitive) regexp to match against filenames and descriptions.",
+		"uids":  "uids are the users to search. If empty, all users are searched.",
+	},
+	"SearchContentResponse": {
+		"@": "SearchContentResponse is the response to a SearchContent request.",
+	},
+	"ContentSearchResultsRequest": {
+		"@":     "ContentSearchResultsRequest is the request for the results of a content search.",
+		"query": "query is the query of the search.",
+	},
+	"ContentSearchSeller": {
+		"@":        "ContentSearchSeller is a user that shares a file found in a search.",
+		"uid":      "uid is the ID of the user.",
+		"nick":     "nick is the nick of the user.",
+		"file_id":  "file_id is the ID of the file shared by the user.",
+		"filename": "filename is the name of the file shared by the user.",
+		"cost":     "cost (in atoms) to download the file from the user.",
+	},
+	"ContentSearchResult": {
+		"@":           "ContentSearchResult is a file found in a search. Files with the same content shared by multiple users are aggregated in a single result.",
+		"hash":        "hash of the file contents.",
+		"filename":    "filename of the file.",
+		"description": "description of the file.",
+		"size":        "size (in bytes) of the file.",
+		"sellers":     "sellers are the users that share the file, ordered by cost.",
+	},
+	"ContentSearchResultsResponse": {
+		"@":       "ContentSearchResultsResponse is the response to a ContentSearchResults request.",
+		"query":   "query is the query of the search.",
+		"started": "started is the unix timestamp of when the search was started.",
+		"updated": "updated is the unix timestamp of the last received reply.",
+		"queried": "queried is the number of users searched.",
+		"replied": "replied is the number of users that replied.",
+		"results": "results are the files found.",
+	},
+	"MediaInfo": {
+		"@":           "MediaInfo is the metadata (and optionally, the data) of an audio or video message.",
+		"filename":    "filename is the name of the media file.",
+		"mime_type":   "mime_type is the mime type of the media (for example, audio/ogg).",
+		"codec":       "codec is the codec used to encode the media (for example, opus).",
+		"duration_ms": "duration_ms is the duration of the media in milliseconds.",
+		"size":        "size is the size of the media file.",
+		"thumbnail":   "thumbnail is an optional small image that represents the media.",
+		"data":        "data is the content of the media file, when it was embedded in the message.",
+		"alt":         "alt is a short description of the media.",
+		"file_id":     "file_id is the ID of the file transfer that sends the media, when it was too large to be embedded in the message. It matches the metadata hash of the file reported in ContentService.DownloadsCompletedStream.",
+	},
+	"SendMediaRequest": {
+		"@":               "SendMediaRequest is a request to send an audio or video message.",
+		"user":            "user is the nick or hex-encoded ID of the destination user. Either user or gc must be specified.",
+		"gc":              "gc is the alias or hex-encoded ID of the destination GC.",
+		"path":            "path is the local path to the media file. If empty, media.data must be specified. Calls made with an API token may only specify a path if the token is allowed to access local files.",
+		"media":           "media is the metadata of the media. Unspecified fields are determined from the media file when possible.",
+		"caption":         "caption is an optional text sent along with the media.",
+		"max_cost_matoms": "max_cost_matoms is the maximum estimated cost (in milliatoms) to send the media to a GC, including sending the media file to every member. If zero, the media is sent regardless of cost.",
+	},
+	"SendMediaResponse": {
+		"@":           "SendMediaResponse is the response to a SendMedia request.",
+		"inline":      "inline is true if the media was embedded in the message, as opposed to sent as a file transfer.",
+		"cost_matoms": "cost_matoms is the estimated total cost (in milliatoms) to send the media to a GC.",
+		"nb_dests":    "nb_dests is the number of GC members the media was sent to.",
+	},
+	"MediaStreamRequest": {
+		"@":            "MediaStreamRequest is the request for a new media message reception stream.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed media message. Media received by the server that has a higher sequence_id will be streamed back to the client.",
+	},
+	"ReceivedMedia": {
+		"@":            "ReceivedMedia is an audio or video message received by the client.",
+		"sequence_id":  "sequence_id is an opaque sequential ID.",
+		"uid":          "uid is the source user ID in raw format.",
+		"nick":         "nick is the source's nick or alias.",
+		"gc_id":        "gc_id is the raw ID of the GC where the media was sent. Empty when the media was received as a private message.",
+		"gc_alias":     "gc_alias is the local alias of the GC where the media was sent.",
+		"timestamp_ms": "timestamp_ms is the timestamp from unix epoch with millisecond precision.",
+		"message":      "message is the full message that included the media.",
+		"media":        "media is the received media.",
+	},
 	"RMPrivateMessage": {
 		"@":       "RMPrivateMessage is the network-level routed private message.",
 		"message": "message is the private message payload.",
@@ -1875,15 +3613,18 @@ var help_messages = map[string]map[string]string{
 		"version":      "version is the GC rules version.",
 		"members":      "members is the list of user IDs that are in the GC.",
 		"extra_admins": "extra_admins is the list of user IDs that are additional admins of the GC.",
+		"channel":      "channel is true if the GC is an announcement-only channel.",
 	},
 	"RMFetchResource": {
-		"@":     "RMFetchResource is the lowlevel request to fetch a resource.",
-		"path":  "path is the resource's path (already split into segments).",
-		"meta":  "meta is metadata associated with the request.",
-		"tag":   "tag is a unique tag that should be relayed back on the reply.",
-		"data":  "data is raw request data.",
-		"index": "index is used in chunked/multipart requests.",
-		"count": "count is the total number of chunks in multipart requests.",
+		"@":            "RMFetchResource is the lowlevel request to fetch a resource.",
+		"path":         "path is the resource's path (already split into segments).",
+		"meta":         "meta is metadata associated with the request.",
+		"tag":          "tag is a unique tag that should be relayed back on the reply.",
+		"data":         "data is raw request data.",
+		"index":        "index is used in chunked/multipart requests.",
+		"count":        "count is the total number of chunks in multipart requests.",
+		"method":       "method is the request method (GET or POST). Empty for requests sent by older clients.",
+		"content_type": "content_type is the content type of the request data. Empty for requests sent by older clients (which always send json data).",
 	},
 	"RMFetchResourceReply": {
 		"@":      "RMFetchResourceReply is the lowlevel resource response.",
@@ -1912,6 +3653,13 @@ var help_messages = map[string]map[string]string{
 		"manifest":    "manifest of the chunks that compose the file.",
 		"signature":   "signature of the file by the host.",
 		"attributes":  "attributes of the file.",
+		"files":       "files of a multi-file bundle.",
+	},
+	"BundleFile": {
+		"@":    "BundleFile is one of the files of a multi-file bundle.",
+		"path": "path of the file, relative to the root of the bundle.",
+		"size": "size (in bytes) of the file.",
+		"hash": "hash of the file contents.",
 	},
 	"TipStreamRequest": {
 		"@":            "TipStreamRequest is the request for a new tip reception stream.",
@@ -1923,4 +3671,452 @@ var help_messages = map[string]map[string]string{
 		"amount_matoms": "amount_matoms is the amount being tipped in milli-atoms.",
 		"sequence_id":   "sequence_id is an opaque sequential ID.",
 	},
+	"AddressBookRequest": {
+		"@": "AddressBookRequest is the request to list the address book.",
+	},
+	"AddressBookEntry": {
+		"@":                      "AddressBookEntry is a user in the address book.",
+		"uid":                    "uid is the raw ID of the user.",
+		"nick":                   "nick is the local nick of the user.",
+		"name":                   "name is the name of the user, as defined by them.",
+		"ignored":                "ignored is true if messages from the user are ignored.",
+		"first_created":          "first_created is the unix timestamp of the first KX with the user.",
+		"last_completed_kx":      "last_completed_kx is the unix timestamp of the last completed KX with the user (either the initial one or a ratchet reset).",
+		"last_handshake_attempt": "last_handshake_attempt is the unix timestamp of the last handshake started by the local client with the user.",
+	},
+	"AddressBookResponse": {
+		"@":     "AddressBookResponse is the list of users in the address book.",
+		"users": "users are the users in the address book.",
+	},
+	"RenameUserRequest": {
+		"@":        "RenameUserRequest is the request to change the local nick of a user.",
+		"user":     "user is the nick or hex-encoded ID of the user.",
+		"new_nick": "new_nick is the new local nick of the user.",
+	},
+	"RenameUserResponse": {
+		"@": "RenameUserResponse is the response to RenameUser.",
+	},
+	"IgnoreUserRequest": {
+		"@":      "IgnoreUserRequest is the request to set or clear the ignored flag of a user.",
+		"user":   "user is the nick or hex-encoded ID of the user.",
+		"ignore": "ignore is whether to ignore (true) or unignore (false) the user.",
+	},
+	"IgnoreUserResponse": {
+		"@": "IgnoreUserResponse is the response to IgnoreUser.",
+	},
+	"BlockUserRequest": {
+		"@":    "BlockUserRequest is the request to block a user.",
+		"user": "user is the nick or hex-encoded ID of the user.",
+	},
+	"BlockUserResponse": {
+		"@": "BlockUserResponse is the response to BlockUser.",
+	},
+	"HandshakeRequest": {
+		"@":    "HandshakeRequest is the request to start a handshake with a user.",
+		"user": "user is the nick or hex-encoded ID of the user.",
+	},
+	"HandshakeResponse": {
+		"@": "HandshakeResponse is the response to Handshake.",
+	},
+	"ResetRatchetRequest": {
+		"@":    "ResetRatchetRequest is the request to reset the ratchet with a user.",
+		"user": "user is the nick or hex-encoded ID of the user.",
+	},
+	"ResetRatchetResponse": {
+		"@": "ResetRatchetResponse is the response to ResetRatchet.",
+	},
+	"UsersLastReceivedTimeRequest": {
+		"@": "UsersLastReceivedTimeRequest is the request to list the time of the last message received from each user.",
+	},
+	"UserLastReceivedTime": {
+		"@":              "UserLastReceivedTime is the time of the last message received from a user.",
+		"uid":            "uid is the raw ID of the user.",
+		"nick":           "nick is the local nick of the user.",
+		"last_decrypted": "last_decrypted is the unix timestamp of the last message decrypted from the user.",
+	},
+	"UsersLastReceivedTimeResponse": {
+		"@":     "UsersLastReceivedTimeResponse is the response to UsersLastReceivedTime.",
+		"users": "users are the users, ordered by the most recent received message.",
+	},
+	"AddressBookStreamRequest": {
+		"@":            "AddressBookStreamRequest is the request for a stream of address book changes.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed event. Events with a higher sequence_id will be streamed back to the client.",
+	},
+	"AddressBookChanged": {
+		"@":           "AddressBookChanged is a change to the address book.",
+		"sequence_id": "sequence_id is an opaque sequential ID.",
+		"kind":        "kind is the kind of change.",
+		"uid":         "uid is the raw ID of the user.",
+		"nick":        "nick is the local nick of the user, after the change.",
+	},
+	"PostEmbed": {
+		"@":    "PostEmbed is a file embedded in a post.",
+		"name": "name is the name of the embedded file.",
+		"alt":  "alt is the alternative text of the embed.",
+		"type": "type is the MIME type of the embedded file (e.g. image/jpeg).",
+		"data": "data is the content of the embedded file.",
+	},
+	"CreatePostRequest": {
+		"@":           "CreatePostRequest is the request to create a new post.",
+		"content":     "content is the markdown content of the post.",
+		"description": "description is an optional description of the post.",
+		"embeds":      "embeds are files embedded in the post. They are added, in order, after the content.",
+	},
+	"CreatePostResponse": {
+		"@":       "CreatePostResponse is the response to CreatePost.",
+		"summary": "summary is the summary of the created post.",
+	},
+	"ListPostsRequest": {
+		"@": "ListPostsRequest is the request to list the posts.",
+	},
+	"ListPostsResponse": {
+		"@":     "ListPostsResponse is the list of posts.",
+		"posts": "posts are the summaries of the posts.",
+	},
+	"ReadPostRequest": {
+		"@":       "ReadPostRequest is the request to read a post.",
+		"from":    "from is the raw ID of the relayer of the post (the local client ID for local posts).",
+		"post_id": "post_id is the raw ID of the post.",
+	},
+	"ReadPostResponse": {
+		"@":    "ReadPostResponse is the response to ReadPost.",
+		"post": "post is the full post data.",
+	},
+	"CommentPostRequest": {
+		"@":       "CommentPostRequest is the request to comment on a post.",
+		"from":    "from is the raw ID of the relayer of the post (the local client ID for local posts).",
+		"post_id": "post_id is the raw ID of the post.",
+		"comment": "comment is the text of the comment.",
+		"parent":  "parent is the optional raw ID of the comment being replied to.",
+	},
+	"CommentPostResponse": {
+		"@":          "CommentPostResponse is the response to CommentPost.",
+		"comment_id": "comment_id is the raw ID of the new comment.",
+	},
+	"HeartPostRequest": {
+		"@":       "HeartPostRequest is the request to heart or unheart a post.",
+		"from":    "from is the raw ID of the relayer of the post (the local client ID for local posts).",
+		"post_id": "post_id is the raw ID of the post.",
+		"heart":   "heart is whether to add (true) or remove (false) the heart.",
+	},
+	"HeartPostResponse": {
+		"@": "HeartPostResponse is the response to HeartPost.",
+	},
+	"RelayPostRequest": {
+		"@":       "RelayPostRequest is the request to relay a post.",
+		"from":    "from is the raw ID of the relayer of the post (the local client ID for local posts).",
+		"post_id": "post_id is the raw ID of the post.",
+		"to_user": "to_user is the nick or hex-encoded ID of the user to relay the post to. If empty, the post is relayed to all subscribers of the local client.",
+	},
+	"RelayPostResponse": {
+		"@": "RelayPostResponse is the response to RelayPost.",
+	},
+	"LNGetInfoRequest": {
+		"@": "LNGetInfoRequest is the request for information about the LN node.",
+	},
+	"LNGetInfoResponse": {
+		"@":                     "LNGetInfoResponse is information about the LN node.",
+		"node_id":               "node_id is the hex-encoded public key of the node.",
+		"version":               "version is the version of the node.",
+		"num_active_channels":   "num_active_channels is the number of active channels.",
+		"num_inactive_channels": "num_inactive_channels is the number of inactive channels.",
+		"num_pending_channels":  "num_pending_channels is the number of pending channels.",
+		"num_peers":             "num_peers is the number of connected peers.",
+		"block_height":          "block_height is the height of the best block known to the node.",
+		"block_hash":            "block_hash is the hash of the best block known to the node.",
+		"synced_to_chain":       "synced_to_chain is true if the node is synced to the chain.",
+		"synced_to_graph":       "synced_to_graph is true if the node is synced to the network graph.",
+	},
+	"LNBalancesRequest": {
+		"@": "LNBalancesRequest is the request for the balances of the node.",
+	},
+	"LNBalancesResponse": {
+		"@":                        "LNBalancesResponse is the balances of the node.",
+		"channel_local_atoms":      "channel_local_atoms is the sum of the local balances of the channels.",
+		"channel_remote_atoms":     "channel_remote_atoms is the sum of the remote balances of the channels.",
+		"max_inbound_atoms":        "max_inbound_atoms is the maximum amount that can be received in a single payment.",
+		"max_outbound_atoms":       "max_outbound_atoms is the maximum amount that can be sent in a single payment.",
+		"wallet_confirmed_atoms":   "wallet_confirmed_atoms is the confirmed on-chain balance.",
+		"wallet_unconfirmed_atoms": "wallet_unconfirmed_atoms is the unconfirmed on-chain balance.",
+	},
+	"LNListChannelsRequest": {
+		"@": "LNListChannelsRequest is the request to list channels.",
+	},
+	"LNChannel": {
+		"@":              "LNChannel is a channel of the node.",
+		"channel_point":  "channel_point is the funding outpoint of the channel (txid:index).",
+		"remote_node_id": "remote_node_id is the hex-encoded public key of the remote node.",
+		"capacity_atoms": "capacity_atoms is the total capacity of the channel.",
+		"local_atoms":    "local_atoms is the local balance of the channel.",
+		"remote_atoms":   "remote_atoms is the remote balance of the channel.",
+		"active":         "active is true if the channel can be used for payments.",
+		"pending":        "pending is true if the channel is not yet open or not yet closed.",
+		"chan_id":        "chan_id is the short channel ID (zero for pending channels).",
+	},
+	"LNListChannelsResponse": {
+		"@":             "LNListChannelsResponse is the list of channels.",
+		"channels":      "channels are the open channels.",
+		"pending_open":  "pending_open are channels waiting for the funding transaction to confirm.",
+		"pending_close": "pending_close are channels waiting for the closing transaction to confirm or for funds to be recovered.",
+	},
+	"LNOpenChannelRequest": {
+		"@":             "LNOpenChannelRequest is the request to open a channel.",
+		"node_id":       "node_id is the hex-encoded public key of the remote node.",
+		"host":          "host is the optional address (host:port) of the remote node, used to connect to it if not yet connected.",
+		"funding_atoms": "funding_atoms is the amount to commit to the channel.",
+		"push_atoms":    "push_atoms is the amount to send to the remote node when opening the channel.",
+	},
+	"LNOpenChannelResponse": {
+		"@":             "LNOpenChannelResponse is the response to OpenChannel.",
+		"channel_point": "channel_point is the funding outpoint of the channel (txid:index).",
+	},
+	"LNCloseChannelRequest": {
+		"@":             "LNCloseChannelRequest is the request to close a channel.",
+		"channel_point": "channel_point is the funding outpoint of the channel (txid:index).",
+		"force":         "force closes the channel unilaterally, for example when the remote node is offline.",
+	},
+	"LNCloseChannelResponse": {
+		"@":            "LNCloseChannelResponse is the response to CloseChannel.",
+		"closing_txid": "closing_txid is the id of the closing transaction.",
+	},
+	"LNNewAddressRequest": {
+		"@":       "LNNewAddressRequest is the request for a new on-chain address.",
+		"account": "account is the wallet account of the address. If empty, the default account is used.",
+	},
+	"LNNewAddressResponse": {
+		"@":       "LNNewAddressResponse is the response to NewAddress.",
+		"address": "address is the new address.",
+	},
+	"LNCreateInvoiceRequest": {
+		"@":              "LNCreateInvoiceRequest is the request to create an invoice.",
+		"amount_matoms":  "amount_matoms is the amount of the invoice. If zero, the payer defines the amount.",
+		"memo":           "memo is a description of the invoice.",
+		"expiry_seconds": "expiry_seconds is the time until the invoice expires. If zero, the default expiry is used.",
+	},
+	"LNCreateInvoiceResponse": {
+		"@":            "LNCreateInvoiceResponse is the response to CreateInvoice.",
+		"invoice":      "invoice is the encoded invoice (payment request).",
+		"payment_hash": "payment_hash is the hash of the invoice.",
+		"add_index":    "add_index is the index of the invoice, as used in InvoicesStream.",
+	},
+	"LNDecodeInvoiceRequest": {
+		"@":       "LNDecodeInvoiceRequest is the request to decode an invoice.",
+		"invoice": "invoice is the encoded invoice.",
+	},
+	"LNDecodeInvoiceResponse": {
+		"@":              "LNDecodeInvoiceResponse is a decoded invoice.",
+		"destination":    "destination is the hex-encoded public key of the receiving node.",
+		"payment_hash":   "payment_hash is the hex-encoded hash of the invoice.",
+		"amount_matoms":  "amount_matoms is the amount of the invoice.",
+		"timestamp":      "timestamp is the unix timestamp of the creation of the invoice.",
+		"expiry_seconds": "expiry_seconds is the time after timestamp when the invoice expires.",
+		"memo":           "memo is the description of the invoice.",
+	},
+	"LNPayInvoiceRequest": {
+		"@":             "LNPayInvoiceRequest is the request to pay an invoice.",
+		"invoice":       "invoice is the encoded invoice.",
+		"amount_matoms": "amount_matoms is the amount to pay. It must only be set for invoices without an amount.",
+	},
+	"LNPayInvoiceResponse": {
+		"@":           "LNPayInvoiceResponse is the response to PayInvoice.",
+		"fees_matoms": "fees_matoms is the amount paid in routing fees.",
+	},
+	"LNSendOnChainRequest": {
+		"@":            "LNSendOnChainRequest is the request to send on-chain funds.",
+		"address":      "address is the destination address.",
+		"amount_atoms": "amount_atoms is the amount to send.",
+		"account":      "account is the source wallet account. If empty, the default account is used.",
+	},
+	"LNSendOnChainResponse": {
+		"@":    "LNSendOnChainResponse is the response to SendOnChain.",
+		"txid": "txid is the id of the sent transaction.",
+	},
+	"LNChannelEventsStreamRequest": {
+		"@": "LNChannelEventsStreamRequest is the request for a stream of channel events.",
+	},
+	"LNChannelEvent": {
+		"@":             "LNChannelEvent is a change to a channel of the node.",
+		"type":          "type is the type of the event.",
+		"channel_point": "channel_point is the funding outpoint of the channel (txid:index).",
+		"channel":       "channel is the channel data, for open channel events.",
+		"closing_txid":  "closing_txid is the id of the closing transaction, for closed channel events.",
+	},
+	"LNInvoicesStreamRequest": {
+		"@":            "LNInvoicesStreamRequest is the request for a stream of invoices.",
+		"add_index":    "add_index is the add_index of the last created invoice processed by the client. Invoices created after it are sent.",
+		"settle_index": "settle_index is the settle_index of the last settled invoice processed by the client. Invoices settled after it are sent.",
+	},
+	"LNInvoice": {
+		"@":             "LNInvoice is an invoice created by the node.",
+		"invoice":       "invoice is the encoded invoice.",
+		"payment_hash":  "payment_hash is the hash of the invoice.",
+		"memo":          "memo is the description of the invoice.",
+		"amount_matoms": "amount_matoms is the amount of the invoice.",
+		"paid_matoms":   "paid_matoms is the amount paid to the invoice.",
+		"settled":       "settled is true if the invoice was paid.",
+		"creation_date": "creation_date is the unix timestamp of the creation of the invoice.",
+		"settle_date":   "settle_date is the unix timestamp of the payment of the invoice.",
+		"add_index":     "add_index is the index of the creation of the invoice.",
+		"settle_index":  "settle_index is the index of the payment of the invoice.",
+	},
+	"ShareFileRequest": {
+		"@":           "ShareFileRequest is the request to share a local file.",
+		"filename":    "filename is the path to the local file to share.",
+		"cost":        "cost is the cost (in atoms) to download the file.",
+		"description": "description is the description of the file.",
+		"uid":         "uid is the user to share the file with. If empty, the file is shared with all users.",
+	},
+	"ShareFileResponse": {
+		"@":             "ShareFileResponse is the response to a ShareFile request.",
+		"file_id":       "file_id is the ID of the shared file.",
+		"file_metadata": "file_metadata is the metadata of the shared file.",
+	},
+	"UnshareFileRequest": {
+		"@":       "UnshareFileRequest is the request to stop sharing a file.",
+		"file_id": "file_id is the ID of the shared file.",
+		"uid":     "uid is the user to stop sharing the file with. If empty, the file is not shared with any user anymore.",
+	},
+	"UnshareFileResponse": {
+		"@": "UnshareFileResponse is the response to an UnshareFile request.",
+	},
+	"ListLocalSharedFilesRequest": {
+		"@": "ListLocalSharedFilesRequest is the request to list the local shared files.",
+	},
+	"SharedFile": {
+		"@":         "SharedFile is a file shared by the local client.",
+		"file_id":   "file_id is the ID of the shared file.",
+		"file_hash": "file_hash is the hash of the contents of the file.",
+		"filename":  "filename is the name of the file.",
+		"cost":      "cost is the cost (in atoms) to download the file.",
+		"size":      "size is the size (in bytes) of the file.",
+		"global":    "global is true if the file is shared with all users.",
+		"shares":    "shares are the users the file is shared with.",
+	},
+	"ListLocalSharedFilesResponse": {
+		"@":     "ListLocalSharedFilesResponse is the list of local shared files.",
+		"files": "files are the shared files.",
+	},
+	"ListUserContentRequest": {
+		"@":      "ListUserContentRequest is the request to list the files of a remote user.",
+		"uid":    "uid is the remote user.",
+		"dirs":   "dirs are the dirs to list (global, shared or * for all). If empty, all dirs are listed.",
+		"filter": "filter is an optional regexp to match against the filenames and descriptions of the files.",
+	},
+	"UserContentFile": {
+		"@":             "UserContentFile is a file shared by a remote user.",
+		"file_id":       "file_id is the ID of the file.",
+		"file_metadata": "file_metadata is the metadata of the file.",
+		"disk_path":     "disk_path is the path of the file, if it was already downloaded.",
+	},
+	"ListUserContentResponse": {
+		"@":     "ListUserContentResponse is the list of files of a remote user.",
+		"files": "files are the files shared by the user.",
+	},
+	"GetUserContentRequest": {
+		"@":       "GetUserContentRequest is the request to download a file of a remote user.",
+		"uid":     "uid is the remote user.",
+		"file_id": "file_id is the ID of the file.",
+	},
+	"GetUserContentResponse": {
+		"@": "GetUserContentResponse is the response to a GetUserContent request.",
+	},
+	"ListDownloadsRequest": {
+		"@": "ListDownloadsRequest is the request to list downloads.",
+	},
+	"FileDownload": {
+		"@":                    "FileDownload is a download of the local client.",
+		"uid":                  "uid is the user the file is downloaded from.",
+		"file_id":              "file_id is the ID of the file.",
+		"file_metadata":        "file_metadata is the metadata of the file, if already known.",
+		"disk_path":            "disk_path is the path of the file, set once the download completes.",
+		"nb_chunks":            "nb_chunks is the number of chunks of the file.",
+		"nb_downloaded_chunks": "nb_downloaded_chunks is the number of chunks already downloaded.",
+		"is_sent_file":         "is_sent_file is true if the file was pushed by the remote user.",
+	},
+	"ListDownloadsResponse": {
+		"@":         "ListDownloadsResponse is the list of downloads.",
+		"downloads": "downloads are the downloads of the local client.",
+	},
+	"CancelDownloadRequest": {
+		"@":       "CancelDownloadRequest is the request to cancel a download.",
+		"file_id": "file_id is the ID of the file being downloaded.",
+	},
+	"CancelDownloadResponse": {
+		"@": "CancelDownloadResponse is the response to a CancelDownload request.",
+	},
+	"TransfersProgressStreamRequest": {
+		"@": "TransfersProgressStreamRequest is the request for a stream of transfer progress events.",
+	},
+	"TransferProgress": {
+		"@":                 "TransferProgress is a progress event of a file transfer.",
+		"direction":         "direction is the direction of the transfer.",
+		"uid":               "uid is the remote user.",
+		"nick":              "nick is the nick of the remote user.",
+		"file_id":           "file_id is the ID of the file.",
+		"filename":          "filename is the name of the file.",
+		"nb_chunks":         "nb_chunks is the number of chunks of the file, if known.",
+		"nb_missing_chunks": "nb_missing_chunks is the number of chunks not yet downloaded, for downloads.",
+		"chunk_index":       "chunk_index is the index of the chunk that was sent, for uploads.",
+	},
+	"NewPagesSessionRequest": {
+		"@": "NewPagesSessionRequest is the request to start a new pages session.",
+	},
+	"NewPagesSessionResponse": {
+		"@":          "NewPagesSessionResponse is the response to a NewPagesSession call.",
+		"session_id": "session_id is the ID of the new pages session.",
+	},
+	"FetchResourceRequest": {
+		"@":           "FetchResourceRequest is the request to fetch a resource from a remote user.",
+		"uid":         "uid is the ID of the user from which to fetch the resource.",
+		"path":        "path is the resource's path (already split into segments).",
+		"meta":        "meta is metadata sent with the request.",
+		"session_id":  "session_id is the pages session the request is made on. May be zero to not associate the resource with a session.",
+		"parent_page": "parent_page is the page (within the session) that originated the request.",
+		"data":        "data is optional raw (json) request data. If specified, it must be valid json.",
+	},
+	"FetchResourceResponse": {
+		"@":   "FetchResourceResponse is the response to a FetchResource call.",
+		"tag": "tag is the tag of the request, which is returned in the corresponding FetchedResource event.",
+	},
+	"FetchedResourcesStreamRequest": {
+		"@":            "FetchedResourcesStreamRequest is the request for a stream of fetched resources.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed event. Events with a higher sequence_id will be streamed back to the client.",
+	},
+	"FetchedResource": {
+		"@":              "FetchedResource is a resource fetched by the local client.",
+		"sequence_id":    "sequence_id is an opaque sequential ID.",
+		"uid":            "uid is the ID of the user that sent the resource.",
+		"nick":           "nick is the nick of the user that sent the resource.",
+		"session_id":     "session_id is the pages session of the request.",
+		"parent_page":    "parent_page is the page that originated the request.",
+		"page_id":        "page_id is the ID of the fetched page within its session.",
+		"request_ts_ms":  "request_ts_ms is the unix timestamp (in milliseconds) of the request.",
+		"response_ts_ms": "response_ts_ms is the unix timestamp (in milliseconds) of the response.",
+		"request":        "request is the request sent to the remote user.",
+		"response":       "response is the response sent by the remote user.",
+	},
+	"ListPayLedgerRequest": {
+		"@":        "ListPayLedgerRequest is the request to list the entries of the payment ledger.",
+		"start_ts": "start_ts is the unix timestamp (in seconds) of the start of the interval to list. Zero means unbounded.",
+		"end_ts":   "end_ts is the unix timestamp (in seconds) of the end (exclusive) of the interval to list. Zero means unbounded.",
+		"uid":      "uid optionally restricts the entries to the ones related to the given user.",
+	},
+	"PayLedgerEntry": {
+		"@":              "PayLedgerEntry is an entry of the payment ledger.",
+		"timestamp":      "timestamp is the unix timestamp (in seconds) of the payment.",
+		"uid":            "uid is the ID of the counterparty of the payment.",
+		"nick":           "nick is the nick of the counterparty of the payment.",
+		"event":          "event is the specific event that triggered the payment.",
+		"purpose":        "purpose is the general purpose of the payment (tip, file_chunk, paywall, store_order, sub_fee or push_fee).",
+		"amount_matoms":  "amount_matoms is the amount of the payment, in milli-atoms. It is negative for outbound payments and positive for inbound ones.",
+		"pay_fee_matoms": "pay_fee_matoms is the (negative) amount of fees paid, in milli-atoms.",
+	},
+	"ListPayLedgerResponse": {
+		"@":       "ListPayLedgerResponse is the response to a ListPayLedger call.",
+		"entries": "",
+	},
+	"ExportPayLedgerCSVResponse": {
+		"@":   "ExportPayLedgerCSVResponse is the response to an ExportPayLedgerCSV call.",
+		"csv": "csv is the CSV-formatted ledger.",
+	},
 }
@@ -0,0 +1,164 @@
+//go:build cgo && !noaudio
+
+package audio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/gopus"
+	"golang.org/x/sync/errgroup"
+)
+
+// callEncodeLoop opus-encodes raw samples from the capture loop and sends each
+// encoded frame to the remote party of a call.
+func (ar *NoteRecorder) callEncodeLoop(sendFrame func([]byte) error) error {
+	encoder, err := gopus.NewEncoder(sampleRate, channels, gopus.Voip)
+	if err != nil {
+		return fmt.Errorf("gopus.NewEcoder: %v", err)
+	}
+
+	encoder.SetBitrate(encodeBitRate)
+
+	ar.log.Debug("Starting call encoding loop")
+
+	var encodeBuffer = make([]byte, 4000)
+	var sendErr error
+	var frames int
+
+	// Keep draining samples even after a send error, so that the capture
+	// loop is not blocked.
+	for samples := range ar.encodeChan {
+		if samples == nil {
+			break
+		}
+		if sendErr != nil {
+			ar.int16Buffers.Put(samples[:0])
+			continue
+		}
+
+		encoded, err := encoder.Encode(samples, len(samples), encodeBuffer)
+		ar.int16Buffers.Put(samples[:0])
+		if err != nil {
+			sendErr = err
+			continue
+		}
+
+		encoded = append([]byte(nil), encoded...) // Copy bytes from encodeBuffer.
+		sendErr = sendFrame(encoded)
+		frames += 1
+	}
+
+	ar.log.Debugf("Finished call encoding loop: %d frames sent", frames)
+	return sendErr
+}
+
+// callDecodeLoop decodes opus-encoded frames received in a call and sends them
+// to the playback loop. A nil frame is decoded using packet loss concealment.
+func (ar *NoteRecorder) callDecodeLoop(ctx context.Context, frames <-chan []byte) error {
+	decoder, err := gopus.NewDecoder(sampleRate, channels)
+	if err != nil {
+		return fmt.Errorf("gopus.NewDecoder: %v", err)
+	}
+
+	// Must be agreed upon.
+	const frameSize = sampleRate / 1000 * periodSizeMS
+
+	ar.log.Debugf("Starting call decode loop")
+
+	var inFrames, lostFrames int
+	var decodeBuffer = make([]int16, frameSize*channels*2)
+
+loop:
+	for {
+		var frame []byte
+		var ok bool
+		select {
+		case frame, ok = <-frames:
+			if !ok {
+				break loop
+			}
+		case <-ctx.Done():
+			break loop
+		}
+
+		inFrames += 1
+		if frame == nil {
+			lostFrames += 1
+		}
+		decoded, err := decoder.Decode(frame, frameSize, false, decodeBuffer)
+		if err != nil {
+			ar.log.Warnf("Unable to decode call frame: %v", err)
+			continue
+		}
+
+		samples := ar.bytesBuffers.Get().([]byte)
+		samples = leS16SliceToBytes(decoded, samples)
+
+		select {
+		case <-ctx.Done():
+			break loop
+		case ar.playbackChan <- samples:
+		}
+	}
+
+	// Send an empty message to signal that we finished decoding.
+	ar.playbackChan <- nil
+	ar.log.Debugf("Finished call decode loop with %d in frames (%d lost)",
+		inFrames, lostFrames)
+	return nil
+}
+
+// Call streams audio during a real-time call. Audio captured from the capture
+// device is encoded and passed to sendFrame, while frames read from recvFrames
+// are decoded and played in the playback device.
+//
+// This blocks until recvFrames is closed, sendFrame returns an error, the
+// context is canceled or Stop() is called.
+func (ar *NoteRecorder) Call(ctx context.Context, sendFrame func([]byte) error,
+	recvFrames <-chan []byte) error {
+
+	ar.mtx.Lock()
+	if ar.recording || ar.playing {
+		ar.mtx.Unlock()
+		return errors.New("cannot call while recording or playing")
+	}
+
+	ctx, ar.stop = context.WithCancel(ctx)
+	stop := ar.stop
+	ar.recording = true
+	ar.playing = true
+
+	start := time.Now()
+	ar.log.Infof("Starting call audio stream")
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error { return ar.captureLoop(gctx) })
+	g.Go(func() error { return ar.callEncodeLoop(sendFrame) })
+	g.Go(func() error {
+		// The call is over once the remote frames end.
+		defer stop()
+		return ar.callDecodeLoop(gctx, recvFrames)
+	})
+	g.Go(func() error {
+		err := ar.playbackLoop(gctx)
+		if errors.Is(err, context.Canceled) {
+			err = nil
+		}
+		return err
+	})
+
+	ar.mtx.Unlock()
+
+	err := g.Wait()
+
+	ar.mtx.Lock()
+	ar.recording = false
+	ar.playing = false
+	ar.mtx.Unlock()
+
+	ar.log.Infof("Finished call audio stream after %s", time.Since(start))
+	return err
+}
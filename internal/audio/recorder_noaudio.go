@@ -33,3 +33,7 @@ func (ar *NoteRecorder) OpusFile() ([]byte, error) { return nil, errAudioDisable
 func (ar *NoteRecorder) Capture(ctx context.Context) error { return errAudioDisabledCompilation }
 
 func (ar *NoteRecorder) Playback(ctx context.Context) error { return errAudioDisabledCompilation }
+
+func (ar *NoteRecorder) Call(ctx context.Context, sendFrame func([]byte) error, recvFrames <-chan []byte) error {
+	return errAudioDisabledCompilation
+}
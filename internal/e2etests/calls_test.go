@@ -0,0 +1,101 @@
+package e2etests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// assertCallFrames sends nbFrames frames in the call from src and asserts they
+// are played back in order by dst. Packets that arrive too late to be played
+// are replaced by empty frames, so those are tolerated as long as some frames
+// are played.
+func assertCallFrames(t testing.TB, src, dst *client.VoiceCall, nbFrames int) {
+	t.Helper()
+	for i := 0; i < nbFrames; i++ {
+		frame := []byte(fmt.Sprintf("frame %03d", i))
+		assert.NilErr(t, src.SendFrame(frame))
+	}
+	var played int
+	for i := 0; i < nbFrames; i++ {
+		select {
+		case frame := <-dst.Frames():
+			if frame == nil {
+				continue
+			}
+			want := fmt.Sprintf("frame %03d", i)
+			if string(frame) != want {
+				t.Fatalf("unexpected frame: got %q, want %q",
+					frame, want)
+			}
+			played++
+		case <-time.After(30 * time.Second):
+			t.Fatalf("timeout waiting for frame %d", i)
+		}
+	}
+	if played == 0 {
+		t.Fatalf("no frames were played")
+	}
+}
+
+// TestVoiceCall tests offering, accepting, streaming and hanging up a voice
+// call.
+func TestVoiceCall(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	bobOfferChan := make(chan *client.VoiceCall, 5)
+	bob.handle(client.OnCallOfferedNtfn(func(_ *client.RemoteUser, call *client.VoiceCall, _ uint64) {
+		bobOfferChan <- call
+	}))
+	aliceAnswerChan := make(chan bool, 5)
+	alice.handle(client.OnCallAnsweredNtfn(func(_ *client.RemoteUser, _ *client.VoiceCall, accepted bool, _ string) {
+		aliceAnswerChan <- accepted
+	}))
+	bobEndedChan := make(chan bool, 5)
+	bob.handle(client.OnCallEndedNtfn(func(_ *client.RemoteUser, _ *client.VoiceCall, _ string, byRemote bool) {
+		bobEndedChan <- byRemote
+	}))
+
+	// Bob rejects the first call.
+	aliceCall, err := alice.OfferCall(bob.PublicID())
+	assert.NilErr(t, err)
+	bobCall := assert.ChanWritten(t, bobOfferChan)
+	assert.DeepEqual(t, bobCall.ID, aliceCall.ID)
+	assert.NilErr(t, bob.RejectCall(bobCall.ID, "busy"))
+	assert.ChanWrittenWithVal(t, aliceAnswerChan, false)
+	assert.DeepEqual(t, aliceCall.State(), client.CallStateEnded)
+
+	// Bob accepts the second call.
+	aliceCall, err = alice.OfferCall(bob.PublicID())
+	assert.NilErr(t, err)
+	bobCall = assert.ChanWritten(t, bobOfferChan)
+	_, err = bob.AcceptCall(bobCall.ID)
+	assert.NilErr(t, err)
+	assert.ChanWrittenWithVal(t, aliceAnswerChan, true)
+	assert.DeepEqual(t, aliceCall.State(), client.CallStateActive)
+
+	// Frames flow in both directions.
+	const nbFrames = 40
+	assertCallFrames(t, aliceCall, bobCall, nbFrames)
+	assertCallFrames(t, bobCall, aliceCall, nbFrames)
+	stats := bobCall.Stats()
+	if stats.PacketsReceived == 0 || stats.PacketsSent == 0 {
+		t.Fatalf("unexpected call stats: %+v", stats)
+	}
+
+	// Alice hangs up.
+	assert.NilErr(t, alice.HangupCall(aliceCall.ID, "bye"))
+	assert.ChanWrittenWithVal(t, bobEndedChan, true)
+	assert.DeepEqual(t, bobCall.State(), client.CallStateEnded)
+	_, err = bob.Call(bobCall.ID)
+	assert.ErrorIs(t, err, client.ErrCallNotFound)
+}
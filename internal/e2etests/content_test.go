@@ -1,9 +1,17 @@
 package e2etests
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -11,12 +19,50 @@ import (
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/mediamsg"
 	"github.com/companyzero/bisonrelay/internal/testutils"
 	"github.com/companyzero/bisonrelay/ratchet"
 	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/davecgh/go-spew/spew"
 )
 
+// hookChunkPayments hooks the mock payment clients of the buyer and sellers,
+// such that invoices generated by the sellers are reported as settled when
+// paid by the buyer.
+func hookChunkPayments(buyer *testClient, sellers ...*testClient) {
+	type hookedInvoice struct {
+		amt int64
+		cb  func(int64)
+	}
+	var invoicesMtx sync.Mutex
+	var nbInvoices int
+	invoices := map[string]hookedInvoice{}
+	for _, seller := range sellers {
+		seller.mpc.HookGetInvoice(func(amt int64, cb func(int64)) (string, error) {
+			invoicesMtx.Lock()
+			id := fmt.Sprintf("hooked-inv-%03d", nbInvoices)
+			nbInvoices++
+			invoices[id] = hookedInvoice{amt: amt, cb: cb}
+			invoicesMtx.Unlock()
+			return id, nil
+		})
+	}
+	buyer.mpc.HookPayInvoice(func(id string) (int64, error) {
+		invoicesMtx.Lock()
+		inv, ok := invoices[id]
+		invoicesMtx.Unlock()
+		if !ok {
+			// Not a hooked invoice.
+			return 0, nil
+		}
+
+		// Tell the seller that the buyer paid the invoice.
+		inv.cb(inv.amt)
+		return inv.amt, nil
+	})
+}
+
 // TestFtDownloadFile verifies the behavior of downloading files from a remote
 // user.
 func TestFtDownloadFile(t *testing.T) {
@@ -136,6 +182,297 @@ func TestFtDownloadFile(t *testing.T) {
 	assert.EqualFiles(t, fGlobal, completedPath1)
 }
 
+// TestFtSwarmDownload verifies that a file shared by multiple users is
+// downloaded from all of them.
+func TestFtSwarmDownload(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob", withSwarmDownloads(time.Minute))
+	charlie := ts.newClient("charlie")
+	dave := ts.newClient("dave")
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(bob, charlie)
+	ts.kxUsers(bob, dave)
+
+	completedFileChan := make(chan string, 10)
+	bob.handle(client.OnFileDownloadCompleted(func(user *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
+		completedFileChan <- diskPath
+	}))
+	searchChan := make(chan clientdb.ContentSearch, 10)
+	bob.handle(client.OnContentSearchResultsNtfn(func(_ *client.RemoteUser, search clientdb.ContentSearch) {
+		searchChan <- search
+	}))
+
+	// Hooks to handle chunk payment.
+	hookChunkPayments(bob, alice, charlie, dave)
+
+	// Alice, Charlie and Dave share the same file. Dave charges more for
+	// it.
+	fname := testutils.RandomFile(t, defaultChunkSize*8)
+	sfAlice, _, err := alice.ShareFile(fname, nil, 1, "global file")
+	assert.NilErr(t, err)
+	sfCharlie, _, err := charlie.ShareFile(fname, nil, 1, "same file")
+	assert.NilErr(t, err)
+	_, _, err = dave.ShareFile(fname, nil, 1000, "expensive file")
+	assert.NilErr(t, err)
+
+	// Bob searches for the file, so that other users that share it are
+	// known.
+	assert.NilErr(t, bob.SearchContent("file", nil))
+	var search clientdb.ContentSearch
+	for search.Replied < 3 {
+		search = assert.ChanWritten(t, searchChan)
+	}
+
+	// Bob downloads the file from Alice. The download is completed with
+	// chunks from both Alice and Charlie.
+	assert.NilErr(t, bob.GetUserContent(alice.PublicID(), sfAlice.FID))
+	completedPath := assert.ChanWritten(t, completedFileChan)
+	assert.EqualFiles(t, fname, completedPath)
+
+	fds, err := bob.ListDownloads()
+	assert.NilErr(t, err)
+	if len(fds) != 1 {
+		t.Fatalf("unexpected nb of downloads: got %d, want 1", len(fds))
+	}
+	fd := fds[0]
+	src, ok := fd.Source(charlie.PublicID())
+	if !ok {
+		t.Fatalf("charlie is not a source of the download")
+	}
+	assert.DeepEqual(t, src.FID, sfCharlie.FID)
+	if _, ok := fd.Source(dave.PublicID()); ok {
+		t.Fatalf("dave is a source of the download with a higher cost")
+	}
+	chunksBySource := map[clientdb.UserID]int{}
+	for i := range fd.Metadata.Manifest {
+		chunksBySource[fd.GetChunkSource(i)]++
+	}
+	if chunksBySource[alice.PublicID()] == 0 || chunksBySource[charlie.PublicID()] == 0 {
+		t.Fatalf("chunks not downloaded from both sources: %v", chunksBySource)
+	}
+
+	// Bob knows the file shared by Charlie was already downloaded.
+	downloadedPath, err := bob.HasDownloadedFile(sfCharlie.FID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, downloadedPath, completedPath)
+}
+
+// TestFtDownloadBundle verifies that a dir shared as a bundle is downloaded
+// as a dir tree.
+func TestFtDownloadBundle(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	completedFileChan := make(chan string, 10)
+	bob.handle(client.OnFileDownloadCompleted(func(user *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
+		completedFileChan <- diskPath
+	}))
+	hookChunkPayments(bob, alice)
+
+	// Create the dir tree. File sizes are not multiples of the chunk size,
+	// so chunks straddle file boundaries.
+	srcDir := filepath.Join(t.TempDir(), "album")
+	files := map[string]int{
+		"cover.jpg":          defaultChunkSize*2 + 3,
+		"empty.txt":          0,
+		"photos/001.jpg":     defaultChunkSize + 5,
+		"photos/002.jpg":     defaultChunkSize - 1,
+		"photos/raw/001.raw": defaultChunkSize * 3,
+	}
+	for fname, size := range files {
+		dest := filepath.Join(srcDir, filepath.FromSlash(fname))
+		assert.NilErr(t, os.MkdirAll(filepath.Dir(dest), 0o700))
+		if size == 0 {
+			assert.NilErr(t, os.WriteFile(dest, nil, 0o600))
+			continue
+		}
+		assert.NilErr(t, os.Rename(testutils.RandomFile(t, size), dest))
+	}
+
+	// Symlinks are not followed and are not included in the bundle.
+	linkPath := filepath.Join(srcDir, "link.jpg")
+	if err := os.Symlink(filepath.Join(srcDir, "cover.jpg"), linkPath); err != nil {
+		t.Logf("Unable to create symlink: %v", err)
+	}
+
+	// Alice shares the dir. Sharing it again with Bob reuses the bundle.
+	sf, md, err := alice.ShareDir(srcDir, nil, 1, "photo album")
+	assert.NilErr(t, err)
+	if !md.IsBundle() || len(md.Files) != len(files) {
+		t.Fatalf("unexpected bundle metadata: %s", spew.Sdump(md))
+	}
+	bobUID := bob.PublicID()
+	sf2, _, err := alice.ShareDir(srcDir, &bobUID, 1, "photo album")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, sf2.FID, sf.FID)
+
+	// Bob downloads the bundle.
+	assert.NilErr(t, bob.GetUserContent(alice.PublicID(), sf.FID))
+	completedPath := assert.ChanWritten(t, completedFileChan)
+	assert.DeepEqual(t, filepath.Base(completedPath), "album")
+	for fname := range files {
+		fpath := filepath.FromSlash(fname)
+		assert.EqualFiles(t, filepath.Join(srcDir, fpath),
+			filepath.Join(completedPath, fpath))
+	}
+	if _, err := os.Lstat(filepath.Join(completedPath, "link.jpg")); !os.IsNotExist(err) {
+		t.Fatalf("unexpected symlink in downloaded bundle: %v", err)
+	}
+}
+
+// TestFtStreamDownload verifies that a download can be read as a stream
+// while it is still being downloaded.
+func TestFtStreamDownload(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	progressChan := make(chan int, 100)
+	bob.handle(client.OnFileDownloadProgress(func(user *client.RemoteUser, fm rpc.FileMetadata, nbMissingChunks int) {
+		progressChan <- nbMissingChunks
+	}))
+	hookChunkPayments(bob, alice)
+
+	fname := testutils.RandomFile(t, defaultChunkSize*12+7)
+	wantData, err := os.ReadFile(fname)
+	assert.NilErr(t, err)
+	sf, _, err := alice.ShareFile(fname, nil, 1, "movie")
+	assert.NilErr(t, err)
+
+	// Bob starts the download and opens a stream once the metadata has
+	// been received.
+	assert.NilErr(t, bob.GetUserContent(alice.PublicID(), sf.FID))
+	assert.ChanWritten(t, progressChan)
+	s, err := bob.OpenDownloadStream(context.Background(), sf.FID)
+	assert.NilErr(t, err)
+	defer s.Close()
+	assert.DeepEqual(t, s.Size(), int64(len(wantData)))
+
+	// Read a section at the end of the file first, then the entire file.
+	tail := make([]byte, defaultChunkSize)
+	_, err = s.Seek(-int64(len(tail)), io.SeekEnd)
+	assert.NilErr(t, err)
+	_, err = io.ReadFull(s, tail)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, tail, wantData[len(wantData)-len(tail):])
+
+	_, err = s.Seek(0, io.SeekStart)
+	assert.NilErr(t, err)
+	gotData, err := io.ReadAll(s)
+	assert.NilErr(t, err)
+	if !bytes.Equal(gotData, wantData) {
+		t.Fatalf("streamed data does not match original file")
+	}
+
+	// Fetch a range of the file through the local stream server.
+	streamURL, err := bob.DownloadStreamURL(sf.FID)
+	assert.NilErr(t, err)
+	req, err := http.NewRequest("GET", streamURL, nil)
+	assert.NilErr(t, err)
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", defaultChunkSize/2,
+		defaultChunkSize*3+1))
+	res, err := http.DefaultClient.Do(req)
+	assert.NilErr(t, err)
+	defer res.Body.Close()
+	assert.DeepEqual(t, res.StatusCode, http.StatusPartialContent)
+	gotData, err = io.ReadAll(res.Body)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, gotData, wantData[defaultChunkSize/2:defaultChunkSize*3+2])
+
+	// Requests without the session token are rejected.
+	u, err := url.Parse(streamURL)
+	assert.NilErr(t, err)
+	u.Path = "/" + sf.FID.String()
+	res, err = http.Get(u.String())
+	assert.NilErr(t, err)
+	res.Body.Close()
+	assert.DeepEqual(t, res.StatusCode, http.StatusNotFound)
+	u.Path = "/badtoken/" + sf.FID.String()
+	res, err = http.Get(u.String())
+	assert.NilErr(t, err)
+	res.Body.Close()
+	assert.DeepEqual(t, res.StatusCode, http.StatusNotFound)
+}
+
+// TestFtSearchContent verifies searching for content across multiple users.
+func TestFtSearchContent(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(bob, charlie)
+
+	searchChan := make(chan clientdb.ContentSearch, 10)
+	bob.handle(client.OnContentSearchResultsNtfn(func(_ *client.RemoteUser, search clientdb.ContentSearch) {
+		searchChan <- search
+	}))
+	listedFilesChan := make(chan []clientdb.RemoteFile, 10)
+	bob.handle(client.OnContentListReceived(func(_ *client.RemoteUser, files []clientdb.RemoteFile, listErr error) {
+		listedFilesChan <- files
+	}))
+
+	// Alice and Charlie share the same report. Charlie shares an
+	// additional file.
+	fReport := testutils.RandomFile(t, defaultChunkSize*2)
+	fPhoto := testutils.RandomFile(t, defaultChunkSize)
+	_, mdAlice, err := alice.ShareFile(fReport, nil, 2, "Quarterly Report")
+	assert.NilErr(t, err)
+	_, mdCharlie, err := charlie.ShareFile(fReport, nil, 1, "the report")
+	assert.NilErr(t, err)
+	_, _, err = charlie.ShareFile(fPhoto, nil, 1, "vacation photo")
+	assert.NilErr(t, err)
+
+	// Listing files with a filter only returns the matching files.
+	assert.NilErr(t, bob.ListUserContent(charlie.PublicID(),
+		[]string{rpc.RMFTDGlobal}, "vacation"))
+	files := assert.ChanWritten(t, listedFilesChan)
+	if len(files) != 1 || files[0].Metadata.Description != "vacation photo" {
+		t.Fatalf("unexpected listed files: %s", spew.Sdump(files))
+	}
+
+	// Bob searches for the report. Both replies are aggregated in a
+	// single result, with Charlie (the cheapest seller) first.
+	assert.NilErr(t, bob.SearchContent("REPORT", nil))
+	var search clientdb.ContentSearch
+	for search.Replied < 2 {
+		search = assert.ChanWritten(t, searchChan)
+	}
+	assert.DeepEqual(t, search.Queried, 2)
+	if len(search.Results) != 1 {
+		t.Fatalf("unexpected search results: %s", spew.Sdump(search))
+	}
+	res := search.Results[0]
+	assert.DeepEqual(t, res.Hash, mdAlice.Hash)
+	if len(res.Sellers) != 2 {
+		t.Fatalf("unexpected sellers: %s", spew.Sdump(res.Sellers))
+	}
+	assert.DeepEqual(t, res.Sellers[0].UID, charlie.PublicID())
+	assert.DeepEqual(t, res.Sellers[0].FID, mdCharlie.MetadataHash())
+	assert.DeepEqual(t, res.Sellers[1].UID, alice.PublicID())
+
+	// The results are cached.
+	cached, err := bob.ContentSearchResults("REPORT")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, cached.Results, search.Results)
+}
+
 // TestFtSendFile tests that the send file feature works.
 func TestFtSendFile(t *testing.T) {
 	t.Parallel()
@@ -306,3 +643,76 @@ func TestFtSendFileReceiverRestarts(t *testing.T) {
 	assert.EqualFiles(t, fSent, completedPath1)
 	_ = bob
 }
+
+// TestMediaMessages tests sending small media messages inline and large media
+// messages through file transfer.
+func TestMediaMessages(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	pmChan := make(chan string, 10)
+	bob.handle(client.OnPMNtfn(func(_ *client.RemoteUser, pm rpc.RMPrivateMessage, _ time.Time) {
+		pmChan <- pm.Message
+	}))
+	completedFileChan := make(chan string, 10)
+	completedFidChan := make(chan zkidentity.ShortID, 10)
+	bob.handle(client.OnFileDownloadCompleted(func(_ *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
+		completedFidChan <- zkidentity.ShortID(fm.MetadataHash())
+		completedFileChan <- diskPath
+	}))
+
+	rng := testRand(t)
+	writeMedia := func(name string, size int) string {
+		data := make([]byte, size)
+		rng.Read(data)
+		path := filepath.Join(t.TempDir(), name)
+		assert.NilErr(t, os.WriteFile(path, data, 0o600))
+		return path
+	}
+
+	// Small media is sent inline.
+	smallPath := writeMedia("small.webm", 1024)
+	m, err := mediamsg.LoadFile(smallPath)
+	assert.NilErr(t, err)
+	m.Duration = 1500 * time.Millisecond
+	m.Thumbnail = []byte("thumb")
+	inline, err := alice.SendMediaPM(bob.PublicID(), m, "small clip")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, inline, true)
+
+	msg := assert.ChanWritten(t, pmChan)
+	if !strings.HasPrefix(msg, "small clip\n") {
+		t.Fatalf("caption not found in message %q", msg)
+	}
+	media := mediamsg.ParseMessage(msg)
+	assert.DeepEqual(t, len(media), 1)
+	wantData, err := os.ReadFile(smallPath)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, media[0].Data, wantData)
+	assert.DeepEqual(t, media[0].MimeType, "video/webm")
+	assert.DeepEqual(t, media[0].Duration, m.Duration)
+	assert.DeepEqual(t, media[0].Thumbnail, m.Thumbnail)
+
+	// Large media is sent as a file transfer.
+	largePath := writeMedia("large.webm", client.MediaInlineMaxSize+1)
+	m, err = mediamsg.LoadFile(largePath)
+	assert.NilErr(t, err)
+	inline, err = alice.SendMediaPM(bob.PublicID(), m, "")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, inline, false)
+
+	completedPath := assert.ChanWritten(t, completedFileChan)
+	assert.EqualFiles(t, largePath, completedPath)
+	msg = assert.ChanWritten(t, pmChan)
+	media = mediamsg.ParseMessage(msg)
+	assert.DeepEqual(t, len(media), 1)
+	assert.DeepEqual(t, len(media[0].Data), 0)
+	assert.DeepEqual(t, media[0].Filename, "large.webm")
+	assert.DeepEqual(t, media[0].Size, uint64(client.MediaInlineMaxSize+1))
+	assert.DeepEqual(t, media[0].FileID, assert.ChanWritten(t, completedFidChan))
+}
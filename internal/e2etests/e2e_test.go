@@ -104,6 +104,10 @@ type clientCfg struct {
 	disableAutoUnsubIdle bool
 	disableAutoHandshake bool
 	gcInviteExpiration   time.Duration
+	gcListChunkSize      int
+	swarmDownloads       bool
+	swarmChunkTimeout    time.Duration
+	logMsgs              bool
 }
 
 const defaultAutoUnsubIdleUserInterval = 14 * time.Second
@@ -195,6 +199,29 @@ func withGCInviteExpiration(d time.Duration) newClientOpt {
 	}
 }
 
+func withGCListChunkSize(n int) newClientOpt {
+	return func(cfg *clientCfg) {
+		cfg.gcListChunkSize = n
+	}
+}
+
+// withSwarmDownloads enables downloading files from multiple sources. Chunks
+// not received after chunkTimeout are requested from a different source.
+func withSwarmDownloads(chunkTimeout time.Duration) newClientOpt {
+	return func(cfg *clientCfg) {
+		cfg.swarmDownloads = true
+		cfg.swarmChunkTimeout = chunkTimeout
+	}
+}
+
+// withMsgsLog enables logging PM and GC messages to the client's messages
+// log dir.
+func withMsgsLog() newClientOpt {
+	return func(cfg *clientCfg) {
+		cfg.logMsgs = true
+	}
+}
+
 func withLogName(s string) newClientOpt {
 	return func(cfg *clientCfg) {
 		cfg.logName = s
@@ -442,6 +469,9 @@ func (ts *testScaffold) newClientWithCfg(nccfg *clientCfg, opts ...newClientOpt)
 		Logger:        dbLog,
 		ChunkSize:     defaultChunkSize,
 	}
+	if nccfg.logMsgs {
+		dbCfg.MsgsRoot = filepath.Join(rootDir, "logs")
+	}
 	db, err := clientdb.New(dbCfg)
 	assert.NilErr(ts.t, err)
 
@@ -482,6 +512,9 @@ func (ts *testScaffold) newClientWithCfg(nccfg *clientCfg, opts ...newClientOpt)
 		GCMQInitialDelay: time.Second,
 
 		GCInviteExpiration: nccfg.gcInviteExpiration,
+		GCListChunkSize:    nccfg.gcListChunkSize,
+		SwarmDownloads:     nccfg.swarmDownloads,
+		SwarmChunkTimeout:  nccfg.swarmChunkTimeout,
 
 		RecentMediateIDThreshold:   chooseTimeout(time.Second, 3*time.Second),
 		UnkxdWarningTimeout:        chooseTimeout(250*time.Millisecond, time.Second),
@@ -1,15 +1,18 @@
 package e2etests
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"regexp"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/internal/assert"
 	"github.com/companyzero/bisonrelay/rpc"
@@ -590,6 +593,10 @@ func TestBlockIgnore(t *testing.T) {
 	bob.handle(client.OnBlockNtfn(func(user *client.RemoteUser) {
 		bobBlockedChan <- struct{}{}
 	}))
+	aliceABChangedChan := make(chan client.AddressBookChange, 3)
+	alice.handle(client.OnAddressBookChangedNtfn(func(_ *client.RemoteUser, change client.AddressBookChange) {
+		aliceABChangedChan <- change
+	}))
 
 	ts.kxUsers(alice, bob)
 	ts.kxUsers(alice, charlie)
@@ -606,6 +613,7 @@ func TestBlockIgnore(t *testing.T) {
 
 	// Alice ignores bob.
 	assert.NilErr(t, alice.Ignore(bob.PublicID(), true))
+	assert.DeepEqual(t, assert.ChanWritten(t, aliceABChangedChan), client.AddressBookChangeIgnored)
 
 	// Alice can msg Bob, Bob cannot msg Alice.
 	assertClientsCanPMOneWay(t, alice, bob)
@@ -613,11 +621,13 @@ func TestBlockIgnore(t *testing.T) {
 
 	// Alice un-ignores Bob. Bob can now msg Alice again.
 	assert.NilErr(t, alice.Ignore(bob.PublicID(), false))
+	assert.DeepEqual(t, assert.ChanWritten(t, aliceABChangedChan), client.AddressBookChangeUnignored)
 	assertClientsCanPM(t, alice, bob)
 
 	// Alice blocks Bob.
 	assert.NilErr(t, alice.Block(bob.PublicID()))
 	assert.ChanWritten(t, bobBlockedChan)
+	assert.DeepEqual(t, assert.ChanWritten(t, aliceABChangedChan), client.AddressBookChangeBlocked)
 
 	// Attempting to send a message from either Bob or Alice errors.
 	assert.NonNilErr(t, alice.PM(bob.PublicID(), ""))
@@ -1318,3 +1328,347 @@ func TestGCInviteExpiration(t *testing.T) {
 	assert.NilErr(t, bob.AcceptGroupChatInvite(iid3))
 	assert.ChanWritten(t, bobJoinedGCChan)
 }
+
+// TestChannels tests the basic features of announcement-only channels.
+func TestChannels(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+	dave := ts.newClient("dave")
+
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(alice, charlie)
+	ts.kxUsers(alice, dave)
+
+	// Alice creates a channel and generates a shareable invite for it.
+	gcID, err := alice.NewChannel("news")
+	assert.NilErr(t, err)
+	invite, err := alice.ChannelShareInvite(gcID, false)
+	assert.NilErr(t, err)
+
+	// The invite survives encoding.
+	decoded, err := client.ParseChannelInvite(invite.String())
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, decoded, invite)
+
+	// Bob and Charlie join using the same invite.
+	assert.NilErr(t, bob.JoinChannel(decoded))
+	assertClientInGC(t, bob, gcID)
+	assert.NilErr(t, charlie.JoinChannel(decoded))
+	assertClientInGC(t, charlie, gcID)
+	assertClientSeesInGC(t, alice, gcID, charlie.PublicID())
+
+	// Subscribers only know about the owner and themselves.
+	bobGC, err := bob.GetGC(gcID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, bobGC.Channel, true)
+	assert.DeepEqual(t, bobGC.Members, []zkidentity.ShortID{alice.PublicID(), bob.PublicID()})
+	assert.DeepEqual(t, alice.GetGCDestCount(gcID), 2)
+
+	// Alice's messages are seen by subscribers.
+	assertClientsCanSeeGCM(t, gcID, alice, bob, charlie)
+
+	// Subscribers cannot publish.
+	err = bob.GCMessage(gcID, "reply", 0, nil)
+	assert.NonNilErr(t, err)
+
+	// Regenerating the invite invalidates the old one.
+	newInvite, err := alice.ChannelShareInvite(gcID, true)
+	assert.NilErr(t, err)
+	if newInvite.Token == invite.Token {
+		t.Fatal("invite token was not regenerated")
+	}
+	assert.NilErr(t, dave.JoinChannel(invite))
+	time.Sleep(time.Second)
+	assertGCDoesNotExist(t, gcID, dave)
+
+	// Kicking Charlie removes the channel from Charlie, but Bob still
+	// receives messages.
+	charliePartedChan := charlie.nextGCUserPartedIs(gcID, charlie.PublicID(), true)
+	assert.NilErr(t, alice.GCKick(gcID, charlie.PublicID(), "bye"))
+	assert.NilErrFromChan(t, charliePartedChan)
+	assertGCDoesNotExist(t, gcID, charlie)
+	assertClientsCanSeeGCM(t, gcID, alice, bob)
+
+	// Charlie cannot rejoin using the share token.
+	assert.NilErr(t, charlie.JoinChannel(newInvite))
+	time.Sleep(time.Second)
+	assertGCDoesNotExist(t, gcID, charlie)
+
+	// A direct invite from Alice allows Charlie to rejoin.
+	assertJoinsGC(t, alice, charlie, gcID)
+	assertClientInGC(t, charlie, gcID)
+	assertClientsCanSeeGCM(t, gcID, alice, bob, charlie)
+}
+
+// TestGCHistorySync tests that members that join a GC configured to share its
+// history receive the recent messages of the GC.
+func TestGCHistorySync(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice", withMsgsLog())
+	bob := ts.newClient("bob", withMsgsLog())
+	charlie := ts.newClient("charlie", withMsgsLog())
+	dave := ts.newClient("dave", withMsgsLog())
+
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(alice, charlie)
+	ts.kxUsers(alice, dave)
+	ts.kxUsers(bob, charlie)
+	ts.kxUsers(bob, dave)
+
+	// Alice creates the GC and Bob joins. Exchange some messages.
+	gcID, err := alice.NewGroupChat("test gc")
+	assert.NilErr(t, err)
+	assertJoinsGC(t, alice, bob, gcID)
+	assertClientInGC(t, bob, gcID)
+	assertClientSeesInGC(t, alice, gcID, bob.PublicID())
+	assertClientsCanSeeGCM(t, gcID, alice, bob)
+	assertClientsCanSeeGCM(t, gcID, alice, bob)
+	assertClientsCanSeeGCM(t, gcID, bob, alice)
+
+	// Wait until the messages are logged by alice (received messages are
+	// only logged after the gcm cacher delay).
+	for i := 0; i < 100; i++ {
+		msgs, _, err := alice.ReadHistoryMessages(gcID, true, 100, 0)
+		assert.NilErr(t, err)
+		if len(msgs) > 0 && msgs[len(msgs)-1].From == "bob" {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Charlie joins without history sharing enabled and receives nothing.
+	historyChan := make(chan []rpc.RMGCHistoryEntry, 5)
+	charlie.handle(client.OnGCHistoryReceivedNtfn(func(_ *client.RemoteUser, _ rpc.RMGroupList, entries []rpc.RMGCHistoryEntry) {
+		historyChan <- entries
+	}))
+	assertJoinsGC(t, alice, charlie, gcID)
+	assertClientInGC(t, charlie, gcID)
+	assert.ChanNotWritten(t, historyChan, time.Second)
+
+	// Charlie still expects history after restarting, so Bob (who is not
+	// the inviting admin) can send it.
+	charlie = ts.recreateClient(charlie, withMsgsLog())
+	charlie.handle(client.OnGCHistoryReceivedNtfn(func(_ *client.RemoteUser, _ rpc.RMGroupList, entries []rpc.RMGCHistoryEntry) {
+		historyChan <- entries
+	}))
+	assertClientSeesInGC(t, bob, gcID, charlie.PublicID())
+	assert.NilErr(t, bob.SendGCHistory(gcID, charlie.PublicID(), 1))
+	entries := assert.ChanWritten(t, historyChan)
+	assert.DeepEqual(t, len(entries), 1)
+	assert.DeepEqual(t, entries[0].Message, "msg from bob")
+
+	// Only a single history bundle is merged.
+	assert.NilErr(t, alice.SendGCHistory(gcID, charlie.PublicID(), 1))
+	assert.ChanNotWritten(t, historyChan, time.Second)
+
+	// Enable history sharing. Dave receives the 2 most recent messages.
+	assert.NilErr(t, alice.SetGCShareHistory(gcID, 2))
+	historyChan = make(chan []rpc.RMGCHistoryEntry, 5)
+	dave.handle(client.OnGCHistoryReceivedNtfn(func(_ *client.RemoteUser, _ rpc.RMGroupList, entries []rpc.RMGCHistoryEntry) {
+		historyChan <- entries
+	}))
+	assertJoinsGC(t, alice, dave, gcID)
+	entries = assert.ChanWritten(t, historyChan)
+	assert.DeepEqual(t, len(entries), 2)
+	assert.DeepEqual(t, entries[0].From, "alice")
+	assert.DeepEqual(t, entries[0].Message, "msg from alice")
+	assert.DeepEqual(t, entries[1].From, "bob")
+	assert.DeepEqual(t, entries[1].Message, "msg from bob")
+
+	// The history was merged into Dave's GC log.
+	msgs, _, err := dave.ReadHistoryMessages(gcID, true, 100, 0)
+	assert.NilErr(t, err)
+	var foundMsg bool
+	for _, msg := range msgs {
+		foundMsg = foundMsg || msg.Message == "msg from bob"
+	}
+	if !foundMsg {
+		t.Fatalf("history message not found in GC log: %v", msgs)
+	}
+
+	// Further history sent by other members is ignored.
+	assertClientSeesInGC(t, bob, gcID, dave.PublicID())
+	assert.NilErr(t, bob.SendGCHistory(gcID, dave.PublicID(), 10))
+	assert.ChanNotWritten(t, historyChan, time.Second)
+
+	// Subscribers joining a channel through its shared invite also
+	// receive the channel history.
+	chanID, err := alice.NewChannel("news")
+	assert.NilErr(t, err)
+	invite, err := alice.ChannelShareInvite(chanID, false)
+	assert.NilErr(t, err)
+	assert.NilErr(t, bob.JoinChannel(invite))
+	assertClientInGC(t, bob, chanID)
+	assertClientsCanSeeGCM(t, chanID, alice, bob)
+	assert.NilErr(t, alice.SetGCShareHistory(chanID, 1))
+	historyChan = make(chan []rpc.RMGCHistoryEntry, 5)
+	charlie.handle(client.OnGCHistoryReceivedNtfn(func(_ *client.RemoteUser, _ rpc.RMGroupList, entries []rpc.RMGCHistoryEntry) {
+		historyChan <- entries
+	}))
+	assert.NilErr(t, charlie.JoinChannel(invite))
+	assertClientInGC(t, charlie, chanID)
+	entries = assert.ChanWritten(t, historyChan)
+	assert.DeepEqual(t, len(entries), 1)
+	assert.DeepEqual(t, entries[0].From, "alice")
+}
+
+// TestLargeGCMembership tests that membership changes in large version 3 GCs
+// are sent as deltas and that large GC lists are sent in chunks.
+func TestLargeGCMembership(t *testing.T) {
+	t.Parallel()
+
+	const nbFakeMembers = 300
+	const chunkSize = 100
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice", withGCListChunkSize(chunkSize))
+	bob := ts.newClient("bob", withGCListChunkSize(chunkSize))
+	charlie := ts.newClient("charlie", withGCListChunkSize(chunkSize))
+
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(alice, charlie)
+
+	// Track the GC messages received by Bob.
+	var bobLists, bobChunks, bobDeltas atomic.Int32
+	bob.handle(client.OnRMReceived(func(_ *client.RemoteUser, _ *rpc.RMHeader, p interface{}, _ time.Time) {
+		switch p.(type) {
+		case rpc.RMGroupList:
+			bobLists.Add(1)
+		case rpc.RMGroupListChunk:
+			bobChunks.Add(1)
+		case rpc.RMGroupMembersDelta:
+			bobDeltas.Add(1)
+		}
+	}))
+
+	// Alice creates a GC and adds a large number of (fake) members to it.
+	gcID, err := alice.NewGroupChatVersion("test gc", 3)
+	assert.NilErr(t, err)
+	rnd := testRand(t)
+	fakeMembers := make([]zkidentity.ShortID, nbFakeMembers)
+	for i := range fakeMembers {
+		rnd.Read(fakeMembers[i][:])
+	}
+	err = alice.db.Update(context.Background(), func(tx clientdb.ReadWriteTx) error {
+		gc, err := alice.db.GetGC(tx, gcID)
+		if err != nil {
+			return err
+		}
+		gc.Metadata.Members = append(gc.Metadata.Members, fakeMembers...)
+		return alice.db.SaveGC(tx, gc)
+	})
+	assert.NilErr(t, err)
+
+	// The fake members will never complete a KX, so avoid Bob and Charlie
+	// flooding Alice with mediate id requests for them.
+	for _, tc := range []*testClient{bob, charlie} {
+		err = tc.db.Update(context.Background(), func(tx clientdb.ReadWriteTx) error {
+			for _, uid := range fakeMembers {
+				err := tc.db.StoreMediateIDRequested(tx, alice.PublicID(), uid)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		assert.NilErr(t, err)
+	}
+
+	// assertSameGC asserts the client eventually has the same GC
+	// definition as Alice.
+	assertSameGC := func(tc *testClient) {
+		t.Helper()
+		aliceGC, err := alice.GetGC(gcID)
+		assert.NilErr(t, err)
+		var gc rpc.RMGroupList
+		for i := 0; i < 100; i++ {
+			gc, err = tc.GetGC(gcID)
+			if err == nil && gc.Generation == aliceGC.Generation {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, gc.Generation, aliceGC.Generation)
+		assert.DeepEqual(t, gc.Members, aliceGC.Members)
+	}
+
+	// Bob joins. The list is larger than the chunk size, so Bob receives
+	// it in chunks.
+	assertJoinsGC(t, alice, bob, gcID)
+	assertClientInGC(t, bob, gcID)
+	assertSameGC(bob)
+	wantChunks := int32((nbFakeMembers + 2 + chunkSize - 1) / chunkSize)
+	assert.DeepEqual(t, bobChunks.Load(), wantChunks)
+	assert.DeepEqual(t, bobLists.Load(), int32(0))
+
+	// Charlie joins. Bob receives only a delta.
+	assertJoinsGC(t, alice, charlie, gcID)
+	assertClientInGC(t, charlie, gcID)
+	assertSameGC(charlie)
+	assertSameGC(bob)
+	assert.DeepEqual(t, bobDeltas.Load(), int32(1))
+	assert.DeepEqual(t, bobChunks.Load(), wantChunks)
+
+	// Alice kicks a member. Bob and Charlie receive the removal delta.
+	bobPartedChan := bob.nextGCUserPartedIs(gcID, fakeMembers[0], true)
+	assert.NilErr(t, alice.GCKick(gcID, fakeMembers[0], "kicked"))
+	assert.NilErrFromChan(t, bobPartedChan)
+	assertSameGC(bob)
+	assertSameGC(charlie)
+	assert.DeepEqual(t, bobDeltas.Load(), int32(2))
+
+	// Simulate Bob missing the last delta. The next delta does not chain
+	// to Bob's GC, so Bob requests and receives the full list again.
+	err = bob.db.Update(context.Background(), func(tx clientdb.ReadWriteTx) error {
+		gc, err := bob.db.GetGC(tx, gcID)
+		if err != nil {
+			return err
+		}
+		gc.Metadata.Members = append(gc.Metadata.Members, fakeMembers[0])
+		gc.Metadata.Generation -= 1
+		return bob.db.SaveGC(tx, gc)
+	})
+	assert.NilErr(t, err)
+	assert.NilErr(t, alice.GCKick(gcID, fakeMembers[1], "kicked"))
+	assertSameGC(bob)
+	assertSameGC(charlie)
+	assert.DeepEqual(t, bobChunks.Load(), wantChunks*2)
+	assert.DeepEqual(t, bobLists.Load(), int32(0))
+
+	// Bob and Charlie can still see messages sent in the GC.
+	assertClientsCanSeeGCM(t, gcID, alice, bob, charlie)
+
+	// Chunked lists that were not requested are ignored, even when they
+	// are sent by the admin.
+	err = bob.db.Update(context.Background(), func(tx clientdb.ReadWriteTx) error {
+		gc, err := bob.db.GetGC(tx, gcID)
+		if err != nil {
+			return err
+		}
+		gc.Metadata.Generation -= 1
+		return bob.db.SaveGC(tx, gc)
+	})
+	assert.NilErr(t, err)
+	bobID := bob.PublicID()
+	assert.NilErr(t, alice.ResendGCList(gcID, &bobID))
+	for i := 0; i < 100 && bobChunks.Load() < wantChunks*3; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.DeepEqual(t, bobChunks.Load(), wantChunks*3)
+	time.Sleep(100 * time.Millisecond)
+	aliceGC, err := alice.GetGC(gcID)
+	assert.NilErr(t, err)
+	bobGC, err := bob.GetGC(gcID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, bobGC.Generation, aliceGC.Generation-1)
+}
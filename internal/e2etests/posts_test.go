@@ -2,13 +2,20 @@ package e2etests
 
 import (
 	"context"
+	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/client/rpcserver"
+	"github.com/companyzero/bisonrelay/clientrpc/jsonrpc"
+	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/internal/tlsconn"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
 )
@@ -507,3 +514,169 @@ func TestResubscribeToPosts(t *testing.T) {
 	assertPostSubscription(t, bob, alice, false)
 	assertReceivesNewPost(t, alice, []*testClient{bob})
 }
+
+// newPostsServiceClient starts a clientrpc server bound to the PostsService of
+// the given client and returns a JSON-RPC client for it.
+func newPostsServiceClient(t testing.TB, tc *testClient) types.PostsServiceClient {
+	t.Helper()
+
+	dir := t.TempDir()
+	listeners, err := tlsconn.TLSListeners(tlsconn.TLSListenersConfig{
+		Addresses:                   []string{"127.0.0.1:0"},
+		CertPath:                    filepath.Join(dir, "rpc.cert"),
+		KeyPath:                     filepath.Join(dir, "rpc.key"),
+		CreateCertPairIfNotExists:   true,
+		ClientCAPath:                filepath.Join(dir, "rpc-ca.cert"),
+		ClientCertPath:              filepath.Join(dir, "rpc-client.cert"),
+		ClientKeyPath:               filepath.Join(dir, "rpc-client.key"),
+		CreateClientCertIfNotExists: true,
+	})
+	assert.NilErr(t, err)
+
+	s := rpcserver.New(rpcserver.Config{
+		JSONRPCListeners: listeners,
+		Log:              tc.log,
+	})
+	err = s.InitPostsService(rpcserver.PostsServerCfg{
+		Client:            tc.Client,
+		Log:               tc.log,
+		RootReplayMsgLogs: filepath.Join(dir, "replaymsglog"),
+	})
+	assert.NilErr(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.Run(ctx)
+
+	_, port, err := net.SplitHostPort(listeners[0].Addr().String())
+	assert.NilErr(t, err)
+	c, err := jsonrpc.NewWSClient(
+		jsonrpc.WithWebsocketURL("wss://localhost:"+port+"/ws"),
+		jsonrpc.WithServerTLSCertPath(filepath.Join(dir, "rpc.cert")),
+		jsonrpc.WithClientTLSCert(filepath.Join(dir, "rpc-client.cert"),
+			filepath.Join(dir, "rpc-client.key")),
+	)
+	assert.NilErr(t, err)
+	go c.Run(ctx)
+	return types.NewPostsServiceClient(c)
+}
+
+// TestPostsServiceRPC tests creating and interacting with posts through the
+// clientrpc PostsService.
+func TestPostsServiceRPC(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+
+	bobRecvPosts := make(chan rpc.PostMetadata, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- pm
+	}))
+	aliceRecvStatus := make(chan rpc.PostMetadataStatus, 3)
+	alice.handle(client.OnPostStatusRcvdNtfn(func(user *client.RemoteUser, pid clientintf.PostID,
+		statusFrom client.UserID, status rpc.PostMetadataStatus) {
+		if statusFrom == bob.PublicID() {
+			aliceRecvStatus <- status
+		}
+	}))
+	charlieRecvPosts := make(chan rpc.PostMetadata, 1)
+	charlie.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+		charlieRecvPosts <- pm
+	}))
+	subChanged := make(chan bool, 2)
+	handleSubChanged := client.OnRemoteSubscriptionChangedNtfn(func(user *client.RemoteUser, subscribed bool) {
+		subChanged <- subscribed
+	})
+	bob.handle(handleSubChanged)
+	charlie.handle(handleSubChanged)
+
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(bob, charlie)
+	assert.NilErr(t, bob.SubscribeToPosts(alice.PublicID()))
+	assert.ChanWrittenWithVal(t, subChanged, true)
+	assert.NilErr(t, charlie.SubscribeToPosts(bob.PublicID()))
+	assert.ChanWrittenWithVal(t, subChanged, true)
+
+	alicePosts := newPostsServiceClient(t, alice)
+	bobPosts := newPostsServiceClient(t, bob)
+	ctx := context.Background()
+
+	// Alice creates a post with an embed. The alt text includes chars that
+	// are part of the embed syntax.
+	wantEmbed := &types.PostEmbed{
+		Name: "sunset.txt",
+		Alt:  "sunset, at the beach ]-- alt=x",
+		Type: "text/plain",
+		Data: []byte("sunset"),
+	}
+	var createRes types.CreatePostResponse
+	err := alicePosts.CreatePost(ctx, &types.CreatePostRequest{
+		Content: "my post",
+		Embeds:  []*types.PostEmbed{wantEmbed},
+	}, &createRes)
+	assert.NilErr(t, err)
+	from, pid := createRes.Summary.From, createRes.Summary.Id
+
+	// Bob receives the post with the embed intact.
+	pm := assert.ChanWritten(t, bobRecvPosts)
+	var embeds []mdembeds.EmbeddedArgs
+	content := mdembeds.ReplaceEmbeds(pm.Attributes[rpc.RMPMain], func(args mdembeds.EmbeddedArgs) string {
+		embeds = append(embeds, args)
+		return ""
+	})
+	assert.DeepEqual(t, content, "my post\n")
+	assert.DeepEqual(t, len(embeds), 1)
+	assert.DeepEqual(t, embeds[0].Alt, wantEmbed.Alt)
+	assert.DeepEqual(t, embeds[0].Typ, wantEmbed.Type)
+	assert.DeepEqual(t, embeds[0].Data, wantEmbed.Data)
+
+	// Bob reads the post.
+	var readRes types.ReadPostResponse
+	err = bobPosts.ReadPost(ctx, &types.ReadPostRequest{From: from, PostId: pid}, &readRes)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, readRes.Post.Attributes[rpc.RMPMain], pm.Attributes[rpc.RMPMain])
+
+	// Bob comments on and hearts the post. Alice receives the updates.
+	var commentRes types.CommentPostResponse
+	err = bobPosts.CommentPost(ctx, &types.CommentPostRequest{
+		From:    from,
+		PostId:  pid,
+		Comment: "nice post",
+	}, &commentRes)
+	assert.NilErr(t, err)
+	status := assert.ChanWritten(t, aliceRecvStatus)
+	assert.DeepEqual(t, status.Attributes[rpc.RMPSComment], "nice post")
+
+	err = bobPosts.HeartPost(ctx, &types.HeartPostRequest{
+		From:   from,
+		PostId: pid,
+		Heart:  true,
+	}, &types.HeartPostResponse{})
+	assert.NilErr(t, err)
+	status = assert.ChanWritten(t, aliceRecvStatus)
+	assert.DeepEqual(t, status.Attributes[rpc.RMPSHeart], rpc.RMPSHeartYes)
+
+	// Empty comments are rejected.
+	err = bobPosts.CommentPost(ctx, &types.CommentPostRequest{
+		From:   from,
+		PostId: pid,
+	}, &commentRes)
+	if err == nil {
+		t.Fatal("expected error commenting an empty comment")
+	}
+
+	// Bob relays the post to Charlie.
+	err = bobPosts.RelayPost(ctx, &types.RelayPostRequest{
+		From:   from,
+		PostId: pid,
+		ToUser: "charlie",
+	}, &types.RelayPostResponse{})
+	assert.NilErr(t, err)
+	pm = assert.ChanWritten(t, charlieRecvPosts)
+	relayedPID := pm.Hash()
+	assert.DeepEqual(t, relayedPID[:], pid)
+}
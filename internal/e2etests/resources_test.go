@@ -1,12 +1,22 @@
 package e2etests
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/client/resources"
+	"github.com/companyzero/bisonrelay/client/resources/paywall"
 	"github.com/companyzero/bisonrelay/internal/assert"
 	"github.com/companyzero/bisonrelay/rpc"
 )
@@ -55,3 +65,390 @@ func TestFetchesFixedResource(t *testing.T) {
 	// Bob does not receive a reply.
 	assert.ChanNotWritten(t, chanResReply, time.Second)
 }
+
+// TestPaywallResource tests that resources behind a paywall are only
+// fulfilled after the remote user pays for access.
+func TestPaywallResource(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Setup Alice's paywalled resource.
+	const cost = 1000
+	resourcePath := resources.SplitPath("premium/page")
+	staticData := []byte("premium content")
+	pw, err := paywall.New(paywall.Config{
+		Client:     alice.Client,
+		Provider:   &resources.StaticResource{Data: staticData},
+		Name:       "premium",
+		MilliAtoms: cost,
+		Duration:   time.Hour,
+	})
+	assert.NilErr(t, err)
+	alice.modifyHandlers(func() {
+		r := resources.NewRouter()
+		r.BindPrefixPath(resourcePath[:1], pw)
+		alice.resourcesProvider = r
+	})
+
+	// Alice's invoices are settled once Bob pays them.
+	testDone := make(chan struct{})
+	t.Cleanup(func() { close(testDone) })
+	var invoicesMtx sync.Mutex
+	paidChans := make(map[string]chan struct{})
+	invoicePaidChan := func(inv string) chan struct{} {
+		invoicesMtx.Lock()
+		defer invoicesMtx.Unlock()
+		if paidChans[inv] == nil {
+			paidChans[inv] = make(chan struct{})
+		}
+		return paidChans[inv]
+	}
+	var nbInvoices int
+	alice.mpc.HookGetInvoice(func(amt int64, _ func(int64)) (string, error) {
+		nbInvoices++
+		return fmt.Sprintf("paywall-inv-%d", nbInvoices), nil
+	})
+	alice.mpc.HookTrackInvoice(func(inv string, amt int64) (int64, error) {
+		select {
+		case <-invoicePaidChan(inv):
+			return amt, nil
+		case <-testDone:
+			return 0, clientintf.ErrInvoiceExpired
+		}
+	})
+	bob.mpc.HookPayInvoice(func(inv string) (int64, error) {
+		close(invoicePaidChan(inv))
+		return 0, nil
+	})
+
+	// Handlers.
+	chanResReply := make(chan rpc.RMFetchResourceReply, 10)
+	bob.handle(client.OnResourceFetchedNtfn(func(user *client.RemoteUser,
+		fr clientdb.FetchedResource, sess clientdb.PageSessionOverview) {
+		chanResReply <- fr.Response
+	}))
+	invoiceChan := make(chan string, 10)
+	bob.handle(client.OnPaywallInvoiceNtfn(func(ru *client.RemoteUser, name,
+		invoice string, decoded clientintf.DecodedInvoice) {
+		assert.DeepEqual(t, name, "premium")
+		invoiceChan <- invoice
+	}))
+	grantedChan := make(chan clientdb.PaywallAccess, 10)
+	alice.handle(client.OnPaywallAccessGrantedNtfn(func(ru *client.RemoteUser,
+		access clientdb.PaywallAccess) {
+		grantedChan <- access
+	}))
+
+	// Bob fetches the resource without access. He receives an invoice
+	// instead of the resource.
+	_, err = bob.FetchResource(alice.PublicID(), resourcePath, nil, 0, 0, nil)
+	assert.NilErr(t, err)
+	res := assert.ChanWritten(t, chanResReply)
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatusPaymentRequired)
+	invoice := assert.ChanWritten(t, invoiceChan)
+	assert.DeepEqual(t, res.Meta[paywall.MetaInvoice], invoice)
+
+	// Fetching again reuses the outstanding invoice, which is not sent
+	// again.
+	_, err = bob.FetchResource(alice.PublicID(), resourcePath, nil, 0, 0, nil)
+	assert.NilErr(t, err)
+	res = assert.ChanWritten(t, chanResReply)
+	assert.DeepEqual(t, res.Meta[paywall.MetaInvoice], invoice)
+	assert.ChanNotWritten(t, invoiceChan, 100*time.Millisecond)
+
+	// Alice changes the terms of the paywall. Bob receives a new invoice.
+	pw2, err := paywall.New(paywall.Config{
+		Client:     alice.Client,
+		Provider:   &resources.StaticResource{Data: staticData},
+		Name:       "premium",
+		MilliAtoms: cost * 2,
+		Duration:   time.Hour,
+	})
+	assert.NilErr(t, err)
+	alice.modifyHandlers(func() {
+		r := resources.NewRouter()
+		r.BindPrefixPath(resourcePath[:1], pw2)
+		alice.resourcesProvider = r
+	})
+	_, err = bob.FetchResource(alice.PublicID(), resourcePath, nil, 0, 0, nil)
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, chanResReply)
+	if newInvoice := assert.ChanWritten(t, invoiceChan); newInvoice == invoice {
+		t.Fatalf("invoice was not replaced after terms changed")
+	}
+
+	// Bob pays the first invoice and Alice grants access for one hour.
+	assert.NilErr(t, bob.PayPaywallInvoice(alice.PublicID(), "premium", invoice))
+	access := assert.ChanWritten(t, grantedChan)
+	assert.DeepEqual(t, access.UID, bob.PublicID())
+	assert.DeepEqual(t, access.PaidMAtoms, int64(cost))
+	if d := time.Until(access.Expires); d < 59*time.Minute || d > time.Hour {
+		t.Fatalf("unexpected access expiration: %s", access.Expires)
+	}
+
+	// Bob now receives the resource.
+	_, err = bob.FetchResource(alice.PublicID(), resourcePath, nil, 0, 0, nil)
+	assert.NilErr(t, err)
+	res = assert.ChanWritten(t, chanResReply)
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatusOk)
+	assert.DeepEqual(t, res.Data, staticData)
+}
+
+// TestPaywallSubscriptionAccess tests that subscriptions to a product extend
+// the access to a paywall without shortening access that was paid for.
+func TestPaywallSubscriptionAccess(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	_, err := paywall.New(paywall.Config{
+		Client:              alice.Client,
+		Provider:            &resources.StaticResource{},
+		Name:                "premium",
+		MilliAtoms:          1000,
+		SubscriptionProduct: "sub01",
+	})
+	assert.NilErr(t, err)
+
+	// Subscription notifications are handled asynchronously.
+	notifySub := func(active bool, expires time.Time) {
+		t.Helper()
+		alice.NotifyProductSubscriptionChanged(client.ProductSubscription{
+			UID:     bob.PublicID(),
+			Product: "sub01",
+			Active:  active,
+			Expires: expires,
+		})
+		time.Sleep(250 * time.Millisecond)
+	}
+	assertExpires := func(want time.Time) {
+		t.Helper()
+		access, err := alice.PaywallAccess("premium", bob.PublicID())
+		assert.NilErr(t, err)
+		if !access.Expires.Equal(want) {
+			t.Fatalf("unexpected access expiration: got %s, want %s",
+				access.Expires, want)
+		}
+	}
+
+	// Bob has (paid) access for an hour.
+	now := time.Now().Truncate(time.Second)
+	paidExpires := now.Add(time.Hour)
+	assert.NilErr(t, alice.SetPaywallAccess("premium", bob.PublicID(), paidExpires))
+
+	// A shorter subscription does not shorten the access, and the lapse
+	// of the subscription does not revoke it.
+	notifySub(true, now.Add(10*time.Minute))
+	assertExpires(paidExpires)
+	notifySub(false, now.Add(10*time.Minute))
+	assertExpires(paidExpires)
+
+	// A longer subscription extends the access.
+	subExpires := now.Add(2 * time.Hour)
+	notifySub(true, subExpires)
+	assertExpires(subExpires)
+	notifySub(false, subExpires)
+	assertExpires(subExpires)
+
+	// Permanent access is kept.
+	assert.NilErr(t, alice.SetPaywallAccess("premium", bob.PublicID(), time.Time{}))
+	notifySub(true, now.Add(3*time.Hour))
+	assertExpires(time.Time{})
+}
+
+// TestResourceFormSubmission tests that form submissions are forwarded as POST
+// requests to upstream http providers and can be parsed by local providers.
+func TestResourceFormSubmission(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Setup the upstream http server.
+	type upstreamReq struct {
+		method      string
+		contentType string
+		uid         string
+		body        string
+	}
+	upstreamChan := make(chan upstreamReq, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		upstreamChan <- upstreamReq{
+			method:      r.Method,
+			contentType: r.Header.Get("Content-Type"),
+			uid:         r.Header.Get("X-BisonRelay-UID"),
+			body:        string(body),
+		}
+		if r.Method == http.MethodPost {
+			w.Header().Set("Location", "/poll/results")
+			w.WriteHeader(http.StatusSeeOther)
+			return
+		}
+		w.Write([]byte("poll page"))
+	}))
+	t.Cleanup(srv.Close)
+
+	// Setup Alice's resources: an upstream http server and a local
+	// provider that parses the submitted form.
+	formChan := make(chan interface{}, 10)
+	alice.modifyHandlers(func() {
+		r := resources.NewRouter()
+		r.BindPrefixPath([]string{"poll"}, resources.NewHttpProvider(srv.URL))
+		r.BindExactPath([]string{"signup"}, resources.ProviderFunc(func(ctx context.Context,
+			uid clientintf.UserID, req *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+			form, err := resources.ParseForm(req)
+			if err != nil {
+				formChan <- err
+			} else {
+				formChan <- form
+			}
+			return &rpc.RMFetchResourceReply{Status: rpc.ResourceStatusOk}, nil
+		}))
+		alice.resourcesProvider = r
+	})
+
+	chanResReply := make(chan rpc.RMFetchResourceReply, 10)
+	bob.handle(client.OnResourceFetchedNtfn(func(user *client.RemoteUser,
+		fr clientdb.FetchedResource, sess clientdb.PageSessionOverview) {
+		chanResReply <- fr.Response
+	}))
+
+	// Fetching a page without data is a GET request.
+	_, err := bob.FetchResource(alice.PublicID(), []string{"poll"}, nil, 0, 0, nil)
+	assert.NilErr(t, err)
+	req := assert.ChanWritten(t, upstreamChan)
+	assert.DeepEqual(t, req.method, http.MethodGet)
+	assert.DeepEqual(t, req.uid, bob.PublicID().String())
+	res := assert.ChanWritten(t, chanResReply)
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatusOk)
+	assert.DeepEqual(t, string(res.Data), "poll page")
+
+	// Submitting a form is a POST request. The upstream status is relayed
+	// back.
+	formData := json.RawMessage(`{"choice":"blue","count":2,"tags":["a","b"]}`)
+	_, err = bob.FetchResource(alice.PublicID(), []string{"poll"}, nil, 0, 0, formData)
+	assert.NilErr(t, err)
+	req = assert.ChanWritten(t, upstreamChan)
+	assert.DeepEqual(t, req.method, http.MethodPost)
+	assert.DeepEqual(t, req.contentType, rpc.ResourceContentTypeJSON)
+	assert.DeepEqual(t, req.body, string(formData))
+	res = assert.ChanWritten(t, chanResReply)
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatus(http.StatusSeeOther))
+	assert.DeepEqual(t, res.Meta["Location"], "/poll/results")
+
+	// The local provider parses the submitted fields.
+	_, err = bob.FetchResource(alice.PublicID(), []string{"signup"}, nil, 0, 0, formData)
+	assert.NilErr(t, err)
+	switch form := assert.ChanWritten(t, formChan).(type) {
+	case error:
+		t.Fatal(form)
+	case url.Values:
+		assert.DeepEqual(t, form, url.Values{
+			"choice": {"blue"},
+			"count":  {"2"},
+			"tags":   {"a", "b"},
+		})
+	}
+}
+
+// TestResourceCache tests that fetched resources are cached according to their
+// cache control meta, revalidated with their etag and served from the cache
+// while offline.
+func TestResourceCache(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Setup Alice's resources. The cache control of the replies is
+	// modifiable by the test.
+	freshPath := resources.SplitPath("fresh")
+	revalPath := resources.SplitPath("reval")
+	pageData := []byte("cached page")
+	chanReqs := make(chan rpc.RMFetchResource, 10)
+	alice.modifyHandlers(func() {
+		p := resources.ProviderFunc(func(ctx context.Context, uid clientintf.UserID,
+			req *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+			chanReqs <- *req
+			cacheControl := "max-age=3600"
+			if req.Path[0] == revalPath[0] {
+				cacheControl = "no-cache"
+			}
+			reply := &rpc.RMFetchResourceReply{
+				Status: rpc.ResourceStatusOk,
+				Data:   pageData,
+				Meta: map[string]string{
+					rpc.ResourceMetaCacheControl: cacheControl,
+				},
+			}
+			resources.ConditionalReply(req, reply)
+			return reply, nil
+		})
+		r := resources.NewRouter()
+		r.BindExactPath(freshPath, p)
+		r.BindExactPath(revalPath, p)
+		alice.resourcesProvider = r
+	})
+
+	chanResReply := make(chan rpc.RMFetchResourceReply, 10)
+	bob.handle(client.OnResourceFetchedNtfn(func(user *client.RemoteUser,
+		fr clientdb.FetchedResource, sess clientdb.PageSessionOverview) {
+		chanResReply <- fr.Response
+	}))
+
+	fetch := func(path []string) rpc.RMFetchResourceReply {
+		t.Helper()
+		tag, err := bob.FetchResource(alice.PublicID(), path, nil, 0, 0, nil)
+		assert.NilErr(t, err)
+		res := assert.ChanWritten(t, chanResReply)
+		assert.DeepEqual(t, res.Tag, tag)
+		assert.DeepEqual(t, res.Status, rpc.ResourceStatusOk)
+		assert.DeepEqual(t, res.Data, pageData)
+		return res
+	}
+
+	// First fetch of the fresh resource is sent to Alice. The second one
+	// is served from the cache.
+	fetch(freshPath)
+	assert.ChanWritten(t, chanReqs)
+	res := fetch(freshPath)
+	assert.DeepEqual(t, res.Meta[client.ResourceMetaCached], "fresh")
+	assert.ChanNotWritten(t, chanReqs, 500*time.Millisecond)
+
+	// Resources that must be revalidated are sent with their etag and
+	// Alice replies that the resource was not modified.
+	fetch(revalPath)
+	req := assert.ChanWritten(t, chanReqs)
+	assert.DeepEqual(t, req.Meta[rpc.ResourceMetaIfNoneMatch], "")
+	fetch(revalPath)
+	req = assert.ChanWritten(t, chanReqs)
+	assert.DeepEqual(t, req.Meta[rpc.ResourceMetaIfNoneMatch],
+		resources.ResourceETag(pageData))
+
+	// While offline, the cached resource is used.
+	assertGoesOffline(t, bob)
+	res = fetch(revalPath)
+	assert.DeepEqual(t, res.Meta[client.ResourceMetaCached], "stale")
+	assert.ChanNotWritten(t, chanReqs, 500*time.Millisecond)
+
+	// Cached resources are listed.
+	crs, err := bob.ListCachedResources(nil)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(crs), 2)
+}
@@ -91,6 +91,22 @@ func (nfp NumberedFilePattern) Last(dir string) (MatchedNumberedFile, error) {
 	return res, err
 }
 
+// First returns the number and filename of the file with lowest number in the
+// dir.
+//
+// If no file is found, the returned filename is empty.
+func (nfp NumberedFilePattern) First(dir string) (MatchedNumberedFile, error) {
+	var res MatchedNumberedFile
+	err := nfp.walkFiles(dir, func(name string, i uint64) error {
+		if res.Filename == "" || i < res.ID {
+			res.ID = i
+			res.Filename = name
+		}
+		return nil
+	})
+	return res, err
+}
+
 // FilenameFor returns the filename for a given number.
 func (nfp NumberedFilePattern) FilenameFor(i uint64) string {
 	return fmt.Sprintf(nfp.nameFmt, i)
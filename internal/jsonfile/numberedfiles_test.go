@@ -96,6 +96,16 @@ func testNumberedFiles(t *testing.T, prefix, suffix string, base int, isDir bool
 			t.Fatalf("unexpected Last() element: got %v, want %v",
 				gotLast, wantLast)
 		}
+		gotFirst, err := nfp.First(dir)
+		assert.NilErr(t, err)
+		var wantFirst MatchedNumberedFile
+		if len(want) > 0 {
+			wantFirst = want[0]
+		}
+		if gotFirst != wantFirst {
+			t.Fatalf("unexpected First() element: got %v, want %v",
+				gotFirst, wantFirst)
+		}
 	}
 
 	// Before any files exist.
@@ -186,6 +196,8 @@ func TestNumberedFilesDirDoesNotExist(t *testing.T) {
 	nfp := MakeDecimalFilePattern("", "", false)
 	_, err := nfp.Last(dir)
 	assert.NilErr(t, err)
+	_, err = nfp.First(dir)
+	assert.NilErr(t, err)
 	_, err = nfp.MatchFiles(dir)
 	assert.NilErr(t, err)
 }
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/zkidentity"
@@ -25,6 +26,12 @@ type EmbeddedArgs struct {
 	Size     uint64
 	Cost     uint64
 
+	// media metadata
+	Codec     string
+	Duration  time.Duration
+	Thumbnail []byte
+	FileID    zkidentity.ShortID
+
 	// processed locally
 	LocalFilename string
 
@@ -32,22 +39,54 @@ type EmbeddedArgs struct {
 	Uid *clientintf.UserID
 }
 
+// escapeArg percent-encodes the characters of a free-text argument that would
+// break the embed syntax, along with the percent sign itself, such that the
+// argument may be decoded with url.PathUnescape. Other characters are kept
+// as-is, so that common values (such as mime types) are readable by older
+// clients.
+func escapeArg(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '%', c == ',', c == '[', c == ']', c < 0x20, c == 0x7f:
+			fmt.Fprintf(&b, "%%%02X", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// unescapeArg decodes a free-text argument encoded by escapeArg. Arguments
+// that are not validly encoded (for example, those created by older clients
+// that did not escape them) are returned as-is.
+func unescapeArg(s string) string {
+	decoded, err := url.PathUnescape(s)
+	if err != nil {
+		return s
+	}
+	return decoded
+}
+
+// String returns the embed string for the args. Free-text args are escaped,
+// so they may contain any character.
 func (args EmbeddedArgs) String() string {
 	var parts []string
 	if args.Name != "" {
-		parts = append(parts, "part="+args.Name)
+		parts = append(parts, "name="+escapeArg(args.Name))
 	}
 	if args.Alt != "" {
-		parts = append(parts, "alt="+args.Alt)
+		parts = append(parts, "alt="+escapeArg(args.Alt))
 	}
 	if args.Typ != "" {
-		parts = append(parts, "type="+args.Typ)
+		parts = append(parts, "type="+escapeArg(args.Typ))
 	}
 	if !args.Download.IsEmpty() {
 		parts = append(parts, "download="+args.Download.String())
 	}
 	if args.Filename != "" {
-		parts = append(parts, "filename="+args.Filename)
+		parts = append(parts, "filename="+escapeArg(args.Filename))
 	}
 	if args.Size > 0 {
 		parts = append(parts, "size="+strconv.FormatUint(args.Size, 10))
@@ -55,6 +94,18 @@ func (args EmbeddedArgs) String() string {
 	if args.Cost > 0 {
 		parts = append(parts, "cost="+strconv.FormatUint(args.Cost, 10))
 	}
+	if args.Codec != "" {
+		parts = append(parts, "codec="+escapeArg(args.Codec))
+	}
+	if args.Duration > 0 {
+		parts = append(parts, "duration="+strconv.FormatInt(args.Duration.Milliseconds(), 10))
+	}
+	if args.Thumbnail != nil {
+		parts = append(parts, "thumbnail="+base64.StdEncoding.EncodeToString(args.Thumbnail))
+	}
+	if !args.FileID.IsEmpty() {
+		parts = append(parts, "fileid="+args.FileID.String())
+	}
 	if args.Data != nil {
 		parts = append(parts, "data="+base64.StdEncoding.EncodeToString(args.Data))
 	}
@@ -71,7 +122,7 @@ func FindAllStringIndex(s string) [][]int {
 }
 
 // ParseEmbedArgs parses the given raw embed string, which should be --[]--,
-// with the embed conted between brackets.
+// with the embed conted between brackets. Free-text args are unescaped.
 func ParseEmbedArgs(rawEmbedStr string) EmbeddedArgs {
 	// Copy everything between the [] (the raw argument list).
 	start, end := strings.Index(rawEmbedStr, "["), strings.LastIndex(rawEmbedStr, "]")
@@ -90,10 +141,11 @@ func ParseEmbedArgs(rawEmbedStr string) EmbeddedArgs {
 		}
 		k, v := kv[0], kv[1]
 		switch k {
-		case "name":
-			args.Name = v
+		case "name", "part":
+			// Older clients wrote the name as "part".
+			args.Name = unescapeArg(v)
 		case "type":
-			args.Typ = v
+			args.Typ = unescapeArg(v)
 		case "data":
 			decoded, err := base64.StdEncoding.DecodeString(v)
 			if err != nil {
@@ -101,22 +153,29 @@ func ParseEmbedArgs(rawEmbedStr string) EmbeddedArgs {
 			}
 			args.Data = decoded
 		case "alt":
-			decoded, err := url.PathUnescape(v)
-			if err != nil {
-				decoded = fmt.Sprintf("[err processing alt: %v]", err)
-			}
-			args.Alt = decoded
+			args.Alt = unescapeArg(v)
 		case "download":
 			// Ignore the error and leave download empty.
 			args.Download.FromString(v)
 		case "filename":
-			args.Filename = v
+			args.Filename = unescapeArg(v)
 		case "size":
 			args.Size, _ = strconv.ParseUint(v, 10, 64)
 		case "cost":
 			args.Cost, _ = strconv.ParseUint(v, 10, 64)
+		case "codec":
+			args.Codec = unescapeArg(v)
+		case "duration":
+			ms, _ := strconv.ParseInt(v, 10, 64)
+			args.Duration = time.Duration(ms) * time.Millisecond
+		case "thumbnail":
+			// Ignore the error and leave the thumbnail empty.
+			args.Thumbnail, _ = base64.StdEncoding.DecodeString(v)
+		case "fileid":
+			// Ignore the error and leave the file id empty.
+			args.FileID.FromString(v)
 		case "localfilename":
-			args.LocalFilename = v
+			args.LocalFilename = unescapeArg(v)
 		}
 	}
 
@@ -3,6 +3,7 @@ package mdembeds
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/companyzero/bisonrelay/zkidentity"
 )
@@ -46,6 +47,26 @@ func TestReplaceEmbeds(t *testing.T) {
 		src:      "first " + testRawArg + " second " + testRawArg + " end",
 		wantArgs: []EmbeddedArgs{testArg, testArg},
 		wantDst:  "first xxx second xxx end",
+	}, {
+		name: "media metadata",
+		src:  "start --embed[type=video/webm,filename=clip.webm,size=1000,codec=vp8,duration=1500,thumbnail=dGh1bWI=]-- end",
+		wantArgs: []EmbeddedArgs{{
+			Typ:       "video/webm",
+			Filename:  "clip.webm",
+			Size:      1000,
+			Codec:     "vp8",
+			Duration:  1500 * time.Millisecond,
+			Thumbnail: []byte("thumb"),
+		}},
+		wantDst: "start xxx end",
+	}, {
+		name: "legacy part name and unescaped args",
+		src:  "start --embed[part=file.txt,filename=100% done.txt]-- end",
+		wantArgs: []EmbeddedArgs{{
+			Name:     "file.txt",
+			Filename: "100% done.txt",
+		}},
+		wantDst: "start xxx end",
 	}, {
 		name:     "broken download id",
 		src:      "start --embed[alt=alt,download=broken]-- end",
@@ -80,3 +101,81 @@ func TestReplaceEmbeds(t *testing.T) {
 		})
 	}
 }
+
+// TestMediaEmbedRoundTrip tests that media metadata is preserved when encoding
+// and decoding embeds.
+func TestMediaEmbedRoundTrip(t *testing.T) {
+	args := EmbeddedArgs{
+		Typ:       "audio/ogg",
+		Filename:  "note.opus",
+		Codec:     "opus",
+		Duration:  2750 * time.Millisecond,
+		Thumbnail: []byte{0x01, 0x02, 0x03},
+		Data:      []byte("audio data"),
+	}
+	got := ParseEmbedArgs(args.String())
+	if !reflect.DeepEqual(got, args) {
+		t.Fatalf("unexpected args: got %#v, want %#v", got, args)
+	}
+}
+
+// TestEmbedRoundTrip tests that all args are preserved when encoding and
+// decoding embeds, including free-text args with characters that are part of
+// the embed syntax.
+func TestEmbedRoundTrip(t *testing.T) {
+	const tricky = "a,b=c]--[d] 100%20 %zz\nline"
+	tests := []struct {
+		name string
+		args EmbeddedArgs
+	}{{
+		name: "plain",
+		args: EmbeddedArgs{
+			Name:     "file.txt",
+			Alt:      "some alt",
+			Typ:      "text/plain",
+			Data:     []byte("test"),
+			Download: mustDecodeID("891534a17af07aacd247a78e33ea93de5c5c590138af784eef3d9a7164968f4c"),
+			Filename: "file.txt",
+			Size:     1000,
+			Cost:     2000,
+		},
+	}, {
+		name: "media file",
+		args: EmbeddedArgs{
+			Typ:       "video/webm",
+			Filename:  "clip.webm",
+			Size:      1 << 20,
+			Codec:     "vp8, opus",
+			Duration:  1500 * time.Millisecond,
+			Thumbnail: []byte("thumb"),
+			FileID:    mustDecodeID("0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"),
+		},
+	}, {
+		name: "free-text args with syntax chars",
+		args: EmbeddedArgs{
+			Name:     tricky,
+			Alt:      tricky,
+			Typ:      tricky,
+			Filename: tricky,
+			Codec:    tricky,
+		},
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "start " + tc.args.String() + " end"
+			var got []EmbeddedArgs
+			dst := ReplaceEmbeds(src, func(args EmbeddedArgs) string {
+				got = append(got, args)
+				return "xxx"
+			})
+			if dst != "start xxx end" {
+				t.Fatalf("unexpected final string: %s", dst)
+			}
+			if len(got) != 1 || !reflect.DeepEqual(got[0], tc.args) {
+				t.Fatalf("unexpected args: got %#v, want %#v", got, tc.args)
+			}
+		})
+	}
+}
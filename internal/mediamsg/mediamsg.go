@@ -0,0 +1,307 @@
+// Package mediamsg packages audio and video files as media messages that can
+// be embedded in PMs and GC messages.
+package mediamsg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// MaxThumbnailSize is the max size of a thumbnail included in a media message.
+const MaxThumbnailSize = 32 * 1024
+
+// Media is an audio or video file, along with its metadata.
+type Media struct {
+	// Path is the local path to the media file. It may be empty if Data is
+	// specified.
+	Path string
+
+	// Filename is the name of the file, as presented to the remote user.
+	Filename string
+
+	// Data is the contents of the file. It may be nil if Path is
+	// specified.
+	Data []byte
+
+	// Size is the size of the file.
+	Size uint64
+
+	// MimeType is the mime type of the file (for example, audio/ogg).
+	MimeType string
+
+	// Codec is the codec used to encode the media (for example, opus).
+	Codec string
+
+	// Duration is the duration of the media.
+	Duration time.Duration
+
+	// Thumbnail is an optional small image that represents the media.
+	Thumbnail []byte
+
+	// Alt is a short description of the media.
+	Alt string
+
+	// FileID is the ID of the file transfer that sends the media, when
+	// it is too large to be embedded in the message.
+	FileID zkidentity.ShortID
+}
+
+type extInfo struct {
+	mimeType string
+	codec    string
+}
+
+// knownExts are the file extensions of known media types.
+var knownExts = map[string]extInfo{
+	".opus": {"audio/ogg", "opus"},
+	".ogg":  {"audio/ogg", ""},
+	".oga":  {"audio/ogg", ""},
+	".mp3":  {"audio/mpeg", "mp3"},
+	".m4a":  {"audio/mp4", "aac"},
+	".wav":  {"audio/wav", "pcm"},
+	".flac": {"audio/flac", "flac"},
+	".webm": {"video/webm", ""},
+	".ogv":  {"video/ogg", ""},
+	".mp4":  {"video/mp4", ""},
+	".mov":  {"video/quicktime", ""},
+	".mkv":  {"video/x-matroska", ""},
+}
+
+// IsMediaType returns true if the mime type is of an audio or video file.
+func IsMediaType(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "audio/") ||
+		strings.HasPrefix(mimeType, "video/")
+}
+
+// IsVideo returns true if the media is a video.
+func (m *Media) IsVideo() bool {
+	return strings.HasPrefix(m.MimeType, "video/")
+}
+
+// fillFromExt fills the mime type and codec of the media based on the
+// extension of its filename, if they are not yet specified.
+func (m *Media) fillFromExt() {
+	ext := strings.ToLower(filepath.Ext(m.Filename))
+	info, ok := knownExts[ext]
+	if !ok {
+		return
+	}
+	if m.MimeType == "" {
+		m.MimeType = info.mimeType
+	}
+	if m.Codec == "" {
+		m.Codec = info.codec
+	}
+}
+
+// probeOpus fills the codec and duration of the media if it is an Ogg Opus
+// stream.
+func (m *Media) probeOpus(r io.ReaderAt, size int64) {
+	if m.MimeType != "audio/ogg" || (m.Codec != "" && m.Codec != "opus") {
+		return
+	}
+	d, err := OggOpusDuration(r, size)
+	if err != nil {
+		return
+	}
+	m.Codec = "opus"
+	if m.Duration == 0 {
+		m.Duration = d
+	}
+}
+
+// LoadFile loads the metadata of the media file at the given path. The
+// contents of the file are not read into Data.
+func LoadFile(path string) (Media, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Media{}, err
+	}
+	defer f.Close()
+
+	st, err := f.Stat()
+	if err != nil {
+		return Media{}, err
+	}
+	if st.IsDir() {
+		return Media{}, fmt.Errorf("%s is a directory", path)
+	}
+
+	m := Media{
+		Path:     path,
+		Filename: filepath.Base(path),
+		Size:     uint64(st.Size()),
+	}
+	m.fillFromExt()
+	if !IsMediaType(m.MimeType) {
+		return Media{}, fmt.Errorf("file %s is not a known audio or "+
+			"video file", m.Filename)
+	}
+	m.probeOpus(f, st.Size())
+	return m, nil
+}
+
+// FromData creates a new media from in-memory data.
+func FromData(filename string, data []byte) Media {
+	m := Media{
+		Filename: filename,
+		Data:     data,
+		Size:     uint64(len(data)),
+	}
+	m.fillFromExt()
+	m.probeOpus(bytes.NewReader(data), int64(len(data)))
+	return m
+}
+
+// Validate returns an error if the media is not valid to be sent.
+func (m *Media) Validate() error {
+	if m.Path == "" && m.Data == nil {
+		return errors.New("media does not have a path or data")
+	}
+	if m.Filename == "" {
+		return errors.New("media does not have a filename")
+	}
+	if strings.ContainsAny(m.Filename, ",]") {
+		return fmt.Errorf("media filename %q has invalid chars", m.Filename)
+	}
+	if !IsMediaType(m.MimeType) {
+		return fmt.Errorf("mime type %q is not an audio or video type",
+			m.MimeType)
+	}
+	if strings.ContainsAny(m.Codec, ",]") {
+		return fmt.Errorf("media codec %q has invalid chars", m.Codec)
+	}
+	if len(m.Thumbnail) > MaxThumbnailSize {
+		return fmt.Errorf("thumbnail size %d is greater than max %d",
+			len(m.Thumbnail), MaxThumbnailSize)
+	}
+	if m.Duration < 0 {
+		return errors.New("media duration cannot be negative")
+	}
+	return nil
+}
+
+// ReadData reads the contents of the media file into Data, if needed.
+func (m *Media) ReadData() error {
+	if m.Data != nil {
+		return nil
+	}
+	data, err := os.ReadFile(m.Path)
+	if err != nil {
+		return err
+	}
+	m.Data = data
+	m.Size = uint64(len(data))
+	return nil
+}
+
+// EmbedArgs returns the embed args for the media. If inline is true, the data
+// of the media is included in the embed.
+func (m *Media) EmbedArgs(inline bool) mdembeds.EmbeddedArgs {
+	args := mdembeds.EmbeddedArgs{
+		Alt:       m.Alt,
+		Typ:       m.MimeType,
+		Filename:  m.Filename,
+		Codec:     m.Codec,
+		Duration:  m.Duration,
+		Thumbnail: m.Thumbnail,
+	}
+	if inline {
+		args.Data = m.Data
+	} else {
+		args.Size = m.Size
+		args.FileID = m.FileID
+	}
+	return args
+}
+
+// FromEmbedArgs returns the media defined in the embed args. Returns false if
+// the embed is not of an audio or video file.
+func FromEmbedArgs(args mdembeds.EmbeddedArgs) (Media, bool) {
+	if !IsMediaType(args.Typ) {
+		return Media{}, false
+	}
+	m := Media{
+		Filename:  args.Filename,
+		Data:      args.Data,
+		Size:      args.Size,
+		MimeType:  args.Typ,
+		Codec:     args.Codec,
+		Duration:  args.Duration,
+		Thumbnail: args.Thumbnail,
+		Alt:       args.Alt,
+		FileID:    args.FileID,
+	}
+	if m.Data != nil {
+		m.Size = uint64(len(m.Data))
+	}
+	return m, true
+}
+
+// ParseMessage returns all media embedded in the given message.
+func ParseMessage(msg string) []Media {
+	var res []Media
+	for _, idx := range mdembeds.FindAllStringIndex(msg) {
+		args := mdembeds.ParseEmbedArgs(msg[idx[0]:idx[1]])
+		if m, ok := FromEmbedArgs(args); ok {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+const (
+	oggSig           = "OggS"
+	oggHeaderLen     = 27
+	opusHeadSig      = "OpusHead"
+	opusSampleRate   = 48000
+	oggProbeTailSize = 64 * 1024
+)
+
+// OggOpusDuration returns the duration of the Ogg Opus stream read from r.
+func OggOpusDuration(r io.ReaderAt, size int64) (time.Duration, error) {
+	// The first page must contain the OpusHead packet.
+	head := make([]byte, oggHeaderLen+255+19)
+	n, err := r.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, err
+	}
+	head = head[:n]
+	if len(head) < oggHeaderLen || string(head[:4]) != oggSig {
+		return 0, errors.New("not an ogg stream")
+	}
+	dataStart := oggHeaderLen + int(head[26])
+	if len(head) < dataStart+12 || string(head[dataStart:dataStart+8]) != opusHeadSig {
+		return 0, errors.New("not an opus stream")
+	}
+	preSkip := uint64(binary.LittleEndian.Uint16(head[dataStart+10:]))
+
+	// The duration is determined by the granule position of the last page.
+	tailStart := max(0, size-oggProbeTailSize)
+	tail := make([]byte, size-tailStart)
+	n, err = r.ReadAt(tail, tailStart)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, err
+	}
+	tail = tail[:n]
+	i := bytes.LastIndex(tail, []byte(oggSig))
+	if i < 0 || len(tail) < i+14 {
+		return 0, errors.New("last ogg page not found")
+	}
+	granule := binary.LittleEndian.Uint64(tail[i+6:])
+	if granule < preSkip {
+		return 0, nil
+	}
+	samples := granule - preSkip
+	return time.Duration(samples) * time.Second / opusSampleRate, nil
+}
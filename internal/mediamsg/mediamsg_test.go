@@ -0,0 +1,143 @@
+package mediamsg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// oggPage returns a minimal ogg page with the given granule position and a
+// single segment.
+func oggPage(granule uint64, payload []byte) []byte {
+	page := make([]byte, oggHeaderLen+1)
+	copy(page, oggSig)
+	binary.LittleEndian.PutUint64(page[6:], granule)
+	page[26] = 1
+	page[27] = byte(len(payload))
+	return append(page, payload...)
+}
+
+// testOpusFile returns a fake Ogg Opus stream with the given pre-skip and final
+// granule position.
+func testOpusFile(preSkip uint16, granule uint64) []byte {
+	opusHead := make([]byte, 19)
+	copy(opusHead, opusHeadSig)
+	opusHead[8] = 1
+	opusHead[9] = 1
+	binary.LittleEndian.PutUint16(opusHead[10:], preSkip)
+
+	var b bytes.Buffer
+	b.Write(oggPage(0, opusHead))
+	b.Write(oggPage(0, []byte("OpusTags")))
+	b.Write(oggPage(granule/2, bytes.Repeat([]byte{0xaa}, 200)))
+	b.Write(oggPage(granule, bytes.Repeat([]byte{0xbb}, 200)))
+	return b.Bytes()
+}
+
+// TestOggOpusDuration tests determining the duration of Ogg Opus streams.
+func TestOggOpusDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    time.Duration
+		wantErr bool
+	}{{
+		name: "2.5 seconds",
+		data: testOpusFile(312, 312+opusSampleRate*5/2),
+		want: 2500 * time.Millisecond,
+	}, {
+		name: "granule before pre-skip",
+		data: testOpusFile(312, 100),
+		want: 0,
+	}, {
+		name:    "not ogg",
+		data:    []byte("not an ogg file at all, but long enough to be read"),
+		wantErr: true,
+	}, {
+		name:    "ogg without opus",
+		data:    oggPage(0, []byte("vorbis header......")),
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := OggOpusDuration(bytes.NewReader(tc.data), int64(len(tc.data)))
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("unexpected error: got %v, want err %v",
+					err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Fatalf("unexpected duration: got %s, want %s",
+					got, tc.want)
+			}
+		})
+	}
+}
+
+// TestLoadFile tests loading the metadata of media files.
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	opusPath := filepath.Join(dir, "note.ogg")
+	opusData := testOpusFile(0, opusSampleRate*3)
+	if err := os.WriteFile(opusPath, opusData, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadFile(opusPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Media{
+		Path:     opusPath,
+		Filename: "note.ogg",
+		Size:     uint64(len(opusData)),
+		MimeType: "audio/ogg",
+		Codec:    "opus",
+		Duration: 3 * time.Second,
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("unexpected media: got %#v, want %#v", m, want)
+	}
+
+	txtPath := filepath.Join(dir, "file.txt")
+	if err := os.WriteFile(txtPath, []byte("text"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadFile(txtPath); err == nil {
+		t.Fatal("expected error when loading non-media file")
+	}
+}
+
+// TestParseMessage tests that media embedded in messages is parsed back.
+func TestParseMessage(t *testing.T) {
+	m := FromData("clip.webm", []byte("video data"))
+	m.Duration = 1500 * time.Millisecond
+	m.Thumbnail = []byte("thumb")
+	m.Alt = "short clip, 1.5s"
+	if err := m.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	inline := m.EmbedArgs(true)
+	ref := m.EmbedArgs(false)
+	msg := "caption " + inline.String() + " text --embed[type=image/png,data=AA==]-- " +
+		ref.String()
+	got := ParseMessage(msg)
+	if len(got) != 2 {
+		t.Fatalf("unexpected nb of media: got %d, want 2", len(got))
+	}
+
+	wantInline := m
+	if !reflect.DeepEqual(got[0], wantInline) {
+		t.Fatalf("unexpected inline media: got %#v, want %#v", got[0], wantInline)
+	}
+	wantRef := m
+	wantRef.Data = nil
+	if !reflect.DeepEqual(got[1], wantRef) {
+		t.Fatalf("unexpected ref media: got %#v, want %#v", got[1], wantRef)
+	}
+}
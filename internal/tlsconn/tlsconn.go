@@ -41,6 +41,10 @@ type TLSListenersConfig struct {
 	ClientKeyPath               string
 	CreateClientCertIfNotExists bool
 
+	// NextProtos are the application protocols advertised during the TLS
+	// handshake (e.g. "h2" for gRPC listeners).
+	NextProtos []string
+
 	Log slog.Logger
 }
 
@@ -302,6 +306,7 @@ func TLSListeners(cfg TLSListenersConfig) ([]net.Listener, error) {
 	tlsConfig := &tls.Config{
 		Certificates: []tls.Certificate{cert},
 		MinVersion:   tls.VersionTLS12,
+		NextProtos:   cfg.NextProtos,
 	}
 
 	// Setup client cert auth.
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"strconv"
 	"time"
 
@@ -129,6 +130,11 @@ type RMInvoice struct {
 	Invoice string
 	Tag     uint32
 	Error   *string `json:"error,omitempty"`
+
+	// Paywall is set when the invoice grants access to the resources
+	// behind the named paywall (instead of being the reply to an
+	// RMGetInvoice request).
+	Paywall string `json:"paywall,omitempty"`
 }
 
 const RMCKXSuggestion = "kxsuggestion"
@@ -173,9 +179,42 @@ func (rs ResourceStatus) String() string {
 }
 
 const (
-	ResourceStatusOk         = 200
-	ResourceStatusBadRequest = 400
-	ResourceStatusNotFound   = 404
+	ResourceStatusOk              = 200
+	ResourceStatusNotModified     = 304
+	ResourceStatusBadRequest      = 400
+	ResourceStatusPaymentRequired = 402
+	ResourceStatusNotFound        = 404
+)
+
+// Meta keys used for caching resources. These follow the canonical form of the
+// corresponding HTTP headers, so that they are relayed unchanged by HTTP
+// upstream providers.
+const (
+	// ResourceMetaCacheControl is set in replies to control how the
+	// resource may be cached. Supported directives are "no-store",
+	// "no-cache" and "max-age=<seconds>".
+	ResourceMetaCacheControl = "Cache-Control"
+
+	// ResourceMetaETag is set in replies to identify the version of the
+	// resource.
+	ResourceMetaETag = "Etag"
+
+	// ResourceMetaIfNoneMatch is set in requests with the ETag of the
+	// cached version of the resource. Providers may reply with
+	// ResourceStatusNotModified (and no data) if it is still current.
+	ResourceMetaIfNoneMatch = "If-None-Match"
+)
+
+// Methods of resource requests.
+const (
+	ResourceMethodGet  = "GET"
+	ResourceMethodPost = "POST"
+)
+
+// Content types of the data of resource requests.
+const (
+	ResourceContentTypeJSON = "application/json"
+	ResourceContentTypeForm = "application/x-www-form-urlencoded"
 )
 
 const RMCFetchResource = "fetchresource"
@@ -187,6 +226,84 @@ type RMFetchResource struct {
 	Data  json.RawMessage   `json:"data"`
 	Index uint32            `json:"index"`
 	Count uint32            `json:"count"`
+
+	// Method and ContentType are not sent by older clients. Use
+	// RequestMethod() and RequestContentType() to handle their defaults.
+	//
+	// Data is always encoded as json. For content types other than json,
+	// Data is a json string with the raw request body (see Body() and
+	// SetBody()).
+	Method      string `json:"method,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// isJSONContentType returns true if the media type of the given content type
+// is json, ignoring any parameters (such as charset).
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == ResourceContentTypeJSON
+}
+
+// SetBody sets the data of the request to the given body of the given content
+// type.
+func (rm *RMFetchResource) SetBody(contentType string, body []byte) error {
+	rm.ContentType = contentType
+	if isJSONContentType(contentType) {
+		if !json.Valid(body) {
+			return fmt.Errorf("invalid json request body")
+		}
+		rm.Data = body
+		return nil
+	}
+	data, err := json.Marshal(string(body))
+	if err != nil {
+		return err
+	}
+	rm.Data = data
+	return nil
+}
+
+// Body returns the raw body of the request, decoding it from Data according
+// to the content type of the request.
+func (rm *RMFetchResource) Body() ([]byte, error) {
+	if len(rm.Data) == 0 {
+		return nil, nil
+	}
+	if isJSONContentType(rm.RequestContentType()) {
+		return rm.Data, nil
+	}
+	var body string
+	if err := json.Unmarshal(rm.Data, &body); err != nil {
+		return nil, fmt.Errorf("unable to decode request body: %v", err)
+	}
+	return []byte(body), nil
+}
+
+// RequestMethod returns the method of the request. Older clients do not send
+// a method, but submit forms by sending data, so requests without a method
+// are assumed to be POST requests if they have data and GET requests
+// otherwise.
+func (rm *RMFetchResource) RequestMethod() string {
+	switch {
+	case rm.Method != "":
+		return rm.Method
+	case len(rm.Data) > 0:
+		return ResourceMethodPost
+	default:
+		return ResourceMethodGet
+	}
+}
+
+// RequestContentType returns the content type of the request data. Older
+// clients do not send a content type, but always send json data.
+func (rm *RMFetchResource) RequestContentType() string {
+	if rm.ContentType != "" {
+		return rm.ContentType
+	}
+	if len(rm.Data) > 0 {
+		return ResourceContentTypeJSON
+	}
+	return ""
 }
 
 const RMCFetchResourceReply = "fetchresourcereply"
@@ -302,9 +419,21 @@ func ComposeCompressedRM(fromSigner MessageSigner, rm interface{}, zlibLevel int
 	case RMGroupList:
 		h.Command = RMCGroupList
 
+	case RMGroupListChunk:
+		h.Command = RMCGroupListChunk
+
+	case RMGroupListRequest:
+		h.Command = RMCGroupListRequest
+
+	case RMGroupMembersDelta:
+		h.Command = RMCGroupMembersDelta
+
 	case RMGroupMessage:
 		h.Command = RMCGroupMessage
 
+	case RMGCHistory:
+		h.Command = RMCGCHistory
+
 	// File transfer
 	case RMFTList:
 		h.Command = RMCFTList
@@ -384,6 +513,16 @@ func ComposeCompressedRM(fromSigner MessageSigner, rm interface{}, zlibLevel int
 	case RMFetchResourceReply:
 		h.Command = RMCFetchResourceReply
 
+	// Calls
+	case RMCallOffer:
+		h.Command = RMCCallOffer
+
+	case RMCallAnswer:
+		h.Command = RMCCallAnswer
+
+	case RMCallHangup:
+		h.Command = RMCCallHangup
+
 	// Purely transitive commands
 
 	default:
@@ -621,6 +760,26 @@ func DecomposeRM(msgVerifier MessageVerifier, mb []byte, maxDecompressSize uint)
 		err = pmd.Decode(&groupList)
 		payload = groupList
 
+	case RMCGroupListChunk:
+		var groupListChunk RMGroupListChunk
+		err = pmd.Decode(&groupListChunk)
+		payload = groupListChunk
+
+	case RMCGroupListRequest:
+		var groupListReq RMGroupListRequest
+		err = pmd.Decode(&groupListReq)
+		payload = groupListReq
+
+	case RMCGroupMembersDelta:
+		var groupMembersDelta RMGroupMembersDelta
+		err = pmd.Decode(&groupMembersDelta)
+		payload = groupMembersDelta
+
+	case RMCGCHistory:
+		var gcHistory RMGCHistory
+		err = pmd.Decode(&gcHistory)
+		payload = gcHistory
+
 	// File transfer
 	case RMCFTList:
 		var ftList RMFTList
@@ -755,6 +914,22 @@ func DecomposeRM(msgVerifier MessageVerifier, mb []byte, maxDecompressSize uint)
 		err = pmd.Decode(&fetchResReply)
 		payload = fetchResReply
 
+	// Calls
+	case RMCCallOffer:
+		var callOffer RMCallOffer
+		err = pmd.Decode(&callOffer)
+		payload = callOffer
+
+	case RMCCallAnswer:
+		var callAnswer RMCallAnswer
+		err = pmd.Decode(&callAnswer)
+		payload = callAnswer
+
+	case RMCCallHangup:
+		var callHangup RMCallHangup
+		err = pmd.Decode(&callHangup)
+		payload = callHangup
+
 	// Purely transitive commands
 
 	default:
@@ -902,10 +1077,56 @@ type RMGroupList struct {
 	// ExtraAdmins are additional admins. Members[0] is still considered
 	// an admin in version 1 GCs.
 	ExtraAdmins []zkidentity.ShortID `json:"extra_admins"`
+
+	// Version 2 fields.
+
+	// Channel flags this GC as an announcement-only channel. Only
+	// Members[0] may publish messages in a channel and the list sent to
+	// subscribers only includes the owner and the subscriber itself.
+	Channel bool `json:"channel,omitempty"`
 }
 
 const RMCGroupList = "grouplist"
 
+// MaxGroupListChunks is the maximum number of chunks a GC list may be split
+// into.
+const MaxGroupListChunks = 1024
+
+// RMGroupListChunk is a part of a GC definition that is too large to be sent
+// in a single RMGroupList. The List field contains the full GC definition,
+// except that Members only includes the members of this chunk. The full
+// members list is the concatenation of the members of every chunk, in order.
+type RMGroupListChunk struct {
+	List        RMGroupList `json:"list"`
+	Chunk       uint32      `json:"chunk"`
+	TotalChunks uint32      `json:"total_chunks"`
+}
+
+const RMCGroupListChunk = "grouplistchunk"
+
+// RMGroupListRequest is sent by a GC member to a GC admin to request the full
+// GC definition. This is used when the member detects it missed updates.
+type RMGroupListRequest struct {
+	ID zkidentity.ShortID `json:"id"` // group id
+}
+
+const RMCGroupListRequest = "grouplistrequest"
+
+// RMGroupMembersDelta is an incremental update to the members of a version 2
+// GC. It is sent instead of a full RMGroupList to existing members when a
+// member is added or removed. A delta is only applicable to a GC definition
+// at generation Generation-1.
+type RMGroupMembersDelta struct {
+	ID         zkidentity.ShortID   `json:"id"`         // group id
+	Generation uint64               `json:"generation"` // generation after applying the delta
+	Timestamp  int64                `json:"timestamp"`
+	Added      []zkidentity.ShortID `json:"added,omitempty"`
+	Removed    []zkidentity.ShortID `json:"removed,omitempty"`
+	Reason     string               `json:"reason,omitempty"` // reason for removals
+}
+
+const RMCGroupMembersDelta = "groupmembersdelta"
+
 // RMGroupMessage is a message to a group.
 type RMGroupMessage struct {
 	ID         zkidentity.ShortID `json:"id"`         // group name
@@ -916,6 +1137,55 @@ type RMGroupMessage struct {
 
 const RMCGroupMessage = "groupmessage"
 
+// MaxGCHistoryEntries is the maximum number of entries accepted in a GC
+// history bundle.
+const MaxGCHistoryEntries = 500
+
+// RMGCHistoryEntry is a single message of a GC history bundle.
+type RMGCHistoryEntry struct {
+	From      string `json:"from"` // Nick of the original sender
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// RMGCHistory is a bundle of recent GC messages sent by an existing member to
+// a newly joined member of the GC. The signature is made by the sender over
+// Hash().
+type RMGCHistory struct {
+	ID        zkidentity.ShortID            `json:"id"` // group id
+	Entries   []RMGCHistoryEntry            `json:"entries"`
+	Signature zkidentity.FixedSizeSignature `json:"signature"`
+}
+
+// Hash returns the hash of the history bundle, which is used as the message
+// signed by the sender.
+func (gh *RMGCHistory) Hash() [32]byte {
+	h := blake256.New()
+	var b [32]byte
+
+	writeUint64 := func(i uint64) {
+		binary.LittleEndian.PutUint64(b[:], i)
+		h.Write(b[:])
+	}
+	writeString := func(s string) {
+		writeUint64(uint64(len(s)))
+		h.Write([]byte(s))
+	}
+
+	h.Write(gh.ID[:])
+	writeUint64(uint64(len(gh.Entries)))
+	for _, e := range gh.Entries {
+		writeString(e.From)
+		writeString(e.Message)
+		writeUint64(uint64(e.Timestamp))
+	}
+
+	copy(b[:], h.Sum(nil))
+	return b
+}
+
+const RMCGCHistory = "gchistory"
+
 // RMFTList asks other side for a list of files. Directories are constants that
 // describe which directories it should access. Currently only "global" and
 // "shared" are allowed.
@@ -923,6 +1193,10 @@ type RMFTList struct {
 	Directories []string `json:"directories"`      // Which directories to obtain
 	Filter      string   `json:"filter,omitempty"` // Filter list by this regex
 	Tag         uint32   `json:"tag"`              // Tag to copy in replies
+
+	// Hashes, when specified, restricts the list to files with one of
+	// these content hashes (FileMetadata.Hash).
+	Hashes []string `json:"hashes,omitempty"`
 }
 
 const (
@@ -938,6 +1212,13 @@ type FileManifest struct {
 	Hash  []byte `json:"hash"`
 }
 
+// BundleFile is one of the files included in a multi-file bundle.
+type BundleFile struct {
+	Path string `json:"path"` // Slash-separated, relative to the bundle root
+	Size uint64 `json:"size"`
+	Hash string `json:"hash"`
+}
+
 type FileMetadata struct {
 	Version     uint64            `json:"version"`
 	Cost        uint64            `json:"cost"`
@@ -949,9 +1230,47 @@ type FileMetadata struct {
 	Manifest    []FileManifest    `json:"manifest"` // len == number of chunks
 	Signature   string            `json:"signature"`
 	Attributes  map[string]string `json:"attributes,omitempty"`
+
+	// Files is the list of files of a bundle. The contents of a bundle are
+	// the concatenation of the contents of its files, in order.
+	Files []BundleFile `json:"files,omitempty"`
 }
 
-const FileMetadataVersion = 1
+const (
+	FileMetadataVersion = 1
+
+	// FileMetadataVersionBundle is the version of the metadata of
+	// multi-file bundles.
+	FileMetadataVersionBundle = 2
+)
+
+// IsBundle returns true if the metadata is of a multi-file bundle.
+func (fm *FileMetadata) IsBundle() bool {
+	return fm.Version >= FileMetadataVersionBundle && len(fm.Files) > 0
+}
+
+// BundleSigHash returns the hash that is signed by the host of a bundle. It
+// commits to the hash of the bundle contents and its list of files.
+//
+// Variable length fields are prefixed with their length, so that different
+// lists of files cannot produce the same hash.
+func (fm *FileMetadata) BundleSigHash(contentHash []byte) []byte {
+	h := sha256.New()
+	var b [8]byte
+	writeUint64 := func(i uint64) {
+		binary.LittleEndian.PutUint64(b[:], i)
+		h.Write(b[:])
+	}
+	h.Write(contentHash)
+	for _, f := range fm.Files {
+		writeUint64(uint64(len(f.Path)))
+		h.Write([]byte(f.Path))
+		writeUint64(f.Size)
+		writeUint64(uint64(len(f.Hash)))
+		h.Write([]byte(f.Hash))
+	}
+	return h.Sum(nil)
+}
 
 // MetadataHash calculates the hash of the metadata info. Note that the specific
 // information that is hashed depends on the version of the metadata.
@@ -974,6 +1293,15 @@ func (fm *FileMetadata) MetadataHash() [32]byte {
 	writeStr(fm.Hash)
 	writeStr(fm.Signature)
 
+	if fm.Version >= FileMetadataVersionBundle {
+		writeUint64(uint64(len(fm.Files)))
+		for _, f := range fm.Files {
+			writeStr(f.Path)
+			writeUint64(f.Size)
+			writeStr(f.Hash)
+		}
+	}
+
 	// In the future, add new fields conditional on the metadata version so
 	// that old versions will still calculate the same hash.
 
@@ -1304,3 +1632,33 @@ type RMProfileUpdate struct {
 
 // RMCProfileUpdate is the command for a RMProfileUpdate.
 const RMCProfileUpdate = "profileupdt"
+
+// RMCallOffer is an offer to start a real-time voice call with the remote
+// user. The media packets of the call are encrypted with keys and pushed to
+// RV points derived from Secret.
+type RMCallOffer struct {
+	CallID       zkidentity.ShortID `json:"call_id"`
+	Secret       [32]byte           `json:"secret"`
+	Codec        string             `json:"codec"`
+	FrameMs      uint32             `json:"frame_ms"`      // Duration of each frame
+	PacketFrames uint32             `json:"packet_frames"` // Frames per packet
+}
+
+const RMCCallOffer = "calloffer"
+
+// RMCallAnswer is the answer to a call offer.
+type RMCallAnswer struct {
+	CallID   zkidentity.ShortID `json:"call_id"`
+	Accepted bool               `json:"accepted"`
+	Reason   string             `json:"reason,omitempty"`
+}
+
+const RMCCallAnswer = "callanswer"
+
+// RMCallHangup ends (or cancels the offer of) a call.
+type RMCallHangup struct {
+	CallID zkidentity.ShortID `json:"call_id"`
+	Reason string             `json:"reason,omitempty"`
+}
+
+const RMCCallHangup = "callhangup"
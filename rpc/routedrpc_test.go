@@ -370,3 +370,71 @@ func TestMetadataStatusHash(t *testing.T) {
 		})
 	}
 }
+
+// TestBundleSigHashAmbiguousFiles tests that lists of bundle files that
+// serialize to the same bytes when concatenated produce different hashes.
+func TestBundleSigHashAmbiguousFiles(t *testing.T) {
+	contentHash := bytes.Repeat([]byte{0x01}, 32)
+	fm1 := FileMetadata{Files: []BundleFile{
+		{Path: "ab", Size: 1, Hash: "00"},
+		{Path: "c", Size: 2, Hash: "01"},
+	}}
+	fm2 := FileMetadata{Files: []BundleFile{
+		{Path: "a", Size: 1, Hash: "00"},
+		{Path: "bc", Size: 2, Hash: "01"},
+	}}
+	fm3 := FileMetadata{Files: []BundleFile{
+		{Path: "ab", Size: 1, Hash: "0"},
+		{Path: "0c", Size: 2, Hash: "01"},
+	}}
+
+	hashes := map[string]int{}
+	for i, fm := range []FileMetadata{fm1, fm2, fm3} {
+		h := hex.EncodeToString(fm.BundleSigHash(contentHash))
+		if j, ok := hashes[h]; ok {
+			t.Fatalf("bundle %d has the same hash as bundle %d", i, j)
+		}
+		hashes[h] = i
+	}
+}
+
+// TestFetchResourceBody tests encoding and decoding the body of resource
+// requests of different content types.
+func TestFetchResourceBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		rawJSON     bool
+	}{
+		{"json", "application/json", `{"a":1}`, true},
+		{"json with charset", "application/json; charset=utf-8", `{"a":1}`, true},
+		{"json mixed case", "Application/JSON", `{"a":1}`, true},
+		{"form", "application/x-www-form-urlencoded", "a=1&b=2", false},
+		{"text", "text/plain; charset=utf-8", `{"a":1}`, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var rm RMFetchResource
+			if err := rm.SetBody(tc.contentType, []byte(tc.body)); err != nil {
+				t.Fatal(err)
+			}
+			if gotRaw := string(rm.Data) == tc.body; gotRaw != tc.rawJSON {
+				t.Fatalf("unexpected encoding of data: %s", rm.Data)
+			}
+			body, err := rm.Body()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != tc.body {
+				t.Fatalf("unexpected body: got %q, want %q", body, tc.body)
+			}
+		})
+	}
+
+	// Invalid json is rejected regardless of content type parameters.
+	var rm RMFetchResource
+	if err := rm.SetBody("application/json; charset=utf-8", []byte("{")); err == nil {
+		t.Fatal("expected error setting invalid json body")
+	}
+}